@@ -14,6 +14,8 @@ import (
 	"github.com/go-tangra/go-tangra-common/registration"
 	"github.com/go-tangra/go-tangra-common/service"
 	"github.com/go-tangra/go-tangra-sharing/cmd/server/assets"
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/server"
 )
 
 var (
@@ -29,6 +31,13 @@ func newApp(
 	ctx *bootstrap.Context,
 	gs *grpc.Server,
 	hs *kratosHttp.Server,
+	ms *server.MTLSServer,
+	ps *server.MetricsServer,
+	ew *server.ExpiryWorker,
+	nw *server.NotificationWorker,
+	ww *server.WebhookWorker,
+	gw *server.GeoIPWorker,
+	rw *server.ReputationWorker,
 ) *kratos.App {
 	globalRegHelper = registration.StartRegistration(ctx, ctx.GetLogger(), &registration.Config{
 		ModuleID:          moduleID,
@@ -47,7 +56,12 @@ func newApp(
 		MaxRetries:        60,
 	})
 
-	return bootstrap.NewApp(ctx, gs, hs)
+	return bootstrap.NewApp(ctx, gs, hs, ms, ps, ew, nw, ww, gw, rw)
+}
+
+// newAuthorizer builds the role permission table from the embedded menu definitions
+func newAuthorizer() (*authz.Authorizer, error) {
+	return authz.NewAuthorizer(assets.MenusData)
 }
 
 func runApp() error {
@@ -22,6 +22,7 @@ func initApp(*bootstrap.Context) (*kratos.App, func(), error) {
 			dataProviders.ProviderSet,
 			serverProviders.ProviderSet,
 			serviceProviders.ProviderSet,
+			newAuthorizer,
 			newApp,
 		),
 	)
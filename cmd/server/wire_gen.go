@@ -10,6 +10,8 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-tangra/go-tangra-sharing/internal/cert"
 	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
 	"github.com/go-tangra/go-tangra-sharing/internal/server"
 	"github.com/go-tangra/go-tangra-sharing/internal/service"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -30,6 +32,13 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	sharedLinkRepo := data.NewSharedLinkRepo(context, entClient)
 	emailTemplateRepo := data.NewEmailTemplateRepo(context, entClient)
 	sharePolicyRepo := data.NewSharePolicyRepo(context, entClient)
+	policySetRepo := data.NewPolicySetRepo(context, entClient)
+	shareAccessEventRepo := data.NewShareAccessEventRepo(context, entClient)
+	notificationPreferenceRepo := data.NewNotificationPreferenceRepo(context, entClient)
+	riskSettingsRepo := data.NewRiskSettingsRepo(context, entClient)
+	webhookRepo := data.NewWebhookRepo(context, entClient)
+	webhookDeliveryRepo := data.NewWebhookDeliveryRepo(context, entClient)
+	webhookDispatcher := service.NewWebhookDispatcher(context, webhookRepo, webhookDeliveryRepo)
 	wardenClient, cleanup2, err := data.NewWardenClient(context)
 	if err != nil {
 		cleanup()
@@ -42,13 +51,48 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		return nil, nil, err
 	}
 	sender := data.NewMailSender()
-	shareService := service.NewShareService(context, sharedLinkRepo, emailTemplateRepo, sharePolicyRepo, wardenClient, paperlessClient, sender)
-	templateService := service.NewTemplateService(context, emailTemplateRepo)
+	resolver, cleanup4, err := geoip.NewResolver(context)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	feeds, cleanup5, err := reputation.NewFeeds(context)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	shareService := service.NewShareService(context, sharedLinkRepo, emailTemplateRepo, sharePolicyRepo, policySetRepo, shareAccessEventRepo, notificationPreferenceRepo, riskSettingsRepo, webhookDispatcher, wardenClient, paperlessClient, sender, resolver, feeds)
+	templateService := service.NewTemplateService(context, emailTemplateRepo, webhookDispatcher)
+	policySetService := service.NewPolicySetService(context, policySetRepo, sharedLinkRepo, webhookDispatcher, feeds)
 	backupService := service.NewBackupService(context, entClient)
-	grpcServer := server.NewGRPCServer(context, certManager, shareService, templateService, backupService)
+	webhookService := service.NewWebhookService(context, webhookRepo, webhookDeliveryRepo, webhookDispatcher)
+	authorizer, err := newAuthorizer()
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(context, certManager, authorizer, shareService, templateService, policySetService, backupService, webhookService)
 	httpServer := server.NewHTTPServer(context, shareService)
-	app := newApp(context, grpcServer, httpServer)
+	mtlsServer := server.NewMTLSServer(context, shareService)
+	metricsServer := server.NewMetricsServer(context, shareService)
+	expiryWorker := server.NewExpiryWorker(context, shareService)
+	notificationWorker := server.NewNotificationWorker(context, shareService)
+	webhookWorker := server.NewWebhookWorker(context, webhookDispatcher)
+	geoIPWorker := server.NewGeoIPWorker(context, resolver)
+	reputationWorker := server.NewReputationWorker(context, feeds)
+	app := newApp(context, grpcServer, httpServer, mtlsServer, metricsServer, expiryWorker, notificationWorker, webhookWorker, geoIPWorker, reputationWorker)
 	return app, func() {
+		cleanup5()
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
@@ -82,11 +82,22 @@ type SharePolicyMethod int32
 const (
 	SharePolicyMethod_SHARE_POLICY_METHOD_UNSPECIFIED SharePolicyMethod = 0
 	SharePolicyMethod_SHARE_POLICY_METHOD_IP          SharePolicyMethod = 1
-	SharePolicyMethod_SHARE_POLICY_METHOD_MAC         SharePolicyMethod = 2
-	SharePolicyMethod_SHARE_POLICY_METHOD_REGION      SharePolicyMethod = 3
-	SharePolicyMethod_SHARE_POLICY_METHOD_TIME        SharePolicyMethod = 4
-	SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE      SharePolicyMethod = 5
-	SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK     SharePolicyMethod = 6 // CIDR notation e.g. 10.1.111.0/24
+	// Deprecated: client MAC addresses are not visible over HTTP, so MAC
+	// policies never match. Use CERTIFICATE policies instead.
+	//
+	// Deprecated: Marked as deprecated in sharing/service/v1/share.proto.
+	SharePolicyMethod_SHARE_POLICY_METHOD_MAC        SharePolicyMethod = 2
+	SharePolicyMethod_SHARE_POLICY_METHOD_REGION     SharePolicyMethod = 3
+	SharePolicyMethod_SHARE_POLICY_METHOD_TIME       SharePolicyMethod = 4
+	SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE     SharePolicyMethod = 5
+	SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK    SharePolicyMethod = 6 // CIDR notation e.g. 10.1.111.0/24
+	SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION SharePolicyMethod = 7 // CEL expression over the request, e.g. country == "BG" && attempt_count < 3
+	// Client certificate presented on the mTLS listener, e.g. "sha256:<fingerprint>",
+	// "subject:CN=alice,O=Acme", "issuer:CN=Acme Device CA" or "ca-sha256:<fingerprint>"
+	SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE SharePolicyMethod = 8
+	// Client IP on one of the comma-separated reputation lists loaded from
+	// local feed files, e.g. "tor,vpn"
+	SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION SharePolicyMethod = 9
 )
 
 // Enum value maps for SharePolicyMethod.
@@ -99,6 +110,9 @@ var (
 		4: "SHARE_POLICY_METHOD_TIME",
 		5: "SHARE_POLICY_METHOD_DEVICE",
 		6: "SHARE_POLICY_METHOD_NETWORK",
+		7: "SHARE_POLICY_METHOD_EXPRESSION",
+		8: "SHARE_POLICY_METHOD_CERTIFICATE",
+		9: "SHARE_POLICY_METHOD_REPUTATION",
 	}
 	SharePolicyMethod_value = map[string]int32{
 		"SHARE_POLICY_METHOD_UNSPECIFIED": 0,
@@ -108,6 +122,9 @@ var (
 		"SHARE_POLICY_METHOD_TIME":        4,
 		"SHARE_POLICY_METHOD_DEVICE":      5,
 		"SHARE_POLICY_METHOD_NETWORK":     6,
+		"SHARE_POLICY_METHOD_EXPRESSION":  7,
+		"SHARE_POLICY_METHOD_CERTIFICATE": 8,
+		"SHARE_POLICY_METHOD_REPUTATION":  9,
 	}
 )
 
@@ -138,6 +155,116 @@ func (SharePolicyMethod) EnumDescriptor() ([]byte, []int) {
 	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{1}
 }
 
+// How the policies of a share are combined into a decision
+type SharePolicyMode int32
+
+const (
+	// Same as ALL_MUST_PASS
+	SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED SharePolicyMode = 0
+	// Every method must pass: any matching blacklist entry denies, and for each
+	// method with whitelist entries at least one of them must match
+	SharePolicyMode_SHARE_POLICY_MODE_ALL_MUST_PASS SharePolicyMode = 1
+	// Any matching blacklist entry denies, otherwise any matching whitelist
+	// entry, of whichever method, allows
+	SharePolicyMode_SHARE_POLICY_MODE_ANY_WHITELIST SharePolicyMode = 2
+	// Policies are checked in priority order and the first match decides
+	SharePolicyMode_SHARE_POLICY_MODE_FIRST_MATCH SharePolicyMode = 3
+)
+
+// Enum value maps for SharePolicyMode.
+var (
+	SharePolicyMode_name = map[int32]string{
+		0: "SHARE_POLICY_MODE_UNSPECIFIED",
+		1: "SHARE_POLICY_MODE_ALL_MUST_PASS",
+		2: "SHARE_POLICY_MODE_ANY_WHITELIST",
+		3: "SHARE_POLICY_MODE_FIRST_MATCH",
+	}
+	SharePolicyMode_value = map[string]int32{
+		"SHARE_POLICY_MODE_UNSPECIFIED":   0,
+		"SHARE_POLICY_MODE_ALL_MUST_PASS": 1,
+		"SHARE_POLICY_MODE_ANY_WHITELIST": 2,
+		"SHARE_POLICY_MODE_FIRST_MATCH":   3,
+	}
+)
+
+func (x SharePolicyMode) Enum() *SharePolicyMode {
+	p := new(SharePolicyMode)
+	*p = x
+	return p
+}
+
+func (x SharePolicyMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SharePolicyMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[2].Descriptor()
+}
+
+func (SharePolicyMode) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[2]
+}
+
+func (x SharePolicyMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SharePolicyMode.Descriptor instead.
+func (SharePolicyMode) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{2}
+}
+
+// Decision taken when no policy decides
+type SharePolicyDefault int32
+
+const (
+	// Same as ALLOW
+	SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED SharePolicyDefault = 0
+	SharePolicyDefault_SHARE_POLICY_DEFAULT_ALLOW       SharePolicyDefault = 1
+	SharePolicyDefault_SHARE_POLICY_DEFAULT_DENY        SharePolicyDefault = 2
+)
+
+// Enum value maps for SharePolicyDefault.
+var (
+	SharePolicyDefault_name = map[int32]string{
+		0: "SHARE_POLICY_DEFAULT_UNSPECIFIED",
+		1: "SHARE_POLICY_DEFAULT_ALLOW",
+		2: "SHARE_POLICY_DEFAULT_DENY",
+	}
+	SharePolicyDefault_value = map[string]int32{
+		"SHARE_POLICY_DEFAULT_UNSPECIFIED": 0,
+		"SHARE_POLICY_DEFAULT_ALLOW":       1,
+		"SHARE_POLICY_DEFAULT_DENY":        2,
+	}
+)
+
+func (x SharePolicyDefault) Enum() *SharePolicyDefault {
+	p := new(SharePolicyDefault)
+	*p = x
+	return p
+}
+
+func (x SharePolicyDefault) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SharePolicyDefault) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[3].Descriptor()
+}
+
+func (SharePolicyDefault) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[3]
+}
+
+func (x SharePolicyDefault) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SharePolicyDefault.Descriptor instead.
+func (SharePolicyDefault) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{3}
+}
+
 // Resource type being shared
 type ResourceType int32
 
@@ -172,11 +299,11 @@ func (x ResourceType) String() string {
 }
 
 func (ResourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_sharing_service_v1_share_proto_enumTypes[2].Descriptor()
+	return file_sharing_service_v1_share_proto_enumTypes[4].Descriptor()
 }
 
 func (ResourceType) Type() protoreflect.EnumType {
-	return &file_sharing_service_v1_share_proto_enumTypes[2]
+	return &file_sharing_service_v1_share_proto_enumTypes[4]
 }
 
 func (x ResourceType) Number() protoreflect.EnumNumber {
@@ -185,19 +312,262 @@ func (x ResourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResourceType.Descriptor instead.
 func (ResourceType) EnumDescriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{2}
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{4}
+}
+
+// Outcome of an attempt to open a shared link
+type ShareAccessOutcome int32
+
+const (
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_UNSPECIFIED     ShareAccessOutcome = 0
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_GRANTED         ShareAccessOutcome = 1
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_POLICY_DENIED   ShareAccessOutcome = 2
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ALREADY_VIEWED  ShareAccessOutcome = 3
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_REVOKED         ShareAccessOutcome = 4
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_NOT_FOUND       ShareAccessOutcome = 5
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ERROR           ShareAccessOutcome = 6
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_EXPIRED         ShareAccessOutcome = 7
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH ShareAccessOutcome = 8
+	// A risky attempt was asked for a passphrase or email code
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED ShareAccessOutcome = 9
+	// A risky attempt presented a wrong passphrase or email code
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_FAILED ShareAccessOutcome = 10
+	// The risk score of the attempt was too high
+	ShareAccessOutcome_SHARE_ACCESS_OUTCOME_RISK_DENIED ShareAccessOutcome = 11
+)
+
+// Enum value maps for ShareAccessOutcome.
+var (
+	ShareAccessOutcome_name = map[int32]string{
+		0:  "SHARE_ACCESS_OUTCOME_UNSPECIFIED",
+		1:  "SHARE_ACCESS_OUTCOME_GRANTED",
+		2:  "SHARE_ACCESS_OUTCOME_POLICY_DENIED",
+		3:  "SHARE_ACCESS_OUTCOME_ALREADY_VIEWED",
+		4:  "SHARE_ACCESS_OUTCOME_REVOKED",
+		5:  "SHARE_ACCESS_OUTCOME_NOT_FOUND",
+		6:  "SHARE_ACCESS_OUTCOME_ERROR",
+		7:  "SHARE_ACCESS_OUTCOME_EXPIRED",
+		8:  "SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH",
+		9:  "SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED",
+		10: "SHARE_ACCESS_OUTCOME_STEP_UP_FAILED",
+		11: "SHARE_ACCESS_OUTCOME_RISK_DENIED",
+	}
+	ShareAccessOutcome_value = map[string]int32{
+		"SHARE_ACCESS_OUTCOME_UNSPECIFIED":      0,
+		"SHARE_ACCESS_OUTCOME_GRANTED":          1,
+		"SHARE_ACCESS_OUTCOME_POLICY_DENIED":    2,
+		"SHARE_ACCESS_OUTCOME_ALREADY_VIEWED":   3,
+		"SHARE_ACCESS_OUTCOME_REVOKED":          4,
+		"SHARE_ACCESS_OUTCOME_NOT_FOUND":        5,
+		"SHARE_ACCESS_OUTCOME_ERROR":            6,
+		"SHARE_ACCESS_OUTCOME_EXPIRED":          7,
+		"SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH":  8,
+		"SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED": 9,
+		"SHARE_ACCESS_OUTCOME_STEP_UP_FAILED":   10,
+		"SHARE_ACCESS_OUTCOME_RISK_DENIED":      11,
+	}
+)
+
+func (x ShareAccessOutcome) Enum() *ShareAccessOutcome {
+	p := new(ShareAccessOutcome)
+	*p = x
+	return p
+}
+
+func (x ShareAccessOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareAccessOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[5].Descriptor()
+}
+
+func (ShareAccessOutcome) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[5]
+}
+
+func (x ShareAccessOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareAccessOutcome.Descriptor instead.
+func (ShareAccessOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{5}
+}
+
+// Lifecycle status of a share
+type ShareStatus int32
+
+const (
+	ShareStatus_SHARE_STATUS_UNSPECIFIED ShareStatus = 0
+	ShareStatus_SHARE_STATUS_ACTIVE      ShareStatus = 1 // Not yet viewed, revoked or expired
+	ShareStatus_SHARE_STATUS_VIEWED      ShareStatus = 2
+	ShareStatus_SHARE_STATUS_REVOKED     ShareStatus = 3
+	ShareStatus_SHARE_STATUS_EXPIRED     ShareStatus = 4 // Expired before being viewed
+)
+
+// Enum value maps for ShareStatus.
+var (
+	ShareStatus_name = map[int32]string{
+		0: "SHARE_STATUS_UNSPECIFIED",
+		1: "SHARE_STATUS_ACTIVE",
+		2: "SHARE_STATUS_VIEWED",
+		3: "SHARE_STATUS_REVOKED",
+		4: "SHARE_STATUS_EXPIRED",
+	}
+	ShareStatus_value = map[string]int32{
+		"SHARE_STATUS_UNSPECIFIED": 0,
+		"SHARE_STATUS_ACTIVE":      1,
+		"SHARE_STATUS_VIEWED":      2,
+		"SHARE_STATUS_REVOKED":     3,
+		"SHARE_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ShareStatus) Enum() *ShareStatus {
+	p := new(ShareStatus)
+	*p = x
+	return p
+}
+
+func (x ShareStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[6].Descriptor()
+}
+
+func (ShareStatus) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[6]
+}
+
+func (x ShareStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareStatus.Descriptor instead.
+func (ShareStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{6}
+}
+
+// Field to sort shares by
+type ShareSortField int32
+
+const (
+	ShareSortField_SHARE_SORT_FIELD_UNSPECIFIED     ShareSortField = 0 // Defaults to create time
+	ShareSortField_SHARE_SORT_FIELD_CREATE_TIME     ShareSortField = 1
+	ShareSortField_SHARE_SORT_FIELD_RESOURCE_NAME   ShareSortField = 2
+	ShareSortField_SHARE_SORT_FIELD_RECIPIENT_EMAIL ShareSortField = 3
+)
+
+// Enum value maps for ShareSortField.
+var (
+	ShareSortField_name = map[int32]string{
+		0: "SHARE_SORT_FIELD_UNSPECIFIED",
+		1: "SHARE_SORT_FIELD_CREATE_TIME",
+		2: "SHARE_SORT_FIELD_RESOURCE_NAME",
+		3: "SHARE_SORT_FIELD_RECIPIENT_EMAIL",
+	}
+	ShareSortField_value = map[string]int32{
+		"SHARE_SORT_FIELD_UNSPECIFIED":     0,
+		"SHARE_SORT_FIELD_CREATE_TIME":     1,
+		"SHARE_SORT_FIELD_RESOURCE_NAME":   2,
+		"SHARE_SORT_FIELD_RECIPIENT_EMAIL": 3,
+	}
+)
+
+func (x ShareSortField) Enum() *ShareSortField {
+	p := new(ShareSortField)
+	*p = x
+	return p
+}
+
+func (x ShareSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[7].Descriptor()
+}
+
+func (ShareSortField) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[7]
+}
+
+func (x ShareSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareSortField.Descriptor instead.
+func (ShareSortField) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{7}
+}
+
+// Sort direction
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0 // Defaults to descending
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_share_proto_enumTypes[8].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_share_proto_enumTypes[8]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{8}
 }
 
 // Share policy restriction entity
 type SharePolicy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ShareLinkId   string                 `protobuf:"bytes,2,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
-	Type          SharePolicyType        `protobuf:"varint,3,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
-	Method        SharePolicyMethod      `protobuf:"varint,4,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
-	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShareLinkId string                 `protobuf:"bytes,2,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	Type        SharePolicyType        `protobuf:"varint,3,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
+	Method      SharePolicyMethod      `protobuf:"varint,4,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
+	Value       string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Reason      string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreateTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Policies with lower priority are evaluated first
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Set on policies of a deprecated method, which should be replaced
+	Deprecated    bool `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -281,6 +651,20 @@ func (x *SharePolicy) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SharePolicy) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SharePolicy) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
 // Shared link entity
 type SharedLink struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -298,8 +682,30 @@ type SharedLink struct {
 	CreatedBy      *uint32                `protobuf:"varint,12,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
 	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	Policies       []*SharePolicy         `protobuf:"bytes,14,rep,name=policies,proto3" json:"policies,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	SenderEmail    string                 `protobuf:"bytes,15,opt,name=sender_email,json=senderEmail,proto3" json:"sender_email,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// User the upstream service authorized to read the shared resource
+	AuthorizedBy *uint32 `protobuf:"varint,17,opt,name=authorized_by,json=authorizedBy,proto3,oneof" json:"authorized_by,omitempty"`
+	// Upstream service and permission that authorized the share (e.g. "warden:share")
+	AuthorizedVia string `protobuf:"bytes,18,opt,name=authorized_via,json=authorizedVia,proto3" json:"authorized_via,omitempty"`
+	// When the upstream service authorized the share
+	AuthorizedAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=authorized_at,json=authorizedAt,proto3,oneof" json:"authorized_at,omitempty"`
+	// Policy sets whose rules apply to the share in addition to its own policies
+	PolicySetIds  []string           `protobuf:"bytes,20,rep,name=policy_set_ids,json=policySetIds,proto3" json:"policy_set_ids,omitempty"`
+	PolicyMode    SharePolicyMode    `protobuf:"varint,21,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	PolicyDefault SharePolicyDefault `protobuf:"varint,22,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
+	// How many times the share can be viewed
+	MaxViews uint32 `protobuf:"varint,23,opt,name=max_views,json=maxViews,proto3" json:"max_views,omitempty"`
+	// How many times the share has been viewed
+	ViewCount uint32 `protobuf:"varint,24,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// Whether all views must come from the device of the first view
+	BindDevice bool `protobuf:"varint,25,opt,name=bind_device,json=bindDevice,proto3" json:"bind_device,omitempty"`
+	// When the share was bound to the device of its first view
+	DeviceBoundAt *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=device_bound_at,json=deviceBoundAt,proto3,oneof" json:"device_bound_at,omitempty"`
+	// Whether the share has a passphrase for step-up challenges
+	HasPassphrase bool `protobuf:"varint,27,opt,name=has_passphrase,json=hasPassphrase,proto3" json:"has_passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SharedLink) Reset() {
@@ -430,6 +836,97 @@ func (x *SharedLink) GetPolicies() []*SharePolicy {
 	return nil
 }
 
+func (x *SharedLink) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
+}
+
+func (x *SharedLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SharedLink) GetAuthorizedBy() uint32 {
+	if x != nil && x.AuthorizedBy != nil {
+		return *x.AuthorizedBy
+	}
+	return 0
+}
+
+func (x *SharedLink) GetAuthorizedVia() string {
+	if x != nil {
+		return x.AuthorizedVia
+	}
+	return ""
+}
+
+func (x *SharedLink) GetAuthorizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthorizedAt
+	}
+	return nil
+}
+
+func (x *SharedLink) GetPolicySetIds() []string {
+	if x != nil {
+		return x.PolicySetIds
+	}
+	return nil
+}
+
+func (x *SharedLink) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *SharedLink) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+func (x *SharedLink) GetMaxViews() uint32 {
+	if x != nil {
+		return x.MaxViews
+	}
+	return 0
+}
+
+func (x *SharedLink) GetViewCount() uint32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *SharedLink) GetBindDevice() bool {
+	if x != nil {
+		return x.BindDevice
+	}
+	return false
+}
+
+func (x *SharedLink) GetDeviceBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeviceBoundAt
+	}
+	return nil
+}
+
+func (x *SharedLink) GetHasPassphrase() bool {
+	if x != nil {
+		return x.HasPassphrase
+	}
+	return false
+}
+
 // Request to create a share
 type CreateShareRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -444,7 +941,24 @@ type CreateShareRequest struct {
 	// Optional template ID (uses default if not specified)
 	TemplateId *string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
 	// Optional access restriction policies
-	Policies      []*CreateSharePolicyInput `protobuf:"bytes,6,rep,name=policies,proto3" json:"policies,omitempty"`
+	Policies []*CreateSharePolicyInput `protobuf:"bytes,6,rep,name=policies,proto3" json:"policies,omitempty"`
+	// Optional address for sender notifications (defaults to the caller's username if it is an email)
+	NotifyEmail *string `protobuf:"bytes,7,opt,name=notify_email,json=notifyEmail,proto3,oneof" json:"notify_email,omitempty"`
+	// Optional expiry; the link stops working if not viewed by then
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// Optional policy sets whose rules apply to the share
+	PolicySetIds []string `protobuf:"bytes,9,rep,name=policy_set_ids,json=policySetIds,proto3" json:"policy_set_ids,omitempty"`
+	// How the policies are combined (defaults to ALL_MUST_PASS)
+	PolicyMode SharePolicyMode `protobuf:"varint,10,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	// Decision when no policy decides (defaults to ALLOW)
+	PolicyDefault SharePolicyDefault `protobuf:"varint,11,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
+	// How many times the share can be viewed (defaults to 1)
+	MaxViews *uint32 `protobuf:"varint,12,opt,name=max_views,json=maxViews,proto3,oneof" json:"max_views,omitempty"`
+	// Bind the views of a multi-view share to the device of the first view
+	BindDevice bool `protobuf:"varint,13,opt,name=bind_device,json=bindDevice,proto3" json:"bind_device,omitempty"`
+	// Optional passphrase the recipient can answer step-up challenges with,
+	// shared with them out of band
+	Passphrase    *string `protobuf:"bytes,14,opt,name=passphrase,proto3,oneof" json:"passphrase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -521,19 +1035,75 @@ func (x *CreateShareRequest) GetPolicies() []*CreateSharePolicyInput {
 	return nil
 }
 
-type CreateShareResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareId       string                 `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
-	ShareLink     string                 `protobuf:"bytes,2,opt,name=share_link,json=shareLink,proto3" json:"share_link,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateShareRequest) GetNotifyEmail() string {
+	if x != nil && x.NotifyEmail != nil {
+		return *x.NotifyEmail
+	}
+	return ""
 }
 
-func (x *CreateShareResponse) Reset() {
-	*x = CreateShareResponse{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateShareRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateShareRequest) GetPolicySetIds() []string {
+	if x != nil {
+		return x.PolicySetIds
+	}
+	return nil
+}
+
+func (x *CreateShareRequest) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *CreateShareRequest) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+func (x *CreateShareRequest) GetMaxViews() uint32 {
+	if x != nil && x.MaxViews != nil {
+		return *x.MaxViews
+	}
+	return 0
+}
+
+func (x *CreateShareRequest) GetBindDevice() bool {
+	if x != nil {
+		return x.BindDevice
+	}
+	return false
+}
+
+func (x *CreateShareRequest) GetPassphrase() string {
+	if x != nil && x.Passphrase != nil {
+		return *x.Passphrase
+	}
+	return ""
+}
+
+type CreateShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareId       string                 `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	ShareLink     string                 `protobuf:"bytes,2,opt,name=share_link,json=shareLink,proto3" json:"share_link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareResponse) Reset() {
+	*x = CreateShareResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateShareResponse) String() string {
@@ -664,15 +1234,35 @@ func (x *GetShareResponse) GetShare() *SharedLink {
 
 // Request to list shares
 type ListSharesRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Page     *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number for offset pagination (ignored when page_token is set)
+	Page     *uint32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 	// Filter by resource type
 	ResourceType *ResourceType `protobuf:"varint,3,opt,name=resource_type,json=resourceType,proto3,enum=sharing.service.v1.ResourceType,oneof" json:"resource_type,omitempty"`
 	// Filter by recipient email
 	RecipientEmail *string `protobuf:"bytes,4,opt,name=recipient_email,json=recipientEmail,proto3,oneof" json:"recipient_email,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Filter by status
+	Status *ShareStatus `protobuf:"varint,5,opt,name=status,proto3,enum=sharing.service.v1.ShareStatus,oneof" json:"status,omitempty"`
+	// Filter by creator user ID
+	CreatedBy *uint32 `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// Only shares created at or after this time
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	// Only shares created before this time
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	// Only shares viewed at or after this time
+	ViewedAfter *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=viewed_after,json=viewedAfter,proto3,oneof" json:"viewed_after,omitempty"`
+	// Only shares viewed before this time
+	ViewedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=viewed_before,json=viewedBefore,proto3,oneof" json:"viewed_before,omitempty"`
+	// Case-insensitive substring search on resource name, message and recipient email
+	Search *string `protobuf:"bytes,11,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	// Sort field and direction
+	SortBy    *ShareSortField `protobuf:"varint,12,opt,name=sort_by,json=sortBy,proto3,enum=sharing.service.v1.ShareSortField,oneof" json:"sort_by,omitempty"`
+	SortOrder *SortOrder      `protobuf:"varint,13,opt,name=sort_order,json=sortOrder,proto3,enum=sharing.service.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	// Opaque cursor returned as next_page_token by a previous call with the same sort
+	PageToken     *string `protobuf:"bytes,14,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSharesRequest) Reset() {
@@ -733,10 +1323,82 @@ func (x *ListSharesRequest) GetRecipientEmail() string {
 	return ""
 }
 
+func (x *ListSharesRequest) GetStatus() ShareStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ShareStatus_SHARE_STATUS_UNSPECIFIED
+}
+
+func (x *ListSharesRequest) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ListSharesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListSharesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListSharesRequest) GetViewedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedAfter
+	}
+	return nil
+}
+
+func (x *ListSharesRequest) GetViewedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedBefore
+	}
+	return nil
+}
+
+func (x *ListSharesRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListSharesRequest) GetSortBy() ShareSortField {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ShareSortField_SHARE_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListSharesRequest) GetSortOrder() SortOrder {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListSharesRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
 type ListSharesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shares        []*SharedLink          `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Shares []*SharedLink          `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	Total  uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor for the next page; empty when there are no more results
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -785,6 +1447,13 @@ func (x *ListSharesResponse) GetTotal() uint32 {
 	return 0
 }
 
+func (x *ListSharesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Request to revoke a share
 type RevokeShareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -832,8 +1501,17 @@ func (x *RevokeShareRequest) GetId() string {
 
 // Request to view shared content (public, by token)
 type ViewSharedContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Device binding token issued on the first view of a device-bound share
+	DeviceToken *string `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3,oneof" json:"device_token,omitempty"`
+	// Answers to a step-up challenge: the share passphrase or an emailed code
+	Passphrase       *string `protobuf:"bytes,3,opt,name=passphrase,proto3,oneof" json:"passphrase,omitempty"`
+	VerificationCode *string `protobuf:"bytes,4,opt,name=verification_code,json=verificationCode,proto3,oneof" json:"verification_code,omitempty"`
+	// Proof of work for the share token, when the server requires one: a
+	// challenge from GetShareChallenge and its solution
+	PowChallenge  *string `protobuf:"bytes,5,opt,name=pow_challenge,json=powChallenge,proto3,oneof" json:"pow_challenge,omitempty"`
+	PowSolution   *string `protobuf:"bytes,6,opt,name=pow_solution,json=powSolution,proto3,oneof" json:"pow_solution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -875,6 +1553,41 @@ func (x *ViewSharedContentRequest) GetToken() string {
 	return ""
 }
 
+func (x *ViewSharedContentRequest) GetDeviceToken() string {
+	if x != nil && x.DeviceToken != nil {
+		return *x.DeviceToken
+	}
+	return ""
+}
+
+func (x *ViewSharedContentRequest) GetPassphrase() string {
+	if x != nil && x.Passphrase != nil {
+		return *x.Passphrase
+	}
+	return ""
+}
+
+func (x *ViewSharedContentRequest) GetVerificationCode() string {
+	if x != nil && x.VerificationCode != nil {
+		return *x.VerificationCode
+	}
+	return ""
+}
+
+func (x *ViewSharedContentRequest) GetPowChallenge() string {
+	if x != nil && x.PowChallenge != nil {
+		return *x.PowChallenge
+	}
+	return ""
+}
+
+func (x *ViewSharedContentRequest) GetPowSolution() string {
+	if x != nil && x.PowSolution != nil {
+		return *x.PowSolution
+	}
+	return ""
+}
+
 type ViewSharedContentResponse struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	ResourceType ResourceType           `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=sharing.service.v1.ResourceType" json:"resource_type,omitempty"`
@@ -885,7 +1598,10 @@ type ViewSharedContentResponse struct {
 	FileName    string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	MimeType    string `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
 	// Resource metadata
-	ResourceName  string `protobuf:"bytes,6,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	ResourceName string `protobuf:"bytes,6,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// Device binding token issued by the first view of a device-bound share,
+	// to be presented on the following views
+	DeviceToken   string `protobuf:"bytes,7,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -962,20 +1678,146 @@ func (x *ViewSharedContentResponse) GetResourceName() string {
 	return ""
 }
 
+func (x *ViewSharedContentResponse) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+// Request to report a leaked share token (public, by token)
+type ReportLeakedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Where the token was found (e.g. "github", "gitlab", "dlp")
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// URL of the location where the token was found
+	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLeakedTokenRequest) Reset() {
+	*x = ReportLeakedTokenRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLeakedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLeakedTokenRequest) ProtoMessage() {}
+
+func (x *ReportLeakedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLeakedTokenRequest.ProtoReflect.Descriptor instead.
+func (*ReportLeakedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReportLeakedTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReportLeakedTokenRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReportLeakedTokenRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ReportLeakedTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the token belongs to an existing share
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// Whether the matching share was active and has now been revoked
+	Revoked       bool `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLeakedTokenResponse) Reset() {
+	*x = ReportLeakedTokenResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLeakedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLeakedTokenResponse) ProtoMessage() {}
+
+func (x *ReportLeakedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLeakedTokenResponse.ProtoReflect.Descriptor instead.
+func (*ReportLeakedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReportLeakedTokenResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *ReportLeakedTokenResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
 // Input for creating a policy (used in both CreateShare and CreateSharePolicy)
 type CreateSharePolicyInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          SharePolicyType        `protobuf:"varint,1,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
-	Method        SharePolicyMethod      `protobuf:"varint,2,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Type   SharePolicyType        `protobuf:"varint,1,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
+	Method SharePolicyMethod      `protobuf:"varint,2,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
+	Value  string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reason string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Policies with lower priority are evaluated first
+	Priority      int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSharePolicyInput) Reset() {
 	*x = CreateSharePolicyInput{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[11]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1829,7 @@ func (x *CreateSharePolicyInput) String() string {
 func (*CreateSharePolicyInput) ProtoMessage() {}
 
 func (x *CreateSharePolicyInput) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[11]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1842,7 @@ func (x *CreateSharePolicyInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSharePolicyInput.ProtoReflect.Descriptor instead.
 func (*CreateSharePolicyInput) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{11}
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateSharePolicyInput) GetType() SharePolicyType {
@@ -1031,21 +1873,30 @@ func (x *CreateSharePolicyInput) GetReason() string {
 	return ""
 }
 
+func (x *CreateSharePolicyInput) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 // Request to create a share policy
 type CreateSharePolicyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
-	Type          SharePolicyType        `protobuf:"varint,2,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
-	Method        SharePolicyMethod      `protobuf:"varint,3,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinkId string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	Type        SharePolicyType        `protobuf:"varint,2,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
+	Method      SharePolicyMethod      `protobuf:"varint,3,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
+	Value       string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Reason      string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Policies with lower priority are evaluated first
+	Priority      int32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSharePolicyRequest) Reset() {
 	*x = CreateSharePolicyRequest{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[12]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1908,7 @@ func (x *CreateSharePolicyRequest) String() string {
 func (*CreateSharePolicyRequest) ProtoMessage() {}
 
 func (x *CreateSharePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[12]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1921,7 @@ func (x *CreateSharePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSharePolicyRequest.ProtoReflect.Descriptor instead.
 func (*CreateSharePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{12}
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateSharePolicyRequest) GetShareLinkId() string {
@@ -1108,6 +1959,13 @@ func (x *CreateSharePolicyRequest) GetReason() string {
 	return ""
 }
 
+func (x *CreateSharePolicyRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type CreateSharePolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Policy        *SharePolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
@@ -1117,7 +1975,7 @@ type CreateSharePolicyResponse struct {
 
 func (x *CreateSharePolicyResponse) Reset() {
 	*x = CreateSharePolicyResponse{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[13]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1129,7 +1987,7 @@ func (x *CreateSharePolicyResponse) String() string {
 func (*CreateSharePolicyResponse) ProtoMessage() {}
 
 func (x *CreateSharePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[13]
+	mi := &file_sharing_service_v1_share_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +2000,7 @@ func (x *CreateSharePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSharePolicyResponse.ProtoReflect.Descriptor instead.
 func (*CreateSharePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{13}
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateSharePolicyResponse) GetPolicy() *SharePolicy {
@@ -1152,29 +2010,44 @@ func (x *CreateSharePolicyResponse) GetPolicy() *SharePolicy {
 	return nil
 }
 
-// Request to list share policies
-type ListSharePoliciesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+// Recorded attempt to open a shared link
+type ShareAccessEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ShareLinkId string                 `protobuf:"bytes,3,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	TokenPrefix string                 `protobuf:"bytes,4,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"`
+	Outcome     ShareAccessOutcome     `protobuf:"varint,5,opt,name=outcome,proto3,enum=sharing.service.v1.ShareAccessOutcome" json:"outcome,omitempty"`
+	PolicyId    string                 `protobuf:"bytes,6,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Reason      string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ClientIp    string                 `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent   string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreateTime  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// ISO 3166-1 alpha-2 country resolved from the client IP, empty when unknown
+	Country string `protobuf:"bytes,11,opt,name=country,proto3" json:"country,omitempty"`
+	// Reputation list the client IP matched when a REPUTATION policy decided the outcome
+	ReputationList string `protobuf:"bytes,12,opt,name=reputation_list,json=reputationList,proto3" json:"reputation_list,omitempty"`
+	// Risk score of the attempt (0-100), set when risk-based access is enabled
+	RiskScore     *uint32 `protobuf:"varint,13,opt,name=risk_score,json=riskScore,proto3,oneof" json:"risk_score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSharePoliciesRequest) Reset() {
-	*x = ListSharePoliciesRequest{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[14]
+func (x *ShareAccessEvent) Reset() {
+	*x = ShareAccessEvent{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSharePoliciesRequest) String() string {
+func (x *ShareAccessEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSharePoliciesRequest) ProtoMessage() {}
+func (*ShareAccessEvent) ProtoMessage() {}
 
-func (x *ListSharePoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[14]
+func (x *ShareAccessEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,40 +2058,2123 @@ func (x *ListSharePoliciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSharePoliciesRequest.ProtoReflect.Descriptor instead.
-func (*ListSharePoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ShareAccessEvent.ProtoReflect.Descriptor instead.
+func (*ShareAccessEvent) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShareAccessEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ShareAccessEvent) GetShareLinkId() string {
+	if x != nil {
+		return x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetOutcome() ShareAccessOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ShareAccessOutcome_SHARE_ACCESS_OUTCOME_UNSPECIFIED
+}
+
+func (x *ShareAccessEvent) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ShareAccessEvent) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetReputationList() string {
+	if x != nil {
+		return x.ReputationList
+	}
+	return ""
+}
+
+func (x *ShareAccessEvent) GetRiskScore() uint32 {
+	if x != nil && x.RiskScore != nil {
+		return *x.RiskScore
+	}
+	return 0
+}
+
+// Request to list share access events
+type ListShareAccessEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by share link
+	ShareLinkId *string `protobuf:"bytes,3,opt,name=share_link_id,json=shareLinkId,proto3,oneof" json:"share_link_id,omitempty"`
+	// Filter by outcome
+	Outcome *ShareAccessOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=sharing.service.v1.ShareAccessOutcome,oneof" json:"outcome,omitempty"`
+	// Filter by client IP
+	ClientIp *string `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`
+	// Only events at or after this time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	// Only events before this time
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareAccessEventsRequest) Reset() {
+	*x = ListShareAccessEventsRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareAccessEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareAccessEventsRequest) ProtoMessage() {}
+
+func (x *ListShareAccessEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareAccessEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListShareAccessEventsRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListShareAccessEventsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListShareAccessEventsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShareAccessEventsRequest) GetShareLinkId() string {
+	if x != nil && x.ShareLinkId != nil {
+		return *x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *ListShareAccessEventsRequest) GetOutcome() ShareAccessOutcome {
+	if x != nil && x.Outcome != nil {
+		return *x.Outcome
+	}
+	return ShareAccessOutcome_SHARE_ACCESS_OUTCOME_UNSPECIFIED
+}
+
+func (x *ListShareAccessEventsRequest) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *ListShareAccessEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListShareAccessEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListShareAccessEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ShareAccessEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareAccessEventsResponse) Reset() {
+	*x = ListShareAccessEventsResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareAccessEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareAccessEventsResponse) ProtoMessage() {}
+
+func (x *ListShareAccessEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareAccessEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListShareAccessEventsResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListShareAccessEventsResponse) GetEvents() []*ShareAccessEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListShareAccessEventsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to get sharing statistics
+type GetSharingStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only shares created at or after this time (defaults to 30 days ago)
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	// Only shares created before this time (defaults to now)
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// Number of entries in the top recipient, domain and sharer lists (defaults to 10)
+	TopLimit      *uint32 `protobuf:"varint,3,opt,name=top_limit,json=topLimit,proto3,oneof" json:"top_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharingStatsRequest) Reset() {
+	*x = GetSharingStatsRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharingStatsRequest) ProtoMessage() {}
+
+func (x *GetSharingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSharingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSharingStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetSharingStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetSharingStatsRequest) GetTopLimit() uint32 {
+	if x != nil && x.TopLimit != nil {
+		return *x.TopLimit
+	}
+	return 0
+}
+
+// Share counts by status; every share is counted in exactly one status
+type ShareStatusCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        uint64                 `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Viewed        uint64                 `protobuf:"varint,2,opt,name=viewed,proto3" json:"viewed,omitempty"`
+	Revoked       uint64                 `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Expired       uint64                 `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareStatusCounts) Reset() {
+	*x = ShareStatusCounts{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareStatusCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareStatusCounts) ProtoMessage() {}
+
+func (x *ShareStatusCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareStatusCounts.ProtoReflect.Descriptor instead.
+func (*ShareStatusCounts) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ShareStatusCounts) GetActive() uint64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *ShareStatusCounts) GetViewed() uint64 {
+	if x != nil {
+		return x.Viewed
+	}
+	return 0
+}
+
+func (x *ShareStatusCounts) GetRevoked() uint64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *ShareStatusCounts) GetExpired() uint64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+// Share count for a resource type
+type ResourceTypeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  ResourceType           `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=sharing.service.v1.ResourceType" json:"resource_type,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceTypeCount) Reset() {
+	*x = ResourceTypeCount{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTypeCount) ProtoMessage() {}
+
+func (x *ResourceTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTypeCount.ProtoReflect.Descriptor instead.
+func (*ResourceTypeCount) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResourceTypeCount) GetResourceType() ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ResourceTypeCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Number of shares created on a day
+type DailyShareCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day in YYYY-MM-DD format
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count         uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyShareCount) Reset() {
+	*x = DailyShareCount{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyShareCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyShareCount) ProtoMessage() {}
+
+func (x *DailyShareCount) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyShareCount.ProtoReflect.Descriptor instead.
+func (*DailyShareCount) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DailyShareCount) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyShareCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Share count for a recipient address or domain
+type RecipientCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipientCount) Reset() {
+	*x = RecipientCount{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipientCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipientCount) ProtoMessage() {}
+
+func (x *RecipientCount) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipientCount.ProtoReflect.Descriptor instead.
+func (*RecipientCount) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RecipientCount) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RecipientCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Share count for a sharer
+type SharerCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *uint32                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	SenderEmail   string                 `protobuf:"bytes,2,opt,name=sender_email,json=senderEmail,proto3" json:"sender_email,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharerCount) Reset() {
+	*x = SharerCount{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharerCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharerCount) ProtoMessage() {}
+
+func (x *SharerCount) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharerCount.ProtoReflect.Descriptor instead.
+func (*SharerCount) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SharerCount) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SharerCount) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
+}
+
+func (x *SharerCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetSharingStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Total          uint64                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	ByStatus       *ShareStatusCounts     `protobuf:"bytes,4,opt,name=by_status,json=byStatus,proto3" json:"by_status,omitempty"`
+	ByResourceType []*ResourceTypeCount   `protobuf:"bytes,5,rep,name=by_resource_type,json=byResourceType,proto3" json:"by_resource_type,omitempty"`
+	PerDay         []*DailyShareCount     `protobuf:"bytes,6,rep,name=per_day,json=perDay,proto3" json:"per_day,omitempty"`
+	// Median time between creating and viewing a share, in seconds (0 when nothing was viewed)
+	MedianSecondsToView float64           `protobuf:"fixed64,7,opt,name=median_seconds_to_view,json=medianSecondsToView,proto3" json:"median_seconds_to_view,omitempty"`
+	TopRecipients       []*RecipientCount `protobuf:"bytes,8,rep,name=top_recipients,json=topRecipients,proto3" json:"top_recipients,omitempty"`
+	TopDomains          []*RecipientCount `protobuf:"bytes,9,rep,name=top_domains,json=topDomains,proto3" json:"top_domains,omitempty"`
+	TopSharers          []*SharerCount    `protobuf:"bytes,10,rep,name=top_sharers,json=topSharers,proto3" json:"top_sharers,omitempty"`
+	// Access attempts denied by a policy during the time range
+	PolicyDenials uint64 `protobuf:"varint,11,opt,name=policy_denials,json=policyDenials,proto3" json:"policy_denials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharingStatsResponse) Reset() {
+	*x = GetSharingStatsResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharingStatsResponse) ProtoMessage() {}
+
+func (x *GetSharingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSharingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSharingStatsResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetSharingStatsResponse) GetByStatus() *ShareStatusCounts {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetByResourceType() []*ResourceTypeCount {
+	if x != nil {
+		return x.ByResourceType
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetPerDay() []*DailyShareCount {
+	if x != nil {
+		return x.PerDay
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetMedianSecondsToView() float64 {
+	if x != nil {
+		return x.MedianSecondsToView
+	}
+	return 0
+}
+
+func (x *GetSharingStatsResponse) GetTopRecipients() []*RecipientCount {
+	if x != nil {
+		return x.TopRecipients
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetTopDomains() []*RecipientCount {
+	if x != nil {
+		return x.TopDomains
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetTopSharers() []*SharerCount {
+	if x != nil {
+		return x.TopSharers
+	}
+	return nil
+}
+
+func (x *GetSharingStatsResponse) GetPolicyDenials() uint64 {
+	if x != nil {
+		return x.PolicyDenials
+	}
+	return 0
+}
+
+// Sender notification preferences of a user
+type NotificationPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotifyViewed  bool                   `protobuf:"varint,1,opt,name=notify_viewed,json=notifyViewed,proto3" json:"notify_viewed,omitempty"`
+	NotifyDenied  bool                   `protobuf:"varint,2,opt,name=notify_denied,json=notifyDenied,proto3" json:"notify_denied,omitempty"`
+	NotifyExpired bool                   `protobuf:"varint,3,opt,name=notify_expired,json=notifyExpired,proto3" json:"notify_expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NotificationPreferences) GetNotifyViewed() bool {
+	if x != nil {
+		return x.NotifyViewed
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetNotifyDenied() bool {
+	if x != nil {
+		return x.NotifyDenied
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetNotifyExpired() bool {
+	if x != nil {
+		return x.NotifyExpired
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{27}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Request to update notification preferences (unset fields are left unchanged)
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotifyViewed  *bool                  `protobuf:"varint,1,opt,name=notify_viewed,json=notifyViewed,proto3,oneof" json:"notify_viewed,omitempty"`
+	NotifyDenied  *bool                  `protobuf:"varint,2,opt,name=notify_denied,json=notifyDenied,proto3,oneof" json:"notify_denied,omitempty"`
+	NotifyExpired *bool                  `protobuf:"varint,3,opt,name=notify_expired,json=notifyExpired,proto3,oneof" json:"notify_expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetNotifyViewed() bool {
+	if x != nil && x.NotifyViewed != nil {
+		return *x.NotifyViewed
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetNotifyDenied() bool {
+	if x != nil && x.NotifyDenied != nil {
+		return *x.NotifyDenied
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetNotifyExpired() bool {
+	if x != nil && x.NotifyExpired != nil {
+		return *x.NotifyExpired
+	}
+	return false
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Risk-based access settings of a tenant. Access attempts scoring below the
+// step-up threshold reveal directly, attempts from the step-up threshold
+// must answer a passphrase or email code challenge and attempts from the
+// deny threshold are denied.
+type RiskSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StepUpThreshold uint32                 `protobuf:"varint,2,opt,name=step_up_threshold,json=stepUpThreshold,proto3" json:"step_up_threshold,omitempty"`
+	// 101 never denies
+	DenyThreshold uint32 `protobuf:"varint,3,opt,name=deny_threshold,json=denyThreshold,proto3" json:"deny_threshold,omitempty"`
+	// Business hours of the tenant's senders, in TIME policy syntax
+	// (e.g. "Mon-Fri 08:00-18:00 Europe/Sofia")
+	BusinessHours string                 `protobuf:"bytes,4,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskSettings) Reset() {
+	*x = RiskSettings{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskSettings) ProtoMessage() {}
+
+func (x *RiskSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskSettings.ProtoReflect.Descriptor instead.
+func (*RiskSettings) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RiskSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RiskSettings) GetStepUpThreshold() uint32 {
+	if x != nil {
+		return x.StepUpThreshold
+	}
+	return 0
+}
+
+func (x *RiskSettings) GetDenyThreshold() uint32 {
+	if x != nil {
+		return x.DenyThreshold
+	}
+	return 0
+}
+
+func (x *RiskSettings) GetBusinessHours() string {
+	if x != nil {
+		return x.BusinessHours
+	}
+	return ""
+}
+
+func (x *RiskSettings) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetRiskSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskSettingsRequest) Reset() {
+	*x = GetRiskSettingsRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskSettingsRequest) ProtoMessage() {}
+
+func (x *GetRiskSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{32}
+}
+
+type GetRiskSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *RiskSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskSettingsResponse) Reset() {
+	*x = GetRiskSettingsResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskSettingsResponse) ProtoMessage() {}
+
+func (x *GetRiskSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRiskSettingsResponse) GetSettings() *RiskSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Request to update risk settings (unset fields are left unchanged)
+type UpdateRiskSettingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	StepUpThreshold *uint32                `protobuf:"varint,2,opt,name=step_up_threshold,json=stepUpThreshold,proto3,oneof" json:"step_up_threshold,omitempty"`
+	DenyThreshold   *uint32                `protobuf:"varint,3,opt,name=deny_threshold,json=denyThreshold,proto3,oneof" json:"deny_threshold,omitempty"`
+	BusinessHours   *string                `protobuf:"bytes,4,opt,name=business_hours,json=businessHours,proto3,oneof" json:"business_hours,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateRiskSettingsRequest) Reset() {
+	*x = UpdateRiskSettingsRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRiskSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRiskSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateRiskSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRiskSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRiskSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateRiskSettingsRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateRiskSettingsRequest) GetStepUpThreshold() uint32 {
+	if x != nil && x.StepUpThreshold != nil {
+		return *x.StepUpThreshold
+	}
+	return 0
+}
+
+func (x *UpdateRiskSettingsRequest) GetDenyThreshold() uint32 {
+	if x != nil && x.DenyThreshold != nil {
+		return *x.DenyThreshold
+	}
+	return 0
+}
+
+func (x *UpdateRiskSettingsRequest) GetBusinessHours() string {
+	if x != nil && x.BusinessHours != nil {
+		return *x.BusinessHours
+	}
+	return ""
+}
+
+type UpdateRiskSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *RiskSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRiskSettingsResponse) Reset() {
+	*x = UpdateRiskSettingsResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRiskSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRiskSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateRiskSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRiskSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRiskSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateRiskSettingsResponse) GetSettings() *RiskSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Request to email a verification code to the recipient of a share (public, by token)
+type SendShareVerificationCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Proof of work for the share token, when the server requires one: a
+	// challenge from GetShareChallenge and its solution
+	PowChallenge  *string `protobuf:"bytes,2,opt,name=pow_challenge,json=powChallenge,proto3,oneof" json:"pow_challenge,omitempty"`
+	PowSolution   *string `protobuf:"bytes,3,opt,name=pow_solution,json=powSolution,proto3,oneof" json:"pow_solution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendShareVerificationCodeRequest) Reset() {
+	*x = SendShareVerificationCodeRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendShareVerificationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendShareVerificationCodeRequest) ProtoMessage() {}
+
+func (x *SendShareVerificationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendShareVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendShareVerificationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SendShareVerificationCodeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SendShareVerificationCodeRequest) GetPowChallenge() string {
+	if x != nil && x.PowChallenge != nil {
+		return *x.PowChallenge
+	}
+	return ""
+}
+
+func (x *SendShareVerificationCodeRequest) GetPowSolution() string {
+	if x != nil && x.PowSolution != nil {
+		return *x.PowSolution
+	}
+	return ""
+}
+
+// Request for a proof-of-work challenge (public, by token)
+type GetShareChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareChallengeRequest) Reset() {
+	*x = GetShareChallengeRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareChallengeRequest) ProtoMessage() {}
+
+func (x *GetShareChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetShareChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetShareChallengeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetShareChallengeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether requests for the token currently need a proof of work
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// Signed challenge to solve, empty when not required
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Number of leading zero bits the hash of the solution must have
+	Difficulty uint32 `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Hash the solution is computed with: SHA-256(challenge + ":" + solution)
+	Algorithm     string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareChallengeResponse) Reset() {
+	*x = GetShareChallengeResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareChallengeResponse) ProtoMessage() {}
+
+func (x *GetShareChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetShareChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetShareChallengeResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GetShareChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetShareChallengeResponse) GetDifficulty() uint32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *GetShareChallengeResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetShareChallengeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SendShareVerificationCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the code expires
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendShareVerificationCodeResponse) Reset() {
+	*x = SendShareVerificationCodeResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendShareVerificationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendShareVerificationCodeResponse) ProtoMessage() {}
+
+func (x *SendShareVerificationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendShareVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendShareVerificationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SendShareVerificationCodeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Request to list share policies
+type ListSharePoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharePoliciesRequest) Reset() {
+	*x = ListSharePoliciesRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharePoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharePoliciesRequest) ProtoMessage() {}
+
+func (x *ListSharePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSharePoliciesRequest) GetShareLinkId() string {
+	if x != nil {
+		return x.ShareLinkId
+	}
+	return ""
+}
+
+type ListSharePoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*SharePolicy         `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharePoliciesResponse) Reset() {
+	*x = ListSharePoliciesResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharePoliciesResponse) ProtoMessage() {}
+
+func (x *ListSharePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSharePoliciesResponse) GetPolicies() []*SharePolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// Request to delete a share policy
+type DeleteSharePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharePolicyRequest) Reset() {
+	*x = DeleteSharePolicyRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharePolicyRequest) ProtoMessage() {}
+
+func (x *DeleteSharePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSharePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteSharePolicyRequest) GetShareLinkId() string {
+	if x != nil {
+		return x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *DeleteSharePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request to replace the policy sets referenced by a share
+type SetSharePolicySetsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinkId string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	// Policy sets to reference; empty removes all
+	PolicySetIds  []string `protobuf:"bytes,2,rep,name=policy_set_ids,json=policySetIds,proto3" json:"policy_set_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharePolicySetsRequest) Reset() {
+	*x = SetSharePolicySetsRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharePolicySetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharePolicySetsRequest) ProtoMessage() {}
+
+func (x *SetSharePolicySetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharePolicySetsRequest.ProtoReflect.Descriptor instead.
+func (*SetSharePolicySetsRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetSharePolicySetsRequest) GetShareLinkId() string {
+	if x != nil {
+		return x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *SetSharePolicySetsRequest) GetPolicySetIds() []string {
+	if x != nil {
+		return x.PolicySetIds
+	}
+	return nil
+}
+
+type SetSharePolicySetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicySetIds  []string               `protobuf:"bytes,1,rep,name=policy_set_ids,json=policySetIds,proto3" json:"policy_set_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharePolicySetsResponse) Reset() {
+	*x = SetSharePolicySetsResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharePolicySetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharePolicySetsResponse) ProtoMessage() {}
+
+func (x *SetSharePolicySetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharePolicySetsResponse.ProtoReflect.Descriptor instead.
+func (*SetSharePolicySetsResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetSharePolicySetsResponse) GetPolicySetIds() []string {
+	if x != nil {
+		return x.PolicySetIds
+	}
+	return nil
+}
+
+// Request to change how the policies of a share are combined
+type SetSharePolicyModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
+	PolicyMode    SharePolicyMode        `protobuf:"varint,2,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	PolicyDefault SharePolicyDefault     `protobuf:"varint,3,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharePolicyModeRequest) Reset() {
+	*x = SetSharePolicyModeRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharePolicyModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharePolicyModeRequest) ProtoMessage() {}
+
+func (x *SetSharePolicyModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharePolicyModeRequest.ProtoReflect.Descriptor instead.
+func (*SetSharePolicyModeRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetSharePolicyModeRequest) GetShareLinkId() string {
+	if x != nil {
+		return x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *SetSharePolicyModeRequest) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *SetSharePolicyModeRequest) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+type SetSharePolicyModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyMode    SharePolicyMode        `protobuf:"varint,1,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	PolicyDefault SharePolicyDefault     `protobuf:"varint,2,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharePolicyModeResponse) Reset() {
+	*x = SetSharePolicyModeResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharePolicyModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharePolicyModeResponse) ProtoMessage() {}
+
+func (x *SetSharePolicyModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharePolicyModeResponse.ProtoReflect.Descriptor instead.
+func (*SetSharePolicyModeResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetSharePolicyModeResponse) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *SetSharePolicyModeResponse) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+// Request to reset the device binding of a share
+type ResetShareDeviceBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetShareDeviceBindingRequest) Reset() {
+	*x = ResetShareDeviceBindingRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetShareDeviceBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetShareDeviceBindingRequest) ProtoMessage() {}
+
+func (x *ResetShareDeviceBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetShareDeviceBindingRequest.ProtoReflect.Descriptor instead.
+func (*ResetShareDeviceBindingRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResetShareDeviceBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Hypothetical client that policies are evaluated for
+type PolicyEvaluationClient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ip    string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Time of the access attempt (defaults to now)
+	Time      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3,oneof" json:"time,omitempty"`
+	UserAgent string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// ISO 3166-1 alpha-2 country code; when empty the location is resolved from the IP
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	// ISO 3166-2 subdivision codes (without the country prefix)
+	Subdivisions []string `protobuf:"bytes,5,rep,name=subdivisions,proto3" json:"subdivisions,omitempty"`
+	City         string   `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	// Number of earlier attempts to open the share; defaults to the recorded
+	// attempts when a share is evaluated
+	AttemptCount *int64 `protobuf:"varint,7,opt,name=attempt_count,json=attemptCount,proto3,oneof" json:"attempt_count,omitempty"`
+	// Recipient email address; defaults to the share's recipient
+	RecipientEmail string `protobuf:"bytes,8,opt,name=recipient_email,json=recipientEmail,proto3" json:"recipient_email,omitempty"`
+	// Client certificate presented on the mTLS listener
+	Certificate *PolicyEvaluationCertificate `protobuf:"bytes,9,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Reputation lists the client IP is on; looked up in the feeds when empty
+	ReputationLists []string `protobuf:"bytes,10,rep,name=reputation_lists,json=reputationLists,proto3" json:"reputation_lists,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationClient) Reset() {
+	*x = PolicyEvaluationClient{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationClient) ProtoMessage() {}
+
+func (x *PolicyEvaluationClient) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationClient.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationClient) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PolicyEvaluationClient) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationClient) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationClient) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationClient) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationClient) GetSubdivisions() []string {
+	if x != nil {
+		return x.Subdivisions
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationClient) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationClient) GetAttemptCount() int64 {
+	if x != nil && x.AttemptCount != nil {
+		return *x.AttemptCount
+	}
+	return 0
+}
+
+func (x *PolicyEvaluationClient) GetRecipientEmail() string {
+	if x != nil {
+		return x.RecipientEmail
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationClient) GetCertificate() *PolicyEvaluationCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationClient) GetReputationLists() []string {
+	if x != nil {
+		return x.ReputationLists
+	}
+	return nil
+}
+
+// Hypothetical client certificate that CERTIFICATE policies are evaluated for
+type PolicyEvaluationCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SHA-256 fingerprint of the certificate in hex
+	Sha256 string `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Subject and issuer distinguished names, e.g. "CN=alice,O=Acme"
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer  string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Whether the certificate chains to a trusted client CA
+	Verified bool `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	// SHA-256 fingerprints of the CA certificates of the verified chain
+	CaSha256      []string `protobuf:"bytes,5,rep,name=ca_sha256,json=caSha256,proto3" json:"ca_sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationCertificate) Reset() {
+	*x = PolicyEvaluationCertificate{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationCertificate) ProtoMessage() {}
+
+func (x *PolicyEvaluationCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ListSharePoliciesRequest) GetShareLinkId() string {
+// Deprecated: Use PolicyEvaluationCertificate.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationCertificate) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PolicyEvaluationCertificate) GetSha256() string {
 	if x != nil {
-		return x.ShareLinkId
+		return x.Sha256
 	}
 	return ""
 }
 
-type ListSharePoliciesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Policies      []*SharePolicy         `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+func (x *PolicyEvaluationCertificate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationCertificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationCertificate) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *PolicyEvaluationCertificate) GetCaSha256() []string {
+	if x != nil {
+		return x.CaSha256
+	}
+	return nil
+}
+
+// Request to evaluate share policies without opening the share.
+// Either share_link_id, or policies and/or policy_set_ids must be set.
+type EvaluateSharePoliciesRequest struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	ShareLinkId *string                   `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3,oneof" json:"share_link_id,omitempty"`
+	Policies    []*CreateSharePolicyInput `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Client      *PolicyEvaluationClient   `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	// Policy sets evaluated together with the inline policies
+	PolicySetIds []string `protobuf:"bytes,4,rep,name=policy_set_ids,json=policySetIds,proto3" json:"policy_set_ids,omitempty"`
+	// Combination mode and default decision; when unspecified those of the
+	// share, or ALL_MUST_PASS and ALLOW for inline policies, are used
+	PolicyMode    SharePolicyMode    `protobuf:"varint,5,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	PolicyDefault SharePolicyDefault `protobuf:"varint,6,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSharePoliciesResponse) Reset() {
-	*x = ListSharePoliciesResponse{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[15]
+func (x *EvaluateSharePoliciesRequest) Reset() {
+	*x = EvaluateSharePoliciesRequest{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSharePoliciesResponse) String() string {
+func (x *EvaluateSharePoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSharePoliciesResponse) ProtoMessage() {}
+func (*EvaluateSharePoliciesRequest) ProtoMessage() {}
 
-func (x *ListSharePoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[15]
+func (x *EvaluateSharePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,42 +4185,90 @@ func (x *ListSharePoliciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSharePoliciesResponse.ProtoReflect.Descriptor instead.
-func (*ListSharePoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use EvaluateSharePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateSharePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListSharePoliciesResponse) GetPolicies() []*SharePolicy {
+func (x *EvaluateSharePoliciesRequest) GetShareLinkId() string {
+	if x != nil && x.ShareLinkId != nil {
+		return *x.ShareLinkId
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesRequest) GetPolicies() []*CreateSharePolicyInput {
 	if x != nil {
 		return x.Policies
 	}
 	return nil
 }
 
-// Request to delete a share policy
-type DeleteSharePolicyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareLinkId   string                 `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId,proto3" json:"share_link_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *EvaluateSharePoliciesRequest) GetClient() *PolicyEvaluationClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EvaluateSharePoliciesRequest) GetPolicySetIds() []string {
+	if x != nil {
+		return x.PolicySetIds
+	}
+	return nil
+}
+
+func (x *EvaluateSharePoliciesRequest) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *EvaluateSharePoliciesRequest) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+// Evaluation of a single policy
+type SharePolicyTrace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Policy ID, empty for inline policies
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// Position of the policy in the evaluated list
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Policy set the rule belongs to, empty for policies of the share or request
+	PolicySetId string            `protobuf:"bytes,9,opt,name=policy_set_id,json=policySetId,proto3" json:"policy_set_id,omitempty"`
+	Type        SharePolicyType   `protobuf:"varint,3,opt,name=type,proto3,enum=sharing.service.v1.SharePolicyType" json:"type,omitempty"`
+	Method      SharePolicyMethod `protobuf:"varint,4,opt,name=method,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"method,omitempty"`
+	Value       string            `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Matched     bool              `protobuf:"varint,6,opt,name=matched,proto3" json:"matched,omitempty"`
+	// Whether this policy decided the outcome
+	Decisive bool `protobuf:"varint,7,opt,name=decisive,proto3" json:"decisive,omitempty"`
+	// Why the policy did or did not match
+	Explanation   string `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Priority      int32  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSharePolicyRequest) Reset() {
-	*x = DeleteSharePolicyRequest{}
-	mi := &file_sharing_service_v1_share_proto_msgTypes[16]
+func (x *SharePolicyTrace) Reset() {
+	*x = SharePolicyTrace{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSharePolicyRequest) String() string {
+func (x *SharePolicyTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSharePolicyRequest) ProtoMessage() {}
+func (*SharePolicyTrace) ProtoMessage() {}
 
-func (x *DeleteSharePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sharing_service_v1_share_proto_msgTypes[16]
+func (x *SharePolicyTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,30 +4279,239 @@ func (x *DeleteSharePolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSharePolicyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSharePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SharePolicyTrace.ProtoReflect.Descriptor instead.
+func (*SharePolicyTrace) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeleteSharePolicyRequest) GetShareLinkId() string {
+func (x *SharePolicyTrace) GetPolicyId() string {
 	if x != nil {
-		return x.ShareLinkId
+		return x.PolicyId
 	}
 	return ""
 }
 
-func (x *DeleteSharePolicyRequest) GetId() string {
+func (x *SharePolicyTrace) GetIndex() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SharePolicyTrace) GetPolicySetId() string {
+	if x != nil {
+		return x.PolicySetId
+	}
+	return ""
+}
+
+func (x *SharePolicyTrace) GetType() SharePolicyType {
+	if x != nil {
+		return x.Type
+	}
+	return SharePolicyType_SHARE_POLICY_TYPE_UNSPECIFIED
+}
+
+func (x *SharePolicyTrace) GetMethod() SharePolicyMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SharePolicyMethod_SHARE_POLICY_METHOD_UNSPECIFIED
+}
+
+func (x *SharePolicyTrace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SharePolicyTrace) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *SharePolicyTrace) GetDecisive() bool {
+	if x != nil {
+		return x.Decisive
+	}
+	return false
+}
+
+func (x *SharePolicyTrace) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *SharePolicyTrace) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type EvaluateSharePoliciesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Denial message the client would see, empty when allowed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Method whose policies denied access
+	DeniedMethod SharePolicyMethod   `protobuf:"varint,3,opt,name=denied_method,json=deniedMethod,proto3,enum=sharing.service.v1.SharePolicyMethod" json:"denied_method,omitempty"`
+	Trace        []*SharePolicyTrace `protobuf:"bytes,4,rep,name=trace,proto3" json:"trace,omitempty"`
+	// Client attributes the policies were matched against
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Subdivisions  []string               `protobuf:"bytes,7,rep,name=subdivisions,proto3" json:"subdivisions,omitempty"`
+	City          string                 `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	DeviceOs      string                 `protobuf:"bytes,9,opt,name=device_os,json=deviceOs,proto3" json:"device_os,omitempty"`
+	DeviceBrowser string                 `protobuf:"bytes,10,opt,name=device_browser,json=deviceBrowser,proto3" json:"device_browser,omitempty"`
+	DeviceClass   string                 `protobuf:"bytes,11,opt,name=device_class,json=deviceClass,proto3" json:"device_class,omitempty"`
+	// Combination mode and default decision that were applied
+	PolicyMode    SharePolicyMode    `protobuf:"varint,12,opt,name=policy_mode,json=policyMode,proto3,enum=sharing.service.v1.SharePolicyMode" json:"policy_mode,omitempty"`
+	PolicyDefault SharePolicyDefault `protobuf:"varint,13,opt,name=policy_default,json=policyDefault,proto3,enum=sharing.service.v1.SharePolicyDefault" json:"policy_default,omitempty"`
+	// Whether no policy decided and the default decision was taken
+	DefaultApplied bool `protobuf:"varint,14,opt,name=default_applied,json=defaultApplied,proto3" json:"default_applied,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvaluateSharePoliciesResponse) Reset() {
+	*x = EvaluateSharePoliciesResponse{}
+	mi := &file_sharing_service_v1_share_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateSharePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateSharePoliciesResponse) ProtoMessage() {}
+
+func (x *EvaluateSharePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sharing_service_v1_share_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateSharePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateSharePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_sharing_service_v1_share_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *EvaluateSharePoliciesResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *EvaluateSharePoliciesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesResponse) GetDeniedMethod() SharePolicyMethod {
+	if x != nil {
+		return x.DeniedMethod
+	}
+	return SharePolicyMethod_SHARE_POLICY_METHOD_UNSPECIFIED
+}
+
+func (x *EvaluateSharePoliciesResponse) GetTrace() []*SharePolicyTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *EvaluateSharePoliciesResponse) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+func (x *EvaluateSharePoliciesResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesResponse) GetSubdivisions() []string {
+	if x != nil {
+		return x.Subdivisions
+	}
+	return nil
+}
+
+func (x *EvaluateSharePoliciesResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesResponse) GetDeviceOs() string {
+	if x != nil {
+		return x.DeviceOs
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesResponse) GetDeviceBrowser() string {
+	if x != nil {
+		return x.DeviceBrowser
+	}
+	return ""
+}
+
+func (x *EvaluateSharePoliciesResponse) GetDeviceClass() string {
+	if x != nil {
+		return x.DeviceClass
 	}
 	return ""
 }
 
+func (x *EvaluateSharePoliciesResponse) GetPolicyMode() SharePolicyMode {
+	if x != nil {
+		return x.PolicyMode
+	}
+	return SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED
+}
+
+func (x *EvaluateSharePoliciesResponse) GetPolicyDefault() SharePolicyDefault {
+	if x != nil {
+		return x.PolicyDefault
+	}
+	return SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED
+}
+
+func (x *EvaluateSharePoliciesResponse) GetDefaultApplied() bool {
+	if x != nil {
+		return x.DefaultApplied
+	}
+	return false
+}
+
 var File_sharing_service_v1_share_proto protoreflect.FileDescriptor
 
 const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"\n" +
-	"\x1esharing/service/v1/share.proto\x12\x12sharing.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xa4\x02\n" +
+	"\x1esharing/service/v1/share.proto\x12\x12sharing.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xe0\x02\n" +
 	"\vSharePolicy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\rshare_link_id\x18\x02 \x01(\tR\vshareLinkId\x127\n" +
@@ -1307,7 +4520,12 @@ const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"\x05value\x18\x05 \x01(\tR\x05value\x12\x16\n" +
 	"\x06reason\x18\x06 \x01(\tR\x06reason\x12;\n" +
 	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\"\xd2\x04\n" +
+	"createTime\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\t \x01(\bR\n" +
+	"deprecated\"\x9b\n" +
+	"\n" +
 	"\n" +
 	"SharedLink\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
@@ -1327,10 +4545,31 @@ const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"created_by\x18\f \x01(\rH\x01R\tcreatedBy\x88\x01\x01\x12;\n" +
 	"\vcreate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12;\n" +
-	"\bpolicies\x18\x0e \x03(\v2\x1f.sharing.service.v1.SharePolicyR\bpoliciesB\f\n" +
+	"\bpolicies\x18\x0e \x03(\v2\x1f.sharing.service.v1.SharePolicyR\bpolicies\x12!\n" +
+	"\fsender_email\x18\x0f \x01(\tR\vsenderEmail\x12>\n" +
+	"\n" +
+	"expires_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\texpiresAt\x88\x01\x01\x12(\n" +
+	"\rauthorized_by\x18\x11 \x01(\rH\x03R\fauthorizedBy\x88\x01\x01\x12%\n" +
+	"\x0eauthorized_via\x18\x12 \x01(\tR\rauthorizedVia\x12D\n" +
+	"\rauthorized_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\fauthorizedAt\x88\x01\x01\x12$\n" +
+	"\x0epolicy_set_ids\x18\x14 \x03(\tR\fpolicySetIds\x12D\n" +
+	"\vpolicy_mode\x18\x15 \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\x16 \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefault\x12\x1b\n" +
+	"\tmax_views\x18\x17 \x01(\rR\bmaxViews\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x18 \x01(\rR\tviewCount\x12\x1f\n" +
+	"\vbind_device\x18\x19 \x01(\bR\n" +
+	"bindDevice\x12G\n" +
+	"\x0fdevice_bound_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\rdeviceBoundAt\x88\x01\x01\x12%\n" +
+	"\x0ehas_passphrase\x18\x1b \x01(\bR\rhasPassphraseB\f\n" +
 	"\n" +
 	"_viewed_atB\r\n" +
-	"\v_created_by\"\x8d\x03\n" +
+	"\v_created_byB\r\n" +
+	"\v_expires_atB\x10\n" +
+	"\x0e_authorized_byB\x10\n" +
+	"\x0e_authorized_atB\x12\n" +
+	"\x10_device_bound_at\"\xa2\a\n" +
 	"\x12CreateShareRequest\x12R\n" +
 	"\rresource_type\x18\x01 \x01(\x0e2 .sharing.service.v1.ResourceTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\fresourceType\x12.\n" +
 	"\vresource_id\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
@@ -1339,8 +4578,27 @@ const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"\amessage\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\amessage\x12?\n" +
 	"\vtemplate_id\x18\x05 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\n" +
 	"templateId\x88\x01\x01\x12F\n" +
-	"\bpolicies\x18\x06 \x03(\v2*.sharing.service.v1.CreateSharePolicyInputR\bpoliciesB\x0e\n" +
-	"\f_template_id\"O\n" +
+	"\bpolicies\x18\x06 \x03(\v2*.sharing.service.v1.CreateSharePolicyInputR\bpolicies\x120\n" +
+	"\fnotify_email\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xc0\x02H\x01R\vnotifyEmail\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\texpiresAt\x88\x01\x01\x12J\n" +
+	"\x0epolicy_set_ids\x18\t \x03(\tB$\xbaH!\x92\x01\x1e\x10\x14\x18\x01\"\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\fpolicySetIds\x12D\n" +
+	"\vpolicy_mode\x18\n" +
+	" \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\v \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefault\x12+\n" +
+	"\tmax_views\x18\f \x01(\rB\t\xbaH\x06*\x04\x18d(\x01H\x03R\bmaxViews\x88\x01\x01\x12\x1f\n" +
+	"\vbind_device\x18\r \x01(\bR\n" +
+	"bindDevice\x125\n" +
+	"\n" +
+	"passphrase\x18\x0e \x01(\tB\x10\xbaH\ar\x05\x10\b\x18\x80\x02ڶ\x1a\x02z\x00H\x04R\n" +
+	"passphrase\x88\x01\x01B\x0e\n" +
+	"\f_template_idB\x0f\n" +
+	"\r_notify_emailB\r\n" +
+	"\v_expires_atB\f\n" +
+	"\n" +
+	"_max_viewsB\r\n" +
+	"\v_passphrase\"O\n" +
 	"\x13CreateShareResponse\x12\x19\n" +
 	"\bshare_id\x18\x01 \x01(\tR\ashareId\x12\x1d\n" +
 	"\n" +
@@ -1348,67 +4606,379 @@ const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"\x0fGetShareRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"H\n" +
 	"\x10GetShareResponse\x124\n" +
-	"\x05share\x18\x01 \x01(\v2\x1e.sharing.service.v1.SharedLinkR\x05share\"\x85\x02\n" +
+	"\x05share\x18\x01 \x01(\v2\x1e.sharing.service.v1.SharedLinkR\x05share\"\xfa\a\n" +
 	"\x11ListSharesRequest\x12\x17\n" +
-	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x02 \x01(\rH\x01R\bpageSize\x88\x01\x01\x12J\n" +
+	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x01R\bpageSize\x88\x01\x01\x12J\n" +
 	"\rresource_type\x18\x03 \x01(\x0e2 .sharing.service.v1.ResourceTypeH\x02R\fresourceType\x88\x01\x01\x12,\n" +
-	"\x0frecipient_email\x18\x04 \x01(\tH\x03R\x0erecipientEmail\x88\x01\x01B\a\n" +
+	"\x0frecipient_email\x18\x04 \x01(\tH\x03R\x0erecipientEmail\x88\x01\x01\x12<\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1f.sharing.service.v1.ShareStatusH\x04R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\rH\x05R\tcreatedBy\x88\x01\x01\x12D\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\aR\rcreatedBefore\x88\x01\x01\x12B\n" +
+	"\fviewed_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\bR\vviewedAfter\x88\x01\x01\x12D\n" +
+	"\rviewed_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\tR\fviewedBefore\x88\x01\x01\x12%\n" +
+	"\x06search\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\n" +
+	"R\x06search\x88\x01\x01\x12@\n" +
+	"\asort_by\x18\f \x01(\x0e2\".sharing.service.v1.ShareSortFieldH\vR\x06sortBy\x88\x01\x01\x12A\n" +
+	"\n" +
+	"sort_order\x18\r \x01(\x0e2\x1d.sharing.service.v1.SortOrderH\fR\tsortOrder\x88\x01\x01\x12,\n" +
+	"\n" +
+	"page_token\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\rR\tpageToken\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\x10\n" +
 	"\x0e_resource_typeB\x12\n" +
-	"\x10_recipient_email\"b\n" +
+	"\x10_recipient_emailB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_created_byB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_beforeB\x0f\n" +
+	"\r_viewed_afterB\x10\n" +
+	"\x0e_viewed_beforeB\t\n" +
+	"\a_searchB\n" +
+	"\n" +
+	"\b_sort_byB\r\n" +
+	"\v_sort_orderB\r\n" +
+	"\v_page_token\"\x8a\x01\n" +
 	"\x12ListSharesResponse\x126\n" +
 	"\x06shares\x18\x01 \x03(\v2\x1e.sharing.service.v1.SharedLinkR\x06shares\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"D\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"D\n" +
 	"\x12RevokeShareRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"M\n" +
-	"\x18ViewSharedContentRequest\x121\n" +
-	"\x05token\x18\x01 \x01(\tB\x1b\xe0A\x02\xbaH\x15r\x132\x0e^[a-fA-F0-9]+$\x98\x01@R\x05token\"\x91\x02\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xdd\x03\n" +
+	"\x18ViewSharedContentRequest\x12K\n" +
+	"\x05token\x18\x01 \x01(\tB5\xe0A\x02\xbaH/r-\x105\x18@2'^(tgs_[0-9A-Za-z]{49}|[a-fA-F0-9]{64})$R\x05token\x126\n" +
+	"\fdevice_token\x18\x02 \x01(\tB\x0e\xbaH\x05r\x03\x18\x80\x02ڶ\x1a\x02z\x00H\x00R\vdeviceToken\x88\x01\x01\x123\n" +
+	"\n" +
+	"passphrase\x18\x03 \x01(\tB\x0e\xbaH\x05r\x03\x18\x80\x02ڶ\x1a\x02z\x00H\x01R\n" +
+	"passphrase\x88\x01\x01\x12I\n" +
+	"\x11verification_code\x18\x04 \x01(\tB\x17\xbaH\x0er\f2\n" +
+	"^[0-9]{6}$ڶ\x1a\x02z\x00H\x02R\x10verificationCode\x88\x01\x01\x122\n" +
+	"\rpow_challenge\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02H\x03R\fpowChallenge\x88\x01\x01\x12/\n" +
+	"\fpow_solution\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18 H\x04R\vpowSolution\x88\x01\x01B\x0f\n" +
+	"\r_device_tokenB\r\n" +
+	"\v_passphraseB\x14\n" +
+	"\x12_verification_codeB\x10\n" +
+	"\x0e_pow_challengeB\x0f\n" +
+	"\r_pow_solution\"\xbc\x02\n" +
 	"\x19ViewSharedContentResponse\x12E\n" +
 	"\rresource_type\x18\x01 \x01(\x0e2 .sharing.service.v1.ResourceTypeR\fresourceType\x12\"\n" +
 	"\bpassword\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\x12*\n" +
 	"\ffile_content\x18\x03 \x01(\fB\aڶ\x1a\x03\x82\x01\x00R\vfileContent\x12\x1b\n" +
 	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12#\n" +
-	"\rresource_name\x18\x06 \x01(\tR\fresourceName\"\xe7\x01\n" +
+	"\rresource_name\x18\x06 \x01(\tR\fresourceName\x12)\n" +
+	"\fdevice_token\x18\a \x01(\tB\x06ڶ\x1a\x02z\x00R\vdeviceToken\"\xa5\x01\n" +
+	"\x18ReportLeakedTokenRequest\x12K\n" +
+	"\x05token\x18\x01 \x01(\tB5\xe0A\x02\xbaH/r-\x105\x18@2'^(tgs_[0-9A-Za-z]{49}|[a-fA-F0-9]{64})$R\x05token\x12 \n" +
+	"\x06source\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x06source\x12\x1a\n" +
+	"\x03url\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x03url\"O\n" +
+	"\x19ReportLeakedTokenResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12\x18\n" +
+	"\arevoked\x18\x02 \x01(\bR\arevoked\"\x83\x02\n" +
 	"\x16CreateSharePolicyInput\x12D\n" +
 	"\x04type\x18\x01 \x01(\x0e2#.sharing.service.v1.SharePolicyTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\x04type\x12J\n" +
 	"\x06method\x18\x02 \x01(\x0e2%.sharing.service.v1.SharePolicyMethodB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\x06method\x12#\n" +
 	"\x05value\x18\x03 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x04R\x05value\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xad\x02\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\"\xc9\x02\n" +
 	"\x18CreateSharePolicyRequest\x12B\n" +
 	"\rshare_link_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\vshareLinkId\x12D\n" +
 	"\x04type\x18\x02 \x01(\x0e2#.sharing.service.v1.SharePolicyTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\x04type\x12J\n" +
 	"\x06method\x18\x03 \x01(\x0e2%.sharing.service.v1.SharePolicyMethodB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\x06method\x12#\n" +
 	"\x05value\x18\x04 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x04R\x05value\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\"T\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\x05R\bpriority\"T\n" +
 	"\x19CreateSharePolicyResponse\x127\n" +
-	"\x06policy\x18\x01 \x01(\v2\x1f.sharing.service.v1.SharePolicyR\x06policy\"^\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1f.sharing.service.v1.SharePolicyR\x06policy\"\xec\x03\n" +
+	"\x10ShareAccessEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\"\n" +
+	"\rshare_link_id\x18\x03 \x01(\tR\vshareLinkId\x12!\n" +
+	"\ftoken_prefix\x18\x04 \x01(\tR\vtokenPrefix\x12@\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2&.sharing.service.v1.ShareAccessOutcomeR\aoutcome\x12\x1b\n" +
+	"\tpolicy_id\x18\x06 \x01(\tR\bpolicyId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgent\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x18\n" +
+	"\acountry\x18\v \x01(\tR\acountry\x12'\n" +
+	"\x0freputation_list\x18\f \x01(\tR\x0ereputationList\x12\"\n" +
+	"\n" +
+	"risk_score\x18\r \x01(\rH\x00R\triskScore\x88\x01\x01B\r\n" +
+	"\v_risk_score\"\xf4\x03\n" +
+	"\x1cListShareAccessEventsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x01R\bpageSize\x88\x01\x01\x12B\n" +
+	"\rshare_link_id\x18\x03 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x02R\vshareLinkId\x88\x01\x01\x12E\n" +
+	"\aoutcome\x18\x04 \x01(\x0e2&.sharing.service.v1.ShareAccessOutcomeH\x03R\aoutcome\x88\x01\x01\x12)\n" +
+	"\tclient_ip\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18-H\x04R\bclientIp\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\aendTime\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\x10\n" +
+	"\x0e_share_link_idB\n" +
+	"\n" +
+	"\b_outcomeB\f\n" +
+	"\n" +
+	"_client_ipB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"s\n" +
+	"\x1dListShareAccessEventsResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.sharing.service.v1.ShareAccessEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xe9\x01\n" +
+	"\x16GetSharingStatsRequest\x12>\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12)\n" +
+	"\ttop_limit\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dH\x02R\btopLimit\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\f\n" +
+	"\n" +
+	"_top_limit\"w\n" +
+	"\x11ShareStatusCounts\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\x04R\x06active\x12\x16\n" +
+	"\x06viewed\x18\x02 \x01(\x04R\x06viewed\x12\x18\n" +
+	"\arevoked\x18\x03 \x01(\x04R\arevoked\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\x04R\aexpired\"p\n" +
+	"\x11ResourceTypeCount\x12E\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2 .sharing.service.v1.ResourceTypeR\fresourceType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\";\n" +
+	"\x0fDailyShareCount\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"8\n" +
+	"\x0eRecipientCount\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"p\n" +
+	"\vSharerCount\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\rH\x00R\x06userId\x88\x01\x01\x12!\n" +
+	"\fsender_email\x18\x02 \x01(\tR\vsenderEmail\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05countB\n" +
+	"\n" +
+	"\b_user_id\"\xa2\x05\n" +
+	"\x17GetSharingStatsResponse\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x04R\x05total\x12B\n" +
+	"\tby_status\x18\x04 \x01(\v2%.sharing.service.v1.ShareStatusCountsR\bbyStatus\x12O\n" +
+	"\x10by_resource_type\x18\x05 \x03(\v2%.sharing.service.v1.ResourceTypeCountR\x0ebyResourceType\x12<\n" +
+	"\aper_day\x18\x06 \x03(\v2#.sharing.service.v1.DailyShareCountR\x06perDay\x123\n" +
+	"\x16median_seconds_to_view\x18\a \x01(\x01R\x13medianSecondsToView\x12I\n" +
+	"\x0etop_recipients\x18\b \x03(\v2\".sharing.service.v1.RecipientCountR\rtopRecipients\x12C\n" +
+	"\vtop_domains\x18\t \x03(\v2\".sharing.service.v1.RecipientCountR\n" +
+	"topDomains\x12@\n" +
+	"\vtop_sharers\x18\n" +
+	" \x03(\v2\x1f.sharing.service.v1.SharerCountR\n" +
+	"topSharers\x12%\n" +
+	"\x0epolicy_denials\x18\v \x01(\x04R\rpolicyDenials\"\x8a\x01\n" +
+	"\x17NotificationPreferences\x12#\n" +
+	"\rnotify_viewed\x18\x01 \x01(\bR\fnotifyViewed\x12#\n" +
+	"\rnotify_denied\x18\x02 \x01(\bR\fnotifyDenied\x12%\n" +
+	"\x0enotify_expired\x18\x03 \x01(\bR\rnotifyExpired\"#\n" +
+	"!GetNotificationPreferencesRequest\"s\n" +
+	"\"GetNotificationPreferencesResponse\x12M\n" +
+	"\vpreferences\x18\x01 \x01(\v2+.sharing.service.v1.NotificationPreferencesR\vpreferences\"\xdd\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12(\n" +
+	"\rnotify_viewed\x18\x01 \x01(\bH\x00R\fnotifyViewed\x88\x01\x01\x12(\n" +
+	"\rnotify_denied\x18\x02 \x01(\bH\x01R\fnotifyDenied\x88\x01\x01\x12*\n" +
+	"\x0enotify_expired\x18\x03 \x01(\bH\x02R\rnotifyExpired\x88\x01\x01B\x10\n" +
+	"\x0e_notify_viewedB\x10\n" +
+	"\x0e_notify_deniedB\x11\n" +
+	"\x0f_notify_expired\"v\n" +
+	"%UpdateNotificationPreferencesResponse\x12M\n" +
+	"\vpreferences\x18\x01 \x01(\v2+.sharing.service.v1.NotificationPreferencesR\vpreferences\"\xf4\x01\n" +
+	"\fRiskSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12*\n" +
+	"\x11step_up_threshold\x18\x02 \x01(\rR\x0fstepUpThreshold\x12%\n" +
+	"\x0edeny_threshold\x18\x03 \x01(\rR\rdenyThreshold\x12%\n" +
+	"\x0ebusiness_hours\x18\x04 \x01(\tR\rbusinessHours\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updateTime\x88\x01\x01B\x0e\n" +
+	"\f_update_time\"\x18\n" +
+	"\x16GetRiskSettingsRequest\"W\n" +
+	"\x17GetRiskSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .sharing.service.v1.RiskSettingsR\bsettings\"\xab\x02\n" +
+	"\x19UpdateRiskSettingsRequest\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bH\x00R\aenabled\x88\x01\x01\x12:\n" +
+	"\x11step_up_threshold\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18d(\x01H\x01R\x0fstepUpThreshold\x88\x01\x01\x125\n" +
+	"\x0edeny_threshold\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18e(\x01H\x02R\rdenyThreshold\x88\x01\x01\x124\n" +
+	"\x0ebusiness_hours\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x03R\rbusinessHours\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\x14\n" +
+	"\x12_step_up_thresholdB\x11\n" +
+	"\x0f_deny_thresholdB\x11\n" +
+	"\x0f_business_hours\"Z\n" +
+	"\x1aUpdateRiskSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .sharing.service.v1.RiskSettingsR\bsettings\"\xf7\x01\n" +
+	" SendShareVerificationCodeRequest\x12K\n" +
+	"\x05token\x18\x01 \x01(\tB5\xe0A\x02\xbaH/r-\x105\x18@2'^(tgs_[0-9A-Za-z]{49}|[a-fA-F0-9]{64})$R\x05token\x122\n" +
+	"\rpow_challenge\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02H\x00R\fpowChallenge\x88\x01\x01\x12/\n" +
+	"\fpow_solution\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18 H\x01R\vpowSolution\x88\x01\x01B\x10\n" +
+	"\x0e_pow_challengeB\x0f\n" +
+	"\r_pow_solution\"g\n" +
+	"\x18GetShareChallengeRequest\x12K\n" +
+	"\x05token\x18\x01 \x01(\tB5\xe0A\x02\xbaH/r-\x105\x18@2'^(tgs_[0-9A-Za-z]{49}|[a-fA-F0-9]{64})$R\x05token\"\xe2\x01\n" +
+	"\x19GetShareChallengeResponse\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\rR\n" +
+	"difficulty\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"^\n" +
+	"!SendShareVerificationCodeResponse\x129\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"^\n" +
 	"\x18ListSharePoliciesRequest\x12B\n" +
 	"\rshare_link_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\vshareLinkId\"X\n" +
 	"\x19ListSharePoliciesResponse\x12;\n" +
 	"\bpolicies\x18\x01 \x03(\v2\x1f.sharing.service.v1.SharePolicyR\bpolicies\"\x8e\x01\n" +
 	"\x18DeleteSharePolicyRequest\x12B\n" +
 	"\rshare_link_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\vshareLinkId\x12.\n" +
-	"\x02id\x18\x02 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id*v\n" +
+	"\x02id\x18\x02 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xab\x01\n" +
+	"\x19SetSharePolicySetsRequest\x12B\n" +
+	"\rshare_link_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\vshareLinkId\x12J\n" +
+	"\x0epolicy_set_ids\x18\x02 \x03(\tB$\xbaH!\x92\x01\x1e\x10\x14\x18\x01\"\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\fpolicySetIds\"B\n" +
+	"\x1aSetSharePolicySetsResponse\x12$\n" +
+	"\x0epolicy_set_ids\x18\x01 \x03(\tR\fpolicySetIds\"\xf4\x01\n" +
+	"\x19SetSharePolicyModeRequest\x12B\n" +
+	"\rshare_link_id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\vshareLinkId\x12D\n" +
+	"\vpolicy_mode\x18\x02 \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\x03 \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefault\"\xb1\x01\n" +
+	"\x1aSetSharePolicyModeResponse\x12D\n" +
+	"\vpolicy_mode\x18\x01 \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\x02 \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefault\"P\n" +
+	"\x1eResetShareDeviceBindingRequest\x12.\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xf3\x03\n" +
+	"\x16PolicyEvaluationClient\x12\x17\n" +
+	"\x02ip\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\x02ip\x123\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04time\x88\x01\x01\x12'\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tuserAgent\x12!\n" +
+	"\acountry\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18\x02R\acountry\x12\"\n" +
+	"\fsubdivisions\x18\x05 \x03(\tR\fsubdivisions\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x121\n" +
+	"\rattempt_count\x18\a \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\fattemptCount\x88\x01\x01\x121\n" +
+	"\x0frecipient_email\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xc0\x02R\x0erecipientEmail\x12Q\n" +
+	"\vcertificate\x18\t \x01(\v2/.sharing.service.v1.PolicyEvaluationCertificateR\vcertificate\x123\n" +
+	"\x10reputation_lists\x18\n" +
+	" \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x14R\x0freputationListsB\a\n" +
+	"\x05_timeB\x10\n" +
+	"\x0e_attempt_count\"\xaa\x01\n" +
+	"\x1bPolicyEvaluationCertificate\x12 \n" +
+	"\x06sha256\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06sha256\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\x12\x1b\n" +
+	"\tca_sha256\x18\x05 \x03(\tR\bcaSha256\"\xec\x03\n" +
+	"\x1cEvaluateSharePoliciesRequest\x12B\n" +
+	"\rshare_link_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\vshareLinkId\x88\x01\x01\x12F\n" +
+	"\bpolicies\x18\x02 \x03(\v2*.sharing.service.v1.CreateSharePolicyInputR\bpolicies\x12M\n" +
+	"\x06client\x18\x03 \x01(\v2*.sharing.service.v1.PolicyEvaluationClientB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06client\x12J\n" +
+	"\x0epolicy_set_ids\x18\x04 \x03(\tB$\xbaH!\x92\x01\x1e\x10\x14\x18\x01\"\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\fpolicySetIds\x12D\n" +
+	"\vpolicy_mode\x18\x05 \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\x06 \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefaultB\x10\n" +
+	"\x0e_share_link_id\"\xeb\x02\n" +
+	"\x10SharePolicyTrace\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\x12\"\n" +
+	"\rpolicy_set_id\x18\t \x01(\tR\vpolicySetId\x127\n" +
+	"\x04type\x18\x03 \x01(\x0e2#.sharing.service.v1.SharePolicyTypeR\x04type\x12=\n" +
+	"\x06method\x18\x04 \x01(\x0e2%.sharing.service.v1.SharePolicyMethodR\x06method\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x18\n" +
+	"\amatched\x18\x06 \x01(\bR\amatched\x12\x1a\n" +
+	"\bdecisive\x18\a \x01(\bR\bdecisive\x12 \n" +
+	"\vexplanation\x18\b \x01(\tR\vexplanation\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\"\x8f\x05\n" +
+	"\x1dEvaluateSharePoliciesResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12J\n" +
+	"\rdenied_method\x18\x03 \x01(\x0e2%.sharing.service.v1.SharePolicyMethodR\fdeniedMethod\x12:\n" +
+	"\x05trace\x18\x04 \x03(\v2$.sharing.service.v1.SharePolicyTraceR\x05trace\x12=\n" +
+	"\fevaluated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\"\n" +
+	"\fsubdivisions\x18\a \x03(\tR\fsubdivisions\x12\x12\n" +
+	"\x04city\x18\b \x01(\tR\x04city\x12\x1b\n" +
+	"\tdevice_os\x18\t \x01(\tR\bdeviceOs\x12%\n" +
+	"\x0edevice_browser\x18\n" +
+	" \x01(\tR\rdeviceBrowser\x12!\n" +
+	"\fdevice_class\x18\v \x01(\tR\vdeviceClass\x12D\n" +
+	"\vpolicy_mode\x18\f \x01(\x0e2#.sharing.service.v1.SharePolicyModeR\n" +
+	"policyMode\x12M\n" +
+	"\x0epolicy_default\x18\r \x01(\x0e2&.sharing.service.v1.SharePolicyDefaultR\rpolicyDefault\x12'\n" +
+	"\x0fdefault_applied\x18\x0e \x01(\bR\x0edefaultApplied*v\n" +
 	"\x0fSharePolicyType\x12!\n" +
 	"\x1dSHARE_POLICY_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bSHARE_POLICY_TYPE_BLACKLIST\x10\x01\x12\x1f\n" +
-	"\x1bSHARE_POLICY_TYPE_WHITELIST\x10\x02*\xf0\x01\n" +
+	"\x1bSHARE_POLICY_TYPE_WHITELIST\x10\x02*\xe1\x02\n" +
 	"\x11SharePolicyMethod\x12#\n" +
 	"\x1fSHARE_POLICY_METHOD_UNSPECIFIED\x10\x00\x12\x1a\n" +
-	"\x16SHARE_POLICY_METHOD_IP\x10\x01\x12\x1b\n" +
-	"\x17SHARE_POLICY_METHOD_MAC\x10\x02\x12\x1e\n" +
+	"\x16SHARE_POLICY_METHOD_IP\x10\x01\x12\x1f\n" +
+	"\x17SHARE_POLICY_METHOD_MAC\x10\x02\x1a\x02\b\x01\x12\x1e\n" +
 	"\x1aSHARE_POLICY_METHOD_REGION\x10\x03\x12\x1c\n" +
 	"\x18SHARE_POLICY_METHOD_TIME\x10\x04\x12\x1e\n" +
 	"\x1aSHARE_POLICY_METHOD_DEVICE\x10\x05\x12\x1f\n" +
-	"\x1bSHARE_POLICY_METHOD_NETWORK\x10\x06*c\n" +
+	"\x1bSHARE_POLICY_METHOD_NETWORK\x10\x06\x12\"\n" +
+	"\x1eSHARE_POLICY_METHOD_EXPRESSION\x10\a\x12#\n" +
+	"\x1fSHARE_POLICY_METHOD_CERTIFICATE\x10\b\x12\"\n" +
+	"\x1eSHARE_POLICY_METHOD_REPUTATION\x10\t*\xa1\x01\n" +
+	"\x0fSharePolicyMode\x12!\n" +
+	"\x1dSHARE_POLICY_MODE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSHARE_POLICY_MODE_ALL_MUST_PASS\x10\x01\x12#\n" +
+	"\x1fSHARE_POLICY_MODE_ANY_WHITELIST\x10\x02\x12!\n" +
+	"\x1dSHARE_POLICY_MODE_FIRST_MATCH\x10\x03*y\n" +
+	"\x12SharePolicyDefault\x12$\n" +
+	" SHARE_POLICY_DEFAULT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSHARE_POLICY_DEFAULT_ALLOW\x10\x01\x12\x1d\n" +
+	"\x19SHARE_POLICY_DEFAULT_DENY\x10\x02*c\n" +
 	"\fResourceType\x12\x1d\n" +
 	"\x19RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14RESOURCE_TYPE_SECRET\x10\x01\x12\x1a\n" +
-	"\x16RESOURCE_TYPE_DOCUMENT\x10\x022\xb5\b\n" +
+	"\x16RESOURCE_TYPE_DOCUMENT\x10\x02*\xd9\x03\n" +
+	"\x12ShareAccessOutcome\x12$\n" +
+	" SHARE_ACCESS_OUTCOME_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSHARE_ACCESS_OUTCOME_GRANTED\x10\x01\x12&\n" +
+	"\"SHARE_ACCESS_OUTCOME_POLICY_DENIED\x10\x02\x12'\n" +
+	"#SHARE_ACCESS_OUTCOME_ALREADY_VIEWED\x10\x03\x12 \n" +
+	"\x1cSHARE_ACCESS_OUTCOME_REVOKED\x10\x04\x12\"\n" +
+	"\x1eSHARE_ACCESS_OUTCOME_NOT_FOUND\x10\x05\x12\x1e\n" +
+	"\x1aSHARE_ACCESS_OUTCOME_ERROR\x10\x06\x12 \n" +
+	"\x1cSHARE_ACCESS_OUTCOME_EXPIRED\x10\a\x12(\n" +
+	"$SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH\x10\b\x12)\n" +
+	"%SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED\x10\t\x12'\n" +
+	"#SHARE_ACCESS_OUTCOME_STEP_UP_FAILED\x10\n" +
+	"\x12$\n" +
+	" SHARE_ACCESS_OUTCOME_RISK_DENIED\x10\v*\x91\x01\n" +
+	"\vShareStatus\x12\x1c\n" +
+	"\x18SHARE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SHARE_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13SHARE_STATUS_VIEWED\x10\x02\x12\x18\n" +
+	"\x14SHARE_STATUS_REVOKED\x10\x03\x12\x18\n" +
+	"\x14SHARE_STATUS_EXPIRED\x10\x04*\x9e\x01\n" +
+	"\x0eShareSortField\x12 \n" +
+	"\x1cSHARE_SORT_FIELD_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSHARE_SORT_FIELD_CREATE_TIME\x10\x01\x12\"\n" +
+	"\x1eSHARE_SORT_FIELD_RESOURCE_NAME\x10\x02\x12$\n" +
+	" SHARE_SORT_FIELD_RECIPIENT_EMAIL\x10\x03*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x022\xdb\x18\n" +
 	"\x13SharingShareService\x12u\n" +
 	"\vCreateShare\x12&.sharing.service.v1.CreateShareRequest\x1a'.sharing.service.v1.CreateShareResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/shares\x12n\n" +
@@ -1417,10 +4987,23 @@ const file_sharing_service_v1_share_proto_rawDesc = "" +
 	"ListShares\x12%.sharing.service.v1.ListSharesRequest\x1a&.sharing.service.v1.ListSharesResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/shares\x12f\n" +
 	"\vRevokeShare\x12&.sharing.service.v1.RevokeShareRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/shares/{id}\x12\x8c\x01\n" +
-	"\x11ViewSharedContent\x12,.sharing.service.v1.ViewSharedContentRequest\x1a-.sharing.service.v1.ViewSharedContentResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/shared/{token}\x12\xa0\x01\n" +
+	"\x11ViewSharedContent\x12,.sharing.service.v1.ViewSharedContentRequest\x1a-.sharing.service.v1.ViewSharedContentResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/shared/{token}\x12\x96\x01\n" +
+	"\x11GetShareChallenge\x12,.sharing.service.v1.GetShareChallengeRequest\x1a-.sharing.service.v1.GetShareChallengeResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/shared/{token}/challenge\x12\xb9\x01\n" +
+	"\x19SendShareVerificationCode\x124.sharing.service.v1.SendShareVerificationCodeRequest\x1a5.sharing.service.v1.SendShareVerificationCodeResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/shared/{token}/verification-code\x12\x8d\x01\n" +
+	"\x11ReportLeakedToken\x12,.sharing.service.v1.ReportLeakedTokenRequest\x1a-.sharing.service.v1.ReportLeakedTokenResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/shared/leaks\x12\x9d\x01\n" +
+	"\x15ListShareAccessEvents\x120.sharing.service.v1.ListShareAccessEventsRequest\x1a1.sharing.service.v1.ListShareAccessEventsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/share-access-events\x12\x85\x01\n" +
+	"\x0fGetSharingStats\x12*.sharing.service.v1.GetSharingStatsRequest\x1a+.sharing.service.v1.GetSharingStatsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/sharing-stats\x12\xb1\x01\n" +
+	"\x1aGetNotificationPreferences\x125.sharing.service.v1.GetNotificationPreferencesRequest\x1a6.sharing.service.v1.GetNotificationPreferencesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification-preferences\x12\xbd\x01\n" +
+	"\x1dUpdateNotificationPreferences\x128.sharing.service.v1.UpdateNotificationPreferencesRequest\x1a9.sharing.service.v1.UpdateNotificationPreferencesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/notification-preferences\x12\x85\x01\n" +
+	"\x0fGetRiskSettings\x12*.sharing.service.v1.GetRiskSettingsRequest\x1a+.sharing.service.v1.GetRiskSettingsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/risk-settings\x12\x91\x01\n" +
+	"\x12UpdateRiskSettings\x12-.sharing.service.v1.UpdateRiskSettingsRequest\x1a..sharing.service.v1.UpdateRiskSettingsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/risk-settings\x12\xa0\x01\n" +
 	"\x11CreateSharePolicy\x12,.sharing.service.v1.CreateSharePolicyRequest\x1a-.sharing.service.v1.CreateSharePolicyResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/shares/{share_link_id}/policies\x12\x9d\x01\n" +
 	"\x11ListSharePolicies\x12,.sharing.service.v1.ListSharePoliciesRequest\x1a-.sharing.service.v1.ListSharePoliciesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/shares/{share_link_id}/policies\x12\x8b\x01\n" +
-	"\x11DeleteSharePolicy\x12,.sharing.service.v1.DeleteSharePolicyRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02**(/v1/shares/{share_link_id}/policies/{id}B\xda\x01\n" +
+	"\x11DeleteSharePolicy\x12,.sharing.service.v1.DeleteSharePolicyRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02**(/v1/shares/{share_link_id}/policies/{id}\x12\xa6\x01\n" +
+	"\x12SetSharePolicySets\x12-.sharing.service.v1.SetSharePolicySetsRequest\x1a..sharing.service.v1.SetSharePolicySetsResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/shares/{share_link_id}/policy-sets\x12\xa6\x01\n" +
+	"\x12SetSharePolicyMode\x12-.sharing.service.v1.SetSharePolicyModeRequest\x1a..sharing.service.v1.SetSharePolicyModeResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/shares/{share_link_id}/policy-mode\x12\x8d\x01\n" +
+	"\x17ResetShareDeviceBinding\x122.sharing.service.v1.ResetShareDeviceBindingRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/shares/{id}/device-binding\x12\xa4\x01\n" +
+	"\x15EvaluateSharePolicies\x120.sharing.service.v1.EvaluateSharePoliciesRequest\x1a1.sharing.service.v1.EvaluateSharePoliciesResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/share-policies:evaluateB\xda\x01\n" +
 	"\x16com.sharing.service.v1B\n" +
 	"ShareProtoP\x01ZJgithub.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1;sharingpb\xa2\x02\x03SSX\xaa\x02\x12Sharing.Service.V1\xca\x02\x12Sharing\\Service\\V1\xe2\x02\x1eSharing\\Service\\V1\\GPBMetadata\xea\x02\x14Sharing::Service::V1b\x06proto3"
 
@@ -1436,73 +5019,197 @@ func file_sharing_service_v1_share_proto_rawDescGZIP() []byte {
 	return file_sharing_service_v1_share_proto_rawDescData
 }
 
-var file_sharing_service_v1_share_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_sharing_service_v1_share_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_sharing_service_v1_share_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_sharing_service_v1_share_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_sharing_service_v1_share_proto_goTypes = []any{
-	(SharePolicyType)(0),              // 0: sharing.service.v1.SharePolicyType
-	(SharePolicyMethod)(0),            // 1: sharing.service.v1.SharePolicyMethod
-	(ResourceType)(0),                 // 2: sharing.service.v1.ResourceType
-	(*SharePolicy)(nil),               // 3: sharing.service.v1.SharePolicy
-	(*SharedLink)(nil),                // 4: sharing.service.v1.SharedLink
-	(*CreateShareRequest)(nil),        // 5: sharing.service.v1.CreateShareRequest
-	(*CreateShareResponse)(nil),       // 6: sharing.service.v1.CreateShareResponse
-	(*GetShareRequest)(nil),           // 7: sharing.service.v1.GetShareRequest
-	(*GetShareResponse)(nil),          // 8: sharing.service.v1.GetShareResponse
-	(*ListSharesRequest)(nil),         // 9: sharing.service.v1.ListSharesRequest
-	(*ListSharesResponse)(nil),        // 10: sharing.service.v1.ListSharesResponse
-	(*RevokeShareRequest)(nil),        // 11: sharing.service.v1.RevokeShareRequest
-	(*ViewSharedContentRequest)(nil),  // 12: sharing.service.v1.ViewSharedContentRequest
-	(*ViewSharedContentResponse)(nil), // 13: sharing.service.v1.ViewSharedContentResponse
-	(*CreateSharePolicyInput)(nil),    // 14: sharing.service.v1.CreateSharePolicyInput
-	(*CreateSharePolicyRequest)(nil),  // 15: sharing.service.v1.CreateSharePolicyRequest
-	(*CreateSharePolicyResponse)(nil), // 16: sharing.service.v1.CreateSharePolicyResponse
-	(*ListSharePoliciesRequest)(nil),  // 17: sharing.service.v1.ListSharePoliciesRequest
-	(*ListSharePoliciesResponse)(nil), // 18: sharing.service.v1.ListSharePoliciesResponse
-	(*DeleteSharePolicyRequest)(nil),  // 19: sharing.service.v1.DeleteSharePolicyRequest
-	(*timestamppb.Timestamp)(nil),     // 20: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 21: google.protobuf.Empty
+	(SharePolicyType)(0),                          // 0: sharing.service.v1.SharePolicyType
+	(SharePolicyMethod)(0),                        // 1: sharing.service.v1.SharePolicyMethod
+	(SharePolicyMode)(0),                          // 2: sharing.service.v1.SharePolicyMode
+	(SharePolicyDefault)(0),                       // 3: sharing.service.v1.SharePolicyDefault
+	(ResourceType)(0),                             // 4: sharing.service.v1.ResourceType
+	(ShareAccessOutcome)(0),                       // 5: sharing.service.v1.ShareAccessOutcome
+	(ShareStatus)(0),                              // 6: sharing.service.v1.ShareStatus
+	(ShareSortField)(0),                           // 7: sharing.service.v1.ShareSortField
+	(SortOrder)(0),                                // 8: sharing.service.v1.SortOrder
+	(*SharePolicy)(nil),                           // 9: sharing.service.v1.SharePolicy
+	(*SharedLink)(nil),                            // 10: sharing.service.v1.SharedLink
+	(*CreateShareRequest)(nil),                    // 11: sharing.service.v1.CreateShareRequest
+	(*CreateShareResponse)(nil),                   // 12: sharing.service.v1.CreateShareResponse
+	(*GetShareRequest)(nil),                       // 13: sharing.service.v1.GetShareRequest
+	(*GetShareResponse)(nil),                      // 14: sharing.service.v1.GetShareResponse
+	(*ListSharesRequest)(nil),                     // 15: sharing.service.v1.ListSharesRequest
+	(*ListSharesResponse)(nil),                    // 16: sharing.service.v1.ListSharesResponse
+	(*RevokeShareRequest)(nil),                    // 17: sharing.service.v1.RevokeShareRequest
+	(*ViewSharedContentRequest)(nil),              // 18: sharing.service.v1.ViewSharedContentRequest
+	(*ViewSharedContentResponse)(nil),             // 19: sharing.service.v1.ViewSharedContentResponse
+	(*ReportLeakedTokenRequest)(nil),              // 20: sharing.service.v1.ReportLeakedTokenRequest
+	(*ReportLeakedTokenResponse)(nil),             // 21: sharing.service.v1.ReportLeakedTokenResponse
+	(*CreateSharePolicyInput)(nil),                // 22: sharing.service.v1.CreateSharePolicyInput
+	(*CreateSharePolicyRequest)(nil),              // 23: sharing.service.v1.CreateSharePolicyRequest
+	(*CreateSharePolicyResponse)(nil),             // 24: sharing.service.v1.CreateSharePolicyResponse
+	(*ShareAccessEvent)(nil),                      // 25: sharing.service.v1.ShareAccessEvent
+	(*ListShareAccessEventsRequest)(nil),          // 26: sharing.service.v1.ListShareAccessEventsRequest
+	(*ListShareAccessEventsResponse)(nil),         // 27: sharing.service.v1.ListShareAccessEventsResponse
+	(*GetSharingStatsRequest)(nil),                // 28: sharing.service.v1.GetSharingStatsRequest
+	(*ShareStatusCounts)(nil),                     // 29: sharing.service.v1.ShareStatusCounts
+	(*ResourceTypeCount)(nil),                     // 30: sharing.service.v1.ResourceTypeCount
+	(*DailyShareCount)(nil),                       // 31: sharing.service.v1.DailyShareCount
+	(*RecipientCount)(nil),                        // 32: sharing.service.v1.RecipientCount
+	(*SharerCount)(nil),                           // 33: sharing.service.v1.SharerCount
+	(*GetSharingStatsResponse)(nil),               // 34: sharing.service.v1.GetSharingStatsResponse
+	(*NotificationPreferences)(nil),               // 35: sharing.service.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 36: sharing.service.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 37: sharing.service.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 38: sharing.service.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 39: sharing.service.v1.UpdateNotificationPreferencesResponse
+	(*RiskSettings)(nil),                          // 40: sharing.service.v1.RiskSettings
+	(*GetRiskSettingsRequest)(nil),                // 41: sharing.service.v1.GetRiskSettingsRequest
+	(*GetRiskSettingsResponse)(nil),               // 42: sharing.service.v1.GetRiskSettingsResponse
+	(*UpdateRiskSettingsRequest)(nil),             // 43: sharing.service.v1.UpdateRiskSettingsRequest
+	(*UpdateRiskSettingsResponse)(nil),            // 44: sharing.service.v1.UpdateRiskSettingsResponse
+	(*SendShareVerificationCodeRequest)(nil),      // 45: sharing.service.v1.SendShareVerificationCodeRequest
+	(*GetShareChallengeRequest)(nil),              // 46: sharing.service.v1.GetShareChallengeRequest
+	(*GetShareChallengeResponse)(nil),             // 47: sharing.service.v1.GetShareChallengeResponse
+	(*SendShareVerificationCodeResponse)(nil),     // 48: sharing.service.v1.SendShareVerificationCodeResponse
+	(*ListSharePoliciesRequest)(nil),              // 49: sharing.service.v1.ListSharePoliciesRequest
+	(*ListSharePoliciesResponse)(nil),             // 50: sharing.service.v1.ListSharePoliciesResponse
+	(*DeleteSharePolicyRequest)(nil),              // 51: sharing.service.v1.DeleteSharePolicyRequest
+	(*SetSharePolicySetsRequest)(nil),             // 52: sharing.service.v1.SetSharePolicySetsRequest
+	(*SetSharePolicySetsResponse)(nil),            // 53: sharing.service.v1.SetSharePolicySetsResponse
+	(*SetSharePolicyModeRequest)(nil),             // 54: sharing.service.v1.SetSharePolicyModeRequest
+	(*SetSharePolicyModeResponse)(nil),            // 55: sharing.service.v1.SetSharePolicyModeResponse
+	(*ResetShareDeviceBindingRequest)(nil),        // 56: sharing.service.v1.ResetShareDeviceBindingRequest
+	(*PolicyEvaluationClient)(nil),                // 57: sharing.service.v1.PolicyEvaluationClient
+	(*PolicyEvaluationCertificate)(nil),           // 58: sharing.service.v1.PolicyEvaluationCertificate
+	(*EvaluateSharePoliciesRequest)(nil),          // 59: sharing.service.v1.EvaluateSharePoliciesRequest
+	(*SharePolicyTrace)(nil),                      // 60: sharing.service.v1.SharePolicyTrace
+	(*EvaluateSharePoliciesResponse)(nil),         // 61: sharing.service.v1.EvaluateSharePoliciesResponse
+	(*timestamppb.Timestamp)(nil),                 // 62: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                         // 63: google.protobuf.Empty
 }
 var file_sharing_service_v1_share_proto_depIdxs = []int32{
 	0,  // 0: sharing.service.v1.SharePolicy.type:type_name -> sharing.service.v1.SharePolicyType
 	1,  // 1: sharing.service.v1.SharePolicy.method:type_name -> sharing.service.v1.SharePolicyMethod
-	20, // 2: sharing.service.v1.SharePolicy.create_time:type_name -> google.protobuf.Timestamp
-	2,  // 3: sharing.service.v1.SharedLink.resource_type:type_name -> sharing.service.v1.ResourceType
-	20, // 4: sharing.service.v1.SharedLink.viewed_at:type_name -> google.protobuf.Timestamp
-	20, // 5: sharing.service.v1.SharedLink.create_time:type_name -> google.protobuf.Timestamp
-	3,  // 6: sharing.service.v1.SharedLink.policies:type_name -> sharing.service.v1.SharePolicy
-	2,  // 7: sharing.service.v1.CreateShareRequest.resource_type:type_name -> sharing.service.v1.ResourceType
-	14, // 8: sharing.service.v1.CreateShareRequest.policies:type_name -> sharing.service.v1.CreateSharePolicyInput
-	4,  // 9: sharing.service.v1.GetShareResponse.share:type_name -> sharing.service.v1.SharedLink
-	2,  // 10: sharing.service.v1.ListSharesRequest.resource_type:type_name -> sharing.service.v1.ResourceType
-	4,  // 11: sharing.service.v1.ListSharesResponse.shares:type_name -> sharing.service.v1.SharedLink
-	2,  // 12: sharing.service.v1.ViewSharedContentResponse.resource_type:type_name -> sharing.service.v1.ResourceType
-	0,  // 13: sharing.service.v1.CreateSharePolicyInput.type:type_name -> sharing.service.v1.SharePolicyType
-	1,  // 14: sharing.service.v1.CreateSharePolicyInput.method:type_name -> sharing.service.v1.SharePolicyMethod
-	0,  // 15: sharing.service.v1.CreateSharePolicyRequest.type:type_name -> sharing.service.v1.SharePolicyType
-	1,  // 16: sharing.service.v1.CreateSharePolicyRequest.method:type_name -> sharing.service.v1.SharePolicyMethod
-	3,  // 17: sharing.service.v1.CreateSharePolicyResponse.policy:type_name -> sharing.service.v1.SharePolicy
-	3,  // 18: sharing.service.v1.ListSharePoliciesResponse.policies:type_name -> sharing.service.v1.SharePolicy
-	5,  // 19: sharing.service.v1.SharingShareService.CreateShare:input_type -> sharing.service.v1.CreateShareRequest
-	7,  // 20: sharing.service.v1.SharingShareService.GetShare:input_type -> sharing.service.v1.GetShareRequest
-	9,  // 21: sharing.service.v1.SharingShareService.ListShares:input_type -> sharing.service.v1.ListSharesRequest
-	11, // 22: sharing.service.v1.SharingShareService.RevokeShare:input_type -> sharing.service.v1.RevokeShareRequest
-	12, // 23: sharing.service.v1.SharingShareService.ViewSharedContent:input_type -> sharing.service.v1.ViewSharedContentRequest
-	15, // 24: sharing.service.v1.SharingShareService.CreateSharePolicy:input_type -> sharing.service.v1.CreateSharePolicyRequest
-	17, // 25: sharing.service.v1.SharingShareService.ListSharePolicies:input_type -> sharing.service.v1.ListSharePoliciesRequest
-	19, // 26: sharing.service.v1.SharingShareService.DeleteSharePolicy:input_type -> sharing.service.v1.DeleteSharePolicyRequest
-	6,  // 27: sharing.service.v1.SharingShareService.CreateShare:output_type -> sharing.service.v1.CreateShareResponse
-	8,  // 28: sharing.service.v1.SharingShareService.GetShare:output_type -> sharing.service.v1.GetShareResponse
-	10, // 29: sharing.service.v1.SharingShareService.ListShares:output_type -> sharing.service.v1.ListSharesResponse
-	21, // 30: sharing.service.v1.SharingShareService.RevokeShare:output_type -> google.protobuf.Empty
-	13, // 31: sharing.service.v1.SharingShareService.ViewSharedContent:output_type -> sharing.service.v1.ViewSharedContentResponse
-	16, // 32: sharing.service.v1.SharingShareService.CreateSharePolicy:output_type -> sharing.service.v1.CreateSharePolicyResponse
-	18, // 33: sharing.service.v1.SharingShareService.ListSharePolicies:output_type -> sharing.service.v1.ListSharePoliciesResponse
-	21, // 34: sharing.service.v1.SharingShareService.DeleteSharePolicy:output_type -> google.protobuf.Empty
-	27, // [27:35] is the sub-list for method output_type
-	19, // [19:27] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	62, // 2: sharing.service.v1.SharePolicy.create_time:type_name -> google.protobuf.Timestamp
+	4,  // 3: sharing.service.v1.SharedLink.resource_type:type_name -> sharing.service.v1.ResourceType
+	62, // 4: sharing.service.v1.SharedLink.viewed_at:type_name -> google.protobuf.Timestamp
+	62, // 5: sharing.service.v1.SharedLink.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 6: sharing.service.v1.SharedLink.policies:type_name -> sharing.service.v1.SharePolicy
+	62, // 7: sharing.service.v1.SharedLink.expires_at:type_name -> google.protobuf.Timestamp
+	62, // 8: sharing.service.v1.SharedLink.authorized_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: sharing.service.v1.SharedLink.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 10: sharing.service.v1.SharedLink.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	62, // 11: sharing.service.v1.SharedLink.device_bound_at:type_name -> google.protobuf.Timestamp
+	4,  // 12: sharing.service.v1.CreateShareRequest.resource_type:type_name -> sharing.service.v1.ResourceType
+	22, // 13: sharing.service.v1.CreateShareRequest.policies:type_name -> sharing.service.v1.CreateSharePolicyInput
+	62, // 14: sharing.service.v1.CreateShareRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 15: sharing.service.v1.CreateShareRequest.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 16: sharing.service.v1.CreateShareRequest.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	10, // 17: sharing.service.v1.GetShareResponse.share:type_name -> sharing.service.v1.SharedLink
+	4,  // 18: sharing.service.v1.ListSharesRequest.resource_type:type_name -> sharing.service.v1.ResourceType
+	6,  // 19: sharing.service.v1.ListSharesRequest.status:type_name -> sharing.service.v1.ShareStatus
+	62, // 20: sharing.service.v1.ListSharesRequest.created_after:type_name -> google.protobuf.Timestamp
+	62, // 21: sharing.service.v1.ListSharesRequest.created_before:type_name -> google.protobuf.Timestamp
+	62, // 22: sharing.service.v1.ListSharesRequest.viewed_after:type_name -> google.protobuf.Timestamp
+	62, // 23: sharing.service.v1.ListSharesRequest.viewed_before:type_name -> google.protobuf.Timestamp
+	7,  // 24: sharing.service.v1.ListSharesRequest.sort_by:type_name -> sharing.service.v1.ShareSortField
+	8,  // 25: sharing.service.v1.ListSharesRequest.sort_order:type_name -> sharing.service.v1.SortOrder
+	10, // 26: sharing.service.v1.ListSharesResponse.shares:type_name -> sharing.service.v1.SharedLink
+	4,  // 27: sharing.service.v1.ViewSharedContentResponse.resource_type:type_name -> sharing.service.v1.ResourceType
+	0,  // 28: sharing.service.v1.CreateSharePolicyInput.type:type_name -> sharing.service.v1.SharePolicyType
+	1,  // 29: sharing.service.v1.CreateSharePolicyInput.method:type_name -> sharing.service.v1.SharePolicyMethod
+	0,  // 30: sharing.service.v1.CreateSharePolicyRequest.type:type_name -> sharing.service.v1.SharePolicyType
+	1,  // 31: sharing.service.v1.CreateSharePolicyRequest.method:type_name -> sharing.service.v1.SharePolicyMethod
+	9,  // 32: sharing.service.v1.CreateSharePolicyResponse.policy:type_name -> sharing.service.v1.SharePolicy
+	5,  // 33: sharing.service.v1.ShareAccessEvent.outcome:type_name -> sharing.service.v1.ShareAccessOutcome
+	62, // 34: sharing.service.v1.ShareAccessEvent.create_time:type_name -> google.protobuf.Timestamp
+	5,  // 35: sharing.service.v1.ListShareAccessEventsRequest.outcome:type_name -> sharing.service.v1.ShareAccessOutcome
+	62, // 36: sharing.service.v1.ListShareAccessEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	62, // 37: sharing.service.v1.ListShareAccessEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	25, // 38: sharing.service.v1.ListShareAccessEventsResponse.events:type_name -> sharing.service.v1.ShareAccessEvent
+	62, // 39: sharing.service.v1.GetSharingStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	62, // 40: sharing.service.v1.GetSharingStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	4,  // 41: sharing.service.v1.ResourceTypeCount.resource_type:type_name -> sharing.service.v1.ResourceType
+	62, // 42: sharing.service.v1.GetSharingStatsResponse.start_time:type_name -> google.protobuf.Timestamp
+	62, // 43: sharing.service.v1.GetSharingStatsResponse.end_time:type_name -> google.protobuf.Timestamp
+	29, // 44: sharing.service.v1.GetSharingStatsResponse.by_status:type_name -> sharing.service.v1.ShareStatusCounts
+	30, // 45: sharing.service.v1.GetSharingStatsResponse.by_resource_type:type_name -> sharing.service.v1.ResourceTypeCount
+	31, // 46: sharing.service.v1.GetSharingStatsResponse.per_day:type_name -> sharing.service.v1.DailyShareCount
+	32, // 47: sharing.service.v1.GetSharingStatsResponse.top_recipients:type_name -> sharing.service.v1.RecipientCount
+	32, // 48: sharing.service.v1.GetSharingStatsResponse.top_domains:type_name -> sharing.service.v1.RecipientCount
+	33, // 49: sharing.service.v1.GetSharingStatsResponse.top_sharers:type_name -> sharing.service.v1.SharerCount
+	35, // 50: sharing.service.v1.GetNotificationPreferencesResponse.preferences:type_name -> sharing.service.v1.NotificationPreferences
+	35, // 51: sharing.service.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> sharing.service.v1.NotificationPreferences
+	62, // 52: sharing.service.v1.RiskSettings.update_time:type_name -> google.protobuf.Timestamp
+	40, // 53: sharing.service.v1.GetRiskSettingsResponse.settings:type_name -> sharing.service.v1.RiskSettings
+	40, // 54: sharing.service.v1.UpdateRiskSettingsResponse.settings:type_name -> sharing.service.v1.RiskSettings
+	62, // 55: sharing.service.v1.GetShareChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	62, // 56: sharing.service.v1.SendShareVerificationCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	9,  // 57: sharing.service.v1.ListSharePoliciesResponse.policies:type_name -> sharing.service.v1.SharePolicy
+	2,  // 58: sharing.service.v1.SetSharePolicyModeRequest.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 59: sharing.service.v1.SetSharePolicyModeRequest.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	2,  // 60: sharing.service.v1.SetSharePolicyModeResponse.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 61: sharing.service.v1.SetSharePolicyModeResponse.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	62, // 62: sharing.service.v1.PolicyEvaluationClient.time:type_name -> google.protobuf.Timestamp
+	58, // 63: sharing.service.v1.PolicyEvaluationClient.certificate:type_name -> sharing.service.v1.PolicyEvaluationCertificate
+	22, // 64: sharing.service.v1.EvaluateSharePoliciesRequest.policies:type_name -> sharing.service.v1.CreateSharePolicyInput
+	57, // 65: sharing.service.v1.EvaluateSharePoliciesRequest.client:type_name -> sharing.service.v1.PolicyEvaluationClient
+	2,  // 66: sharing.service.v1.EvaluateSharePoliciesRequest.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 67: sharing.service.v1.EvaluateSharePoliciesRequest.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	0,  // 68: sharing.service.v1.SharePolicyTrace.type:type_name -> sharing.service.v1.SharePolicyType
+	1,  // 69: sharing.service.v1.SharePolicyTrace.method:type_name -> sharing.service.v1.SharePolicyMethod
+	1,  // 70: sharing.service.v1.EvaluateSharePoliciesResponse.denied_method:type_name -> sharing.service.v1.SharePolicyMethod
+	60, // 71: sharing.service.v1.EvaluateSharePoliciesResponse.trace:type_name -> sharing.service.v1.SharePolicyTrace
+	62, // 72: sharing.service.v1.EvaluateSharePoliciesResponse.evaluated_at:type_name -> google.protobuf.Timestamp
+	2,  // 73: sharing.service.v1.EvaluateSharePoliciesResponse.policy_mode:type_name -> sharing.service.v1.SharePolicyMode
+	3,  // 74: sharing.service.v1.EvaluateSharePoliciesResponse.policy_default:type_name -> sharing.service.v1.SharePolicyDefault
+	11, // 75: sharing.service.v1.SharingShareService.CreateShare:input_type -> sharing.service.v1.CreateShareRequest
+	13, // 76: sharing.service.v1.SharingShareService.GetShare:input_type -> sharing.service.v1.GetShareRequest
+	15, // 77: sharing.service.v1.SharingShareService.ListShares:input_type -> sharing.service.v1.ListSharesRequest
+	17, // 78: sharing.service.v1.SharingShareService.RevokeShare:input_type -> sharing.service.v1.RevokeShareRequest
+	18, // 79: sharing.service.v1.SharingShareService.ViewSharedContent:input_type -> sharing.service.v1.ViewSharedContentRequest
+	46, // 80: sharing.service.v1.SharingShareService.GetShareChallenge:input_type -> sharing.service.v1.GetShareChallengeRequest
+	45, // 81: sharing.service.v1.SharingShareService.SendShareVerificationCode:input_type -> sharing.service.v1.SendShareVerificationCodeRequest
+	20, // 82: sharing.service.v1.SharingShareService.ReportLeakedToken:input_type -> sharing.service.v1.ReportLeakedTokenRequest
+	26, // 83: sharing.service.v1.SharingShareService.ListShareAccessEvents:input_type -> sharing.service.v1.ListShareAccessEventsRequest
+	28, // 84: sharing.service.v1.SharingShareService.GetSharingStats:input_type -> sharing.service.v1.GetSharingStatsRequest
+	36, // 85: sharing.service.v1.SharingShareService.GetNotificationPreferences:input_type -> sharing.service.v1.GetNotificationPreferencesRequest
+	38, // 86: sharing.service.v1.SharingShareService.UpdateNotificationPreferences:input_type -> sharing.service.v1.UpdateNotificationPreferencesRequest
+	41, // 87: sharing.service.v1.SharingShareService.GetRiskSettings:input_type -> sharing.service.v1.GetRiskSettingsRequest
+	43, // 88: sharing.service.v1.SharingShareService.UpdateRiskSettings:input_type -> sharing.service.v1.UpdateRiskSettingsRequest
+	23, // 89: sharing.service.v1.SharingShareService.CreateSharePolicy:input_type -> sharing.service.v1.CreateSharePolicyRequest
+	49, // 90: sharing.service.v1.SharingShareService.ListSharePolicies:input_type -> sharing.service.v1.ListSharePoliciesRequest
+	51, // 91: sharing.service.v1.SharingShareService.DeleteSharePolicy:input_type -> sharing.service.v1.DeleteSharePolicyRequest
+	52, // 92: sharing.service.v1.SharingShareService.SetSharePolicySets:input_type -> sharing.service.v1.SetSharePolicySetsRequest
+	54, // 93: sharing.service.v1.SharingShareService.SetSharePolicyMode:input_type -> sharing.service.v1.SetSharePolicyModeRequest
+	56, // 94: sharing.service.v1.SharingShareService.ResetShareDeviceBinding:input_type -> sharing.service.v1.ResetShareDeviceBindingRequest
+	59, // 95: sharing.service.v1.SharingShareService.EvaluateSharePolicies:input_type -> sharing.service.v1.EvaluateSharePoliciesRequest
+	12, // 96: sharing.service.v1.SharingShareService.CreateShare:output_type -> sharing.service.v1.CreateShareResponse
+	14, // 97: sharing.service.v1.SharingShareService.GetShare:output_type -> sharing.service.v1.GetShareResponse
+	16, // 98: sharing.service.v1.SharingShareService.ListShares:output_type -> sharing.service.v1.ListSharesResponse
+	63, // 99: sharing.service.v1.SharingShareService.RevokeShare:output_type -> google.protobuf.Empty
+	19, // 100: sharing.service.v1.SharingShareService.ViewSharedContent:output_type -> sharing.service.v1.ViewSharedContentResponse
+	47, // 101: sharing.service.v1.SharingShareService.GetShareChallenge:output_type -> sharing.service.v1.GetShareChallengeResponse
+	48, // 102: sharing.service.v1.SharingShareService.SendShareVerificationCode:output_type -> sharing.service.v1.SendShareVerificationCodeResponse
+	21, // 103: sharing.service.v1.SharingShareService.ReportLeakedToken:output_type -> sharing.service.v1.ReportLeakedTokenResponse
+	27, // 104: sharing.service.v1.SharingShareService.ListShareAccessEvents:output_type -> sharing.service.v1.ListShareAccessEventsResponse
+	34, // 105: sharing.service.v1.SharingShareService.GetSharingStats:output_type -> sharing.service.v1.GetSharingStatsResponse
+	37, // 106: sharing.service.v1.SharingShareService.GetNotificationPreferences:output_type -> sharing.service.v1.GetNotificationPreferencesResponse
+	39, // 107: sharing.service.v1.SharingShareService.UpdateNotificationPreferences:output_type -> sharing.service.v1.UpdateNotificationPreferencesResponse
+	42, // 108: sharing.service.v1.SharingShareService.GetRiskSettings:output_type -> sharing.service.v1.GetRiskSettingsResponse
+	44, // 109: sharing.service.v1.SharingShareService.UpdateRiskSettings:output_type -> sharing.service.v1.UpdateRiskSettingsResponse
+	24, // 110: sharing.service.v1.SharingShareService.CreateSharePolicy:output_type -> sharing.service.v1.CreateSharePolicyResponse
+	50, // 111: sharing.service.v1.SharingShareService.ListSharePolicies:output_type -> sharing.service.v1.ListSharePoliciesResponse
+	63, // 112: sharing.service.v1.SharingShareService.DeleteSharePolicy:output_type -> google.protobuf.Empty
+	53, // 113: sharing.service.v1.SharingShareService.SetSharePolicySets:output_type -> sharing.service.v1.SetSharePolicySetsResponse
+	55, // 114: sharing.service.v1.SharingShareService.SetSharePolicyMode:output_type -> sharing.service.v1.SetSharePolicyModeResponse
+	63, // 115: sharing.service.v1.SharingShareService.ResetShareDeviceBinding:output_type -> google.protobuf.Empty
+	61, // 116: sharing.service.v1.SharingShareService.EvaluateSharePolicies:output_type -> sharing.service.v1.EvaluateSharePoliciesResponse
+	96, // [96:117] is the sub-list for method output_type
+	75, // [75:96] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_sharing_service_v1_share_proto_init() }
@@ -1513,13 +5220,25 @@ func file_sharing_service_v1_share_proto_init() {
 	file_sharing_service_v1_share_proto_msgTypes[1].OneofWrappers = []any{}
 	file_sharing_service_v1_share_proto_msgTypes[2].OneofWrappers = []any{}
 	file_sharing_service_v1_share_proto_msgTypes[6].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[9].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[16].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[17].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[19].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[24].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[29].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[31].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[34].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[36].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[38].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[48].OneofWrappers = []any{}
+	file_sharing_service_v1_share_proto_msgTypes[50].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sharing_service_v1_share_proto_rawDesc), len(file_sharing_service_v1_share_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      9,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -102,6 +102,105 @@ func (s *redactedSharingShareServiceServer) ViewSharedContent(ctx context.Contex
 	return res, err
 }
 
+// GetShareChallenge is the redacted wrapper for the actual SharingShareServiceServer.GetShareChallenge method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) GetShareChallenge(ctx context.Context, in *GetShareChallengeRequest) (*GetShareChallengeResponse, error) {
+	res, err := s.srv.GetShareChallenge(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SendShareVerificationCode is the redacted wrapper for the actual SharingShareServiceServer.SendShareVerificationCode method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) SendShareVerificationCode(ctx context.Context, in *SendShareVerificationCodeRequest) (*SendShareVerificationCodeResponse, error) {
+	res, err := s.srv.SendShareVerificationCode(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ReportLeakedToken is the redacted wrapper for the actual SharingShareServiceServer.ReportLeakedToken method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) ReportLeakedToken(ctx context.Context, in *ReportLeakedTokenRequest) (*ReportLeakedTokenResponse, error) {
+	res, err := s.srv.ReportLeakedToken(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListShareAccessEvents is the redacted wrapper for the actual SharingShareServiceServer.ListShareAccessEvents method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) ListShareAccessEvents(ctx context.Context, in *ListShareAccessEventsRequest) (*ListShareAccessEventsResponse, error) {
+	res, err := s.srv.ListShareAccessEvents(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetSharingStats is the redacted wrapper for the actual SharingShareServiceServer.GetSharingStats method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) GetSharingStats(ctx context.Context, in *GetSharingStatsRequest) (*GetSharingStatsResponse, error) {
+	res, err := s.srv.GetSharingStats(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetNotificationPreferences is the redacted wrapper for the actual SharingShareServiceServer.GetNotificationPreferences method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	res, err := s.srv.GetNotificationPreferences(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateNotificationPreferences is the redacted wrapper for the actual SharingShareServiceServer.UpdateNotificationPreferences method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	res, err := s.srv.UpdateNotificationPreferences(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetRiskSettings is the redacted wrapper for the actual SharingShareServiceServer.GetRiskSettings method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) GetRiskSettings(ctx context.Context, in *GetRiskSettingsRequest) (*GetRiskSettingsResponse, error) {
+	res, err := s.srv.GetRiskSettings(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateRiskSettings is the redacted wrapper for the actual SharingShareServiceServer.UpdateRiskSettings method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) UpdateRiskSettings(ctx context.Context, in *UpdateRiskSettingsRequest) (*UpdateRiskSettingsResponse, error) {
+	res, err := s.srv.UpdateRiskSettings(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // CreateSharePolicy is the redacted wrapper for the actual SharingShareServiceServer.CreateSharePolicy method
 // Unary RPC
 func (s *redactedSharingShareServiceServer) CreateSharePolicy(ctx context.Context, in *CreateSharePolicyRequest) (*CreateSharePolicyResponse, error) {
@@ -135,6 +234,50 @@ func (s *redactedSharingShareServiceServer) DeleteSharePolicy(ctx context.Contex
 	return res, err
 }
 
+// SetSharePolicySets is the redacted wrapper for the actual SharingShareServiceServer.SetSharePolicySets method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) SetSharePolicySets(ctx context.Context, in *SetSharePolicySetsRequest) (*SetSharePolicySetsResponse, error) {
+	res, err := s.srv.SetSharePolicySets(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetSharePolicyMode is the redacted wrapper for the actual SharingShareServiceServer.SetSharePolicyMode method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) SetSharePolicyMode(ctx context.Context, in *SetSharePolicyModeRequest) (*SetSharePolicyModeResponse, error) {
+	res, err := s.srv.SetSharePolicyMode(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ResetShareDeviceBinding is the redacted wrapper for the actual SharingShareServiceServer.ResetShareDeviceBinding method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) ResetShareDeviceBinding(ctx context.Context, in *ResetShareDeviceBindingRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ResetShareDeviceBinding(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// EvaluateSharePolicies is the redacted wrapper for the actual SharingShareServiceServer.EvaluateSharePolicies method
+// Unary RPC
+func (s *redactedSharingShareServiceServer) EvaluateSharePolicies(ctx context.Context, in *EvaluateSharePoliciesRequest) (*EvaluateSharePoliciesResponse, error) {
+	res, err := s.srv.EvaluateSharePolicies(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for SharePolicy
 func (x *SharePolicy) Redact() string {
 	if x == nil {
@@ -154,6 +297,10 @@ func (x *SharePolicy) Redact() string {
 	// Safe field: Reason
 
 	// Safe field: CreateTime
+
+	// Safe field: Priority
+
+	// Safe field: Deprecated
 	return x.String()
 }
 
@@ -191,6 +338,32 @@ func (x *SharedLink) Redact() string {
 	// Safe field: CreateTime
 
 	// Safe field: Policies
+
+	// Safe field: SenderEmail
+
+	// Safe field: ExpiresAt
+
+	// Safe field: AuthorizedBy
+
+	// Safe field: AuthorizedVia
+
+	// Safe field: AuthorizedAt
+
+	// Safe field: PolicySetIds
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+
+	// Safe field: MaxViews
+
+	// Safe field: ViewCount
+
+	// Safe field: BindDevice
+
+	// Safe field: DeviceBoundAt
+
+	// Safe field: HasPassphrase
 	return x.String()
 }
 
@@ -211,6 +384,24 @@ func (x *CreateShareRequest) Redact() string {
 	// Safe field: TemplateId
 
 	// Safe field: Policies
+
+	// Safe field: NotifyEmail
+
+	// Safe field: ExpiresAt
+
+	// Safe field: PolicySetIds
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+
+	// Safe field: MaxViews
+
+	// Safe field: BindDevice
+
+	// Redacting field: Passphrase
+	PassphraseTmp := ``
+	x.Passphrase = &PassphraseTmp
 	return x.String()
 }
 
@@ -259,6 +450,26 @@ func (x *ListSharesRequest) Redact() string {
 	// Safe field: ResourceType
 
 	// Safe field: RecipientEmail
+
+	// Safe field: Status
+
+	// Safe field: CreatedBy
+
+	// Safe field: CreatedAfter
+
+	// Safe field: CreatedBefore
+
+	// Safe field: ViewedAfter
+
+	// Safe field: ViewedBefore
+
+	// Safe field: Search
+
+	// Safe field: SortBy
+
+	// Safe field: SortOrder
+
+	// Safe field: PageToken
 	return x.String()
 }
 
@@ -271,6 +482,8 @@ func (x *ListSharesResponse) Redact() string {
 	// Safe field: Shares
 
 	// Safe field: Total
+
+	// Safe field: NextPageToken
 	return x.String()
 }
 
@@ -291,6 +504,22 @@ func (x *ViewSharedContentRequest) Redact() string {
 	}
 
 	// Safe field: Token
+
+	// Redacting field: DeviceToken
+	DeviceTokenTmp := ``
+	x.DeviceToken = &DeviceTokenTmp
+
+	// Redacting field: Passphrase
+	PassphraseTmp := ``
+	x.Passphrase = &PassphraseTmp
+
+	// Redacting field: VerificationCode
+	VerificationCodeTmp := ``
+	x.VerificationCode = &VerificationCodeTmp
+
+	// Safe field: PowChallenge
+
+	// Safe field: PowSolution
 	return x.String()
 }
 
@@ -313,6 +542,35 @@ func (x *ViewSharedContentResponse) Redact() string {
 	// Safe field: MimeType
 
 	// Safe field: ResourceName
+
+	// Redacting field: DeviceToken
+	x.DeviceToken = ``
+	return x.String()
+}
+
+// Redact method implementation for ReportLeakedTokenRequest
+func (x *ReportLeakedTokenRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Token
+
+	// Safe field: Source
+
+	// Safe field: Url
+	return x.String()
+}
+
+// Redact method implementation for ReportLeakedTokenResponse
+func (x *ReportLeakedTokenResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Matched
+
+	// Safe field: Revoked
 	return x.String()
 }
 
@@ -329,6 +587,8 @@ func (x *CreateSharePolicyInput) Redact() string {
 	// Safe field: Value
 
 	// Safe field: Reason
+
+	// Safe field: Priority
 	return x.String()
 }
 
@@ -347,6 +607,8 @@ func (x *CreateSharePolicyRequest) Redact() string {
 	// Safe field: Value
 
 	// Safe field: Reason
+
+	// Safe field: Priority
 	return x.String()
 }
 
@@ -360,34 +622,570 @@ func (x *CreateSharePolicyResponse) Redact() string {
 	return x.String()
 }
 
-// Redact method implementation for ListSharePoliciesRequest
-func (x *ListSharePoliciesRequest) Redact() string {
+// Redact method implementation for ShareAccessEvent
+func (x *ShareAccessEvent) Redact() string {
 	if x == nil {
 		return ""
 	}
 
+	// Safe field: Id
+
+	// Safe field: TenantId
+
 	// Safe field: ShareLinkId
+
+	// Safe field: TokenPrefix
+
+	// Safe field: Outcome
+
+	// Safe field: PolicyId
+
+	// Safe field: Reason
+
+	// Safe field: ClientIp
+
+	// Safe field: UserAgent
+
+	// Safe field: CreateTime
+
+	// Safe field: Country
+
+	// Safe field: ReputationList
+
+	// Safe field: RiskScore
 	return x.String()
 }
 
-// Redact method implementation for ListSharePoliciesResponse
-func (x *ListSharePoliciesResponse) Redact() string {
+// Redact method implementation for ListShareAccessEventsRequest
+func (x *ListShareAccessEventsRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Policies
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: ShareLinkId
+
+	// Safe field: Outcome
+
+	// Safe field: ClientIp
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
 	return x.String()
 }
 
-// Redact method implementation for DeleteSharePolicyRequest
-func (x *DeleteSharePolicyRequest) Redact() string {
+// Redact method implementation for ListShareAccessEventsResponse
+func (x *ListShareAccessEventsResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: ShareLinkId
+	// Safe field: Events
 
-	// Safe field: Id
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for GetSharingStatsRequest
+func (x *GetSharingStatsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+
+	// Safe field: TopLimit
+	return x.String()
+}
+
+// Redact method implementation for ShareStatusCounts
+func (x *ShareStatusCounts) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Active
+
+	// Safe field: Viewed
+
+	// Safe field: Revoked
+
+	// Safe field: Expired
+	return x.String()
+}
+
+// Redact method implementation for ResourceTypeCount
+func (x *ResourceTypeCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ResourceType
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for DailyShareCount
+func (x *DailyShareCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Date
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for RecipientCount
+func (x *RecipientCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Key
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for SharerCount
+func (x *SharerCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: SenderEmail
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for GetSharingStatsResponse
+func (x *GetSharingStatsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+
+	// Safe field: Total
+
+	// Safe field: ByStatus
+
+	// Safe field: ByResourceType
+
+	// Safe field: PerDay
+
+	// Safe field: MedianSecondsToView
+
+	// Safe field: TopRecipients
+
+	// Safe field: TopDomains
+
+	// Safe field: TopSharers
+
+	// Safe field: PolicyDenials
+	return x.String()
+}
+
+// Redact method implementation for NotificationPreferences
+func (x *NotificationPreferences) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: NotifyViewed
+
+	// Safe field: NotifyDenied
+
+	// Safe field: NotifyExpired
+	return x.String()
+}
+
+// Redact method implementation for GetNotificationPreferencesRequest
+func (x *GetNotificationPreferencesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for GetNotificationPreferencesResponse
+func (x *GetNotificationPreferencesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Preferences
+	return x.String()
+}
+
+// Redact method implementation for UpdateNotificationPreferencesRequest
+func (x *UpdateNotificationPreferencesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: NotifyViewed
+
+	// Safe field: NotifyDenied
+
+	// Safe field: NotifyExpired
+	return x.String()
+}
+
+// Redact method implementation for UpdateNotificationPreferencesResponse
+func (x *UpdateNotificationPreferencesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Preferences
+	return x.String()
+}
+
+// Redact method implementation for RiskSettings
+func (x *RiskSettings) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Enabled
+
+	// Safe field: StepUpThreshold
+
+	// Safe field: DenyThreshold
+
+	// Safe field: BusinessHours
+
+	// Safe field: UpdateTime
+	return x.String()
+}
+
+// Redact method implementation for GetRiskSettingsRequest
+func (x *GetRiskSettingsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for GetRiskSettingsResponse
+func (x *GetRiskSettingsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Settings
+	return x.String()
+}
+
+// Redact method implementation for UpdateRiskSettingsRequest
+func (x *UpdateRiskSettingsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Enabled
+
+	// Safe field: StepUpThreshold
+
+	// Safe field: DenyThreshold
+
+	// Safe field: BusinessHours
+	return x.String()
+}
+
+// Redact method implementation for UpdateRiskSettingsResponse
+func (x *UpdateRiskSettingsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Settings
+	return x.String()
+}
+
+// Redact method implementation for SendShareVerificationCodeRequest
+func (x *SendShareVerificationCodeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Token
+
+	// Safe field: PowChallenge
+
+	// Safe field: PowSolution
+	return x.String()
+}
+
+// Redact method implementation for GetShareChallengeRequest
+func (x *GetShareChallengeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Token
+	return x.String()
+}
+
+// Redact method implementation for GetShareChallengeResponse
+func (x *GetShareChallengeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Required
+
+	// Safe field: Challenge
+
+	// Safe field: Difficulty
+
+	// Safe field: Algorithm
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for SendShareVerificationCodeResponse
+func (x *SendShareVerificationCodeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for ListSharePoliciesRequest
+func (x *ListSharePoliciesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ShareLinkId
+	return x.String()
+}
+
+// Redact method implementation for ListSharePoliciesResponse
+func (x *ListSharePoliciesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Policies
+	return x.String()
+}
+
+// Redact method implementation for DeleteSharePolicyRequest
+func (x *DeleteSharePolicyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ShareLinkId
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for SetSharePolicySetsRequest
+func (x *SetSharePolicySetsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ShareLinkId
+
+	// Safe field: PolicySetIds
+	return x.String()
+}
+
+// Redact method implementation for SetSharePolicySetsResponse
+func (x *SetSharePolicySetsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: PolicySetIds
+	return x.String()
+}
+
+// Redact method implementation for SetSharePolicyModeRequest
+func (x *SetSharePolicyModeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ShareLinkId
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+	return x.String()
+}
+
+// Redact method implementation for SetSharePolicyModeResponse
+func (x *SetSharePolicyModeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+	return x.String()
+}
+
+// Redact method implementation for ResetShareDeviceBindingRequest
+func (x *ResetShareDeviceBindingRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for PolicyEvaluationClient
+func (x *PolicyEvaluationClient) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Ip
+
+	// Safe field: Time
+
+	// Safe field: UserAgent
+
+	// Safe field: Country
+
+	// Safe field: Subdivisions
+
+	// Safe field: City
+
+	// Safe field: AttemptCount
+
+	// Safe field: RecipientEmail
+
+	// Safe field: Certificate
+
+	// Safe field: ReputationLists
+	return x.String()
+}
+
+// Redact method implementation for PolicyEvaluationCertificate
+func (x *PolicyEvaluationCertificate) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Sha256
+
+	// Safe field: Subject
+
+	// Safe field: Issuer
+
+	// Safe field: Verified
+
+	// Safe field: CaSha256
+	return x.String()
+}
+
+// Redact method implementation for EvaluateSharePoliciesRequest
+func (x *EvaluateSharePoliciesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ShareLinkId
+
+	// Safe field: Policies
+
+	// Safe field: Client
+
+	// Safe field: PolicySetIds
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+	return x.String()
+}
+
+// Redact method implementation for SharePolicyTrace
+func (x *SharePolicyTrace) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: PolicyId
+
+	// Safe field: Index
+
+	// Safe field: PolicySetId
+
+	// Safe field: Type
+
+	// Safe field: Method
+
+	// Safe field: Value
+
+	// Safe field: Matched
+
+	// Safe field: Decisive
+
+	// Safe field: Explanation
+
+	// Safe field: Priority
+	return x.String()
+}
+
+// Redact method implementation for EvaluateSharePoliciesResponse
+func (x *EvaluateSharePoliciesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Allowed
+
+	// Safe field: Reason
+
+	// Safe field: DeniedMethod
+
+	// Safe field: Trace
+
+	// Safe field: EvaluatedAt
+
+	// Safe field: Country
+
+	// Safe field: Subdivisions
+
+	// Safe field: City
+
+	// Safe field: DeviceOs
+
+	// Safe field: DeviceBrowser
+
+	// Safe field: DeviceClass
+
+	// Safe field: PolicyMode
+
+	// Safe field: PolicyDefault
+
+	// Safe field: DefaultApplied
 	return x.String()
 }
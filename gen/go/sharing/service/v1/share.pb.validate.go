@@ -98,6 +98,10 @@ func (m *SharePolicy) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Priority
+
+	// no validation rules for Deprecated
+
 	if len(errors) > 0 {
 		return SharePolicyMultiError(errors)
 	}
@@ -280,6 +284,22 @@ func (m *SharedLink) validate(all bool) error {
 
 	}
 
+	// no validation rules for SenderEmail
+
+	// no validation rules for AuthorizedVia
+
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	// no validation rules for MaxViews
+
+	// no validation rules for ViewCount
+
+	// no validation rules for BindDevice
+
+	// no validation rules for HasPassphrase
+
 	if m.ViewedAt != nil {
 
 		if all {
@@ -317,6 +337,109 @@ func (m *SharedLink) validate(all bool) error {
 		// no validation rules for CreatedBy
 	}
 
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AuthorizedBy != nil {
+		// no validation rules for AuthorizedBy
+	}
+
+	if m.AuthorizedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetAuthorizedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "AuthorizedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "AuthorizedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAuthorizedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedLinkValidationError{
+					field:  "AuthorizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DeviceBoundAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeviceBoundAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "DeviceBoundAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedLinkValidationError{
+						field:  "DeviceBoundAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeviceBoundAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedLinkValidationError{
+					field:  "DeviceBoundAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SharedLinkMultiError(errors)
 	}
@@ -458,10 +581,61 @@ func (m *CreateShareRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	// no validation rules for BindDevice
+
 	if m.TemplateId != nil {
 		// no validation rules for TemplateId
 	}
 
+	if m.NotifyEmail != nil {
+		// no validation rules for NotifyEmail
+	}
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateShareRequestValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateShareRequestValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateShareRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaxViews != nil {
+		// no validation rules for MaxViews
+	}
+
+	if m.Passphrase != nil {
+		// no validation rules for Passphrase
+	}
+
 	if len(errors) > 0 {
 		return CreateShareRequestMultiError(errors)
 	}
@@ -917,6 +1091,162 @@ func (m *ListSharesRequest) validate(all bool) error {
 		// no validation rules for RecipientEmail
 	}
 
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.CreatedAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "CreatedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "CreatedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSharesRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "CreatedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "CreatedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSharesRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ViewedAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetViewedAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "ViewedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "ViewedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViewedAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSharesRequestValidationError{
+					field:  "ViewedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ViewedBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetViewedBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "ViewedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSharesRequestValidationError{
+						field:  "ViewedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViewedBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSharesRequestValidationError{
+					field:  "ViewedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Search != nil {
+		// no validation rules for Search
+	}
+
+	if m.SortBy != nil {
+		// no validation rules for SortBy
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
 	if len(errors) > 0 {
 		return ListSharesRequestMultiError(errors)
 	}
@@ -1055,6 +1385,8 @@ func (m *ListSharesResponse) validate(all bool) error {
 
 	// no validation rules for Total
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListSharesResponseMultiError(errors)
 	}
@@ -1263,6 +1595,26 @@ func (m *ViewSharedContentRequest) validate(all bool) error {
 
 	// no validation rules for Token
 
+	if m.DeviceToken != nil {
+		// no validation rules for DeviceToken
+	}
+
+	if m.Passphrase != nil {
+		// no validation rules for Passphrase
+	}
+
+	if m.VerificationCode != nil {
+		// no validation rules for VerificationCode
+	}
+
+	if m.PowChallenge != nil {
+		// no validation rules for PowChallenge
+	}
+
+	if m.PowSolution != nil {
+		// no validation rules for PowSolution
+	}
+
 	if len(errors) > 0 {
 		return ViewSharedContentRequestMultiError(errors)
 	}
@@ -1377,6 +1729,8 @@ func (m *ViewSharedContentResponse) validate(all bool) error {
 
 	// no validation rules for ResourceName
 
+	// no validation rules for DeviceToken
+
 	if len(errors) > 0 {
 		return ViewSharedContentResponseMultiError(errors)
 	}
@@ -1457,15 +1811,229 @@ var _ interface {
 	ErrorName() string
 } = ViewSharedContentResponseValidationError{}
 
-// Validate checks the field values on CreateSharePolicyInput with the rules
+// Validate checks the field values on ReportLeakedTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSharePolicyInput) Validate() error {
+func (m *ReportLeakedTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSharePolicyInput with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReportLeakedTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportLeakedTokenRequestMultiError, or nil if none found.
+func (m *ReportLeakedTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportLeakedTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Source
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return ReportLeakedTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportLeakedTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportLeakedTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportLeakedTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportLeakedTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportLeakedTokenRequestMultiError) AllErrors() []error { return m }
+
+// ReportLeakedTokenRequestValidationError is the validation error returned by
+// ReportLeakedTokenRequest.Validate if the designated constraints aren't met.
+type ReportLeakedTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportLeakedTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportLeakedTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportLeakedTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportLeakedTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportLeakedTokenRequestValidationError) ErrorName() string {
+	return "ReportLeakedTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportLeakedTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportLeakedTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportLeakedTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportLeakedTokenRequestValidationError{}
+
+// Validate checks the field values on ReportLeakedTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportLeakedTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportLeakedTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportLeakedTokenResponseMultiError, or nil if none found.
+func (m *ReportLeakedTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportLeakedTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Matched
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return ReportLeakedTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportLeakedTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportLeakedTokenResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReportLeakedTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportLeakedTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportLeakedTokenResponseMultiError) AllErrors() []error { return m }
+
+// ReportLeakedTokenResponseValidationError is the validation error returned by
+// ReportLeakedTokenResponse.Validate if the designated constraints aren't met.
+type ReportLeakedTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportLeakedTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportLeakedTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportLeakedTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportLeakedTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportLeakedTokenResponseValidationError) ErrorName() string {
+	return "ReportLeakedTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportLeakedTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportLeakedTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportLeakedTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportLeakedTokenResponseValidationError{}
+
+// Validate checks the field values on CreateSharePolicyInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSharePolicyInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSharePolicyInput with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
 // CreateSharePolicyInputMultiError, or nil if none found.
 func (m *CreateSharePolicyInput) ValidateAll() error {
@@ -1487,6 +2055,8 @@ func (m *CreateSharePolicyInput) validate(all bool) error {
 
 	// no validation rules for Reason
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
 		return CreateSharePolicyInputMultiError(errors)
 	}
@@ -1599,6 +2169,8 @@ func (m *CreateSharePolicyRequest) validate(all bool) error {
 
 	// no validation rules for Reason
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
 		return CreateSharePolicyRequestMultiError(errors)
 	}
@@ -1810,44 +2382,97 @@ var _ interface {
 	ErrorName() string
 } = CreateSharePolicyResponseValidationError{}
 
-// Validate checks the field values on ListSharePoliciesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSharePoliciesRequest) Validate() error {
+// Validate checks the field values on ShareAccessEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShareAccessEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSharePoliciesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ShareAccessEvent with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSharePoliciesRequestMultiError, or nil if none found.
-func (m *ListSharePoliciesRequest) ValidateAll() error {
+// ShareAccessEventMultiError, or nil if none found.
+func (m *ShareAccessEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSharePoliciesRequest) validate(all bool) error {
+func (m *ShareAccessEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
 	// no validation rules for ShareLinkId
 
+	// no validation rules for TokenPrefix
+
+	// no validation rules for Outcome
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Reason
+
+	// no validation rules for ClientIp
+
+	// no validation rules for UserAgent
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareAccessEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareAccessEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareAccessEventValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Country
+
+	// no validation rules for ReputationList
+
+	if m.RiskScore != nil {
+		// no validation rules for RiskScore
+	}
+
 	if len(errors) > 0 {
-		return ListSharePoliciesRequestMultiError(errors)
+		return ShareAccessEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSharePoliciesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListSharePoliciesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListSharePoliciesRequestMultiError []error
+// ShareAccessEventMultiError is an error wrapping multiple validation errors
+// returned by ShareAccessEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ShareAccessEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSharePoliciesRequestMultiError) Error() string {
+func (m ShareAccessEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1856,11 +2481,11 @@ func (m ListSharePoliciesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSharePoliciesRequestMultiError) AllErrors() []error { return m }
+func (m ShareAccessEventMultiError) AllErrors() []error { return m }
 
-// ListSharePoliciesRequestValidationError is the validation error returned by
-// ListSharePoliciesRequest.Validate if the designated constraints aren't met.
-type ListSharePoliciesRequestValidationError struct {
+// ShareAccessEventValidationError is the validation error returned by
+// ShareAccessEvent.Validate if the designated constraints aren't met.
+type ShareAccessEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1868,24 +2493,22 @@ type ListSharePoliciesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSharePoliciesRequestValidationError) Field() string { return e.field }
+func (e ShareAccessEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSharePoliciesRequestValidationError) Reason() string { return e.reason }
+func (e ShareAccessEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSharePoliciesRequestValidationError) Cause() error { return e.cause }
+func (e ShareAccessEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSharePoliciesRequestValidationError) Key() bool { return e.key }
+func (e ShareAccessEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSharePoliciesRequestValidationError) ErrorName() string {
-	return "ListSharePoliciesRequestValidationError"
-}
+func (e ShareAccessEventValidationError) ErrorName() string { return "ShareAccessEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListSharePoliciesRequestValidationError) Error() string {
+func (e ShareAccessEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1897,14 +2520,14 @@ func (e ListSharePoliciesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSharePoliciesRequest.%s: %s%s",
+		"invalid %sShareAccessEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSharePoliciesRequestValidationError{}
+var _ error = ShareAccessEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1912,38 +2535,4310 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSharePoliciesRequestValidationError{}
+} = ShareAccessEventValidationError{}
 
-// Validate checks the field values on ListSharePoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListShareAccessEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSharePoliciesResponse) Validate() error {
+func (m *ListShareAccessEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSharePoliciesResponse with the
+// ValidateAll checks the field values on ListShareAccessEventsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSharePoliciesResponseMultiError, or nil if none found.
-func (m *ListSharePoliciesResponse) ValidateAll() error {
+// ListShareAccessEventsRequestMultiError, or nil if none found.
+func (m *ListShareAccessEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSharePoliciesResponse) validate(all bool) error {
+func (m *ListShareAccessEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
+	if m.Page != nil {
+		// no validation rules for Page
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.ShareLinkId != nil {
+		// no validation rules for ShareLinkId
+	}
+
+	if m.Outcome != nil {
+		// no validation rules for Outcome
+	}
+
+	if m.ClientIp != nil {
+		// no validation rules for ClientIp
+	}
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareAccessEventsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareAccessEventsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShareAccessEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareAccessEventsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareAccessEventsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShareAccessEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListShareAccessEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareAccessEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListShareAccessEventsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListShareAccessEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareAccessEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareAccessEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListShareAccessEventsRequestValidationError is the validation error returned
+// by ListShareAccessEventsRequest.Validate if the designated constraints
+// aren't met.
+type ListShareAccessEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareAccessEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareAccessEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareAccessEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareAccessEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareAccessEventsRequestValidationError) ErrorName() string {
+	return "ListShareAccessEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareAccessEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareAccessEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareAccessEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareAccessEventsRequestValidationError{}
+
+// Validate checks the field values on ListShareAccessEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareAccessEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareAccessEventsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListShareAccessEventsResponseMultiError, or nil if none found.
+func (m *ListShareAccessEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareAccessEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareAccessEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareAccessEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShareAccessEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListShareAccessEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareAccessEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListShareAccessEventsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListShareAccessEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareAccessEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareAccessEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListShareAccessEventsResponseValidationError is the validation error
+// returned by ListShareAccessEventsResponse.Validate if the designated
+// constraints aren't met.
+type ListShareAccessEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareAccessEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareAccessEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareAccessEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareAccessEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareAccessEventsResponseValidationError) ErrorName() string {
+	return "ListShareAccessEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareAccessEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareAccessEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareAccessEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareAccessEventsResponseValidationError{}
+
+// Validate checks the field values on GetSharingStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharingStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharingStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharingStatsRequestMultiError, or nil if none found.
+func (m *GetSharingStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharingStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TopLimit != nil {
+		// no validation rules for TopLimit
+	}
+
+	if len(errors) > 0 {
+		return GetSharingStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharingStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSharingStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharingStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharingStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharingStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetSharingStatsRequestValidationError is the validation error returned by
+// GetSharingStatsRequest.Validate if the designated constraints aren't met.
+type GetSharingStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharingStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharingStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharingStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharingStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharingStatsRequestValidationError) ErrorName() string {
+	return "GetSharingStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharingStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharingStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharingStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharingStatsRequestValidationError{}
+
+// Validate checks the field values on ShareStatusCounts with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShareStatusCounts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareStatusCounts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareStatusCountsMultiError, or nil if none found.
+func (m *ShareStatusCounts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareStatusCounts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Active
+
+	// no validation rules for Viewed
+
+	// no validation rules for Revoked
+
+	// no validation rules for Expired
+
+	if len(errors) > 0 {
+		return ShareStatusCountsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareStatusCountsMultiError is an error wrapping multiple validation errors
+// returned by ShareStatusCounts.ValidateAll() if the designated constraints
+// aren't met.
+type ShareStatusCountsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareStatusCountsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareStatusCountsMultiError) AllErrors() []error { return m }
+
+// ShareStatusCountsValidationError is the validation error returned by
+// ShareStatusCounts.Validate if the designated constraints aren't met.
+type ShareStatusCountsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareStatusCountsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareStatusCountsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareStatusCountsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareStatusCountsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareStatusCountsValidationError) ErrorName() string {
+	return "ShareStatusCountsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareStatusCountsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareStatusCounts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareStatusCountsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareStatusCountsValidationError{}
+
+// Validate checks the field values on ResourceTypeCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResourceTypeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceTypeCountMultiError, or nil if none found.
+func (m *ResourceTypeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceTypeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ResourceTypeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceTypeCountMultiError is an error wrapping multiple validation errors
+// returned by ResourceTypeCount.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceTypeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceTypeCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceTypeCountMultiError) AllErrors() []error { return m }
+
+// ResourceTypeCountValidationError is the validation error returned by
+// ResourceTypeCount.Validate if the designated constraints aren't met.
+type ResourceTypeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceTypeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceTypeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceTypeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceTypeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceTypeCountValidationError) ErrorName() string {
+	return "ResourceTypeCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceTypeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceTypeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceTypeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceTypeCountValidationError{}
+
+// Validate checks the field values on DailyShareCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DailyShareCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyShareCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DailyShareCountMultiError, or nil if none found.
+func (m *DailyShareCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyShareCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DailyShareCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyShareCountMultiError is an error wrapping multiple validation errors
+// returned by DailyShareCount.ValidateAll() if the designated constraints
+// aren't met.
+type DailyShareCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyShareCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyShareCountMultiError) AllErrors() []error { return m }
+
+// DailyShareCountValidationError is the validation error returned by
+// DailyShareCount.Validate if the designated constraints aren't met.
+type DailyShareCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyShareCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyShareCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyShareCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyShareCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyShareCountValidationError) ErrorName() string { return "DailyShareCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyShareCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyShareCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyShareCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyShareCountValidationError{}
+
+// Validate checks the field values on RecipientCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecipientCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecipientCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecipientCountMultiError,
+// or nil if none found.
+func (m *RecipientCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecipientCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RecipientCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecipientCountMultiError is an error wrapping multiple validation errors
+// returned by RecipientCount.ValidateAll() if the designated constraints
+// aren't met.
+type RecipientCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecipientCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecipientCountMultiError) AllErrors() []error { return m }
+
+// RecipientCountValidationError is the validation error returned by
+// RecipientCount.Validate if the designated constraints aren't met.
+type RecipientCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecipientCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecipientCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecipientCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecipientCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecipientCountValidationError) ErrorName() string { return "RecipientCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecipientCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecipientCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecipientCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecipientCountValidationError{}
+
+// Validate checks the field values on SharerCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SharerCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharerCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SharerCountMultiError, or
+// nil if none found.
+func (m *SharerCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharerCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SenderEmail
+
+	// no validation rules for Count
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if len(errors) > 0 {
+		return SharerCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharerCountMultiError is an error wrapping multiple validation errors
+// returned by SharerCount.ValidateAll() if the designated constraints aren't met.
+type SharerCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharerCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharerCountMultiError) AllErrors() []error { return m }
+
+// SharerCountValidationError is the validation error returned by
+// SharerCount.Validate if the designated constraints aren't met.
+type SharerCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharerCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharerCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharerCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharerCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharerCountValidationError) ErrorName() string { return "SharerCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharerCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharerCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharerCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharerCountValidationError{}
+
+// Validate checks the field values on GetSharingStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharingStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharingStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharingStatsResponseMultiError, or nil if none found.
+func (m *GetSharingStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharingStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSharingStatsResponseValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSharingStatsResponseValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Total
+
+	if all {
+		switch v := interface{}(m.GetByStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "ByStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSharingStatsResponseValidationError{
+					field:  "ByStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetByStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSharingStatsResponseValidationError{
+				field:  "ByStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetByResourceType() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("ByResourceType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("ByResourceType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsResponseValidationError{
+					field:  fmt.Sprintf("ByResourceType[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPerDay() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("PerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("PerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsResponseValidationError{
+					field:  fmt.Sprintf("PerDay[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MedianSecondsToView
+
+	for idx, item := range m.GetTopRecipients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopRecipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopRecipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsResponseValidationError{
+					field:  fmt.Sprintf("TopRecipients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopDomains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopDomains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsResponseValidationError{
+					field:  fmt.Sprintf("TopDomains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopSharers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopSharers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharingStatsResponseValidationError{
+						field:  fmt.Sprintf("TopSharers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharingStatsResponseValidationError{
+					field:  fmt.Sprintf("TopSharers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PolicyDenials
+
+	if len(errors) > 0 {
+		return GetSharingStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharingStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSharingStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharingStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharingStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharingStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetSharingStatsResponseValidationError is the validation error returned by
+// GetSharingStatsResponse.Validate if the designated constraints aren't met.
+type GetSharingStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharingStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharingStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharingStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharingStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharingStatsResponseValidationError) ErrorName() string {
+	return "GetSharingStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharingStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharingStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharingStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharingStatsResponseValidationError{}
+
+// Validate checks the field values on NotificationPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPreferencesMultiError, or nil if none found.
+func (m *NotificationPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotifyViewed
+
+	// no validation rules for NotifyDenied
+
+	// no validation rules for NotifyExpired
+
+	if len(errors) > 0 {
+		return NotificationPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPreferencesMultiError is an error wrapping multiple validation
+// errors returned by NotificationPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPreferencesMultiError) AllErrors() []error { return m }
+
+// NotificationPreferencesValidationError is the validation error returned by
+// NotificationPreferences.Validate if the designated constraints aren't met.
+type NotificationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferencesValidationError) ErrorName() string {
+	return "NotificationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferencesValidationError{}
+
+// Validate checks the field values on GetNotificationPreferencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *GetNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationPreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetNotificationPreferencesRequestValidationError is the validation error
+// returned by GetNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "GetNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationPreferencesRequestValidationError{}
+
+// Validate checks the field values on GetNotificationPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNotificationPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetNotificationPreferencesResponseMultiError, or nil if none found.
+func (m *GetNotificationPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNotificationPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNotificationPreferencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetNotificationPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationPreferencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// GetNotificationPreferencesResponseValidationError is the validation error
+// returned by GetNotificationPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type GetNotificationPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationPreferencesResponseValidationError) ErrorName() string {
+	return "GetNotificationPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationPreferencesResponseValidationError{}
+
+// Validate checks the field values on UpdateNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.NotifyViewed != nil {
+		// no validation rules for NotifyViewed
+	}
+
+	if m.NotifyDenied != nil {
+		// no validation rules for NotifyDenied
+	}
+
+	if m.NotifyExpired != nil {
+		// no validation rules for NotifyExpired
+	}
+
+	if len(errors) > 0 {
+		return UpdateNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationPreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationPreferencesRequestValidationError is the validation error
+// returned by UpdateNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationPreferencesRequestValidationError{}
+
+// Validate checks the field values on UpdateNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateNotificationPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateNotificationPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateNotificationPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNotificationPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNotificationPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationPreferencesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateNotificationPreferencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationPreferencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationPreferencesResponseValidationError is the validation error
+// returned by UpdateNotificationPreferencesResponse.Validate if the
+// designated constraints aren't met.
+type UpdateNotificationPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateNotificationPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationPreferencesResponseValidationError{}
+
+// Validate checks the field values on RiskSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RiskSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RiskSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RiskSettingsMultiError, or
+// nil if none found.
+func (m *RiskSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RiskSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for StepUpThreshold
+
+	// no validation rules for DenyThreshold
+
+	// no validation rules for BusinessHours
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RiskSettingsValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RiskSettingsValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RiskSettingsValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RiskSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RiskSettingsMultiError is an error wrapping multiple validation errors
+// returned by RiskSettings.ValidateAll() if the designated constraints aren't met.
+type RiskSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RiskSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RiskSettingsMultiError) AllErrors() []error { return m }
+
+// RiskSettingsValidationError is the validation error returned by
+// RiskSettings.Validate if the designated constraints aren't met.
+type RiskSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RiskSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RiskSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RiskSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RiskSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RiskSettingsValidationError) ErrorName() string { return "RiskSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RiskSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRiskSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RiskSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RiskSettingsValidationError{}
+
+// Validate checks the field values on GetRiskSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRiskSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRiskSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRiskSettingsRequestMultiError, or nil if none found.
+func (m *GetRiskSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRiskSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetRiskSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRiskSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRiskSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRiskSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRiskSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRiskSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetRiskSettingsRequestValidationError is the validation error returned by
+// GetRiskSettingsRequest.Validate if the designated constraints aren't met.
+type GetRiskSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRiskSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRiskSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRiskSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRiskSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRiskSettingsRequestValidationError) ErrorName() string {
+	return "GetRiskSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRiskSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRiskSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRiskSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRiskSettingsRequestValidationError{}
+
+// Validate checks the field values on GetRiskSettingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRiskSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRiskSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRiskSettingsResponseMultiError, or nil if none found.
+func (m *GetRiskSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRiskSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRiskSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRiskSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRiskSettingsResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRiskSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRiskSettingsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRiskSettingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRiskSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRiskSettingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRiskSettingsResponseMultiError) AllErrors() []error { return m }
+
+// GetRiskSettingsResponseValidationError is the validation error returned by
+// GetRiskSettingsResponse.Validate if the designated constraints aren't met.
+type GetRiskSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRiskSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRiskSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRiskSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRiskSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRiskSettingsResponseValidationError) ErrorName() string {
+	return "GetRiskSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRiskSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRiskSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRiskSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRiskSettingsResponseValidationError{}
+
+// Validate checks the field values on UpdateRiskSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRiskSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRiskSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRiskSettingsRequestMultiError, or nil if none found.
+func (m *UpdateRiskSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRiskSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Enabled != nil {
+		// no validation rules for Enabled
+	}
+
+	if m.StepUpThreshold != nil {
+		// no validation rules for StepUpThreshold
+	}
+
+	if m.DenyThreshold != nil {
+		// no validation rules for DenyThreshold
+	}
+
+	if m.BusinessHours != nil {
+		// no validation rules for BusinessHours
+	}
+
+	if len(errors) > 0 {
+		return UpdateRiskSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRiskSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRiskSettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateRiskSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRiskSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRiskSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRiskSettingsRequestValidationError is the validation error returned by
+// UpdateRiskSettingsRequest.Validate if the designated constraints aren't met.
+type UpdateRiskSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRiskSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRiskSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRiskSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRiskSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRiskSettingsRequestValidationError) ErrorName() string {
+	return "UpdateRiskSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRiskSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRiskSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRiskSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRiskSettingsRequestValidationError{}
+
+// Validate checks the field values on UpdateRiskSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRiskSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRiskSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRiskSettingsResponseMultiError, or nil if none found.
+func (m *UpdateRiskSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRiskSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRiskSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRiskSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRiskSettingsResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRiskSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRiskSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateRiskSettingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRiskSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRiskSettingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRiskSettingsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRiskSettingsResponseValidationError is the validation error returned
+// by UpdateRiskSettingsResponse.Validate if the designated constraints aren't met.
+type UpdateRiskSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRiskSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRiskSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRiskSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRiskSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRiskSettingsResponseValidationError) ErrorName() string {
+	return "UpdateRiskSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRiskSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRiskSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRiskSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRiskSettingsResponseValidationError{}
+
+// Validate checks the field values on SendShareVerificationCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendShareVerificationCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendShareVerificationCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendShareVerificationCodeRequestMultiError, or nil if none found.
+func (m *SendShareVerificationCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendShareVerificationCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if m.PowChallenge != nil {
+		// no validation rules for PowChallenge
+	}
+
+	if m.PowSolution != nil {
+		// no validation rules for PowSolution
+	}
+
+	if len(errors) > 0 {
+		return SendShareVerificationCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendShareVerificationCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SendShareVerificationCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendShareVerificationCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendShareVerificationCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendShareVerificationCodeRequestMultiError) AllErrors() []error { return m }
+
+// SendShareVerificationCodeRequestValidationError is the validation error
+// returned by SendShareVerificationCodeRequest.Validate if the designated
+// constraints aren't met.
+type SendShareVerificationCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendShareVerificationCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendShareVerificationCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendShareVerificationCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendShareVerificationCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendShareVerificationCodeRequestValidationError) ErrorName() string {
+	return "SendShareVerificationCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendShareVerificationCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendShareVerificationCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendShareVerificationCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendShareVerificationCodeRequestValidationError{}
+
+// Validate checks the field values on GetShareChallengeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetShareChallengeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetShareChallengeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetShareChallengeRequestMultiError, or nil if none found.
+func (m *GetShareChallengeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetShareChallengeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return GetShareChallengeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetShareChallengeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetShareChallengeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetShareChallengeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetShareChallengeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetShareChallengeRequestMultiError) AllErrors() []error { return m }
+
+// GetShareChallengeRequestValidationError is the validation error returned by
+// GetShareChallengeRequest.Validate if the designated constraints aren't met.
+type GetShareChallengeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetShareChallengeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetShareChallengeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetShareChallengeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetShareChallengeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetShareChallengeRequestValidationError) ErrorName() string {
+	return "GetShareChallengeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetShareChallengeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetShareChallengeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetShareChallengeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetShareChallengeRequestValidationError{}
+
+// Validate checks the field values on GetShareChallengeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetShareChallengeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetShareChallengeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetShareChallengeResponseMultiError, or nil if none found.
+func (m *GetShareChallengeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetShareChallengeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Required
+
+	// no validation rules for Challenge
+
+	// no validation rules for Difficulty
+
+	// no validation rules for Algorithm
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetShareChallengeResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetShareChallengeResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetShareChallengeResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetShareChallengeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetShareChallengeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetShareChallengeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetShareChallengeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetShareChallengeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetShareChallengeResponseMultiError) AllErrors() []error { return m }
+
+// GetShareChallengeResponseValidationError is the validation error returned by
+// GetShareChallengeResponse.Validate if the designated constraints aren't met.
+type GetShareChallengeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetShareChallengeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetShareChallengeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetShareChallengeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetShareChallengeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetShareChallengeResponseValidationError) ErrorName() string {
+	return "GetShareChallengeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetShareChallengeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetShareChallengeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetShareChallengeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetShareChallengeResponseValidationError{}
+
+// Validate checks the field values on SendShareVerificationCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendShareVerificationCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendShareVerificationCodeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SendShareVerificationCodeResponseMultiError, or nil if none found.
+func (m *SendShareVerificationCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendShareVerificationCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendShareVerificationCodeResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendShareVerificationCodeResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendShareVerificationCodeResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendShareVerificationCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendShareVerificationCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SendShareVerificationCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SendShareVerificationCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendShareVerificationCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendShareVerificationCodeResponseMultiError) AllErrors() []error { return m }
+
+// SendShareVerificationCodeResponseValidationError is the validation error
+// returned by SendShareVerificationCodeResponse.Validate if the designated
+// constraints aren't met.
+type SendShareVerificationCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendShareVerificationCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendShareVerificationCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendShareVerificationCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendShareVerificationCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendShareVerificationCodeResponseValidationError) ErrorName() string {
+	return "SendShareVerificationCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendShareVerificationCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendShareVerificationCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendShareVerificationCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendShareVerificationCodeResponseValidationError{}
+
+// Validate checks the field values on ListSharePoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSharePoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSharePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSharePoliciesRequestMultiError, or nil if none found.
+func (m *ListSharePoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSharePoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareLinkId
+
+	if len(errors) > 0 {
+		return ListSharePoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSharePoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSharePoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSharePoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSharePoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSharePoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListSharePoliciesRequestValidationError is the validation error returned by
+// ListSharePoliciesRequest.Validate if the designated constraints aren't met.
+type ListSharePoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSharePoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSharePoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSharePoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSharePoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSharePoliciesRequestValidationError) ErrorName() string {
+	return "ListSharePoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSharePoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSharePoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSharePoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSharePoliciesRequestValidationError{}
+
+// Validate checks the field values on ListSharePoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSharePoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSharePoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSharePoliciesResponseMultiError, or nil if none found.
+func (m *ListSharePoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSharePoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSharePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSharePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSharePoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSharePoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSharePoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSharePoliciesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSharePoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSharePoliciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSharePoliciesResponseMultiError) AllErrors() []error { return m }
+
+// ListSharePoliciesResponseValidationError is the validation error returned by
+// ListSharePoliciesResponse.Validate if the designated constraints aren't met.
+type ListSharePoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSharePoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSharePoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSharePoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSharePoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSharePoliciesResponseValidationError) ErrorName() string {
+	return "ListSharePoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSharePoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSharePoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSharePoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSharePoliciesResponseValidationError{}
+
+// Validate checks the field values on DeleteSharePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSharePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSharePolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSharePolicyRequestMultiError, or nil if none found.
+func (m *DeleteSharePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSharePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareLinkId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteSharePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSharePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSharePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSharePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSharePolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSharePolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSharePolicyRequestValidationError is the validation error returned by
+// DeleteSharePolicyRequest.Validate if the designated constraints aren't met.
+type DeleteSharePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSharePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSharePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSharePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSharePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSharePolicyRequestValidationError) ErrorName() string {
+	return "DeleteSharePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSharePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSharePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSharePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSharePolicyRequestValidationError{}
+
+// Validate checks the field values on SetSharePolicySetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSharePolicySetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSharePolicySetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSharePolicySetsRequestMultiError, or nil if none found.
+func (m *SetSharePolicySetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSharePolicySetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareLinkId
+
+	if len(errors) > 0 {
+		return SetSharePolicySetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSharePolicySetsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSharePolicySetsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetSharePolicySetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSharePolicySetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSharePolicySetsRequestMultiError) AllErrors() []error { return m }
+
+// SetSharePolicySetsRequestValidationError is the validation error returned by
+// SetSharePolicySetsRequest.Validate if the designated constraints aren't met.
+type SetSharePolicySetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSharePolicySetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSharePolicySetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSharePolicySetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSharePolicySetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSharePolicySetsRequestValidationError) ErrorName() string {
+	return "SetSharePolicySetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSharePolicySetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSharePolicySetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSharePolicySetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSharePolicySetsRequestValidationError{}
+
+// Validate checks the field values on SetSharePolicySetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSharePolicySetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSharePolicySetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSharePolicySetsResponseMultiError, or nil if none found.
+func (m *SetSharePolicySetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSharePolicySetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetSharePolicySetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSharePolicySetsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetSharePolicySetsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetSharePolicySetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSharePolicySetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSharePolicySetsResponseMultiError) AllErrors() []error { return m }
+
+// SetSharePolicySetsResponseValidationError is the validation error returned
+// by SetSharePolicySetsResponse.Validate if the designated constraints aren't met.
+type SetSharePolicySetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSharePolicySetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSharePolicySetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSharePolicySetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSharePolicySetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSharePolicySetsResponseValidationError) ErrorName() string {
+	return "SetSharePolicySetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSharePolicySetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSharePolicySetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSharePolicySetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSharePolicySetsResponseValidationError{}
+
+// Validate checks the field values on SetSharePolicyModeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSharePolicyModeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSharePolicyModeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSharePolicyModeRequestMultiError, or nil if none found.
+func (m *SetSharePolicyModeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSharePolicyModeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareLinkId
+
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	if len(errors) > 0 {
+		return SetSharePolicyModeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSharePolicyModeRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSharePolicyModeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetSharePolicyModeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSharePolicyModeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSharePolicyModeRequestMultiError) AllErrors() []error { return m }
+
+// SetSharePolicyModeRequestValidationError is the validation error returned by
+// SetSharePolicyModeRequest.Validate if the designated constraints aren't met.
+type SetSharePolicyModeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSharePolicyModeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSharePolicyModeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSharePolicyModeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSharePolicyModeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSharePolicyModeRequestValidationError) ErrorName() string {
+	return "SetSharePolicyModeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSharePolicyModeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSharePolicyModeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSharePolicyModeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSharePolicyModeRequestValidationError{}
+
+// Validate checks the field values on SetSharePolicyModeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSharePolicyModeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSharePolicyModeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSharePolicyModeResponseMultiError, or nil if none found.
+func (m *SetSharePolicyModeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSharePolicyModeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	if len(errors) > 0 {
+		return SetSharePolicyModeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSharePolicyModeResponseMultiError is an error wrapping multiple
+// validation errors returned by SetSharePolicyModeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetSharePolicyModeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSharePolicyModeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSharePolicyModeResponseMultiError) AllErrors() []error { return m }
+
+// SetSharePolicyModeResponseValidationError is the validation error returned
+// by SetSharePolicyModeResponse.Validate if the designated constraints aren't met.
+type SetSharePolicyModeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSharePolicyModeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSharePolicyModeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSharePolicyModeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSharePolicyModeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSharePolicyModeResponseValidationError) ErrorName() string {
+	return "SetSharePolicyModeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSharePolicyModeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSharePolicyModeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSharePolicyModeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSharePolicyModeResponseValidationError{}
+
+// Validate checks the field values on ResetShareDeviceBindingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetShareDeviceBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetShareDeviceBindingRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResetShareDeviceBindingRequestMultiError, or nil if none found.
+func (m *ResetShareDeviceBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetShareDeviceBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ResetShareDeviceBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetShareDeviceBindingRequestMultiError is an error wrapping multiple
+// validation errors returned by ResetShareDeviceBindingRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResetShareDeviceBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetShareDeviceBindingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetShareDeviceBindingRequestMultiError) AllErrors() []error { return m }
+
+// ResetShareDeviceBindingRequestValidationError is the validation error
+// returned by ResetShareDeviceBindingRequest.Validate if the designated
+// constraints aren't met.
+type ResetShareDeviceBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetShareDeviceBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetShareDeviceBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetShareDeviceBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetShareDeviceBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetShareDeviceBindingRequestValidationError) ErrorName() string {
+	return "ResetShareDeviceBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetShareDeviceBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetShareDeviceBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetShareDeviceBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetShareDeviceBindingRequestValidationError{}
+
+// Validate checks the field values on PolicyEvaluationClient with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyEvaluationClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyEvaluationClient with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyEvaluationClientMultiError, or nil if none found.
+func (m *PolicyEvaluationClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyEvaluationClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	// no validation rules for Country
+
+	// no validation rules for City
+
+	// no validation rules for RecipientEmail
+
+	if all {
+		switch v := interface{}(m.GetCertificate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyEvaluationClientValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyEvaluationClientValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCertificate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyEvaluationClientValidationError{
+				field:  "Certificate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Time != nil {
+
+		if all {
+			switch v := interface{}(m.GetTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyEvaluationClientValidationError{
+						field:  "Time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyEvaluationClientValidationError{
+						field:  "Time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyEvaluationClientValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AttemptCount != nil {
+		// no validation rules for AttemptCount
+	}
+
+	if len(errors) > 0 {
+		return PolicyEvaluationClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyEvaluationClientMultiError is an error wrapping multiple validation
+// errors returned by PolicyEvaluationClient.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyEvaluationClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyEvaluationClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyEvaluationClientMultiError) AllErrors() []error { return m }
+
+// PolicyEvaluationClientValidationError is the validation error returned by
+// PolicyEvaluationClient.Validate if the designated constraints aren't met.
+type PolicyEvaluationClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyEvaluationClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyEvaluationClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyEvaluationClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyEvaluationClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyEvaluationClientValidationError) ErrorName() string {
+	return "PolicyEvaluationClientValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyEvaluationClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyEvaluationClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyEvaluationClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyEvaluationClientValidationError{}
+
+// Validate checks the field values on PolicyEvaluationCertificate with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyEvaluationCertificate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyEvaluationCertificate with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyEvaluationCertificateMultiError, or nil if none found.
+func (m *PolicyEvaluationCertificate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyEvaluationCertificate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sha256
+
+	// no validation rules for Subject
+
+	// no validation rules for Issuer
+
+	// no validation rules for Verified
+
+	if len(errors) > 0 {
+		return PolicyEvaluationCertificateMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyEvaluationCertificateMultiError is an error wrapping multiple
+// validation errors returned by PolicyEvaluationCertificate.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyEvaluationCertificateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyEvaluationCertificateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyEvaluationCertificateMultiError) AllErrors() []error { return m }
+
+// PolicyEvaluationCertificateValidationError is the validation error returned
+// by PolicyEvaluationCertificate.Validate if the designated constraints
+// aren't met.
+type PolicyEvaluationCertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyEvaluationCertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyEvaluationCertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyEvaluationCertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyEvaluationCertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyEvaluationCertificateValidationError) ErrorName() string {
+	return "PolicyEvaluationCertificateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyEvaluationCertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyEvaluationCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyEvaluationCertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyEvaluationCertificateValidationError{}
+
+// Validate checks the field values on EvaluateSharePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateSharePoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateSharePoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateSharePoliciesRequestMultiError, or nil if none found.
+func (m *EvaluateSharePoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateSharePoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSharePoliciesResponseValidationError{
+					errors = append(errors, EvaluateSharePoliciesRequestValidationError{
 						field:  fmt.Sprintf("Policies[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1951,7 +6846,7 @@ func (m *ListSharePoliciesResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSharePoliciesResponseValidationError{
+					errors = append(errors, EvaluateSharePoliciesRequestValidationError{
 						field:  fmt.Sprintf("Policies[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1960,7 +6855,7 @@ func (m *ListSharePoliciesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListSharePoliciesResponseValidationError{
+				return EvaluateSharePoliciesRequestValidationError{
 					field:  fmt.Sprintf("Policies[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1970,20 +6865,57 @@ func (m *ListSharePoliciesResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateSharePoliciesRequestValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateSharePoliciesRequestValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateSharePoliciesRequestValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	if m.ShareLinkId != nil {
+		// no validation rules for ShareLinkId
+	}
+
 	if len(errors) > 0 {
-		return ListSharePoliciesResponseMultiError(errors)
+		return EvaluateSharePoliciesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSharePoliciesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSharePoliciesResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ListSharePoliciesResponseMultiError []error
+// EvaluateSharePoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by EvaluateSharePoliciesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type EvaluateSharePoliciesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSharePoliciesResponseMultiError) Error() string {
+func (m EvaluateSharePoliciesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1992,11 +6924,12 @@ func (m ListSharePoliciesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSharePoliciesResponseMultiError) AllErrors() []error { return m }
+func (m EvaluateSharePoliciesRequestMultiError) AllErrors() []error { return m }
 
-// ListSharePoliciesResponseValidationError is the validation error returned by
-// ListSharePoliciesResponse.Validate if the designated constraints aren't met.
-type ListSharePoliciesResponseValidationError struct {
+// EvaluateSharePoliciesRequestValidationError is the validation error returned
+// by EvaluateSharePoliciesRequest.Validate if the designated constraints
+// aren't met.
+type EvaluateSharePoliciesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2004,24 +6937,24 @@ type ListSharePoliciesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSharePoliciesResponseValidationError) Field() string { return e.field }
+func (e EvaluateSharePoliciesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSharePoliciesResponseValidationError) Reason() string { return e.reason }
+func (e EvaluateSharePoliciesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSharePoliciesResponseValidationError) Cause() error { return e.cause }
+func (e EvaluateSharePoliciesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSharePoliciesResponseValidationError) Key() bool { return e.key }
+func (e EvaluateSharePoliciesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSharePoliciesResponseValidationError) ErrorName() string {
-	return "ListSharePoliciesResponseValidationError"
+func (e EvaluateSharePoliciesRequestValidationError) ErrorName() string {
+	return "EvaluateSharePoliciesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSharePoliciesResponseValidationError) Error() string {
+func (e EvaluateSharePoliciesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2033,14 +6966,14 @@ func (e ListSharePoliciesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSharePoliciesResponse.%s: %s%s",
+		"invalid %sEvaluateSharePoliciesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSharePoliciesResponseValidationError{}
+var _ error = EvaluateSharePoliciesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2048,48 +6981,249 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSharePoliciesResponseValidationError{}
+} = EvaluateSharePoliciesRequestValidationError{}
 
-// Validate checks the field values on DeleteSharePolicyRequest with the rules
+// Validate checks the field values on SharePolicyTrace with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SharePolicyTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharePolicyTrace with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SharePolicyTraceMultiError, or nil if none found.
+func (m *SharePolicyTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharePolicyTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Index
+
+	// no validation rules for PolicySetId
+
+	// no validation rules for Type
+
+	// no validation rules for Method
+
+	// no validation rules for Value
+
+	// no validation rules for Matched
+
+	// no validation rules for Decisive
+
+	// no validation rules for Explanation
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return SharePolicyTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharePolicyTraceMultiError is an error wrapping multiple validation errors
+// returned by SharePolicyTrace.ValidateAll() if the designated constraints
+// aren't met.
+type SharePolicyTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharePolicyTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharePolicyTraceMultiError) AllErrors() []error { return m }
+
+// SharePolicyTraceValidationError is the validation error returned by
+// SharePolicyTrace.Validate if the designated constraints aren't met.
+type SharePolicyTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharePolicyTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharePolicyTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharePolicyTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharePolicyTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharePolicyTraceValidationError) ErrorName() string { return "SharePolicyTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharePolicyTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharePolicyTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharePolicyTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharePolicyTraceValidationError{}
+
+// Validate checks the field values on EvaluateSharePoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSharePolicyRequest) Validate() error {
+func (m *EvaluateSharePoliciesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSharePolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteSharePolicyRequestMultiError, or nil if none found.
-func (m *DeleteSharePolicyRequest) ValidateAll() error {
+// ValidateAll checks the field values on EvaluateSharePoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EvaluateSharePoliciesResponseMultiError, or nil if none found.
+func (m *EvaluateSharePoliciesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSharePolicyRequest) validate(all bool) error {
+func (m *EvaluateSharePoliciesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ShareLinkId
+	// no validation rules for Allowed
 
-	// no validation rules for Id
+	// no validation rules for Reason
+
+	// no validation rules for DeniedMethod
+
+	for idx, item := range m.GetTrace() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EvaluateSharePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Trace[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EvaluateSharePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Trace[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateSharePoliciesResponseValidationError{
+					field:  fmt.Sprintf("Trace[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvaluatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateSharePoliciesResponseValidationError{
+					field:  "EvaluatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateSharePoliciesResponseValidationError{
+					field:  "EvaluatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateSharePoliciesResponseValidationError{
+				field:  "EvaluatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Country
+
+	// no validation rules for City
+
+	// no validation rules for DeviceOs
+
+	// no validation rules for DeviceBrowser
+
+	// no validation rules for DeviceClass
+
+	// no validation rules for PolicyMode
+
+	// no validation rules for PolicyDefault
+
+	// no validation rules for DefaultApplied
 
 	if len(errors) > 0 {
-		return DeleteSharePolicyRequestMultiError(errors)
+		return EvaluateSharePoliciesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSharePolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSharePolicyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteSharePolicyRequestMultiError []error
+// EvaluateSharePoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by EvaluateSharePoliciesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type EvaluateSharePoliciesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSharePolicyRequestMultiError) Error() string {
+func (m EvaluateSharePoliciesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2098,11 +7232,12 @@ func (m DeleteSharePolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSharePolicyRequestMultiError) AllErrors() []error { return m }
+func (m EvaluateSharePoliciesResponseMultiError) AllErrors() []error { return m }
 
-// DeleteSharePolicyRequestValidationError is the validation error returned by
-// DeleteSharePolicyRequest.Validate if the designated constraints aren't met.
-type DeleteSharePolicyRequestValidationError struct {
+// EvaluateSharePoliciesResponseValidationError is the validation error
+// returned by EvaluateSharePoliciesResponse.Validate if the designated
+// constraints aren't met.
+type EvaluateSharePoliciesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2110,24 +7245,24 @@ type DeleteSharePolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSharePolicyRequestValidationError) Field() string { return e.field }
+func (e EvaluateSharePoliciesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSharePolicyRequestValidationError) Reason() string { return e.reason }
+func (e EvaluateSharePoliciesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSharePolicyRequestValidationError) Cause() error { return e.cause }
+func (e EvaluateSharePoliciesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSharePolicyRequestValidationError) Key() bool { return e.key }
+func (e EvaluateSharePoliciesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSharePolicyRequestValidationError) ErrorName() string {
-	return "DeleteSharePolicyRequestValidationError"
+func (e EvaluateSharePoliciesResponseValidationError) ErrorName() string {
+	return "EvaluateSharePoliciesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSharePolicyRequestValidationError) Error() string {
+func (e EvaluateSharePoliciesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2139,14 +7274,14 @@ func (e DeleteSharePolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSharePolicyRequest.%s: %s%s",
+		"invalid %sEvaluateSharePoliciesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSharePolicyRequestValidationError{}
+var _ error = EvaluateSharePoliciesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2154,4 +7289,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSharePolicyRequestValidationError{}
+} = EvaluateSharePoliciesResponseValidationError{}
@@ -20,14 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SharingShareService_CreateShare_FullMethodName       = "/sharing.service.v1.SharingShareService/CreateShare"
-	SharingShareService_GetShare_FullMethodName          = "/sharing.service.v1.SharingShareService/GetShare"
-	SharingShareService_ListShares_FullMethodName        = "/sharing.service.v1.SharingShareService/ListShares"
-	SharingShareService_RevokeShare_FullMethodName       = "/sharing.service.v1.SharingShareService/RevokeShare"
-	SharingShareService_ViewSharedContent_FullMethodName = "/sharing.service.v1.SharingShareService/ViewSharedContent"
-	SharingShareService_CreateSharePolicy_FullMethodName = "/sharing.service.v1.SharingShareService/CreateSharePolicy"
-	SharingShareService_ListSharePolicies_FullMethodName = "/sharing.service.v1.SharingShareService/ListSharePolicies"
-	SharingShareService_DeleteSharePolicy_FullMethodName = "/sharing.service.v1.SharingShareService/DeleteSharePolicy"
+	SharingShareService_CreateShare_FullMethodName                   = "/sharing.service.v1.SharingShareService/CreateShare"
+	SharingShareService_GetShare_FullMethodName                      = "/sharing.service.v1.SharingShareService/GetShare"
+	SharingShareService_ListShares_FullMethodName                    = "/sharing.service.v1.SharingShareService/ListShares"
+	SharingShareService_RevokeShare_FullMethodName                   = "/sharing.service.v1.SharingShareService/RevokeShare"
+	SharingShareService_ViewSharedContent_FullMethodName             = "/sharing.service.v1.SharingShareService/ViewSharedContent"
+	SharingShareService_GetShareChallenge_FullMethodName             = "/sharing.service.v1.SharingShareService/GetShareChallenge"
+	SharingShareService_SendShareVerificationCode_FullMethodName     = "/sharing.service.v1.SharingShareService/SendShareVerificationCode"
+	SharingShareService_ReportLeakedToken_FullMethodName             = "/sharing.service.v1.SharingShareService/ReportLeakedToken"
+	SharingShareService_ListShareAccessEvents_FullMethodName         = "/sharing.service.v1.SharingShareService/ListShareAccessEvents"
+	SharingShareService_GetSharingStats_FullMethodName               = "/sharing.service.v1.SharingShareService/GetSharingStats"
+	SharingShareService_GetNotificationPreferences_FullMethodName    = "/sharing.service.v1.SharingShareService/GetNotificationPreferences"
+	SharingShareService_UpdateNotificationPreferences_FullMethodName = "/sharing.service.v1.SharingShareService/UpdateNotificationPreferences"
+	SharingShareService_GetRiskSettings_FullMethodName               = "/sharing.service.v1.SharingShareService/GetRiskSettings"
+	SharingShareService_UpdateRiskSettings_FullMethodName            = "/sharing.service.v1.SharingShareService/UpdateRiskSettings"
+	SharingShareService_CreateSharePolicy_FullMethodName             = "/sharing.service.v1.SharingShareService/CreateSharePolicy"
+	SharingShareService_ListSharePolicies_FullMethodName             = "/sharing.service.v1.SharingShareService/ListSharePolicies"
+	SharingShareService_DeleteSharePolicy_FullMethodName             = "/sharing.service.v1.SharingShareService/DeleteSharePolicy"
+	SharingShareService_SetSharePolicySets_FullMethodName            = "/sharing.service.v1.SharingShareService/SetSharePolicySets"
+	SharingShareService_SetSharePolicyMode_FullMethodName            = "/sharing.service.v1.SharingShareService/SetSharePolicyMode"
+	SharingShareService_ResetShareDeviceBinding_FullMethodName       = "/sharing.service.v1.SharingShareService/ResetShareDeviceBinding"
+	SharingShareService_EvaluateSharePolicies_FullMethodName         = "/sharing.service.v1.SharingShareService/EvaluateSharePolicies"
 )
 
 // SharingShareServiceClient is the client API for SharingShareService service.
@@ -46,12 +59,40 @@ type SharingShareServiceClient interface {
 	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// View shared content (used by HTTP public endpoint internally)
 	ViewSharedContent(ctx context.Context, in *ViewSharedContentRequest, opts ...grpc.CallOption) (*ViewSharedContentResponse, error)
+	// Issue a proof-of-work challenge for a share token (public); solutions are
+	// submitted with the requests that look the token up
+	GetShareChallenge(ctx context.Context, in *GetShareChallengeRequest, opts ...grpc.CallOption) (*GetShareChallengeResponse, error)
+	// Email a verification code to the recipient of a share (public), for the step-up
+	// challenge of risky access attempts
+	SendShareVerificationCode(ctx context.Context, in *SendShareVerificationCodeRequest, opts ...grpc.CallOption) (*SendShareVerificationCodeResponse, error)
+	// Report a leaked share token (public, used by secret scanners); revokes the matching share
+	ReportLeakedToken(ctx context.Context, in *ReportLeakedTokenRequest, opts ...grpc.CallOption) (*ReportLeakedTokenResponse, error)
+	// List access attempts recorded for shares of the current tenant
+	ListShareAccessEvents(ctx context.Context, in *ListShareAccessEventsRequest, opts ...grpc.CallOption) (*ListShareAccessEventsResponse, error)
+	// Get aggregated sharing statistics for the current tenant
+	GetSharingStats(ctx context.Context, in *GetSharingStatsRequest, opts ...grpc.CallOption) (*GetSharingStatsResponse, error)
+	// Get the current user's sender notification preferences
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	// Update the current user's sender notification preferences
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
+	// Get the risk-based access settings of the current tenant
+	GetRiskSettings(ctx context.Context, in *GetRiskSettingsRequest, opts ...grpc.CallOption) (*GetRiskSettingsResponse, error)
+	// Update the risk-based access settings of the current tenant
+	UpdateRiskSettings(ctx context.Context, in *UpdateRiskSettingsRequest, opts ...grpc.CallOption) (*UpdateRiskSettingsResponse, error)
 	// Create a policy restriction for a share link
 	CreateSharePolicy(ctx context.Context, in *CreateSharePolicyRequest, opts ...grpc.CallOption) (*CreateSharePolicyResponse, error)
 	// List policy restrictions for a share link
 	ListSharePolicies(ctx context.Context, in *ListSharePoliciesRequest, opts ...grpc.CallOption) (*ListSharePoliciesResponse, error)
 	// Delete a policy restriction
 	DeleteSharePolicy(ctx context.Context, in *DeleteSharePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replace the policy sets referenced by a share
+	SetSharePolicySets(ctx context.Context, in *SetSharePolicySetsRequest, opts ...grpc.CallOption) (*SetSharePolicySetsResponse, error)
+	// Change how the policies of a share are combined
+	SetSharePolicyMode(ctx context.Context, in *SetSharePolicyModeRequest, opts ...grpc.CallOption) (*SetSharePolicyModeResponse, error)
+	// Forget the device a share is bound to; the next view binds it again
+	ResetShareDeviceBinding(ctx context.Context, in *ResetShareDeviceBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Evaluate the policies of a share, or an inline policy list, for a hypothetical client
+	EvaluateSharePolicies(ctx context.Context, in *EvaluateSharePoliciesRequest, opts ...grpc.CallOption) (*EvaluateSharePoliciesResponse, error)
 }
 
 type sharingShareServiceClient struct {
@@ -112,6 +153,96 @@ func (c *sharingShareServiceClient) ViewSharedContent(ctx context.Context, in *V
 	return out, nil
 }
 
+func (c *sharingShareServiceClient) GetShareChallenge(ctx context.Context, in *GetShareChallengeRequest, opts ...grpc.CallOption) (*GetShareChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShareChallengeResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_GetShareChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) SendShareVerificationCode(ctx context.Context, in *SendShareVerificationCodeRequest, opts ...grpc.CallOption) (*SendShareVerificationCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendShareVerificationCodeResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_SendShareVerificationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) ReportLeakedToken(ctx context.Context, in *ReportLeakedTokenRequest, opts ...grpc.CallOption) (*ReportLeakedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportLeakedTokenResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_ReportLeakedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) ListShareAccessEvents(ctx context.Context, in *ListShareAccessEventsRequest, opts ...grpc.CallOption) (*ListShareAccessEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareAccessEventsResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_ListShareAccessEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) GetSharingStats(ctx context.Context, in *GetSharingStatsRequest, opts ...grpc.CallOption) (*GetSharingStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharingStatsResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_GetSharingStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) GetRiskSettings(ctx context.Context, in *GetRiskSettingsRequest, opts ...grpc.CallOption) (*GetRiskSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiskSettingsResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_GetRiskSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) UpdateRiskSettings(ctx context.Context, in *UpdateRiskSettingsRequest, opts ...grpc.CallOption) (*UpdateRiskSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRiskSettingsResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_UpdateRiskSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sharingShareServiceClient) CreateSharePolicy(ctx context.Context, in *CreateSharePolicyRequest, opts ...grpc.CallOption) (*CreateSharePolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSharePolicyResponse)
@@ -142,6 +273,46 @@ func (c *sharingShareServiceClient) DeleteSharePolicy(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *sharingShareServiceClient) SetSharePolicySets(ctx context.Context, in *SetSharePolicySetsRequest, opts ...grpc.CallOption) (*SetSharePolicySetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSharePolicySetsResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_SetSharePolicySets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) SetSharePolicyMode(ctx context.Context, in *SetSharePolicyModeRequest, opts ...grpc.CallOption) (*SetSharePolicyModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSharePolicyModeResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_SetSharePolicyMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) ResetShareDeviceBinding(ctx context.Context, in *ResetShareDeviceBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SharingShareService_ResetShareDeviceBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharingShareServiceClient) EvaluateSharePolicies(ctx context.Context, in *EvaluateSharePoliciesRequest, opts ...grpc.CallOption) (*EvaluateSharePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateSharePoliciesResponse)
+	err := c.cc.Invoke(ctx, SharingShareService_EvaluateSharePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SharingShareServiceServer is the server API for SharingShareService service.
 // All implementations must embed UnimplementedSharingShareServiceServer
 // for forward compatibility.
@@ -158,12 +329,40 @@ type SharingShareServiceServer interface {
 	RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error)
 	// View shared content (used by HTTP public endpoint internally)
 	ViewSharedContent(context.Context, *ViewSharedContentRequest) (*ViewSharedContentResponse, error)
+	// Issue a proof-of-work challenge for a share token (public); solutions are
+	// submitted with the requests that look the token up
+	GetShareChallenge(context.Context, *GetShareChallengeRequest) (*GetShareChallengeResponse, error)
+	// Email a verification code to the recipient of a share (public), for the step-up
+	// challenge of risky access attempts
+	SendShareVerificationCode(context.Context, *SendShareVerificationCodeRequest) (*SendShareVerificationCodeResponse, error)
+	// Report a leaked share token (public, used by secret scanners); revokes the matching share
+	ReportLeakedToken(context.Context, *ReportLeakedTokenRequest) (*ReportLeakedTokenResponse, error)
+	// List access attempts recorded for shares of the current tenant
+	ListShareAccessEvents(context.Context, *ListShareAccessEventsRequest) (*ListShareAccessEventsResponse, error)
+	// Get aggregated sharing statistics for the current tenant
+	GetSharingStats(context.Context, *GetSharingStatsRequest) (*GetSharingStatsResponse, error)
+	// Get the current user's sender notification preferences
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	// Update the current user's sender notification preferences
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	// Get the risk-based access settings of the current tenant
+	GetRiskSettings(context.Context, *GetRiskSettingsRequest) (*GetRiskSettingsResponse, error)
+	// Update the risk-based access settings of the current tenant
+	UpdateRiskSettings(context.Context, *UpdateRiskSettingsRequest) (*UpdateRiskSettingsResponse, error)
 	// Create a policy restriction for a share link
 	CreateSharePolicy(context.Context, *CreateSharePolicyRequest) (*CreateSharePolicyResponse, error)
 	// List policy restrictions for a share link
 	ListSharePolicies(context.Context, *ListSharePoliciesRequest) (*ListSharePoliciesResponse, error)
 	// Delete a policy restriction
 	DeleteSharePolicy(context.Context, *DeleteSharePolicyRequest) (*emptypb.Empty, error)
+	// Replace the policy sets referenced by a share
+	SetSharePolicySets(context.Context, *SetSharePolicySetsRequest) (*SetSharePolicySetsResponse, error)
+	// Change how the policies of a share are combined
+	SetSharePolicyMode(context.Context, *SetSharePolicyModeRequest) (*SetSharePolicyModeResponse, error)
+	// Forget the device a share is bound to; the next view binds it again
+	ResetShareDeviceBinding(context.Context, *ResetShareDeviceBindingRequest) (*emptypb.Empty, error)
+	// Evaluate the policies of a share, or an inline policy list, for a hypothetical client
+	EvaluateSharePolicies(context.Context, *EvaluateSharePoliciesRequest) (*EvaluateSharePoliciesResponse, error)
 	mustEmbedUnimplementedSharingShareServiceServer()
 }
 
@@ -189,6 +388,33 @@ func (UnimplementedSharingShareServiceServer) RevokeShare(context.Context, *Revo
 func (UnimplementedSharingShareServiceServer) ViewSharedContent(context.Context, *ViewSharedContentRequest) (*ViewSharedContentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ViewSharedContent not implemented")
 }
+func (UnimplementedSharingShareServiceServer) GetShareChallenge(context.Context, *GetShareChallengeRequest) (*GetShareChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShareChallenge not implemented")
+}
+func (UnimplementedSharingShareServiceServer) SendShareVerificationCode(context.Context, *SendShareVerificationCodeRequest) (*SendShareVerificationCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendShareVerificationCode not implemented")
+}
+func (UnimplementedSharingShareServiceServer) ReportLeakedToken(context.Context, *ReportLeakedTokenRequest) (*ReportLeakedTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportLeakedToken not implemented")
+}
+func (UnimplementedSharingShareServiceServer) ListShareAccessEvents(context.Context, *ListShareAccessEventsRequest) (*ListShareAccessEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShareAccessEvents not implemented")
+}
+func (UnimplementedSharingShareServiceServer) GetSharingStats(context.Context, *GetSharingStatsRequest) (*GetSharingStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharingStats not implemented")
+}
+func (UnimplementedSharingShareServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedSharingShareServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedSharingShareServiceServer) GetRiskSettings(context.Context, *GetRiskSettingsRequest) (*GetRiskSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRiskSettings not implemented")
+}
+func (UnimplementedSharingShareServiceServer) UpdateRiskSettings(context.Context, *UpdateRiskSettingsRequest) (*UpdateRiskSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRiskSettings not implemented")
+}
 func (UnimplementedSharingShareServiceServer) CreateSharePolicy(context.Context, *CreateSharePolicyRequest) (*CreateSharePolicyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSharePolicy not implemented")
 }
@@ -198,6 +424,18 @@ func (UnimplementedSharingShareServiceServer) ListSharePolicies(context.Context,
 func (UnimplementedSharingShareServiceServer) DeleteSharePolicy(context.Context, *DeleteSharePolicyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSharePolicy not implemented")
 }
+func (UnimplementedSharingShareServiceServer) SetSharePolicySets(context.Context, *SetSharePolicySetsRequest) (*SetSharePolicySetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSharePolicySets not implemented")
+}
+func (UnimplementedSharingShareServiceServer) SetSharePolicyMode(context.Context, *SetSharePolicyModeRequest) (*SetSharePolicyModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSharePolicyMode not implemented")
+}
+func (UnimplementedSharingShareServiceServer) ResetShareDeviceBinding(context.Context, *ResetShareDeviceBindingRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetShareDeviceBinding not implemented")
+}
+func (UnimplementedSharingShareServiceServer) EvaluateSharePolicies(context.Context, *EvaluateSharePoliciesRequest) (*EvaluateSharePoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateSharePolicies not implemented")
+}
 func (UnimplementedSharingShareServiceServer) mustEmbedUnimplementedSharingShareServiceServer() {}
 func (UnimplementedSharingShareServiceServer) testEmbeddedByValue()                             {}
 
@@ -309,6 +547,168 @@ func _SharingShareService_ViewSharedContent_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SharingShareService_GetShareChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).GetShareChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_GetShareChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).GetShareChallenge(ctx, req.(*GetShareChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_SendShareVerificationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendShareVerificationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).SendShareVerificationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_SendShareVerificationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).SendShareVerificationCode(ctx, req.(*SendShareVerificationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_ReportLeakedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLeakedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).ReportLeakedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_ReportLeakedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).ReportLeakedToken(ctx, req.(*ReportLeakedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_ListShareAccessEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareAccessEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).ListShareAccessEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_ListShareAccessEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).ListShareAccessEvents(ctx, req.(*ListShareAccessEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_GetSharingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).GetSharingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_GetSharingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).GetSharingStats(ctx, req.(*GetSharingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_GetRiskSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).GetRiskSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_GetRiskSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).GetRiskSettings(ctx, req.(*GetRiskSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_UpdateRiskSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRiskSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).UpdateRiskSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_UpdateRiskSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).UpdateRiskSettings(ctx, req.(*UpdateRiskSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SharingShareService_CreateSharePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSharePolicyRequest)
 	if err := dec(in); err != nil {
@@ -363,6 +763,78 @@ func _SharingShareService_DeleteSharePolicy_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SharingShareService_SetSharePolicySets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSharePolicySetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).SetSharePolicySets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_SetSharePolicySets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).SetSharePolicySets(ctx, req.(*SetSharePolicySetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_SetSharePolicyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSharePolicyModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).SetSharePolicyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_SetSharePolicyMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).SetSharePolicyMode(ctx, req.(*SetSharePolicyModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_ResetShareDeviceBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetShareDeviceBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).ResetShareDeviceBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_ResetShareDeviceBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).ResetShareDeviceBinding(ctx, req.(*ResetShareDeviceBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharingShareService_EvaluateSharePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateSharePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharingShareServiceServer).EvaluateSharePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharingShareService_EvaluateSharePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharingShareServiceServer).EvaluateSharePolicies(ctx, req.(*EvaluateSharePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SharingShareService_ServiceDesc is the grpc.ServiceDesc for SharingShareService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -390,6 +862,42 @@ var SharingShareService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ViewSharedContent",
 			Handler:    _SharingShareService_ViewSharedContent_Handler,
 		},
+		{
+			MethodName: "GetShareChallenge",
+			Handler:    _SharingShareService_GetShareChallenge_Handler,
+		},
+		{
+			MethodName: "SendShareVerificationCode",
+			Handler:    _SharingShareService_SendShareVerificationCode_Handler,
+		},
+		{
+			MethodName: "ReportLeakedToken",
+			Handler:    _SharingShareService_ReportLeakedToken_Handler,
+		},
+		{
+			MethodName: "ListShareAccessEvents",
+			Handler:    _SharingShareService_ListShareAccessEvents_Handler,
+		},
+		{
+			MethodName: "GetSharingStats",
+			Handler:    _SharingShareService_GetSharingStats_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _SharingShareService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _SharingShareService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "GetRiskSettings",
+			Handler:    _SharingShareService_GetRiskSettings_Handler,
+		},
+		{
+			MethodName: "UpdateRiskSettings",
+			Handler:    _SharingShareService_UpdateRiskSettings_Handler,
+		},
 		{
 			MethodName: "CreateSharePolicy",
 			Handler:    _SharingShareService_CreateSharePolicy_Handler,
@@ -402,6 +910,22 @@ var SharingShareService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSharePolicy",
 			Handler:    _SharingShareService_DeleteSharePolicy_Handler,
 		},
+		{
+			MethodName: "SetSharePolicySets",
+			Handler:    _SharingShareService_SetSharePolicySets_Handler,
+		},
+		{
+			MethodName: "SetSharePolicyMode",
+			Handler:    _SharingShareService_SetSharePolicyMode_Handler,
+		},
+		{
+			MethodName: "ResetShareDeviceBinding",
+			Handler:    _SharingShareService_ResetShareDeviceBinding_Handler,
+		},
+		{
+			MethodName: "EvaluateSharePolicies",
+			Handler:    _SharingShareService_EvaluateSharePolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sharing/service/v1/share.proto",
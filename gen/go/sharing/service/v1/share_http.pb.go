@@ -23,10 +23,23 @@ const _ = http.SupportPackageIsVersion1
 const OperationSharingShareServiceCreateShare = "/sharing.service.v1.SharingShareService/CreateShare"
 const OperationSharingShareServiceCreateSharePolicy = "/sharing.service.v1.SharingShareService/CreateSharePolicy"
 const OperationSharingShareServiceDeleteSharePolicy = "/sharing.service.v1.SharingShareService/DeleteSharePolicy"
+const OperationSharingShareServiceEvaluateSharePolicies = "/sharing.service.v1.SharingShareService/EvaluateSharePolicies"
+const OperationSharingShareServiceGetNotificationPreferences = "/sharing.service.v1.SharingShareService/GetNotificationPreferences"
+const OperationSharingShareServiceGetRiskSettings = "/sharing.service.v1.SharingShareService/GetRiskSettings"
 const OperationSharingShareServiceGetShare = "/sharing.service.v1.SharingShareService/GetShare"
+const OperationSharingShareServiceGetShareChallenge = "/sharing.service.v1.SharingShareService/GetShareChallenge"
+const OperationSharingShareServiceGetSharingStats = "/sharing.service.v1.SharingShareService/GetSharingStats"
+const OperationSharingShareServiceListShareAccessEvents = "/sharing.service.v1.SharingShareService/ListShareAccessEvents"
 const OperationSharingShareServiceListSharePolicies = "/sharing.service.v1.SharingShareService/ListSharePolicies"
 const OperationSharingShareServiceListShares = "/sharing.service.v1.SharingShareService/ListShares"
+const OperationSharingShareServiceReportLeakedToken = "/sharing.service.v1.SharingShareService/ReportLeakedToken"
+const OperationSharingShareServiceResetShareDeviceBinding = "/sharing.service.v1.SharingShareService/ResetShareDeviceBinding"
 const OperationSharingShareServiceRevokeShare = "/sharing.service.v1.SharingShareService/RevokeShare"
+const OperationSharingShareServiceSendShareVerificationCode = "/sharing.service.v1.SharingShareService/SendShareVerificationCode"
+const OperationSharingShareServiceSetSharePolicyMode = "/sharing.service.v1.SharingShareService/SetSharePolicyMode"
+const OperationSharingShareServiceSetSharePolicySets = "/sharing.service.v1.SharingShareService/SetSharePolicySets"
+const OperationSharingShareServiceUpdateNotificationPreferences = "/sharing.service.v1.SharingShareService/UpdateNotificationPreferences"
+const OperationSharingShareServiceUpdateRiskSettings = "/sharing.service.v1.SharingShareService/UpdateRiskSettings"
 const OperationSharingShareServiceViewSharedContent = "/sharing.service.v1.SharingShareService/ViewSharedContent"
 
 type SharingShareServiceHTTPServer interface {
@@ -36,14 +49,42 @@ type SharingShareServiceHTTPServer interface {
 	CreateSharePolicy(context.Context, *CreateSharePolicyRequest) (*CreateSharePolicyResponse, error)
 	// DeleteSharePolicy Delete a policy restriction
 	DeleteSharePolicy(context.Context, *DeleteSharePolicyRequest) (*emptypb.Empty, error)
+	// EvaluateSharePolicies Evaluate the policies of a share, or an inline policy list, for a hypothetical client
+	EvaluateSharePolicies(context.Context, *EvaluateSharePoliciesRequest) (*EvaluateSharePoliciesResponse, error)
+	// GetNotificationPreferences Get the current user's sender notification preferences
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	// GetRiskSettings Get the risk-based access settings of the current tenant
+	GetRiskSettings(context.Context, *GetRiskSettingsRequest) (*GetRiskSettingsResponse, error)
 	// GetShare Get a share by ID
 	GetShare(context.Context, *GetShareRequest) (*GetShareResponse, error)
+	// GetShareChallenge Issue a proof-of-work challenge for a share token (public); solutions are
+	// submitted with the requests that look the token up
+	GetShareChallenge(context.Context, *GetShareChallengeRequest) (*GetShareChallengeResponse, error)
+	// GetSharingStats Get aggregated sharing statistics for the current tenant
+	GetSharingStats(context.Context, *GetSharingStatsRequest) (*GetSharingStatsResponse, error)
+	// ListShareAccessEvents List access attempts recorded for shares of the current tenant
+	ListShareAccessEvents(context.Context, *ListShareAccessEventsRequest) (*ListShareAccessEventsResponse, error)
 	// ListSharePolicies List policy restrictions for a share link
 	ListSharePolicies(context.Context, *ListSharePoliciesRequest) (*ListSharePoliciesResponse, error)
 	// ListShares List shares for the current tenant
 	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	// ReportLeakedToken Report a leaked share token (public, used by secret scanners); revokes the matching share
+	ReportLeakedToken(context.Context, *ReportLeakedTokenRequest) (*ReportLeakedTokenResponse, error)
+	// ResetShareDeviceBinding Forget the device a share is bound to; the next view binds it again
+	ResetShareDeviceBinding(context.Context, *ResetShareDeviceBindingRequest) (*emptypb.Empty, error)
 	// RevokeShare Revoke a share (invalidate the link)
 	RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error)
+	// SendShareVerificationCode Email a verification code to the recipient of a share (public), for the step-up
+	// challenge of risky access attempts
+	SendShareVerificationCode(context.Context, *SendShareVerificationCodeRequest) (*SendShareVerificationCodeResponse, error)
+	// SetSharePolicyMode Change how the policies of a share are combined
+	SetSharePolicyMode(context.Context, *SetSharePolicyModeRequest) (*SetSharePolicyModeResponse, error)
+	// SetSharePolicySets Replace the policy sets referenced by a share
+	SetSharePolicySets(context.Context, *SetSharePolicySetsRequest) (*SetSharePolicySetsResponse, error)
+	// UpdateNotificationPreferences Update the current user's sender notification preferences
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	// UpdateRiskSettings Update the risk-based access settings of the current tenant
+	UpdateRiskSettings(context.Context, *UpdateRiskSettingsRequest) (*UpdateRiskSettingsResponse, error)
 	// ViewSharedContent View shared content (used by HTTP public endpoint internally)
 	ViewSharedContent(context.Context, *ViewSharedContentRequest) (*ViewSharedContentResponse, error)
 }
@@ -55,9 +96,22 @@ func RegisterSharingShareServiceHTTPServer(s *http.Server, srv SharingShareServi
 	r.GET("/v1/shares", _SharingShareService_ListShares0_HTTP_Handler(srv))
 	r.DELETE("/v1/shares/{id}", _SharingShareService_RevokeShare0_HTTP_Handler(srv))
 	r.GET("/v1/shared/{token}", _SharingShareService_ViewSharedContent0_HTTP_Handler(srv))
+	r.GET("/v1/shared/{token}/challenge", _SharingShareService_GetShareChallenge0_HTTP_Handler(srv))
+	r.POST("/v1/shared/{token}/verification-code", _SharingShareService_SendShareVerificationCode0_HTTP_Handler(srv))
+	r.POST("/v1/shared/leaks", _SharingShareService_ReportLeakedToken0_HTTP_Handler(srv))
+	r.GET("/v1/share-access-events", _SharingShareService_ListShareAccessEvents0_HTTP_Handler(srv))
+	r.GET("/v1/sharing-stats", _SharingShareService_GetSharingStats0_HTTP_Handler(srv))
+	r.GET("/v1/notification-preferences", _SharingShareService_GetNotificationPreferences0_HTTP_Handler(srv))
+	r.PUT("/v1/notification-preferences", _SharingShareService_UpdateNotificationPreferences0_HTTP_Handler(srv))
+	r.GET("/v1/risk-settings", _SharingShareService_GetRiskSettings0_HTTP_Handler(srv))
+	r.PUT("/v1/risk-settings", _SharingShareService_UpdateRiskSettings0_HTTP_Handler(srv))
 	r.POST("/v1/shares/{share_link_id}/policies", _SharingShareService_CreateSharePolicy0_HTTP_Handler(srv))
 	r.GET("/v1/shares/{share_link_id}/policies", _SharingShareService_ListSharePolicies0_HTTP_Handler(srv))
 	r.DELETE("/v1/shares/{share_link_id}/policies/{id}", _SharingShareService_DeleteSharePolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/shares/{share_link_id}/policy-sets", _SharingShareService_SetSharePolicySets0_HTTP_Handler(srv))
+	r.PUT("/v1/shares/{share_link_id}/policy-mode", _SharingShareService_SetSharePolicyMode0_HTTP_Handler(srv))
+	r.DELETE("/v1/shares/{id}/device-binding", _SharingShareService_ResetShareDeviceBinding0_HTTP_Handler(srv))
+	r.POST("/v1/share-policies:evaluate", _SharingShareService_EvaluateSharePolicies0_HTTP_Handler(srv))
 }
 
 func _SharingShareService_CreateShare0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
@@ -167,6 +221,195 @@ func _SharingShareService_ViewSharedContent0_HTTP_Handler(srv SharingShareServic
 	}
 }
 
+func _SharingShareService_GetShareChallenge0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetShareChallengeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceGetShareChallenge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetShareChallenge(ctx, req.(*GetShareChallengeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetShareChallengeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_SendShareVerificationCode0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendShareVerificationCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceSendShareVerificationCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendShareVerificationCode(ctx, req.(*SendShareVerificationCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendShareVerificationCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_ReportLeakedToken0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportLeakedTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceReportLeakedToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportLeakedToken(ctx, req.(*ReportLeakedTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportLeakedTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_ListShareAccessEvents0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListShareAccessEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceListShareAccessEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListShareAccessEvents(ctx, req.(*ListShareAccessEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListShareAccessEventsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_GetSharingStats0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSharingStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceGetSharingStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSharingStats(ctx, req.(*GetSharingStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSharingStatsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_GetNotificationPreferences0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetNotificationPreferencesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceGetNotificationPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetNotificationPreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_UpdateNotificationPreferences0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateNotificationPreferencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceUpdateNotificationPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateNotificationPreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_GetRiskSettings0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRiskSettingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceGetRiskSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRiskSettings(ctx, req.(*GetRiskSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRiskSettingsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_UpdateRiskSettings0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRiskSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceUpdateRiskSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRiskSettings(ctx, req.(*UpdateRiskSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateRiskSettingsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _SharingShareService_CreateSharePolicy0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateSharePolicyRequest
@@ -236,6 +479,100 @@ func _SharingShareService_DeleteSharePolicy0_HTTP_Handler(srv SharingShareServic
 	}
 }
 
+func _SharingShareService_SetSharePolicySets0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetSharePolicySetsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceSetSharePolicySets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetSharePolicySets(ctx, req.(*SetSharePolicySetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetSharePolicySetsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_SetSharePolicyMode0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetSharePolicyModeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceSetSharePolicyMode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetSharePolicyMode(ctx, req.(*SetSharePolicyModeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetSharePolicyModeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_ResetShareDeviceBinding0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetShareDeviceBindingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceResetShareDeviceBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetShareDeviceBinding(ctx, req.(*ResetShareDeviceBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SharingShareService_EvaluateSharePolicies0_HTTP_Handler(srv SharingShareServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EvaluateSharePoliciesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSharingShareServiceEvaluateSharePolicies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EvaluateSharePolicies(ctx, req.(*EvaluateSharePoliciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EvaluateSharePoliciesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SharingShareServiceHTTPClient interface {
 	// CreateShare Create a new share (sends email with one-time link)
 	CreateShare(ctx context.Context, req *CreateShareRequest, opts ...http.CallOption) (rsp *CreateShareResponse, err error)
@@ -243,14 +580,42 @@ type SharingShareServiceHTTPClient interface {
 	CreateSharePolicy(ctx context.Context, req *CreateSharePolicyRequest, opts ...http.CallOption) (rsp *CreateSharePolicyResponse, err error)
 	// DeleteSharePolicy Delete a policy restriction
 	DeleteSharePolicy(ctx context.Context, req *DeleteSharePolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// EvaluateSharePolicies Evaluate the policies of a share, or an inline policy list, for a hypothetical client
+	EvaluateSharePolicies(ctx context.Context, req *EvaluateSharePoliciesRequest, opts ...http.CallOption) (rsp *EvaluateSharePoliciesResponse, err error)
+	// GetNotificationPreferences Get the current user's sender notification preferences
+	GetNotificationPreferences(ctx context.Context, req *GetNotificationPreferencesRequest, opts ...http.CallOption) (rsp *GetNotificationPreferencesResponse, err error)
+	// GetRiskSettings Get the risk-based access settings of the current tenant
+	GetRiskSettings(ctx context.Context, req *GetRiskSettingsRequest, opts ...http.CallOption) (rsp *GetRiskSettingsResponse, err error)
 	// GetShare Get a share by ID
 	GetShare(ctx context.Context, req *GetShareRequest, opts ...http.CallOption) (rsp *GetShareResponse, err error)
+	// GetShareChallenge Issue a proof-of-work challenge for a share token (public); solutions are
+	// submitted with the requests that look the token up
+	GetShareChallenge(ctx context.Context, req *GetShareChallengeRequest, opts ...http.CallOption) (rsp *GetShareChallengeResponse, err error)
+	// GetSharingStats Get aggregated sharing statistics for the current tenant
+	GetSharingStats(ctx context.Context, req *GetSharingStatsRequest, opts ...http.CallOption) (rsp *GetSharingStatsResponse, err error)
+	// ListShareAccessEvents List access attempts recorded for shares of the current tenant
+	ListShareAccessEvents(ctx context.Context, req *ListShareAccessEventsRequest, opts ...http.CallOption) (rsp *ListShareAccessEventsResponse, err error)
 	// ListSharePolicies List policy restrictions for a share link
 	ListSharePolicies(ctx context.Context, req *ListSharePoliciesRequest, opts ...http.CallOption) (rsp *ListSharePoliciesResponse, err error)
 	// ListShares List shares for the current tenant
 	ListShares(ctx context.Context, req *ListSharesRequest, opts ...http.CallOption) (rsp *ListSharesResponse, err error)
+	// ReportLeakedToken Report a leaked share token (public, used by secret scanners); revokes the matching share
+	ReportLeakedToken(ctx context.Context, req *ReportLeakedTokenRequest, opts ...http.CallOption) (rsp *ReportLeakedTokenResponse, err error)
+	// ResetShareDeviceBinding Forget the device a share is bound to; the next view binds it again
+	ResetShareDeviceBinding(ctx context.Context, req *ResetShareDeviceBindingRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// RevokeShare Revoke a share (invalidate the link)
 	RevokeShare(ctx context.Context, req *RevokeShareRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SendShareVerificationCode Email a verification code to the recipient of a share (public), for the step-up
+	// challenge of risky access attempts
+	SendShareVerificationCode(ctx context.Context, req *SendShareVerificationCodeRequest, opts ...http.CallOption) (rsp *SendShareVerificationCodeResponse, err error)
+	// SetSharePolicyMode Change how the policies of a share are combined
+	SetSharePolicyMode(ctx context.Context, req *SetSharePolicyModeRequest, opts ...http.CallOption) (rsp *SetSharePolicyModeResponse, err error)
+	// SetSharePolicySets Replace the policy sets referenced by a share
+	SetSharePolicySets(ctx context.Context, req *SetSharePolicySetsRequest, opts ...http.CallOption) (rsp *SetSharePolicySetsResponse, err error)
+	// UpdateNotificationPreferences Update the current user's sender notification preferences
+	UpdateNotificationPreferences(ctx context.Context, req *UpdateNotificationPreferencesRequest, opts ...http.CallOption) (rsp *UpdateNotificationPreferencesResponse, err error)
+	// UpdateRiskSettings Update the risk-based access settings of the current tenant
+	UpdateRiskSettings(ctx context.Context, req *UpdateRiskSettingsRequest, opts ...http.CallOption) (rsp *UpdateRiskSettingsResponse, err error)
 	// ViewSharedContent View shared content (used by HTTP public endpoint internally)
 	ViewSharedContent(ctx context.Context, req *ViewSharedContentRequest, opts ...http.CallOption) (rsp *ViewSharedContentResponse, err error)
 }
@@ -305,6 +670,48 @@ func (c *SharingShareServiceHTTPClientImpl) DeleteSharePolicy(ctx context.Contex
 	return &out, nil
 }
 
+// EvaluateSharePolicies Evaluate the policies of a share, or an inline policy list, for a hypothetical client
+func (c *SharingShareServiceHTTPClientImpl) EvaluateSharePolicies(ctx context.Context, in *EvaluateSharePoliciesRequest, opts ...http.CallOption) (*EvaluateSharePoliciesResponse, error) {
+	var out EvaluateSharePoliciesResponse
+	pattern := "/v1/share-policies:evaluate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceEvaluateSharePolicies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNotificationPreferences Get the current user's sender notification preferences
+func (c *SharingShareServiceHTTPClientImpl) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...http.CallOption) (*GetNotificationPreferencesResponse, error) {
+	var out GetNotificationPreferencesResponse
+	pattern := "/v1/notification-preferences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceGetNotificationPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRiskSettings Get the risk-based access settings of the current tenant
+func (c *SharingShareServiceHTTPClientImpl) GetRiskSettings(ctx context.Context, in *GetRiskSettingsRequest, opts ...http.CallOption) (*GetRiskSettingsResponse, error) {
+	var out GetRiskSettingsResponse
+	pattern := "/v1/risk-settings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceGetRiskSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetShare Get a share by ID
 func (c *SharingShareServiceHTTPClientImpl) GetShare(ctx context.Context, in *GetShareRequest, opts ...http.CallOption) (*GetShareResponse, error) {
 	var out GetShareResponse
@@ -319,6 +726,49 @@ func (c *SharingShareServiceHTTPClientImpl) GetShare(ctx context.Context, in *Ge
 	return &out, nil
 }
 
+// GetShareChallenge Issue a proof-of-work challenge for a share token (public); solutions are
+// submitted with the requests that look the token up
+func (c *SharingShareServiceHTTPClientImpl) GetShareChallenge(ctx context.Context, in *GetShareChallengeRequest, opts ...http.CallOption) (*GetShareChallengeResponse, error) {
+	var out GetShareChallengeResponse
+	pattern := "/v1/shared/{token}/challenge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceGetShareChallenge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSharingStats Get aggregated sharing statistics for the current tenant
+func (c *SharingShareServiceHTTPClientImpl) GetSharingStats(ctx context.Context, in *GetSharingStatsRequest, opts ...http.CallOption) (*GetSharingStatsResponse, error) {
+	var out GetSharingStatsResponse
+	pattern := "/v1/sharing-stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceGetSharingStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListShareAccessEvents List access attempts recorded for shares of the current tenant
+func (c *SharingShareServiceHTTPClientImpl) ListShareAccessEvents(ctx context.Context, in *ListShareAccessEventsRequest, opts ...http.CallOption) (*ListShareAccessEventsResponse, error) {
+	var out ListShareAccessEventsResponse
+	pattern := "/v1/share-access-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceListShareAccessEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListSharePolicies List policy restrictions for a share link
 func (c *SharingShareServiceHTTPClientImpl) ListSharePolicies(ctx context.Context, in *ListSharePoliciesRequest, opts ...http.CallOption) (*ListSharePoliciesResponse, error) {
 	var out ListSharePoliciesResponse
@@ -347,6 +797,34 @@ func (c *SharingShareServiceHTTPClientImpl) ListShares(ctx context.Context, in *
 	return &out, nil
 }
 
+// ReportLeakedToken Report a leaked share token (public, used by secret scanners); revokes the matching share
+func (c *SharingShareServiceHTTPClientImpl) ReportLeakedToken(ctx context.Context, in *ReportLeakedTokenRequest, opts ...http.CallOption) (*ReportLeakedTokenResponse, error) {
+	var out ReportLeakedTokenResponse
+	pattern := "/v1/shared/leaks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceReportLeakedToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetShareDeviceBinding Forget the device a share is bound to; the next view binds it again
+func (c *SharingShareServiceHTTPClientImpl) ResetShareDeviceBinding(ctx context.Context, in *ResetShareDeviceBindingRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/shares/{id}/device-binding"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSharingShareServiceResetShareDeviceBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RevokeShare Revoke a share (invalidate the link)
 func (c *SharingShareServiceHTTPClientImpl) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -361,6 +839,77 @@ func (c *SharingShareServiceHTTPClientImpl) RevokeShare(ctx context.Context, in
 	return &out, nil
 }
 
+// SendShareVerificationCode Email a verification code to the recipient of a share (public), for the step-up
+// challenge of risky access attempts
+func (c *SharingShareServiceHTTPClientImpl) SendShareVerificationCode(ctx context.Context, in *SendShareVerificationCodeRequest, opts ...http.CallOption) (*SendShareVerificationCodeResponse, error) {
+	var out SendShareVerificationCodeResponse
+	pattern := "/v1/shared/{token}/verification-code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceSendShareVerificationCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetSharePolicyMode Change how the policies of a share are combined
+func (c *SharingShareServiceHTTPClientImpl) SetSharePolicyMode(ctx context.Context, in *SetSharePolicyModeRequest, opts ...http.CallOption) (*SetSharePolicyModeResponse, error) {
+	var out SetSharePolicyModeResponse
+	pattern := "/v1/shares/{share_link_id}/policy-mode"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceSetSharePolicyMode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetSharePolicySets Replace the policy sets referenced by a share
+func (c *SharingShareServiceHTTPClientImpl) SetSharePolicySets(ctx context.Context, in *SetSharePolicySetsRequest, opts ...http.CallOption) (*SetSharePolicySetsResponse, error) {
+	var out SetSharePolicySetsResponse
+	pattern := "/v1/shares/{share_link_id}/policy-sets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceSetSharePolicySets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateNotificationPreferences Update the current user's sender notification preferences
+func (c *SharingShareServiceHTTPClientImpl) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...http.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	var out UpdateNotificationPreferencesResponse
+	pattern := "/v1/notification-preferences"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceUpdateNotificationPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateRiskSettings Update the risk-based access settings of the current tenant
+func (c *SharingShareServiceHTTPClientImpl) UpdateRiskSettings(ctx context.Context, in *UpdateRiskSettingsRequest, opts ...http.CallOption) (*UpdateRiskSettingsResponse, error) {
+	var out UpdateRiskSettingsResponse
+	pattern := "/v1/risk-settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSharingShareServiceUpdateRiskSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ViewSharedContent View shared content (used by HTTP public endpoint internally)
 func (c *SharingShareServiceHTTPClientImpl) ViewSharedContent(ctx context.Context, in *ViewSharedContentRequest, opts ...http.CallOption) (*ViewSharedContentResponse, error) {
 	var out ViewSharedContentResponse
@@ -30,20 +30,29 @@ const (
 	SharingErrorReason_INVALID_RESOURCE_TYPE SharingErrorReason = 1
 	SharingErrorReason_INVALID_EMAIL         SharingErrorReason = 2
 	SharingErrorReason_INVALID_TEMPLATE      SharingErrorReason = 3
+	SharingErrorReason_INVALID_TOKEN         SharingErrorReason = 4
 	// 401 - Unauthorized
-	SharingErrorReason_UNAUTHORIZED SharingErrorReason = 100
+	SharingErrorReason_UNAUTHORIZED           SharingErrorReason = 100
+	SharingErrorReason_SHARE_STEP_UP_REQUIRED SharingErrorReason = 101
 	// 403 - Forbidden
-	SharingErrorReason_FORBIDDEN           SharingErrorReason = 300
-	SharingErrorReason_ACCESS_DENIED       SharingErrorReason = 301
-	SharingErrorReason_SHARE_ACCESS_DENIED SharingErrorReason = 302
+	SharingErrorReason_FORBIDDEN             SharingErrorReason = 300
+	SharingErrorReason_ACCESS_DENIED         SharingErrorReason = 301
+	SharingErrorReason_SHARE_ACCESS_DENIED   SharingErrorReason = 302
+	SharingErrorReason_SHARE_DEVICE_MISMATCH SharingErrorReason = 303
 	// 404 - Not Found
-	SharingErrorReason_NOT_FOUND          SharingErrorReason = 400
-	SharingErrorReason_SHARE_NOT_FOUND    SharingErrorReason = 401
-	SharingErrorReason_TEMPLATE_NOT_FOUND SharingErrorReason = 402
+	SharingErrorReason_NOT_FOUND            SharingErrorReason = 400
+	SharingErrorReason_SHARE_NOT_FOUND      SharingErrorReason = 401
+	SharingErrorReason_TEMPLATE_NOT_FOUND   SharingErrorReason = 402
+	SharingErrorReason_POLICY_SET_NOT_FOUND SharingErrorReason = 403
 	// 409 - Conflict
-	SharingErrorReason_SHARE_ALREADY_VIEWED    SharingErrorReason = 900
-	SharingErrorReason_SHARE_REVOKED           SharingErrorReason = 901
-	SharingErrorReason_TEMPLATE_ALREADY_EXISTS SharingErrorReason = 902
+	SharingErrorReason_SHARE_ALREADY_VIEWED      SharingErrorReason = 900
+	SharingErrorReason_SHARE_REVOKED             SharingErrorReason = 901
+	SharingErrorReason_TEMPLATE_ALREADY_EXISTS   SharingErrorReason = 902
+	SharingErrorReason_SHARE_EXPIRED             SharingErrorReason = 903
+	SharingErrorReason_POLICY_SET_ALREADY_EXISTS SharingErrorReason = 904
+	SharingErrorReason_POLICY_SET_IN_USE         SharingErrorReason = 905
+	// 428 - Precondition Required
+	SharingErrorReason_SHARE_CHALLENGE_REQUIRED SharingErrorReason = 2800
 	// 500 - Internal Server Error
 	SharingErrorReason_INTERNAL_SERVER_ERROR SharingErrorReason = 2000
 	SharingErrorReason_SMTP_ERROR            SharingErrorReason = 2001
@@ -62,16 +71,24 @@ var (
 		1:    "INVALID_RESOURCE_TYPE",
 		2:    "INVALID_EMAIL",
 		3:    "INVALID_TEMPLATE",
+		4:    "INVALID_TOKEN",
 		100:  "UNAUTHORIZED",
+		101:  "SHARE_STEP_UP_REQUIRED",
 		300:  "FORBIDDEN",
 		301:  "ACCESS_DENIED",
 		302:  "SHARE_ACCESS_DENIED",
+		303:  "SHARE_DEVICE_MISMATCH",
 		400:  "NOT_FOUND",
 		401:  "SHARE_NOT_FOUND",
 		402:  "TEMPLATE_NOT_FOUND",
+		403:  "POLICY_SET_NOT_FOUND",
 		900:  "SHARE_ALREADY_VIEWED",
 		901:  "SHARE_REVOKED",
 		902:  "TEMPLATE_ALREADY_EXISTS",
+		903:  "SHARE_EXPIRED",
+		904:  "POLICY_SET_ALREADY_EXISTS",
+		905:  "POLICY_SET_IN_USE",
+		2800: "SHARE_CHALLENGE_REQUIRED",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "SMTP_ERROR",
 		2002: "ENCRYPTION_ERROR",
@@ -81,27 +98,35 @@ var (
 		2302: "PAPERLESS_UNAVAILABLE",
 	}
 	SharingErrorReason_value = map[string]int32{
-		"BAD_REQUEST":             0,
-		"INVALID_RESOURCE_TYPE":   1,
-		"INVALID_EMAIL":           2,
-		"INVALID_TEMPLATE":        3,
-		"UNAUTHORIZED":            100,
-		"FORBIDDEN":               300,
-		"ACCESS_DENIED":           301,
-		"SHARE_ACCESS_DENIED":     302,
-		"NOT_FOUND":               400,
-		"SHARE_NOT_FOUND":         401,
-		"TEMPLATE_NOT_FOUND":      402,
-		"SHARE_ALREADY_VIEWED":    900,
-		"SHARE_REVOKED":           901,
-		"TEMPLATE_ALREADY_EXISTS": 902,
-		"INTERNAL_SERVER_ERROR":   2000,
-		"SMTP_ERROR":              2001,
-		"ENCRYPTION_ERROR":        2002,
-		"DATABASE_ERROR":          2003,
-		"SERVICE_UNAVAILABLE":     2300,
-		"WARDEN_UNAVAILABLE":      2301,
-		"PAPERLESS_UNAVAILABLE":   2302,
+		"BAD_REQUEST":               0,
+		"INVALID_RESOURCE_TYPE":     1,
+		"INVALID_EMAIL":             2,
+		"INVALID_TEMPLATE":          3,
+		"INVALID_TOKEN":             4,
+		"UNAUTHORIZED":              100,
+		"SHARE_STEP_UP_REQUIRED":    101,
+		"FORBIDDEN":                 300,
+		"ACCESS_DENIED":             301,
+		"SHARE_ACCESS_DENIED":       302,
+		"SHARE_DEVICE_MISMATCH":     303,
+		"NOT_FOUND":                 400,
+		"SHARE_NOT_FOUND":           401,
+		"TEMPLATE_NOT_FOUND":        402,
+		"POLICY_SET_NOT_FOUND":      403,
+		"SHARE_ALREADY_VIEWED":      900,
+		"SHARE_REVOKED":             901,
+		"TEMPLATE_ALREADY_EXISTS":   902,
+		"SHARE_EXPIRED":             903,
+		"POLICY_SET_ALREADY_EXISTS": 904,
+		"POLICY_SET_IN_USE":         905,
+		"SHARE_CHALLENGE_REQUIRED":  2800,
+		"INTERNAL_SERVER_ERROR":     2000,
+		"SMTP_ERROR":                2001,
+		"ENCRYPTION_ERROR":          2002,
+		"DATABASE_ERROR":            2003,
+		"SERVICE_UNAVAILABLE":       2300,
+		"WARDEN_UNAVAILABLE":        2301,
+		"PAPERLESS_UNAVAILABLE":     2302,
 	}
 )
 
@@ -136,22 +161,30 @@ var File_sharing_service_v1_sharing_error_proto protoreflect.FileDescriptor
 
 const file_sharing_service_v1_sharing_error_proto_rawDesc = "" +
 	"\n" +
-	"&sharing/service/v1/sharing_error.proto\x12\x12sharing.service.v1\x1a\x13errors/errors.proto*\xf1\x04\n" +
+	"&sharing/service/v1/sharing_error.proto\x12\x12sharing.service.v1\x1a\x13errors/errors.proto*\xf2\x06\n" +
 	"\x12SharingErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
 	"\x15INVALID_RESOURCE_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x17\n" +
 	"\rINVALID_EMAIL\x10\x02\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
-	"\x10INVALID_TEMPLATE\x10\x03\x1a\x04\xa8E\x90\x03\x12\x16\n" +
-	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x14\n" +
+	"\x10INVALID_TEMPLATE\x10\x03\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rINVALID_TOKEN\x10\x04\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12 \n" +
+	"\x16SHARE_STEP_UP_REQUIRED\x10e\x1a\x04\xa8E\x91\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x18\n" +
 	"\rACCESS_DENIED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x1e\n" +
-	"\x13SHARE_ACCESS_DENIED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\x13SHARE_ACCESS_DENIED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12 \n" +
+	"\x15SHARE_DEVICE_MISMATCH\x10\xaf\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
 	"\x0fSHARE_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12TEMPLATE_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x14POLICY_SET_NOT_FOUND\x10\x93\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
 	"\x14SHARE_ALREADY_VIEWED\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x18\n" +
 	"\rSHARE_REVOKED\x10\x85\a\x1a\x04\xa8E\x99\x03\x12\"\n" +
-	"\x17TEMPLATE_ALREADY_EXISTS\x10\x86\a\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x17TEMPLATE_ALREADY_EXISTS\x10\x86\a\x1a\x04\xa8E\x99\x03\x12\x18\n" +
+	"\rSHARE_EXPIRED\x10\x87\a\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x19POLICY_SET_ALREADY_EXISTS\x10\x88\a\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x11POLICY_SET_IN_USE\x10\x89\a\x1a\x04\xa8E\x99\x03\x12#\n" +
+	"\x18SHARE_CHALLENGE_REQUIRED\x10\xf0\x15\x1a\x04\xa8E\xac\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12\x15\n" +
 	"\n" +
 	"SMTP_ERROR\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12\x1b\n" +
@@ -61,6 +61,18 @@ func ErrorInvalidTemplate(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, SharingErrorReason_INVALID_TEMPLATE.String(), fmt.Sprintf(format, args...))
 }
 
+func IsInvalidToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_INVALID_TOKEN.String() && e.Code == 400
+}
+
+func ErrorInvalidToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, SharingErrorReason_INVALID_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
 // 401 - Unauthorized
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -75,6 +87,18 @@ func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, SharingErrorReason_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
 }
 
+func IsShareStepUpRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_SHARE_STEP_UP_REQUIRED.String() && e.Code == 401
+}
+
+func ErrorShareStepUpRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, SharingErrorReason_SHARE_STEP_UP_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 403 - Forbidden
 func IsForbidden(err error) bool {
 	if err == nil {
@@ -113,6 +137,18 @@ func ErrorShareAccessDenied(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, SharingErrorReason_SHARE_ACCESS_DENIED.String(), fmt.Sprintf(format, args...))
 }
 
+func IsShareDeviceMismatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_SHARE_DEVICE_MISMATCH.String() && e.Code == 403
+}
+
+func ErrorShareDeviceMismatch(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, SharingErrorReason_SHARE_DEVICE_MISMATCH.String(), fmt.Sprintf(format, args...))
+}
+
 // 404 - Not Found
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -151,6 +187,18 @@ func ErrorTemplateNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, SharingErrorReason_TEMPLATE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+func IsPolicySetNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_POLICY_SET_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorPolicySetNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, SharingErrorReason_POLICY_SET_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 409 - Conflict
 func IsShareAlreadyViewed(err error) bool {
 	if err == nil {
@@ -189,6 +237,56 @@ func ErrorTemplateAlreadyExists(format string, args ...interface{}) *errors.Erro
 	return errors.New(409, SharingErrorReason_TEMPLATE_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
 }
 
+func IsShareExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_SHARE_EXPIRED.String() && e.Code == 409
+}
+
+func ErrorShareExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, SharingErrorReason_SHARE_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPolicySetAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_POLICY_SET_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorPolicySetAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, SharingErrorReason_POLICY_SET_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPolicySetInUse(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_POLICY_SET_IN_USE.String() && e.Code == 409
+}
+
+func ErrorPolicySetInUse(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, SharingErrorReason_POLICY_SET_IN_USE.String(), fmt.Sprintf(format, args...))
+}
+
+// 428 - Precondition Required
+func IsShareChallengeRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == SharingErrorReason_SHARE_CHALLENGE_REQUIRED.String() && e.Code == 428
+}
+
+// 428 - Precondition Required
+func ErrorShareChallengeRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(428, SharingErrorReason_SHARE_CHALLENGE_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 500 - Internal Server Error
 func IsInternalServerError(err error) bool {
 	if err == nil {
@@ -25,6 +25,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Email template kind (what the template is used for)
+type TemplateKind int32
+
+const (
+	TemplateKind_TEMPLATE_KIND_UNSPECIFIED   TemplateKind = 0
+	TemplateKind_TEMPLATE_KIND_SHARE         TemplateKind = 1 // Email sent to the share recipient
+	TemplateKind_TEMPLATE_KIND_SHARE_VIEWED  TemplateKind = 2 // Sender notification: share was viewed
+	TemplateKind_TEMPLATE_KIND_SHARE_DENIED  TemplateKind = 3 // Sender notification: access attempt denied by policy
+	TemplateKind_TEMPLATE_KIND_SHARE_EXPIRED TemplateKind = 4 // Sender notification: share expired unviewed
+)
+
+// Enum value maps for TemplateKind.
+var (
+	TemplateKind_name = map[int32]string{
+		0: "TEMPLATE_KIND_UNSPECIFIED",
+		1: "TEMPLATE_KIND_SHARE",
+		2: "TEMPLATE_KIND_SHARE_VIEWED",
+		3: "TEMPLATE_KIND_SHARE_DENIED",
+		4: "TEMPLATE_KIND_SHARE_EXPIRED",
+	}
+	TemplateKind_value = map[string]int32{
+		"TEMPLATE_KIND_UNSPECIFIED":   0,
+		"TEMPLATE_KIND_SHARE":         1,
+		"TEMPLATE_KIND_SHARE_VIEWED":  2,
+		"TEMPLATE_KIND_SHARE_DENIED":  3,
+		"TEMPLATE_KIND_SHARE_EXPIRED": 4,
+	}
+)
+
+func (x TemplateKind) Enum() *TemplateKind {
+	p := new(TemplateKind)
+	*p = x
+	return p
+}
+
+func (x TemplateKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sharing_service_v1_template_proto_enumTypes[0].Descriptor()
+}
+
+func (TemplateKind) Type() protoreflect.EnumType {
+	return &file_sharing_service_v1_template_proto_enumTypes[0]
+}
+
+func (x TemplateKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateKind.Descriptor instead.
+func (TemplateKind) EnumDescriptor() ([]byte, []int) {
+	return file_sharing_service_v1_template_proto_rawDescGZIP(), []int{0}
+}
+
 // Email template entity
 type EmailTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -38,6 +94,8 @@ type EmailTemplate struct {
 	UpdatedBy     *uint32                `protobuf:"varint,8,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
 	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	Kind          TemplateKind           `protobuf:"varint,11,opt,name=kind,proto3,enum=sharing.service.v1.TemplateKind" json:"kind,omitempty"`
+	TextBody      string                 `protobuf:"bytes,12,opt,name=text_body,json=textBody,proto3" json:"text_body,omitempty"` // Empty when the text part is generated from the HTML body
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,13 +200,31 @@ func (x *EmailTemplate) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EmailTemplate) GetKind() TemplateKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TemplateKind_TEMPLATE_KIND_UNSPECIFIED
+}
+
+func (x *EmailTemplate) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
 // Request to create a template
 type CreateTemplateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	HtmlBody      string                 `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subject   string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody  string                 `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	IsDefault bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// Template kind (defaults to SHARE)
+	Kind *TemplateKind `protobuf:"varint,5,opt,name=kind,proto3,enum=sharing.service.v1.TemplateKind,oneof" json:"kind,omitempty"`
+	// Plain text body; generated from the HTML body when omitted
+	TextBody      *string `protobuf:"bytes,6,opt,name=text_body,json=textBody,proto3,oneof" json:"text_body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -211,6 +287,20 @@ func (x *CreateTemplateRequest) GetIsDefault() bool {
 	return false
 }
 
+func (x *CreateTemplateRequest) GetKind() TemplateKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return TemplateKind_TEMPLATE_KIND_UNSPECIFIED
+}
+
+func (x *CreateTemplateRequest) GetTextBody() string {
+	if x != nil && x.TextBody != nil {
+		return *x.TextBody
+	}
+	return ""
+}
+
 type CreateTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Template      *EmailTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
@@ -346,9 +436,11 @@ func (x *GetTemplateResponse) GetTemplate() *EmailTemplate {
 
 // Request to list templates
 type ListTemplatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by template kind
+	Kind          *TemplateKind `protobuf:"varint,3,opt,name=kind,proto3,enum=sharing.service.v1.TemplateKind,oneof" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,6 +489,13 @@ func (x *ListTemplatesRequest) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *ListTemplatesRequest) GetKind() TemplateKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return TemplateKind_TEMPLATE_KIND_UNSPECIFIED
+}
+
 type ListTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Templates     []*EmailTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
@@ -451,12 +550,14 @@ func (x *ListTemplatesResponse) GetTotal() uint32 {
 
 // Request to update a template
 type UpdateTemplateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Subject       *string                `protobuf:"bytes,3,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
-	HtmlBody      *string                `protobuf:"bytes,4,opt,name=html_body,json=htmlBody,proto3,oneof" json:"html_body,omitempty"`
-	IsDefault     *bool                  `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Subject   *string                `protobuf:"bytes,3,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	HtmlBody  *string                `protobuf:"bytes,4,opt,name=html_body,json=htmlBody,proto3,oneof" json:"html_body,omitempty"`
+	IsDefault *bool                  `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
+	// Plain text body; an empty string reverts to generating it from the HTML body
+	TextBody      *string `protobuf:"bytes,6,opt,name=text_body,json=textBody,proto3,oneof" json:"text_body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -526,6 +627,13 @@ func (x *UpdateTemplateRequest) GetIsDefault() bool {
 	return false
 }
 
+func (x *UpdateTemplateRequest) GetTextBody() string {
+	if x != nil && x.TextBody != nil {
+		return *x.TextBody
+	}
+	return ""
+}
+
 type UpdateTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Template      *EmailTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
@@ -620,6 +728,7 @@ type PreviewTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	HtmlBody      string                 `protobuf:"bytes,2,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	TextBody      *string                `protobuf:"bytes,3,opt,name=text_body,json=textBody,proto3,oneof" json:"text_body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -668,12 +777,20 @@ func (x *PreviewTemplateRequest) GetHtmlBody() string {
 	return ""
 }
 
+func (x *PreviewTemplateRequest) GetTextBody() string {
+	if x != nil && x.TextBody != nil {
+		return *x.TextBody
+	}
+	return ""
+}
+
 type PreviewTemplateResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RenderedSubject string                 `protobuf:"bytes,1,opt,name=rendered_subject,json=renderedSubject,proto3" json:"rendered_subject,omitempty"`
-	RenderedBody    string                 `protobuf:"bytes,2,opt,name=rendered_body,json=renderedBody,proto3" json:"rendered_body,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RenderedSubject  string                 `protobuf:"bytes,1,opt,name=rendered_subject,json=renderedSubject,proto3" json:"rendered_subject,omitempty"`
+	RenderedBody     string                 `protobuf:"bytes,2,opt,name=rendered_body,json=renderedBody,proto3" json:"rendered_body,omitempty"`
+	RenderedTextBody string                 `protobuf:"bytes,3,opt,name=rendered_text_body,json=renderedTextBody,proto3" json:"rendered_text_body,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PreviewTemplateResponse) Reset() {
@@ -720,11 +837,18 @@ func (x *PreviewTemplateResponse) GetRenderedBody() string {
 	return ""
 }
 
+func (x *PreviewTemplateResponse) GetRenderedTextBody() string {
+	if x != nil {
+		return x.RenderedTextBody
+	}
+	return ""
+}
+
 var File_sharing_service_v1_template_proto protoreflect.FileDescriptor
 
 const file_sharing_service_v1_template_proto_rawDesc = "" +
 	"\n" +
-	"!sharing/service/v1/template.proto\x12\x12sharing.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x03\n" +
+	"!sharing/service/v1/template.proto\x12\x12sharing.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x03\n" +
 	"\rEmailTemplate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x12\n" +
@@ -741,31 +865,40 @@ const file_sharing_service_v1_template_proto_rawDesc = "" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
-	"updateTime\x88\x01\x01B\r\n" +
+	"updateTime\x88\x01\x01\x124\n" +
+	"\x04kind\x18\v \x01(\x0e2 .sharing.service.v1.TemplateKindR\x04kind\x12\x1b\n" +
+	"\ttext_body\x18\f \x01(\tR\btextBodyB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\x0e\n" +
-	"\f_update_time\"\xaf\x01\n" +
+	"\f_update_time\"\xae\x02\n" +
 	"\x15CreateTemplateRequest\x12!\n" +
 	"\x04name\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12'\n" +
 	"\asubject\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\bR\asubject\x12+\n" +
 	"\thtml_body\x18\x03 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\bhtmlBody\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"W\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x129\n" +
+	"\x04kind\x18\x05 \x01(\x0e2 .sharing.service.v1.TemplateKindH\x00R\x04kind\x88\x01\x01\x12+\n" +
+	"\ttext_body\x18\x06 \x01(\tB\t\xbaH\x06r\x04\x18\x80\x80\x04H\x01R\btextBody\x88\x01\x01B\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_text_body\"W\n" +
 	"\x16CreateTemplateResponse\x12=\n" +
 	"\btemplate\x18\x01 \x01(\v2!.sharing.service.v1.EmailTemplateR\btemplate\"D\n" +
 	"\x12GetTemplateRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"T\n" +
 	"\x13GetTemplateResponse\x12=\n" +
-	"\btemplate\x18\x01 \x01(\v2!.sharing.service.v1.EmailTemplateR\btemplate\"h\n" +
+	"\btemplate\x18\x01 \x01(\v2!.sharing.service.v1.EmailTemplateR\btemplate\"\xac\x01\n" +
 	"\x14ListTemplatesRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x02 \x01(\rH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\tpage_size\x18\x02 \x01(\rH\x01R\bpageSize\x88\x01\x01\x129\n" +
+	"\x04kind\x18\x03 \x01(\x0e2 .sharing.service.v1.TemplateKindH\x02R\x04kind\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
-	"_page_size\"n\n" +
+	"_page_sizeB\a\n" +
+	"\x05_kind\"n\n" +
 	"\x15ListTemplatesResponse\x12?\n" +
 	"\ttemplates\x18\x01 \x03(\v2!.sharing.service.v1.EmailTemplateR\ttemplates\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\x9c\x02\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xd7\x02\n" +
 	"\x15UpdateTemplateRequest\x12.\n" +
 	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -774,23 +907,36 @@ const file_sharing_service_v1_template_proto_rawDesc = "" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\bH\x01R\asubject\x88\x01\x01\x12-\n" +
 	"\thtml_body\x18\x04 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04H\x02R\bhtmlBody\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01B\a\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01\x12+\n" +
+	"\ttext_body\x18\x06 \x01(\tB\t\xbaH\x06r\x04\x18\x80\x80\x04H\x04R\btextBody\x88\x01\x01B\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
 	"\b_subjectB\f\n" +
 	"\n" +
 	"_html_bodyB\r\n" +
-	"\v_is_default\"W\n" +
+	"\v_is_defaultB\f\n" +
+	"\n" +
+	"_text_body\"W\n" +
 	"\x16UpdateTemplateResponse\x12=\n" +
 	"\btemplate\x18\x01 \x01(\v2!.sharing.service.v1.EmailTemplateR\btemplate\"G\n" +
 	"\x15DeleteTemplateRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"n\n" +
+	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\xa9\x01\n" +
 	"\x16PreviewTemplateRequest\x12'\n" +
 	"\asubject\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\bR\asubject\x12+\n" +
-	"\thtml_body\x18\x02 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\bhtmlBody\"i\n" +
+	"\thtml_body\x18\x02 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\bhtmlBody\x12+\n" +
+	"\ttext_body\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x18\x80\x80\x04H\x00R\btextBody\x88\x01\x01B\f\n" +
+	"\n" +
+	"_text_body\"\x97\x01\n" +
 	"\x17PreviewTemplateResponse\x12)\n" +
 	"\x10rendered_subject\x18\x01 \x01(\tR\x0frenderedSubject\x12#\n" +
-	"\rrendered_body\x18\x02 \x01(\tR\frenderedBody2\x9e\x06\n" +
+	"\rrendered_body\x18\x02 \x01(\tR\frenderedBody\x12,\n" +
+	"\x12rendered_text_body\x18\x03 \x01(\tR\x10renderedTextBody*\xa7\x01\n" +
+	"\fTemplateKind\x12\x1d\n" +
+	"\x19TEMPLATE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TEMPLATE_KIND_SHARE\x10\x01\x12\x1e\n" +
+	"\x1aTEMPLATE_KIND_SHARE_VIEWED\x10\x02\x12\x1e\n" +
+	"\x1aTEMPLATE_KIND_SHARE_DENIED\x10\x03\x12\x1f\n" +
+	"\x1bTEMPLATE_KIND_SHARE_EXPIRED\x10\x042\x9e\x06\n" +
 	"\x16SharingTemplateService\x12\x81\x01\n" +
 	"\x0eCreateTemplate\x12).sharing.service.v1.CreateTemplateRequest\x1a*.sharing.service.v1.CreateTemplateResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/templates\x12z\n" +
 	"\vGetTemplate\x12&.sharing.service.v1.GetTemplateRequest\x1a'.sharing.service.v1.GetTemplateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/templates/{id}\x12{\n" +
@@ -812,47 +958,52 @@ func file_sharing_service_v1_template_proto_rawDescGZIP() []byte {
 	return file_sharing_service_v1_template_proto_rawDescData
 }
 
+var file_sharing_service_v1_template_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_sharing_service_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_sharing_service_v1_template_proto_goTypes = []any{
-	(*EmailTemplate)(nil),           // 0: sharing.service.v1.EmailTemplate
-	(*CreateTemplateRequest)(nil),   // 1: sharing.service.v1.CreateTemplateRequest
-	(*CreateTemplateResponse)(nil),  // 2: sharing.service.v1.CreateTemplateResponse
-	(*GetTemplateRequest)(nil),      // 3: sharing.service.v1.GetTemplateRequest
-	(*GetTemplateResponse)(nil),     // 4: sharing.service.v1.GetTemplateResponse
-	(*ListTemplatesRequest)(nil),    // 5: sharing.service.v1.ListTemplatesRequest
-	(*ListTemplatesResponse)(nil),   // 6: sharing.service.v1.ListTemplatesResponse
-	(*UpdateTemplateRequest)(nil),   // 7: sharing.service.v1.UpdateTemplateRequest
-	(*UpdateTemplateResponse)(nil),  // 8: sharing.service.v1.UpdateTemplateResponse
-	(*DeleteTemplateRequest)(nil),   // 9: sharing.service.v1.DeleteTemplateRequest
-	(*PreviewTemplateRequest)(nil),  // 10: sharing.service.v1.PreviewTemplateRequest
-	(*PreviewTemplateResponse)(nil), // 11: sharing.service.v1.PreviewTemplateResponse
-	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),           // 13: google.protobuf.Empty
+	(TemplateKind)(0),               // 0: sharing.service.v1.TemplateKind
+	(*EmailTemplate)(nil),           // 1: sharing.service.v1.EmailTemplate
+	(*CreateTemplateRequest)(nil),   // 2: sharing.service.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),  // 3: sharing.service.v1.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),      // 4: sharing.service.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),     // 5: sharing.service.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),    // 6: sharing.service.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),   // 7: sharing.service.v1.ListTemplatesResponse
+	(*UpdateTemplateRequest)(nil),   // 8: sharing.service.v1.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),  // 9: sharing.service.v1.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),   // 10: sharing.service.v1.DeleteTemplateRequest
+	(*PreviewTemplateRequest)(nil),  // 11: sharing.service.v1.PreviewTemplateRequest
+	(*PreviewTemplateResponse)(nil), // 12: sharing.service.v1.PreviewTemplateResponse
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 14: google.protobuf.Empty
 }
 var file_sharing_service_v1_template_proto_depIdxs = []int32{
-	12, // 0: sharing.service.v1.EmailTemplate.create_time:type_name -> google.protobuf.Timestamp
-	12, // 1: sharing.service.v1.EmailTemplate.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: sharing.service.v1.CreateTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
-	0,  // 3: sharing.service.v1.GetTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
-	0,  // 4: sharing.service.v1.ListTemplatesResponse.templates:type_name -> sharing.service.v1.EmailTemplate
-	0,  // 5: sharing.service.v1.UpdateTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
-	1,  // 6: sharing.service.v1.SharingTemplateService.CreateTemplate:input_type -> sharing.service.v1.CreateTemplateRequest
-	3,  // 7: sharing.service.v1.SharingTemplateService.GetTemplate:input_type -> sharing.service.v1.GetTemplateRequest
-	5,  // 8: sharing.service.v1.SharingTemplateService.ListTemplates:input_type -> sharing.service.v1.ListTemplatesRequest
-	7,  // 9: sharing.service.v1.SharingTemplateService.UpdateTemplate:input_type -> sharing.service.v1.UpdateTemplateRequest
-	9,  // 10: sharing.service.v1.SharingTemplateService.DeleteTemplate:input_type -> sharing.service.v1.DeleteTemplateRequest
-	10, // 11: sharing.service.v1.SharingTemplateService.PreviewTemplate:input_type -> sharing.service.v1.PreviewTemplateRequest
-	2,  // 12: sharing.service.v1.SharingTemplateService.CreateTemplate:output_type -> sharing.service.v1.CreateTemplateResponse
-	4,  // 13: sharing.service.v1.SharingTemplateService.GetTemplate:output_type -> sharing.service.v1.GetTemplateResponse
-	6,  // 14: sharing.service.v1.SharingTemplateService.ListTemplates:output_type -> sharing.service.v1.ListTemplatesResponse
-	8,  // 15: sharing.service.v1.SharingTemplateService.UpdateTemplate:output_type -> sharing.service.v1.UpdateTemplateResponse
-	13, // 16: sharing.service.v1.SharingTemplateService.DeleteTemplate:output_type -> google.protobuf.Empty
-	11, // 17: sharing.service.v1.SharingTemplateService.PreviewTemplate:output_type -> sharing.service.v1.PreviewTemplateResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	13, // 0: sharing.service.v1.EmailTemplate.create_time:type_name -> google.protobuf.Timestamp
+	13, // 1: sharing.service.v1.EmailTemplate.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: sharing.service.v1.EmailTemplate.kind:type_name -> sharing.service.v1.TemplateKind
+	0,  // 3: sharing.service.v1.CreateTemplateRequest.kind:type_name -> sharing.service.v1.TemplateKind
+	1,  // 4: sharing.service.v1.CreateTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
+	1,  // 5: sharing.service.v1.GetTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
+	0,  // 6: sharing.service.v1.ListTemplatesRequest.kind:type_name -> sharing.service.v1.TemplateKind
+	1,  // 7: sharing.service.v1.ListTemplatesResponse.templates:type_name -> sharing.service.v1.EmailTemplate
+	1,  // 8: sharing.service.v1.UpdateTemplateResponse.template:type_name -> sharing.service.v1.EmailTemplate
+	2,  // 9: sharing.service.v1.SharingTemplateService.CreateTemplate:input_type -> sharing.service.v1.CreateTemplateRequest
+	4,  // 10: sharing.service.v1.SharingTemplateService.GetTemplate:input_type -> sharing.service.v1.GetTemplateRequest
+	6,  // 11: sharing.service.v1.SharingTemplateService.ListTemplates:input_type -> sharing.service.v1.ListTemplatesRequest
+	8,  // 12: sharing.service.v1.SharingTemplateService.UpdateTemplate:input_type -> sharing.service.v1.UpdateTemplateRequest
+	10, // 13: sharing.service.v1.SharingTemplateService.DeleteTemplate:input_type -> sharing.service.v1.DeleteTemplateRequest
+	11, // 14: sharing.service.v1.SharingTemplateService.PreviewTemplate:input_type -> sharing.service.v1.PreviewTemplateRequest
+	3,  // 15: sharing.service.v1.SharingTemplateService.CreateTemplate:output_type -> sharing.service.v1.CreateTemplateResponse
+	5,  // 16: sharing.service.v1.SharingTemplateService.GetTemplate:output_type -> sharing.service.v1.GetTemplateResponse
+	7,  // 17: sharing.service.v1.SharingTemplateService.ListTemplates:output_type -> sharing.service.v1.ListTemplatesResponse
+	9,  // 18: sharing.service.v1.SharingTemplateService.UpdateTemplate:output_type -> sharing.service.v1.UpdateTemplateResponse
+	14, // 19: sharing.service.v1.SharingTemplateService.DeleteTemplate:output_type -> google.protobuf.Empty
+	12, // 20: sharing.service.v1.SharingTemplateService.PreviewTemplate:output_type -> sharing.service.v1.PreviewTemplateResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_sharing_service_v1_template_proto_init() }
@@ -861,20 +1012,23 @@ func file_sharing_service_v1_template_proto_init() {
 		return
 	}
 	file_sharing_service_v1_template_proto_msgTypes[0].OneofWrappers = []any{}
+	file_sharing_service_v1_template_proto_msgTypes[1].OneofWrappers = []any{}
 	file_sharing_service_v1_template_proto_msgTypes[5].OneofWrappers = []any{}
 	file_sharing_service_v1_template_proto_msgTypes[7].OneofWrappers = []any{}
+	file_sharing_service_v1_template_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sharing_service_v1_template_proto_rawDesc), len(file_sharing_service_v1_template_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_sharing_service_v1_template_proto_goTypes,
 		DependencyIndexes: file_sharing_service_v1_template_proto_depIdxs,
+		EnumInfos:         file_sharing_service_v1_template_proto_enumTypes,
 		MessageInfos:      file_sharing_service_v1_template_proto_msgTypes,
 	}.Build()
 	File_sharing_service_v1_template_proto = out.File
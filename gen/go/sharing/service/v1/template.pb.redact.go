@@ -137,6 +137,10 @@ func (x *EmailTemplate) Redact() string {
 	// Safe field: CreateTime
 
 	// Safe field: UpdateTime
+
+	// Safe field: Kind
+
+	// Safe field: TextBody
 	return x.String()
 }
 
@@ -153,6 +157,10 @@ func (x *CreateTemplateRequest) Redact() string {
 	// Safe field: HtmlBody
 
 	// Safe field: IsDefault
+
+	// Safe field: Kind
+
+	// Safe field: TextBody
 	return x.String()
 }
 
@@ -195,6 +203,8 @@ func (x *ListTemplatesRequest) Redact() string {
 	// Safe field: Page
 
 	// Safe field: PageSize
+
+	// Safe field: Kind
 	return x.String()
 }
 
@@ -225,6 +235,8 @@ func (x *UpdateTemplateRequest) Redact() string {
 	// Safe field: HtmlBody
 
 	// Safe field: IsDefault
+
+	// Safe field: TextBody
 	return x.String()
 }
 
@@ -257,6 +269,8 @@ func (x *PreviewTemplateRequest) Redact() string {
 	// Safe field: Subject
 
 	// Safe field: HtmlBody
+
+	// Safe field: TextBody
 	return x.String()
 }
 
@@ -269,5 +283,7 @@ func (x *PreviewTemplateResponse) Redact() string {
 	// Safe field: RenderedSubject
 
 	// Safe field: RenderedBody
+
+	// Safe field: RenderedTextBody
 	return x.String()
 }
@@ -98,6 +98,10 @@ func (m *EmailTemplate) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Kind
+
+	// no validation rules for TextBody
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -247,6 +251,14 @@ func (m *CreateTemplateRequest) validate(all bool) error {
 
 	// no validation rules for IsDefault
 
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
+	if m.TextBody != nil {
+		// no validation rules for TextBody
+	}
+
 	if len(errors) > 0 {
 		return CreateTemplateRequestMultiError(errors)
 	}
@@ -723,6 +735,10 @@ func (m *ListTemplatesRequest) validate(all bool) error {
 		// no validation rules for PageSize
 	}
 
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
 	if len(errors) > 0 {
 		return ListTemplatesRequestMultiError(errors)
 	}
@@ -981,6 +997,10 @@ func (m *UpdateTemplateRequest) validate(all bool) error {
 		// no validation rules for IsDefault
 	}
 
+	if m.TextBody != nil {
+		// no validation rules for TextBody
+	}
+
 	if len(errors) > 0 {
 		return UpdateTemplateRequestMultiError(errors)
 	}
@@ -1322,6 +1342,10 @@ func (m *PreviewTemplateRequest) validate(all bool) error {
 
 	// no validation rules for HtmlBody
 
+	if m.TextBody != nil {
+		// no validation rules for TextBody
+	}
+
 	if len(errors) > 0 {
 		return PreviewTemplateRequestMultiError(errors)
 	}
@@ -1428,6 +1452,8 @@ func (m *PreviewTemplateResponse) validate(all bool) error {
 
 	// no validation rules for RenderedBody
 
+	// no validation rules for RenderedTextBody
+
 	if len(errors) > 0 {
 		return PreviewTemplateResponseMultiError(errors)
 	}
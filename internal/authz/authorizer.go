@@ -0,0 +1,142 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/tx7do/go-crud/viewer"
+	"gopkg.in/yaml.v3"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// Permission codes declared in the permission groups of menus.yaml
+const (
+	PermShareView      = "sharing.share.view"
+	PermShareViewAll   = "sharing.share.view_all"
+	PermShareCreate    = "sharing.share.create"
+	PermShareSecret    = "sharing.share.secret"
+	PermShareDocument  = "sharing.share.document"
+	PermShareRevoke    = "sharing.share.revoke"
+	PermPolicyManage   = "sharing.policy.manage"
+	PermTemplateManage = "sharing.template.manage"
+	PermAccessView     = "sharing.access.view"
+	PermWebhookManage  = "sharing.webhook.manage"
+	PermBackupManage   = "sharing.backup.manage"
+)
+
+// PlatformAdminRole is the authority of platform administrators, the only
+// callers allowed to act without a tenant
+const PlatformAdminRole = "platform:admin"
+
+// superuserAuthorities are the menu authorities that grant every permission of the module
+var superuserAuthorities = []string{PlatformAdminRole, "tenant:manager"}
+
+// menuDefinitions is the subset of menus.yaml describing permissions and roles
+type menuDefinitions struct {
+	PermissionGroups []struct {
+		Permissions []struct {
+			Code string `yaml:"code"`
+		} `yaml:"permissions"`
+	} `yaml:"permission_groups"`
+	Roles []struct {
+		Code        string   `yaml:"code"`
+		Permissions []string `yaml:"permissions"`
+	} `yaml:"roles"`
+}
+
+// Authorizer resolves the roles of a caller to the permissions granted by them
+type Authorizer struct {
+	all   []string
+	roles map[string][]string
+}
+
+// NewAuthorizer creates an Authorizer from the module's menu definitions
+func NewAuthorizer(menusYaml []byte) (*Authorizer, error) {
+	var defs menuDefinitions
+	if err := yaml.Unmarshal(menusYaml, &defs); err != nil {
+		return nil, fmt.Errorf("parse menu definitions: %w", err)
+	}
+
+	a := &Authorizer{roles: make(map[string][]string, len(defs.Roles))}
+	for _, group := range defs.PermissionGroups {
+		for _, p := range group.Permissions {
+			a.all = append(a.all, p.Code)
+		}
+	}
+	for _, role := range defs.Roles {
+		for _, p := range role.Permissions {
+			if !slices.Contains(a.all, p) {
+				return nil, fmt.Errorf("role %s grants undeclared permission %s", role.Code, p)
+			}
+		}
+		a.roles[role.Code] = role.Permissions
+	}
+
+	return a, nil
+}
+
+// Permissions returns the permissions granted by a set of roles
+func (a *Authorizer) Permissions(roles []string) []string {
+	var perms []string
+	for _, r := range roles {
+		if slices.Contains(superuserAuthorities, r) {
+			return slices.Clone(a.all)
+		}
+		for _, p := range a.roles[r] {
+			if !slices.Contains(perms, p) {
+				perms = append(perms, p)
+			}
+		}
+	}
+	return perms
+}
+
+// NewViewerContext returns a copy of ctx carrying a viewer for the user of the
+// tenant with the permissions granted by its roles
+func (a *Authorizer) NewViewerContext(ctx context.Context, tenantID, userID uint32, roles []string) context.Context {
+	return NewViewerContext(ctx, tenantID, userID, roles, a.Permissions(roles))
+}
+
+// HasPermission reports whether the caller holds a permission.
+// Platform and system callers hold every permission.
+func HasPermission(ctx context.Context, perm string) bool {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc == nil {
+		return false
+	}
+	if vc.IsPlatformContext() || vc.IsSystemContext() {
+		return true
+	}
+	return slices.Contains(vc.Permissions(), perm)
+}
+
+// Require returns an access denied error unless the caller holds every permission
+func Require(ctx context.Context, perms ...string) error {
+	for _, p := range perms {
+		if !HasPermission(ctx, p) {
+			return sharingV1.ErrorAccessDenied("missing permission %s", p)
+		}
+	}
+	return nil
+}
+
+// RequireAny returns an access denied error unless the caller holds at least one permission
+func RequireAny(ctx context.Context, perms ...string) error {
+	for _, p := range perms {
+		if HasPermission(ctx, p) {
+			return nil
+		}
+	}
+	return sharingV1.ErrorAccessDenied("missing one of permissions %v", perms)
+}
+
+// CallerID returns the user ID of the caller, or 0 when it is unknown
+func CallerID(ctx context.Context) uint32 {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc == nil {
+		return 0
+	}
+	return uint32(vc.UserID())
+}
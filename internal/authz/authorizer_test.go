@@ -0,0 +1,117 @@
+package authz
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func newTestAuthorizer(t *testing.T) *Authorizer {
+	t.Helper()
+
+	menus, err := os.ReadFile("../../cmd/server/assets/menus.yaml")
+	if err != nil {
+		t.Fatalf("read menus.yaml: %v", err)
+	}
+	a, err := NewAuthorizer(menus)
+	if err != nil {
+		t.Fatalf("NewAuthorizer: %v", err)
+	}
+	return a
+}
+
+func TestMenusDeclarePermissionConstants(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	for _, p := range []string{
+		PermShareView, PermShareViewAll, PermShareCreate, PermShareSecret, PermShareDocument,
+		PermShareRevoke, PermPolicyManage, PermTemplateManage, PermAccessView,
+		PermWebhookManage, PermBackupManage,
+	} {
+		if !slices.Contains(a.all, p) {
+			t.Errorf("permission %s is not declared in menus.yaml", p)
+		}
+	}
+}
+
+func TestRegularUserOnlyHoldsRolePermissions(t *testing.T) {
+	a := newTestAuthorizer(t)
+	ctx := a.NewViewerContext(context.Background(), 1, 10, []string{"sharing.operator"})
+
+	if err := Require(ctx, PermShareCreate, PermShareSecret); err != nil {
+		t.Fatalf("operator cannot share secrets: %v", err)
+	}
+	if HasPermission(ctx, PermShareViewAll) {
+		t.Fatal("operator may view all shares")
+	}
+	if err := Require(ctx, PermTemplateManage); !sharingV1.IsAccessDenied(err) {
+		t.Fatalf("expected AccessDenied managing templates, got %v", err)
+	}
+	if err := RequireAny(ctx, PermTemplateManage, PermShareCreate); err != nil {
+		t.Fatalf("RequireAny: %v", err)
+	}
+	if CallerID(ctx) != 10 {
+		t.Fatalf("CallerID = %d, want 10", CallerID(ctx))
+	}
+}
+
+func TestManagerAuthorityHoldsAllPermissions(t *testing.T) {
+	a := newTestAuthorizer(t)
+	ctx := a.NewViewerContext(context.Background(), 1, 10, []string{"tenant:manager"})
+
+	if err := Require(ctx, a.all...); err != nil {
+		t.Fatalf("tenant manager: %v", err)
+	}
+}
+
+func TestCallerWithoutRolesHoldsNoPermissions(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		a.NewViewerContext(context.Background(), 1, 10, nil),
+	} {
+		if err := Require(ctx, PermShareView); !sharingV1.IsAccessDenied(err) {
+			t.Fatalf("expected AccessDenied, got %v", err)
+		}
+	}
+}
+
+func TestCallerWithoutTenantIsNotPlatform(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	// Tenant 0 only stands for the platform for platform administrators
+	ctx := NewViewerContext(context.Background(), 0, 10, []string{"sharing.operator"}, nil)
+	if err := Require(ctx, PermShareView); !sharingV1.IsAccessDenied(err) {
+		t.Fatalf("expected AccessDenied without a tenant, got %v", err)
+	}
+	if v := NewViewer(0, 10, nil, nil); v.IsPlatformContext() || v.IsTenantContext() {
+		t.Fatal("caller without tenant and roles is a platform or tenant caller")
+	}
+
+	ctx = a.NewViewerContext(context.Background(), 0, 10, []string{PlatformAdminRole})
+	if err := Require(ctx, a.all...); err != nil {
+		t.Fatalf("platform admin: %v", err)
+	}
+	if !NewViewer(0, 10, []string{PlatformAdminRole}, nil).IsPlatformContext() {
+		t.Fatal("platform admin is not a platform caller")
+	}
+}
+
+func TestNewAuthorizerRejectsUndeclaredPermissions(t *testing.T) {
+	menus := []byte(`
+permission_groups:
+  - permissions:
+      - code: sharing.share.view
+roles:
+  - code: sharing.viewer
+    permissions:
+      - sharing.share.delete
+`)
+	if _, err := NewAuthorizer(menus); err == nil {
+		t.Fatal("expected an error for an undeclared permission")
+	}
+}
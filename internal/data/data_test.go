@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+)
+
+// newTestClient opens an in-memory sqlite database private to the test and
+// creates the schema
+func newTestClient(t *testing.T) *entCrud.EntClient[*ent.Client] {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	return entCrud.NewEntClient(client, drv)
+}
+
+// testShare describes a shared link created directly through ent, so tests
+// can control timestamps the repositories set to the current time
+type testShare struct {
+	ID           string
+	ResourceType string
+	Recipient    string
+	SenderEmail  string
+	CreateBy     uint32
+	CreateTime   time.Time
+	ViewedAt     *time.Time
+	ExpiresAt    *time.Time
+	Revoked      bool
+}
+
+// createTestShare creates a shared link of the viewer's tenant
+func createTestShare(t *testing.T, ctx context.Context, client *ent.Client, s testShare) *ent.SharedLink {
+	t.Helper()
+
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	if s.ResourceType == "" {
+		s.ResourceType = "SECRET"
+	}
+	entity, err := client.SharedLink.Create().
+		SetID(s.ID).
+		SetResourceType(sharedlink.ResourceType(s.ResourceType)).
+		SetResourceID("resource-" + s.ID).
+		SetResourceName("Resource " + s.ID).
+		SetToken("tgs_" + s.ID).
+		SetEncryptedContent([]byte("ciphertext")).
+		SetEncryptionNonce([]byte("nonce")).
+		SetRecipientEmail(s.Recipient).
+		SetNillableSenderEmail(&s.SenderEmail).
+		SetCreateBy(s.CreateBy).
+		SetCreateTime(s.CreateTime).
+		SetViewed(s.ViewedAt != nil).
+		SetNillableViewedAt(s.ViewedAt).
+		SetNillableExpiresAt(s.ExpiresAt).
+		SetRevoked(s.Revoked).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create share %s: %v", s.ID, err)
+	}
+	return entity
+}
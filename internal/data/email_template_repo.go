@@ -32,20 +32,26 @@ func NewEmailTemplateRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*
 }
 
 // Create creates a new email template
-func (r *EmailTemplateRepo) Create(ctx context.Context, tenantID uint32, name, subject, htmlBody string, isDefault bool, createdBy *uint32) (*ent.EmailTemplate, error) {
+func (r *EmailTemplateRepo) Create(ctx context.Context, tenantID uint32, name, kind, subject, htmlBody, textBody string, isDefault bool, createdBy *uint32) (*ent.EmailTemplate, error) {
 	id := uuid.New().String()
 
-	// If this is being set as default, unset other defaults first
+	if kind == "" {
+		kind = string(emailtemplate.KindSHARE)
+	}
+
+	// If this is being set as default, unset other defaults of the same kind first
 	if isDefault {
-		r.unsetDefaults(ctx, tenantID)
+		r.unsetDefaults(ctx, tenantID, kind)
 	}
 
 	builder := r.entClient.Client().EmailTemplate.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
+		SetKind(emailtemplate.Kind(kind)).
 		SetSubject(subject).
 		SetHTMLBody(htmlBody).
+		SetTextBody(textBody).
 		SetIsDefault(isDefault).
 		SetCreateTime(time.Now())
 
@@ -80,11 +86,12 @@ func (r *EmailTemplateRepo) GetByID(ctx context.Context, id string) (*ent.EmailT
 	return entity, nil
 }
 
-// GetDefault retrieves the default email template for a tenant
-func (r *EmailTemplateRepo) GetDefault(ctx context.Context, tenantID uint32) (*ent.EmailTemplate, error) {
+// GetDefault retrieves the default email template of the given kind for a tenant
+func (r *EmailTemplateRepo) GetDefault(ctx context.Context, tenantID uint32, kind string) (*ent.EmailTemplate, error) {
 	entity, err := r.entClient.Client().EmailTemplate.Query().
 		Where(
 			emailtemplate.TenantIDEQ(tenantID),
+			emailtemplate.KindEQ(emailtemplate.Kind(kind)),
 			emailtemplate.IsDefaultEQ(true),
 		).
 		Only(ctx)
@@ -99,10 +106,14 @@ func (r *EmailTemplateRepo) GetDefault(ctx context.Context, tenantID uint32) (*e
 }
 
 // ListByTenant lists email templates for a tenant
-func (r *EmailTemplateRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.EmailTemplate, int, error) {
+func (r *EmailTemplateRepo) ListByTenant(ctx context.Context, tenantID uint32, kind *string, page, pageSize uint32) ([]*ent.EmailTemplate, int, error) {
 	query := r.entClient.Client().EmailTemplate.Query().
 		Where(emailtemplate.TenantIDEQ(tenantID))
 
+	if kind != nil && *kind != "" {
+		query = query.Where(emailtemplate.KindEQ(emailtemplate.Kind(*kind)))
+	}
+
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
 		r.log.Errorf("count email templates failed: %s", err.Error())
@@ -126,7 +137,7 @@ func (r *EmailTemplateRepo) ListByTenant(ctx context.Context, tenantID uint32, p
 }
 
 // Update updates an email template
-func (r *EmailTemplateRepo) Update(ctx context.Context, id string, tenantID uint32, name, subject, htmlBody *string, isDefault *bool, updatedBy *uint32) (*ent.EmailTemplate, error) {
+func (r *EmailTemplateRepo) Update(ctx context.Context, id string, tenantID uint32, name, subject, htmlBody, textBody *string, isDefault *bool, updatedBy *uint32) (*ent.EmailTemplate, error) {
 	builder := r.entClient.Client().EmailTemplate.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
@@ -139,9 +150,19 @@ func (r *EmailTemplateRepo) Update(ctx context.Context, id string, tenantID uint
 	if htmlBody != nil {
 		builder.SetHTMLBody(*htmlBody)
 	}
+	if textBody != nil {
+		builder.SetTextBody(*textBody)
+	}
 	if isDefault != nil {
 		if *isDefault {
-			r.unsetDefaults(ctx, tenantID)
+			existing, err := r.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if existing == nil {
+				return nil, sharingV1.ErrorTemplateNotFound("template not found")
+			}
+			r.unsetDefaults(ctx, tenantID, string(existing.Kind))
 		}
 		builder.SetIsDefault(*isDefault)
 	}
@@ -189,9 +210,21 @@ func (r *EmailTemplateRepo) ToProto(entity *ent.EmailTemplate) *sharingV1.EmailT
 		Name:      entity.Name,
 		Subject:   entity.Subject,
 		HtmlBody:  entity.HTMLBody,
+		TextBody:  entity.TextBody,
 		IsDefault: entity.IsDefault,
 	}
 
+	switch entity.Kind {
+	case emailtemplate.KindSHARE:
+		proto.Kind = sharingV1.TemplateKind_TEMPLATE_KIND_SHARE
+	case emailtemplate.KindSHARE_VIEWED:
+		proto.Kind = sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_VIEWED
+	case emailtemplate.KindSHARE_DENIED:
+		proto.Kind = sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_DENIED
+	case emailtemplate.KindSHARE_EXPIRED:
+		proto.Kind = sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_EXPIRED
+	}
+
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -208,11 +241,12 @@ func (r *EmailTemplateRepo) ToProto(entity *ent.EmailTemplate) *sharingV1.EmailT
 	return proto
 }
 
-// unsetDefaults clears the is_default flag on all templates for a tenant
-func (r *EmailTemplateRepo) unsetDefaults(ctx context.Context, tenantID uint32) {
+// unsetDefaults clears the is_default flag on all templates of a kind for a tenant
+func (r *EmailTemplateRepo) unsetDefaults(ctx context.Context, tenantID uint32, kind string) {
 	_, err := r.entClient.Client().EmailTemplate.Update().
 		Where(
 			emailtemplate.TenantIDEQ(tenantID),
+			emailtemplate.KindEQ(emailtemplate.Kind(kind)),
 			emailtemplate.IsDefaultEQ(true),
 		).
 		SetIsDefault(false).
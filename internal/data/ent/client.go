@@ -15,8 +15,15 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,10 +33,24 @@ type Client struct {
 	Schema *migrate.Schema
 	// EmailTemplate is the client for interacting with the EmailTemplate builders.
 	EmailTemplate *EmailTemplateClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PolicySet is the client for interacting with the PolicySet builders.
+	PolicySet *PolicySetClient
+	// RiskSettings is the client for interacting with the RiskSettings builders.
+	RiskSettings *RiskSettingsClient
+	// ShareAccessEvent is the client for interacting with the ShareAccessEvent builders.
+	ShareAccessEvent *ShareAccessEventClient
 	// SharePolicy is the client for interacting with the SharePolicy builders.
 	SharePolicy *SharePolicyClient
 	// SharedLink is the client for interacting with the SharedLink builders.
 	SharedLink *SharedLinkClient
+	// SharedLinkPolicySet is the client for interacting with the SharedLinkPolicySet builders.
+	SharedLinkPolicySet *SharedLinkPolicySetClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,8 +63,15 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.EmailTemplate = NewEmailTemplateClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.PolicySet = NewPolicySetClient(c.config)
+	c.RiskSettings = NewRiskSettingsClient(c.config)
+	c.ShareAccessEvent = NewShareAccessEventClient(c.config)
 	c.SharePolicy = NewSharePolicyClient(c.config)
 	c.SharedLink = NewSharedLinkClient(c.config)
+	c.SharedLinkPolicySet = NewSharedLinkPolicySetClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -134,11 +162,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		EmailTemplate: NewEmailTemplateClient(cfg),
-		SharePolicy:   NewSharePolicyClient(cfg),
-		SharedLink:    NewSharedLinkClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		EmailTemplate:          NewEmailTemplateClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PolicySet:              NewPolicySetClient(cfg),
+		RiskSettings:           NewRiskSettingsClient(cfg),
+		ShareAccessEvent:       NewShareAccessEventClient(cfg),
+		SharePolicy:            NewSharePolicyClient(cfg),
+		SharedLink:             NewSharedLinkClient(cfg),
+		SharedLinkPolicySet:    NewSharedLinkPolicySetClient(cfg),
+		Webhook:                NewWebhookClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -156,11 +191,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		EmailTemplate: NewEmailTemplateClient(cfg),
-		SharePolicy:   NewSharePolicyClient(cfg),
-		SharedLink:    NewSharedLinkClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		EmailTemplate:          NewEmailTemplateClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PolicySet:              NewPolicySetClient(cfg),
+		RiskSettings:           NewRiskSettingsClient(cfg),
+		ShareAccessEvent:       NewShareAccessEventClient(cfg),
+		SharePolicy:            NewSharePolicyClient(cfg),
+		SharedLink:             NewSharedLinkClient(cfg),
+		SharedLinkPolicySet:    NewSharedLinkPolicySetClient(cfg),
+		Webhook:                NewWebhookClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -189,17 +231,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.EmailTemplate.Use(hooks...)
-	c.SharePolicy.Use(hooks...)
-	c.SharedLink.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.EmailTemplate, c.NotificationPreference, c.PolicySet, c.RiskSettings,
+		c.ShareAccessEvent, c.SharePolicy, c.SharedLink, c.SharedLinkPolicySet,
+		c.Webhook, c.WebhookDelivery,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.EmailTemplate.Intercept(interceptors...)
-	c.SharePolicy.Intercept(interceptors...)
-	c.SharedLink.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.EmailTemplate, c.NotificationPreference, c.PolicySet, c.RiskSettings,
+		c.ShareAccessEvent, c.SharePolicy, c.SharedLink, c.SharedLinkPolicySet,
+		c.Webhook, c.WebhookDelivery,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -207,10 +257,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *EmailTemplateMutation:
 		return c.EmailTemplate.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *PolicySetMutation:
+		return c.PolicySet.mutate(ctx, m)
+	case *RiskSettingsMutation:
+		return c.RiskSettings.mutate(ctx, m)
+	case *ShareAccessEventMutation:
+		return c.ShareAccessEvent.mutate(ctx, m)
 	case *SharePolicyMutation:
 		return c.SharePolicy.mutate(ctx, m)
 	case *SharedLinkMutation:
 		return c.SharedLink.mutate(ctx, m)
+	case *SharedLinkPolicySetMutation:
+		return c.SharedLinkPolicySet.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -277,46 +341,582 @@ func (c *EmailTemplateClient) UpdateOne(_m *EmailTemplate) *EmailTemplateUpdateO
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailTemplateClient) UpdateOneID(id string) *EmailTemplateUpdateOne {
-	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplateID(id))
-	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailTemplateClient) UpdateOneID(id string) *EmailTemplateUpdateOne {
+	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplateID(id))
+	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailTemplate.
+func (c *EmailTemplateClient) Delete() *EmailTemplateDelete {
+	mutation := newEmailTemplateMutation(c.config, OpDelete)
+	return &EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailTemplateClient) DeleteOne(_m *EmailTemplate) *EmailTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailTemplateClient) DeleteOneID(id string) *EmailTemplateDeleteOne {
+	builder := c.Delete().Where(emailtemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailTemplate.
+func (c *EmailTemplateClient) Query() *EmailTemplateQuery {
+	return &EmailTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailTemplate entity by its id.
+func (c *EmailTemplateClient) Get(ctx context.Context, id string) (*EmailTemplate, error) {
+	return c.Query().Where(emailtemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailTemplateClient) GetX(ctx context.Context, id string) *EmailTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailTemplateClient) Hooks() []Hook {
+	hooks := c.hooks.EmailTemplate
+	return append(hooks[:len(hooks):len(hooks)], emailtemplate.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailTemplateClient) Interceptors() []Interceptor {
+	return c.inters.EmailTemplate
+}
+
+func (c *EmailTemplateClient) mutate(ctx context.Context, m *EmailTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailTemplate mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id string) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id string) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id string) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id string) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	hooks := c.hooks.NotificationPreference
+	return append(hooks[:len(hooks):len(hooks)], notificationpreference.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// PolicySetClient is a client for the PolicySet schema.
+type PolicySetClient struct {
+	config
+}
+
+// NewPolicySetClient returns a client for the PolicySet from the given config.
+func NewPolicySetClient(c config) *PolicySetClient {
+	return &PolicySetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyset.Hooks(f(g(h())))`.
+func (c *PolicySetClient) Use(hooks ...Hook) {
+	c.hooks.PolicySet = append(c.hooks.PolicySet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyset.Intercept(f(g(h())))`.
+func (c *PolicySetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicySet = append(c.inters.PolicySet, interceptors...)
+}
+
+// Create returns a builder for creating a PolicySet entity.
+func (c *PolicySetClient) Create() *PolicySetCreate {
+	mutation := newPolicySetMutation(c.config, OpCreate)
+	return &PolicySetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicySet entities.
+func (c *PolicySetClient) CreateBulk(builders ...*PolicySetCreate) *PolicySetCreateBulk {
+	return &PolicySetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicySetClient) MapCreateBulk(slice any, setFunc func(*PolicySetCreate, int)) *PolicySetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicySetCreateBulk{err: fmt.Errorf("calling to PolicySetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicySetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicySetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicySet.
+func (c *PolicySetClient) Update() *PolicySetUpdate {
+	mutation := newPolicySetMutation(c.config, OpUpdate)
+	return &PolicySetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicySetClient) UpdateOne(_m *PolicySet) *PolicySetUpdateOne {
+	mutation := newPolicySetMutation(c.config, OpUpdateOne, withPolicySet(_m))
+	return &PolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicySetClient) UpdateOneID(id string) *PolicySetUpdateOne {
+	mutation := newPolicySetMutation(c.config, OpUpdateOne, withPolicySetID(id))
+	return &PolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicySet.
+func (c *PolicySetClient) Delete() *PolicySetDelete {
+	mutation := newPolicySetMutation(c.config, OpDelete)
+	return &PolicySetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicySetClient) DeleteOne(_m *PolicySet) *PolicySetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicySetClient) DeleteOneID(id string) *PolicySetDeleteOne {
+	builder := c.Delete().Where(policyset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicySetDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicySet.
+func (c *PolicySetClient) Query() *PolicySetQuery {
+	return &PolicySetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicySet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicySet entity by its id.
+func (c *PolicySetClient) Get(ctx context.Context, id string) (*PolicySet, error) {
+	return c.Query().Where(policyset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicySetClient) GetX(ctx context.Context, id string) *PolicySet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PolicySetClient) Hooks() []Hook {
+	hooks := c.hooks.PolicySet
+	return append(hooks[:len(hooks):len(hooks)], policyset.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicySetClient) Interceptors() []Interceptor {
+	return c.inters.PolicySet
+}
+
+func (c *PolicySetClient) mutate(ctx context.Context, m *PolicySetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicySetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicySetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicySetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicySet mutation op: %q", m.Op())
+	}
+}
+
+// RiskSettingsClient is a client for the RiskSettings schema.
+type RiskSettingsClient struct {
+	config
+}
+
+// NewRiskSettingsClient returns a client for the RiskSettings from the given config.
+func NewRiskSettingsClient(c config) *RiskSettingsClient {
+	return &RiskSettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `risksettings.Hooks(f(g(h())))`.
+func (c *RiskSettingsClient) Use(hooks ...Hook) {
+	c.hooks.RiskSettings = append(c.hooks.RiskSettings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `risksettings.Intercept(f(g(h())))`.
+func (c *RiskSettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RiskSettings = append(c.inters.RiskSettings, interceptors...)
+}
+
+// Create returns a builder for creating a RiskSettings entity.
+func (c *RiskSettingsClient) Create() *RiskSettingsCreate {
+	mutation := newRiskSettingsMutation(c.config, OpCreate)
+	return &RiskSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RiskSettings entities.
+func (c *RiskSettingsClient) CreateBulk(builders ...*RiskSettingsCreate) *RiskSettingsCreateBulk {
+	return &RiskSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RiskSettingsClient) MapCreateBulk(slice any, setFunc func(*RiskSettingsCreate, int)) *RiskSettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RiskSettingsCreateBulk{err: fmt.Errorf("calling to RiskSettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RiskSettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RiskSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RiskSettings.
+func (c *RiskSettingsClient) Update() *RiskSettingsUpdate {
+	mutation := newRiskSettingsMutation(c.config, OpUpdate)
+	return &RiskSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RiskSettingsClient) UpdateOne(_m *RiskSettings) *RiskSettingsUpdateOne {
+	mutation := newRiskSettingsMutation(c.config, OpUpdateOne, withRiskSettings(_m))
+	return &RiskSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RiskSettingsClient) UpdateOneID(id string) *RiskSettingsUpdateOne {
+	mutation := newRiskSettingsMutation(c.config, OpUpdateOne, withRiskSettingsID(id))
+	return &RiskSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RiskSettings.
+func (c *RiskSettingsClient) Delete() *RiskSettingsDelete {
+	mutation := newRiskSettingsMutation(c.config, OpDelete)
+	return &RiskSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RiskSettingsClient) DeleteOne(_m *RiskSettings) *RiskSettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RiskSettingsClient) DeleteOneID(id string) *RiskSettingsDeleteOne {
+	builder := c.Delete().Where(risksettings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RiskSettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for RiskSettings.
+func (c *RiskSettingsClient) Query() *RiskSettingsQuery {
+	return &RiskSettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRiskSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RiskSettings entity by its id.
+func (c *RiskSettingsClient) Get(ctx context.Context, id string) (*RiskSettings, error) {
+	return c.Query().Where(risksettings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RiskSettingsClient) GetX(ctx context.Context, id string) *RiskSettings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RiskSettingsClient) Hooks() []Hook {
+	hooks := c.hooks.RiskSettings
+	return append(hooks[:len(hooks):len(hooks)], risksettings.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RiskSettingsClient) Interceptors() []Interceptor {
+	return c.inters.RiskSettings
+}
+
+func (c *RiskSettingsClient) mutate(ctx context.Context, m *RiskSettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RiskSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RiskSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RiskSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RiskSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RiskSettings mutation op: %q", m.Op())
+	}
+}
+
+// ShareAccessEventClient is a client for the ShareAccessEvent schema.
+type ShareAccessEventClient struct {
+	config
+}
+
+// NewShareAccessEventClient returns a client for the ShareAccessEvent from the given config.
+func NewShareAccessEventClient(c config) *ShareAccessEventClient {
+	return &ShareAccessEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shareaccessevent.Hooks(f(g(h())))`.
+func (c *ShareAccessEventClient) Use(hooks ...Hook) {
+	c.hooks.ShareAccessEvent = append(c.hooks.ShareAccessEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shareaccessevent.Intercept(f(g(h())))`.
+func (c *ShareAccessEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareAccessEvent = append(c.inters.ShareAccessEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ShareAccessEvent entity.
+func (c *ShareAccessEventClient) Create() *ShareAccessEventCreate {
+	mutation := newShareAccessEventMutation(c.config, OpCreate)
+	return &ShareAccessEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareAccessEvent entities.
+func (c *ShareAccessEventClient) CreateBulk(builders ...*ShareAccessEventCreate) *ShareAccessEventCreateBulk {
+	return &ShareAccessEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareAccessEventClient) MapCreateBulk(slice any, setFunc func(*ShareAccessEventCreate, int)) *ShareAccessEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareAccessEventCreateBulk{err: fmt.Errorf("calling to ShareAccessEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareAccessEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareAccessEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareAccessEvent.
+func (c *ShareAccessEventClient) Update() *ShareAccessEventUpdate {
+	mutation := newShareAccessEventMutation(c.config, OpUpdate)
+	return &ShareAccessEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareAccessEventClient) UpdateOne(_m *ShareAccessEvent) *ShareAccessEventUpdateOne {
+	mutation := newShareAccessEventMutation(c.config, OpUpdateOne, withShareAccessEvent(_m))
+	return &ShareAccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareAccessEventClient) UpdateOneID(id string) *ShareAccessEventUpdateOne {
+	mutation := newShareAccessEventMutation(c.config, OpUpdateOne, withShareAccessEventID(id))
+	return &ShareAccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailTemplate.
-func (c *EmailTemplateClient) Delete() *EmailTemplateDelete {
-	mutation := newEmailTemplateMutation(c.config, OpDelete)
-	return &EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ShareAccessEvent.
+func (c *ShareAccessEventClient) Delete() *ShareAccessEventDelete {
+	mutation := newShareAccessEventMutation(c.config, OpDelete)
+	return &ShareAccessEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailTemplateClient) DeleteOne(_m *EmailTemplate) *EmailTemplateDeleteOne {
+func (c *ShareAccessEventClient) DeleteOne(_m *ShareAccessEvent) *ShareAccessEventDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailTemplateClient) DeleteOneID(id string) *EmailTemplateDeleteOne {
-	builder := c.Delete().Where(emailtemplate.ID(id))
+func (c *ShareAccessEventClient) DeleteOneID(id string) *ShareAccessEventDeleteOne {
+	builder := c.Delete().Where(shareaccessevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailTemplateDeleteOne{builder}
+	return &ShareAccessEventDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailTemplate.
-func (c *EmailTemplateClient) Query() *EmailTemplateQuery {
-	return &EmailTemplateQuery{
+// Query returns a query builder for ShareAccessEvent.
+func (c *ShareAccessEventClient) Query() *ShareAccessEventQuery {
+	return &ShareAccessEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailTemplate},
+		ctx:    &QueryContext{Type: TypeShareAccessEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailTemplate entity by its id.
-func (c *EmailTemplateClient) Get(ctx context.Context, id string) (*EmailTemplate, error) {
-	return c.Query().Where(emailtemplate.ID(id)).Only(ctx)
+// Get returns a ShareAccessEvent entity by its id.
+func (c *ShareAccessEventClient) Get(ctx context.Context, id string) (*ShareAccessEvent, error) {
+	return c.Query().Where(shareaccessevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailTemplateClient) GetX(ctx context.Context, id string) *EmailTemplate {
+func (c *ShareAccessEventClient) GetX(ctx context.Context, id string) *ShareAccessEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -325,28 +925,28 @@ func (c *EmailTemplateClient) GetX(ctx context.Context, id string) *EmailTemplat
 }
 
 // Hooks returns the client hooks.
-func (c *EmailTemplateClient) Hooks() []Hook {
-	hooks := c.hooks.EmailTemplate
-	return append(hooks[:len(hooks):len(hooks)], emailtemplate.Hooks[:]...)
+func (c *ShareAccessEventClient) Hooks() []Hook {
+	hooks := c.hooks.ShareAccessEvent
+	return append(hooks[:len(hooks):len(hooks)], shareaccessevent.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailTemplateClient) Interceptors() []Interceptor {
-	return c.inters.EmailTemplate
+func (c *ShareAccessEventClient) Interceptors() []Interceptor {
+	return c.inters.ShareAccessEvent
 }
 
-func (c *EmailTemplateClient) mutate(ctx context.Context, m *EmailTemplateMutation) (Value, error) {
+func (c *ShareAccessEventClient) mutate(ctx context.Context, m *ShareAccessEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareAccessEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareAccessEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareAccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ShareAccessEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailTemplate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ShareAccessEvent mutation op: %q", m.Op())
 	}
 }
 
@@ -618,12 +1218,418 @@ func (c *SharedLinkClient) mutate(ctx context.Context, m *SharedLinkMutation) (V
 	}
 }
 
+// SharedLinkPolicySetClient is a client for the SharedLinkPolicySet schema.
+type SharedLinkPolicySetClient struct {
+	config
+}
+
+// NewSharedLinkPolicySetClient returns a client for the SharedLinkPolicySet from the given config.
+func NewSharedLinkPolicySetClient(c config) *SharedLinkPolicySetClient {
+	return &SharedLinkPolicySetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedlinkpolicyset.Hooks(f(g(h())))`.
+func (c *SharedLinkPolicySetClient) Use(hooks ...Hook) {
+	c.hooks.SharedLinkPolicySet = append(c.hooks.SharedLinkPolicySet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharedlinkpolicyset.Intercept(f(g(h())))`.
+func (c *SharedLinkPolicySetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SharedLinkPolicySet = append(c.inters.SharedLinkPolicySet, interceptors...)
+}
+
+// Create returns a builder for creating a SharedLinkPolicySet entity.
+func (c *SharedLinkPolicySetClient) Create() *SharedLinkPolicySetCreate {
+	mutation := newSharedLinkPolicySetMutation(c.config, OpCreate)
+	return &SharedLinkPolicySetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedLinkPolicySet entities.
+func (c *SharedLinkPolicySetClient) CreateBulk(builders ...*SharedLinkPolicySetCreate) *SharedLinkPolicySetCreateBulk {
+	return &SharedLinkPolicySetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SharedLinkPolicySetClient) MapCreateBulk(slice any, setFunc func(*SharedLinkPolicySetCreate, int)) *SharedLinkPolicySetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SharedLinkPolicySetCreateBulk{err: fmt.Errorf("calling to SharedLinkPolicySetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SharedLinkPolicySetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SharedLinkPolicySetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedLinkPolicySet.
+func (c *SharedLinkPolicySetClient) Update() *SharedLinkPolicySetUpdate {
+	mutation := newSharedLinkPolicySetMutation(c.config, OpUpdate)
+	return &SharedLinkPolicySetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedLinkPolicySetClient) UpdateOne(_m *SharedLinkPolicySet) *SharedLinkPolicySetUpdateOne {
+	mutation := newSharedLinkPolicySetMutation(c.config, OpUpdateOne, withSharedLinkPolicySet(_m))
+	return &SharedLinkPolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedLinkPolicySetClient) UpdateOneID(id string) *SharedLinkPolicySetUpdateOne {
+	mutation := newSharedLinkPolicySetMutation(c.config, OpUpdateOne, withSharedLinkPolicySetID(id))
+	return &SharedLinkPolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedLinkPolicySet.
+func (c *SharedLinkPolicySetClient) Delete() *SharedLinkPolicySetDelete {
+	mutation := newSharedLinkPolicySetMutation(c.config, OpDelete)
+	return &SharedLinkPolicySetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedLinkPolicySetClient) DeleteOne(_m *SharedLinkPolicySet) *SharedLinkPolicySetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedLinkPolicySetClient) DeleteOneID(id string) *SharedLinkPolicySetDeleteOne {
+	builder := c.Delete().Where(sharedlinkpolicyset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedLinkPolicySetDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedLinkPolicySet.
+func (c *SharedLinkPolicySetClient) Query() *SharedLinkPolicySetQuery {
+	return &SharedLinkPolicySetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSharedLinkPolicySet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SharedLinkPolicySet entity by its id.
+func (c *SharedLinkPolicySetClient) Get(ctx context.Context, id string) (*SharedLinkPolicySet, error) {
+	return c.Query().Where(sharedlinkpolicyset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedLinkPolicySetClient) GetX(ctx context.Context, id string) *SharedLinkPolicySet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SharedLinkPolicySetClient) Hooks() []Hook {
+	hooks := c.hooks.SharedLinkPolicySet
+	return append(hooks[:len(hooks):len(hooks)], sharedlinkpolicyset.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SharedLinkPolicySetClient) Interceptors() []Interceptor {
+	return c.inters.SharedLinkPolicySet
+}
+
+func (c *SharedLinkPolicySetClient) mutate(ctx context.Context, m *SharedLinkPolicySetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SharedLinkPolicySetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SharedLinkPolicySetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SharedLinkPolicySetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SharedLinkPolicySetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SharedLinkPolicySet mutation op: %q", m.Op())
+	}
+}
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(_m *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(_m))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id string) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(_m *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id string) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id string) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id string) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	hooks := c.hooks.Webhook
+	return append(hooks[:len(hooks):len(hooks)], webhook.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		EmailTemplate, SharePolicy, SharedLink []ent.Hook
+		EmailTemplate, NotificationPreference, PolicySet, RiskSettings,
+		ShareAccessEvent, SharePolicy, SharedLink, SharedLinkPolicySet, Webhook,
+		WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		EmailTemplate, SharePolicy, SharedLink []ent.Interceptor
+		EmailTemplate, NotificationPreference, PolicySet, RiskSettings,
+		ShareAccessEvent, SharePolicy, SharedLink, SharedLinkPolicySet, Webhook,
+		WebhookDelivery []ent.Interceptor
 	}
 )
@@ -32,11 +32,15 @@ type EmailTemplate struct {
 	TenantID *uint32 `json:"tenant_id,omitempty"`
 	// Template name
 	Name string `json:"name,omitempty"`
-	// Email subject (Go template)
+	// Template kind: SHARE (recipient email) or a sender notification event
+	Kind emailtemplate.Kind `json:"kind,omitempty"`
+	// Email subject (Go text/template)
 	Subject string `json:"subject,omitempty"`
 	// Email HTML body (Go html/template)
 	HTMLBody string `json:"html_body,omitempty"`
-	// Whether this is the default template for the tenant
+	// Email plain text body (Go text/template), generated from the HTML body when empty
+	TextBody string `json:"text_body,omitempty"`
+	// Whether this is the default template of its kind for the tenant
 	IsDefault    bool `json:"is_default,omitempty"`
 	selectValues sql.SelectValues
 }
@@ -50,7 +54,7 @@ func (*EmailTemplate) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case emailtemplate.FieldCreateBy, emailtemplate.FieldUpdateBy, emailtemplate.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case emailtemplate.FieldID, emailtemplate.FieldName, emailtemplate.FieldSubject, emailtemplate.FieldHTMLBody:
+		case emailtemplate.FieldID, emailtemplate.FieldName, emailtemplate.FieldKind, emailtemplate.FieldSubject, emailtemplate.FieldHTMLBody, emailtemplate.FieldTextBody:
 			values[i] = new(sql.NullString)
 		case emailtemplate.FieldCreateTime, emailtemplate.FieldUpdateTime, emailtemplate.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -123,6 +127,12 @@ func (_m *EmailTemplate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case emailtemplate.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = emailtemplate.Kind(value.String)
+			}
 		case emailtemplate.FieldSubject:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field subject", values[i])
@@ -135,6 +145,12 @@ func (_m *EmailTemplate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.HTMLBody = value.String
 			}
+		case emailtemplate.FieldTextBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text_body", values[i])
+			} else if value.Valid {
+				_m.TextBody = value.String
+			}
 		case emailtemplate.FieldIsDefault:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_default", values[i])
@@ -210,12 +226,18 @@ func (_m *EmailTemplate) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
 	builder.WriteString("subject=")
 	builder.WriteString(_m.Subject)
 	builder.WriteString(", ")
 	builder.WriteString("html_body=")
 	builder.WriteString(_m.HTMLBody)
 	builder.WriteString(", ")
+	builder.WriteString("text_body=")
+	builder.WriteString(_m.TextBody)
+	builder.WriteString(", ")
 	builder.WriteString("is_default=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
 	builder.WriteByte(')')
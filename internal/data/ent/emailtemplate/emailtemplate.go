@@ -3,6 +3,8 @@
 package emailtemplate
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
@@ -26,10 +28,14 @@ const (
 	FieldTenantID = "tenant_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
 	// FieldSubject holds the string denoting the subject field in the database.
 	FieldSubject = "subject"
 	// FieldHTMLBody holds the string denoting the html_body field in the database.
 	FieldHTMLBody = "html_body"
+	// FieldTextBody holds the string denoting the text_body field in the database.
+	FieldTextBody = "text_body"
 	// FieldIsDefault holds the string denoting the is_default field in the database.
 	FieldIsDefault = "is_default"
 	// Table holds the table name of the emailtemplate in the database.
@@ -46,8 +52,10 @@ var Columns = []string{
 	FieldDeleteTime,
 	FieldTenantID,
 	FieldName,
+	FieldKind,
 	FieldSubject,
 	FieldHTMLBody,
+	FieldTextBody,
 	FieldIsDefault,
 }
 
@@ -67,7 +75,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
 var (
-	Hooks  [1]ent.Hook
+	Hooks  [2]ent.Hook
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
@@ -83,6 +91,34 @@ var (
 	IDValidator func(string) error
 )
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindSHARE is the default value of the Kind enum.
+const DefaultKind = KindSHARE
+
+// Kind values.
+const (
+	KindSHARE         Kind = "SHARE"
+	KindSHARE_VIEWED  Kind = "SHARE_VIEWED"
+	KindSHARE_DENIED  Kind = "SHARE_DENIED"
+	KindSHARE_EXPIRED Kind = "SHARE_EXPIRED"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindSHARE, KindSHARE_VIEWED, KindSHARE_DENIED, KindSHARE_EXPIRED:
+		return nil
+	default:
+		return fmt.Errorf("emailtemplate: invalid enum value for kind field: %q", k)
+	}
+}
+
 // OrderOption defines the ordering options for the EmailTemplate queries.
 type OrderOption func(*sql.Selector)
 
@@ -126,6 +162,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
 // BySubject orders the results by the subject field.
 func BySubject(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubject, opts...).ToFunc()
@@ -136,6 +177,11 @@ func ByHTMLBody(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHTMLBody, opts...).ToFunc()
 }
 
+// ByTextBody orders the results by the text_body field.
+func ByTextBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTextBody, opts...).ToFunc()
+}
+
 // ByIsDefault orders the results by the is_default field.
 func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
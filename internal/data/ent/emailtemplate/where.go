@@ -109,6 +109,11 @@ func HTMLBody(v string) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldEQ(FieldHTMLBody, v))
 }
 
+// TextBody applies equality check predicate on the "text_body" field. It's identical to TextBodyEQ.
+func TextBody(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldEQ(FieldTextBody, v))
+}
+
 // IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
 func IsDefault(v bool) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldEQ(FieldIsDefault, v))
@@ -479,6 +484,26 @@ func NameContainsFold(v string) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldContainsFold(FieldName, v))
 }
 
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldNotIn(FieldKind, vs...))
+}
+
 // SubjectEQ applies the EQ predicate on the "subject" field.
 func SubjectEQ(v string) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldEQ(FieldSubject, v))
@@ -609,6 +634,81 @@ func HTMLBodyContainsFold(v string) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldContainsFold(FieldHTMLBody, v))
 }
 
+// TextBodyEQ applies the EQ predicate on the "text_body" field.
+func TextBodyEQ(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldEQ(FieldTextBody, v))
+}
+
+// TextBodyNEQ applies the NEQ predicate on the "text_body" field.
+func TextBodyNEQ(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldNEQ(FieldTextBody, v))
+}
+
+// TextBodyIn applies the In predicate on the "text_body" field.
+func TextBodyIn(vs ...string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldIn(FieldTextBody, vs...))
+}
+
+// TextBodyNotIn applies the NotIn predicate on the "text_body" field.
+func TextBodyNotIn(vs ...string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldNotIn(FieldTextBody, vs...))
+}
+
+// TextBodyGT applies the GT predicate on the "text_body" field.
+func TextBodyGT(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldGT(FieldTextBody, v))
+}
+
+// TextBodyGTE applies the GTE predicate on the "text_body" field.
+func TextBodyGTE(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldGTE(FieldTextBody, v))
+}
+
+// TextBodyLT applies the LT predicate on the "text_body" field.
+func TextBodyLT(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldLT(FieldTextBody, v))
+}
+
+// TextBodyLTE applies the LTE predicate on the "text_body" field.
+func TextBodyLTE(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldLTE(FieldTextBody, v))
+}
+
+// TextBodyContains applies the Contains predicate on the "text_body" field.
+func TextBodyContains(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldContains(FieldTextBody, v))
+}
+
+// TextBodyHasPrefix applies the HasPrefix predicate on the "text_body" field.
+func TextBodyHasPrefix(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldHasPrefix(FieldTextBody, v))
+}
+
+// TextBodyHasSuffix applies the HasSuffix predicate on the "text_body" field.
+func TextBodyHasSuffix(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldHasSuffix(FieldTextBody, v))
+}
+
+// TextBodyIsNil applies the IsNil predicate on the "text_body" field.
+func TextBodyIsNil() predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldIsNull(FieldTextBody))
+}
+
+// TextBodyNotNil applies the NotNil predicate on the "text_body" field.
+func TextBodyNotNil() predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldNotNull(FieldTextBody))
+}
+
+// TextBodyEqualFold applies the EqualFold predicate on the "text_body" field.
+func TextBodyEqualFold(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldEqualFold(FieldTextBody, v))
+}
+
+// TextBodyContainsFold applies the ContainsFold predicate on the "text_body" field.
+func TextBodyContainsFold(v string) predicate.EmailTemplate {
+	return predicate.EmailTemplate(sql.FieldContainsFold(FieldTextBody, v))
+}
+
 // IsDefaultEQ applies the EQ predicate on the "is_default" field.
 func IsDefaultEQ(v bool) predicate.EmailTemplate {
 	return predicate.EmailTemplate(sql.FieldEQ(FieldIsDefault, v))
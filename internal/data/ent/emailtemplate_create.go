@@ -113,6 +113,20 @@ func (_c *EmailTemplateCreate) SetName(v string) *EmailTemplateCreate {
 	return _c
 }
 
+// SetKind sets the "kind" field.
+func (_c *EmailTemplateCreate) SetKind(v emailtemplate.Kind) *EmailTemplateCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *EmailTemplateCreate) SetNillableKind(v *emailtemplate.Kind) *EmailTemplateCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
 // SetSubject sets the "subject" field.
 func (_c *EmailTemplateCreate) SetSubject(v string) *EmailTemplateCreate {
 	_c.mutation.SetSubject(v)
@@ -125,6 +139,20 @@ func (_c *EmailTemplateCreate) SetHTMLBody(v string) *EmailTemplateCreate {
 	return _c
 }
 
+// SetTextBody sets the "text_body" field.
+func (_c *EmailTemplateCreate) SetTextBody(v string) *EmailTemplateCreate {
+	_c.mutation.SetTextBody(v)
+	return _c
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_c *EmailTemplateCreate) SetNillableTextBody(v *string) *EmailTemplateCreate {
+	if v != nil {
+		_c.SetTextBody(*v)
+	}
+	return _c
+}
+
 // SetIsDefault sets the "is_default" field.
 func (_c *EmailTemplateCreate) SetIsDefault(v bool) *EmailTemplateCreate {
 	_c.mutation.SetIsDefault(v)
@@ -186,6 +214,10 @@ func (_c *EmailTemplateCreate) defaults() error {
 		v := emailtemplate.DefaultTenantID
 		_c.mutation.SetTenantID(v)
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := emailtemplate.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 	if _, ok := _c.mutation.IsDefault(); !ok {
 		v := emailtemplate.DefaultIsDefault
 		_c.mutation.SetIsDefault(v)
@@ -203,6 +235,14 @@ func (_c *EmailTemplateCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EmailTemplate.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := emailtemplate.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.kind": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Subject(); !ok {
 		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "EmailTemplate.subject"`)}
 	}
@@ -291,6 +331,10 @@ func (_c *EmailTemplateCreate) createSpec() (*EmailTemplate, *sqlgraph.CreateSpe
 		_spec.SetField(emailtemplate.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(emailtemplate.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
 	if value, ok := _c.mutation.Subject(); ok {
 		_spec.SetField(emailtemplate.FieldSubject, field.TypeString, value)
 		_node.Subject = value
@@ -299,6 +343,10 @@ func (_c *EmailTemplateCreate) createSpec() (*EmailTemplate, *sqlgraph.CreateSpe
 		_spec.SetField(emailtemplate.FieldHTMLBody, field.TypeString, value)
 		_node.HTMLBody = value
 	}
+	if value, ok := _c.mutation.TextBody(); ok {
+		_spec.SetField(emailtemplate.FieldTextBody, field.TypeString, value)
+		_node.TextBody = value
+	}
 	if value, ok := _c.mutation.IsDefault(); ok {
 		_spec.SetField(emailtemplate.FieldIsDefault, field.TypeBool, value)
 		_node.IsDefault = value
@@ -451,6 +499,18 @@ func (u *EmailTemplateUpsert) UpdateName() *EmailTemplateUpsert {
 	return u
 }
 
+// SetKind sets the "kind" field.
+func (u *EmailTemplateUpsert) SetKind(v emailtemplate.Kind) *EmailTemplateUpsert {
+	u.Set(emailtemplate.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EmailTemplateUpsert) UpdateKind() *EmailTemplateUpsert {
+	u.SetExcluded(emailtemplate.FieldKind)
+	return u
+}
+
 // SetSubject sets the "subject" field.
 func (u *EmailTemplateUpsert) SetSubject(v string) *EmailTemplateUpsert {
 	u.Set(emailtemplate.FieldSubject, v)
@@ -475,6 +535,24 @@ func (u *EmailTemplateUpsert) UpdateHTMLBody() *EmailTemplateUpsert {
 	return u
 }
 
+// SetTextBody sets the "text_body" field.
+func (u *EmailTemplateUpsert) SetTextBody(v string) *EmailTemplateUpsert {
+	u.Set(emailtemplate.FieldTextBody, v)
+	return u
+}
+
+// UpdateTextBody sets the "text_body" field to the value that was provided on create.
+func (u *EmailTemplateUpsert) UpdateTextBody() *EmailTemplateUpsert {
+	u.SetExcluded(emailtemplate.FieldTextBody)
+	return u
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (u *EmailTemplateUpsert) ClearTextBody() *EmailTemplateUpsert {
+	u.SetNull(emailtemplate.FieldTextBody)
+	return u
+}
+
 // SetIsDefault sets the "is_default" field.
 func (u *EmailTemplateUpsert) SetIsDefault(v bool) *EmailTemplateUpsert {
 	u.Set(emailtemplate.FieldIsDefault, v)
@@ -653,6 +731,20 @@ func (u *EmailTemplateUpsertOne) UpdateName() *EmailTemplateUpsertOne {
 	})
 }
 
+// SetKind sets the "kind" field.
+func (u *EmailTemplateUpsertOne) SetKind(v emailtemplate.Kind) *EmailTemplateUpsertOne {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EmailTemplateUpsertOne) UpdateKind() *EmailTemplateUpsertOne {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.UpdateKind()
+	})
+}
+
 // SetSubject sets the "subject" field.
 func (u *EmailTemplateUpsertOne) SetSubject(v string) *EmailTemplateUpsertOne {
 	return u.Update(func(s *EmailTemplateUpsert) {
@@ -681,6 +773,27 @@ func (u *EmailTemplateUpsertOne) UpdateHTMLBody() *EmailTemplateUpsertOne {
 	})
 }
 
+// SetTextBody sets the "text_body" field.
+func (u *EmailTemplateUpsertOne) SetTextBody(v string) *EmailTemplateUpsertOne {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.SetTextBody(v)
+	})
+}
+
+// UpdateTextBody sets the "text_body" field to the value that was provided on create.
+func (u *EmailTemplateUpsertOne) UpdateTextBody() *EmailTemplateUpsertOne {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.UpdateTextBody()
+	})
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (u *EmailTemplateUpsertOne) ClearTextBody() *EmailTemplateUpsertOne {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.ClearTextBody()
+	})
+}
+
 // SetIsDefault sets the "is_default" field.
 func (u *EmailTemplateUpsertOne) SetIsDefault(v bool) *EmailTemplateUpsertOne {
 	return u.Update(func(s *EmailTemplateUpsert) {
@@ -1028,6 +1141,20 @@ func (u *EmailTemplateUpsertBulk) UpdateName() *EmailTemplateUpsertBulk {
 	})
 }
 
+// SetKind sets the "kind" field.
+func (u *EmailTemplateUpsertBulk) SetKind(v emailtemplate.Kind) *EmailTemplateUpsertBulk {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EmailTemplateUpsertBulk) UpdateKind() *EmailTemplateUpsertBulk {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.UpdateKind()
+	})
+}
+
 // SetSubject sets the "subject" field.
 func (u *EmailTemplateUpsertBulk) SetSubject(v string) *EmailTemplateUpsertBulk {
 	return u.Update(func(s *EmailTemplateUpsert) {
@@ -1056,6 +1183,27 @@ func (u *EmailTemplateUpsertBulk) UpdateHTMLBody() *EmailTemplateUpsertBulk {
 	})
 }
 
+// SetTextBody sets the "text_body" field.
+func (u *EmailTemplateUpsertBulk) SetTextBody(v string) *EmailTemplateUpsertBulk {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.SetTextBody(v)
+	})
+}
+
+// UpdateTextBody sets the "text_body" field to the value that was provided on create.
+func (u *EmailTemplateUpsertBulk) UpdateTextBody() *EmailTemplateUpsertBulk {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.UpdateTextBody()
+	})
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (u *EmailTemplateUpsertBulk) ClearTextBody() *EmailTemplateUpsertBulk {
+	return u.Update(func(s *EmailTemplateUpsert) {
+		s.ClearTextBody()
+	})
+}
+
 // SetIsDefault sets the "is_default" field.
 func (u *EmailTemplateUpsertBulk) SetIsDefault(v bool) *EmailTemplateUpsertBulk {
 	return u.Update(func(s *EmailTemplateUpsert) {
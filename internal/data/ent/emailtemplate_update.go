@@ -137,6 +137,20 @@ func (_u *EmailTemplateUpdate) SetNillableName(v *string) *EmailTemplateUpdate {
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *EmailTemplateUpdate) SetKind(v emailtemplate.Kind) *EmailTemplateUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EmailTemplateUpdate) SetNillableKind(v *emailtemplate.Kind) *EmailTemplateUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
 // SetSubject sets the "subject" field.
 func (_u *EmailTemplateUpdate) SetSubject(v string) *EmailTemplateUpdate {
 	_u.mutation.SetSubject(v)
@@ -165,6 +179,26 @@ func (_u *EmailTemplateUpdate) SetNillableHTMLBody(v *string) *EmailTemplateUpda
 	return _u
 }
 
+// SetTextBody sets the "text_body" field.
+func (_u *EmailTemplateUpdate) SetTextBody(v string) *EmailTemplateUpdate {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *EmailTemplateUpdate) SetNillableTextBody(v *string) *EmailTemplateUpdate {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (_u *EmailTemplateUpdate) ClearTextBody() *EmailTemplateUpdate {
+	_u.mutation.ClearTextBody()
+	return _u
+}
+
 // SetIsDefault sets the "is_default" field.
 func (_u *EmailTemplateUpdate) SetIsDefault(v bool) *EmailTemplateUpdate {
 	_u.mutation.SetIsDefault(v)
@@ -218,6 +252,11 @@ func (_u *EmailTemplateUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := emailtemplate.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.kind": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Subject(); ok {
 		if err := emailtemplate.SubjectValidator(v); err != nil {
 			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.subject": %w`, err)}
@@ -288,12 +327,21 @@ func (_u *EmailTemplateUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(emailtemplate.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(emailtemplate.FieldKind, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Subject(); ok {
 		_spec.SetField(emailtemplate.FieldSubject, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.HTMLBody(); ok {
 		_spec.SetField(emailtemplate.FieldHTMLBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(emailtemplate.FieldTextBody, field.TypeString, value)
+	}
+	if _u.mutation.TextBodyCleared() {
+		_spec.ClearField(emailtemplate.FieldTextBody, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsDefault(); ok {
 		_spec.SetField(emailtemplate.FieldIsDefault, field.TypeBool, value)
 	}
@@ -427,6 +475,20 @@ func (_u *EmailTemplateUpdateOne) SetNillableName(v *string) *EmailTemplateUpdat
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *EmailTemplateUpdateOne) SetKind(v emailtemplate.Kind) *EmailTemplateUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EmailTemplateUpdateOne) SetNillableKind(v *emailtemplate.Kind) *EmailTemplateUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
 // SetSubject sets the "subject" field.
 func (_u *EmailTemplateUpdateOne) SetSubject(v string) *EmailTemplateUpdateOne {
 	_u.mutation.SetSubject(v)
@@ -455,6 +517,26 @@ func (_u *EmailTemplateUpdateOne) SetNillableHTMLBody(v *string) *EmailTemplateU
 	return _u
 }
 
+// SetTextBody sets the "text_body" field.
+func (_u *EmailTemplateUpdateOne) SetTextBody(v string) *EmailTemplateUpdateOne {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *EmailTemplateUpdateOne) SetNillableTextBody(v *string) *EmailTemplateUpdateOne {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (_u *EmailTemplateUpdateOne) ClearTextBody() *EmailTemplateUpdateOne {
+	_u.mutation.ClearTextBody()
+	return _u
+}
+
 // SetIsDefault sets the "is_default" field.
 func (_u *EmailTemplateUpdateOne) SetIsDefault(v bool) *EmailTemplateUpdateOne {
 	_u.mutation.SetIsDefault(v)
@@ -521,6 +603,11 @@ func (_u *EmailTemplateUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := emailtemplate.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.kind": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Subject(); ok {
 		if err := emailtemplate.SubjectValidator(v); err != nil {
 			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "EmailTemplate.subject": %w`, err)}
@@ -608,12 +695,21 @@ func (_u *EmailTemplateUpdateOne) sqlSave(ctx context.Context) (_node *EmailTemp
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(emailtemplate.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(emailtemplate.FieldKind, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Subject(); ok {
 		_spec.SetField(emailtemplate.FieldSubject, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.HTMLBody(); ok {
 		_spec.SetField(emailtemplate.FieldHTMLBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(emailtemplate.FieldTextBody, field.TypeString, value)
+	}
+	if _u.mutation.TextBodyCleared() {
+		_spec.ClearField(emailtemplate.FieldTextBody, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsDefault(); ok {
 		_spec.SetField(emailtemplate.FieldIsDefault, field.TypeBool, value)
 	}
@@ -13,8 +13,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			emailtemplate.Table: emailtemplate.ValidColumn,
-			sharepolicy.Table:   sharepolicy.ValidColumn,
-			sharedlink.Table:    sharedlink.ValidColumn,
+			emailtemplate.Table:          emailtemplate.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			policyset.Table:              policyset.ValidColumn,
+			risksettings.Table:           risksettings.ValidColumn,
+			shareaccessevent.Table:       shareaccessevent.ValidColumn,
+			sharepolicy.Table:            sharepolicy.ValidColumn,
+			sharedlink.Table:             sharedlink.ValidColumn,
+			sharedlinkpolicyset.Table:    sharedlinkpolicyset.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -21,6 +21,54 @@ func (f EmailTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailTemplateMutation", m)
 }
 
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
+// The PolicySetFunc type is an adapter to allow the use of ordinary
+// function as PolicySet mutator.
+type PolicySetFunc func(context.Context, *ent.PolicySetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicySetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicySetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicySetMutation", m)
+}
+
+// The RiskSettingsFunc type is an adapter to allow the use of ordinary
+// function as RiskSettings mutator.
+type RiskSettingsFunc func(context.Context, *ent.RiskSettingsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RiskSettingsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RiskSettingsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RiskSettingsMutation", m)
+}
+
+// The ShareAccessEventFunc type is an adapter to allow the use of ordinary
+// function as ShareAccessEvent mutator.
+type ShareAccessEventFunc func(context.Context, *ent.ShareAccessEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareAccessEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareAccessEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareAccessEventMutation", m)
+}
+
 // The SharePolicyFunc type is an adapter to allow the use of ordinary
 // function as SharePolicy mutator.
 type SharePolicyFunc func(context.Context, *ent.SharePolicyMutation) (ent.Value, error)
@@ -45,6 +93,42 @@ func (f SharedLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedLinkMutation", m)
 }
 
+// The SharedLinkPolicySetFunc type is an adapter to allow the use of ordinary
+// function as SharedLinkPolicySet mutator.
+type SharedLinkPolicySetFunc func(context.Context, *ent.SharedLinkPolicySetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SharedLinkPolicySetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SharedLinkPolicySetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedLinkPolicySetMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
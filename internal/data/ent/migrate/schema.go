@@ -19,9 +19,11 @@ var (
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Template name"},
-		{Name: "subject", Type: field.TypeString, Size: 1024, Comment: "Email subject (Go template)"},
+		{Name: "kind", Type: field.TypeEnum, Comment: "Template kind: SHARE (recipient email) or a sender notification event", Enums: []string{"SHARE", "SHARE_VIEWED", "SHARE_DENIED", "SHARE_EXPIRED"}, Default: "SHARE"},
+		{Name: "subject", Type: field.TypeString, Size: 1024, Comment: "Email subject (Go text/template)"},
 		{Name: "html_body", Type: field.TypeString, Size: 2147483647, Comment: "Email HTML body (Go html/template)"},
-		{Name: "is_default", Type: field.TypeBool, Comment: "Whether this is the default template for the tenant", Default: false},
+		{Name: "text_body", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Email plain text body (Go text/template), generated from the HTML body when empty"},
+		{Name: "is_default", Type: field.TypeBool, Comment: "Whether this is the default template of its kind for the tenant", Default: false},
 	}
 	// SharingEmailTemplatesTable holds the schema information for the "sharing_email_templates" table.
 	SharingEmailTemplatesTable = &schema.Table{
@@ -35,9 +37,9 @@ var (
 				Columns: []*schema.Column{SharingEmailTemplatesColumns[6], SharingEmailTemplatesColumns[7]},
 			},
 			{
-				Name:    "emailtemplate_tenant_id_is_default",
+				Name:    "emailtemplate_tenant_id_kind_is_default",
 				Unique:  false,
-				Columns: []*schema.Column{SharingEmailTemplatesColumns[6], SharingEmailTemplatesColumns[10]},
+				Columns: []*schema.Column{SharingEmailTemplatesColumns[6], SharingEmailTemplatesColumns[8], SharingEmailTemplatesColumns[12]},
 			},
 			{
 				Name:    "emailtemplate_tenant_id",
@@ -46,6 +48,133 @@ var (
 			},
 		},
 	}
+	// SharingNotificationPreferencesColumns holds the columns for the "sharing_notification_preferences" table.
+	SharingNotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "User the preferences belong to"},
+		{Name: "notify_viewed", Type: field.TypeBool, Comment: "Notify when a share is viewed", Default: true},
+		{Name: "notify_denied", Type: field.TypeBool, Comment: "Notify when an access attempt is denied by policy", Default: true},
+		{Name: "notify_expired", Type: field.TypeBool, Comment: "Notify when a share expires unviewed", Default: true},
+	}
+	// SharingNotificationPreferencesTable holds the schema information for the "sharing_notification_preferences" table.
+	SharingNotificationPreferencesTable = &schema.Table{
+		Name:       "sharing_notification_preferences",
+		Columns:    SharingNotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{SharingNotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SharingNotificationPreferencesColumns[4], SharingNotificationPreferencesColumns[5]},
+			},
+		},
+	}
+	// SharingPolicySetsColumns holds the columns for the "sharing_policy_sets" table.
+	SharingPolicySetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Policy set name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "What the policy set is for"},
+		{Name: "rules", Type: field.TypeJSON, Comment: "Policy rules, evaluated like policies attached to the share"},
+	}
+	// SharingPolicySetsTable holds the schema information for the "sharing_policy_sets" table.
+	SharingPolicySetsTable = &schema.Table{
+		Name:       "sharing_policy_sets",
+		Columns:    SharingPolicySetsColumns,
+		PrimaryKey: []*schema.Column{SharingPolicySetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyset_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SharingPolicySetsColumns[6], SharingPolicySetsColumns[7]},
+			},
+			{
+				Name:    "policyset_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharingPolicySetsColumns[6]},
+			},
+		},
+	}
+	// SharingRiskSettingsColumns holds the columns for the "sharing_risk_settings" table.
+	SharingRiskSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether access attempts are scored for risk", Default: false},
+		{Name: "step_up_threshold", Type: field.TypeInt32, Comment: "Score from which a passphrase or email code is required", Default: 30},
+		{Name: "deny_threshold", Type: field.TypeInt32, Comment: "Score from which access is denied (101 never denies)", Default: 70},
+		{Name: "business_hours", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Business hours of the tenant's senders, in TIME policy syntax"},
+	}
+	// SharingRiskSettingsTable holds the schema information for the "sharing_risk_settings" table.
+	SharingRiskSettingsTable = &schema.Table{
+		Name:       "sharing_risk_settings",
+		Columns:    SharingRiskSettingsColumns,
+		PrimaryKey: []*schema.Column{SharingRiskSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "risksettings_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SharingRiskSettingsColumns[4]},
+			},
+		},
+	}
+	// SharingShareAccessEventsColumns holds the columns for the "sharing_share_access_events" table.
+	SharingShareAccessEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "share_link_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to shared_link.id (empty when the token did not match a share)"},
+		{Name: "token_prefix", Type: field.TypeString, Nullable: true, Size: 16, Comment: "Leading characters of the presented token, for correlating unknown tokens"},
+		{Name: "outcome", Type: field.TypeEnum, Comment: "Result of the access attempt", Enums: []string{"GRANTED", "POLICY_DENIED", "ALREADY_VIEWED", "REVOKED", "NOT_FOUND", "ERROR", "EXPIRED", "DEVICE_MISMATCH", "STEP_UP_REQUIRED", "STEP_UP_FAILED", "RISK_DENIED"}},
+		{Name: "policy_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "ID of the policy that decided the outcome, if any"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Human readable reason for the outcome"},
+		{Name: "client_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "Client IP address"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Client User-Agent header"},
+		{Name: "country", Type: field.TypeString, Nullable: true, Size: 2, Comment: "ISO 3166-1 alpha-2 country resolved from the client IP"},
+		{Name: "reputation_list", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Reputation list the client IP matched when a REPUTATION policy decided the outcome"},
+		{Name: "risk_score", Type: field.TypeInt32, Nullable: true, Comment: "Risk score of the attempt (0-100), set when risk-based access is enabled"},
+	}
+	// SharingShareAccessEventsTable holds the schema information for the "sharing_share_access_events" table.
+	SharingShareAccessEventsTable = &schema.Table{
+		Name:       "sharing_share_access_events",
+		Columns:    SharingShareAccessEventsColumns,
+		PrimaryKey: []*schema.Column{SharingShareAccessEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shareaccessevent_share_link_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharingShareAccessEventsColumns[5]},
+			},
+			{
+				Name:    "shareaccessevent_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SharingShareAccessEventsColumns[4], SharingShareAccessEventsColumns[1]},
+			},
+			{
+				Name:    "shareaccessevent_tenant_id_outcome",
+				Unique:  false,
+				Columns: []*schema.Column{SharingShareAccessEventsColumns[4], SharingShareAccessEventsColumns[7]},
+			},
+			{
+				Name:    "shareaccessevent_client_ip",
+				Unique:  false,
+				Columns: []*schema.Column{SharingShareAccessEventsColumns[10]},
+			},
+		},
+	}
 	// SharingSharePoliciesColumns holds the columns for the "sharing_share_policies" table.
 	SharingSharePoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
@@ -56,9 +185,11 @@ var (
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "share_link_id", Type: field.TypeString, Size: 36, Comment: "FK to shared_link.id"},
 		{Name: "type", Type: field.TypeEnum, Comment: "Restriction type: BLACKLIST (deny) or WHITELIST (allow)", Enums: []string{"BLACKLIST", "WHITELIST"}},
-		{Name: "method", Type: field.TypeEnum, Comment: "Restriction method", Enums: []string{"IP", "MAC", "REGION", "TIME", "DEVICE", "NETWORK"}},
-		{Name: "value", Type: field.TypeString, Size: 512, Comment: "Restriction value (IP, CIDR range, MAC, region code, time range, device ID)"},
+		{Name: "method", Type: field.TypeEnum, Comment: "Restriction method", Enums: []string{"IP", "MAC", "REGION", "TIME", "DEVICE", "NETWORK", "EXPRESSION", "CERTIFICATE", "REPUTATION"}},
+		{Name: "value", Type: field.TypeString, Size: 512, Comment: "Restriction value (IP, CIDR range, MAC, region code, time range, device rule, CEL expression, certificate rule)"},
 		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Explanation for this restriction"},
+		{Name: "priority", Type: field.TypeInt32, Comment: "Evaluation order, lower priorities first", Default: 0},
+		{Name: "deprecated", Type: field.TypeBool, Comment: "Whether the policy uses a deprecated method and should be replaced", Default: false},
 	}
 	// SharingSharePoliciesTable holds the schema information for the "sharing_share_policies" table.
 	SharingSharePoliciesTable = &schema.Table{
@@ -89,16 +220,37 @@ var (
 		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of resource being shared", Enums: []string{"SECRET", "DOCUMENT"}},
 		{Name: "resource_id", Type: field.TypeString, Size: 255, Comment: "ID of the shared resource"},
 		{Name: "resource_name", Type: field.TypeString, Size: 255, Comment: "Display name of the shared resource"},
-		{Name: "token", Type: field.TypeString, Unique: true, Size: 64, Comment: "Unique share token (64 hex chars)"},
+		{Name: "token", Type: field.TypeString, Unique: true, Size: 64, Comment: "Unique share token (tgs_ prefix + base62 + CRC32 checksum, or legacy 64 hex chars)"},
 		{Name: "encrypted_content", Type: field.TypeBytes, Nullable: true, Comment: "AES-256-GCM encrypted content"},
 		{Name: "encryption_nonce", Type: field.TypeBytes, Nullable: true, Comment: "AES-256-GCM nonce"},
 		{Name: "recipient_email", Type: field.TypeString, Size: 320, Comment: "Recipient email address"},
+		{Name: "sender_email", Type: field.TypeString, Nullable: true, Size: 320, Comment: "Sender email address for share notifications"},
 		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Optional message to recipient"},
 		{Name: "template_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Email template ID used"},
 		{Name: "viewed", Type: field.TypeBool, Comment: "Whether the share has been viewed", Default: false},
-		{Name: "viewed_at", Type: field.TypeTime, Nullable: true, Comment: "When the share was viewed"},
+		{Name: "viewed_at", Type: field.TypeTime, Nullable: true, Comment: "When the share was first viewed"},
 		{Name: "viewed_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "IP address of viewer"},
 		{Name: "revoked", Type: field.TypeBool, Comment: "Whether the share has been revoked", Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "When the share expires if not viewed"},
+		{Name: "expiry_notified", Type: field.TypeBool, Comment: "Whether the sender has been notified that the share expired", Default: false},
+		{Name: "expiry_notice_attempts", Type: field.TypeInt32, Comment: "Number of attempts to notify the sender that the share expired", Default: 0},
+		{Name: "expiry_notice_next_attempt_at", Type: field.TypeTime, Nullable: true, Comment: "When the next expiry notification attempt is due"},
+		{Name: "authorized_by", Type: field.TypeUint32, Nullable: true, Comment: "User the upstream service authorized to read the resource"},
+		{Name: "authorized_via", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Upstream service and permission that authorized the share"},
+		{Name: "authorized_at", Type: field.TypeTime, Nullable: true, Comment: "When the upstream service authorized the share"},
+		{Name: "policy_mode", Type: field.TypeEnum, Comment: "How the access policies are combined", Enums: []string{"ALL_MUST_PASS", "ANY_WHITELIST", "FIRST_MATCH"}, Default: "ALL_MUST_PASS"},
+		{Name: "policy_default", Type: field.TypeEnum, Comment: "Decision when no access policy decides", Enums: []string{"ALLOW", "DENY"}, Default: "ALLOW"},
+		{Name: "max_views", Type: field.TypeInt32, Comment: "How many times the share can be viewed", Default: 1},
+		{Name: "view_count", Type: field.TypeInt32, Comment: "How many times the share has been viewed", Default: 0},
+		{Name: "bind_device", Type: field.TypeBool, Comment: "Whether all views must come from the device of the first view", Default: false},
+		{Name: "device_binding", Type: field.TypeString, Nullable: true, Size: 64, Comment: "SHA-256 of the device ID the share is bound to"},
+		{Name: "device_bound_at", Type: field.TypeTime, Nullable: true, Comment: "When the share was bound to a device"},
+		{Name: "passphrase_hash", Type: field.TypeString, Nullable: true, Size: 255, Comment: "PBKDF2 hash of the passphrase accepted as a step-up challenge"},
+		{Name: "verification_code_hash", Type: field.TypeString, Nullable: true, Size: 64, Comment: "HMAC of the pending email verification code"},
+		{Name: "verification_code_expires_at", Type: field.TypeTime, Nullable: true, Comment: "When the pending email verification code expires"},
+		{Name: "step_up_attempts", Type: field.TypeInt32, Comment: "Verification code answers given since the last code was sent", Default: 0},
+		{Name: "passphrase_attempts", Type: field.TypeInt32, Comment: "Passphrase answers given since the last passed step-up challenge", Default: 0},
+		{Name: "step_up_challenged_at", Type: field.TypeTime, Nullable: true, Comment: "When an access attempt was last asked to step up"},
 	}
 	// SharingSharedLinksTable holds the schema information for the "sharing_shared_links" table.
 	SharingSharedLinksTable = &schema.Table{
@@ -129,15 +281,135 @@ var (
 			{
 				Name:    "sharedlink_tenant_id_viewed",
 				Unique:  false,
-				Columns: []*schema.Column{SharingSharedLinksColumns[5], SharingSharedLinksColumns[15]},
+				Columns: []*schema.Column{SharingSharedLinksColumns[5], SharingSharedLinksColumns[16]},
+			},
+			{
+				Name:    "sharedlink_expires_at_viewed_revoked",
+				Unique:  false,
+				Columns: []*schema.Column{SharingSharedLinksColumns[20], SharingSharedLinksColumns[16], SharingSharedLinksColumns[19]},
+			},
+			{
+				Name:    "sharedlink_tenant_id_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharingSharedLinksColumns[5], SharingSharedLinksColumns[2], SharingSharedLinksColumns[0]},
+			},
+		},
+	}
+	// SharingSharedLinkPolicySetsColumns holds the columns for the "sharing_shared_link_policy_sets" table.
+	SharingSharedLinkPolicySetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "share_link_id", Type: field.TypeString, Size: 36, Comment: "FK to shared_link.id"},
+		{Name: "policy_set_id", Type: field.TypeString, Size: 36, Comment: "FK to policy_set.id"},
+	}
+	// SharingSharedLinkPolicySetsTable holds the schema information for the "sharing_shared_link_policy_sets" table.
+	SharingSharedLinkPolicySetsTable = &schema.Table{
+		Name:       "sharing_shared_link_policy_sets",
+		Columns:    SharingSharedLinkPolicySetsColumns,
+		PrimaryKey: []*schema.Column{SharingSharedLinkPolicySetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharedlinkpolicyset_share_link_id_policy_set_id",
+				Unique:  true,
+				Columns: []*schema.Column{SharingSharedLinkPolicySetsColumns[6], SharingSharedLinkPolicySetsColumns[7]},
+			},
+			{
+				Name:    "sharedlinkpolicyset_policy_set_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharingSharedLinkPolicySetsColumns[7]},
+			},
+		},
+	}
+	// SharingWebhooksColumns holds the columns for the "sharing_webhooks" table.
+	SharingWebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Webhook name"},
+		{Name: "url", Type: field.TypeString, Size: 2048, Comment: "Endpoint URL receiving the events"},
+		{Name: "secret", Type: field.TypeString, Size: 255, Comment: "Shared secret used to sign payloads (HMAC-SHA256)"},
+		{Name: "events", Type: field.TypeJSON, Nullable: true, Comment: "Subscribed event types (empty means all events)"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether deliveries are sent to this webhook", Default: true},
+	}
+	// SharingWebhooksTable holds the schema information for the "sharing_webhooks" table.
+	SharingWebhooksTable = &schema.Table{
+		Name:       "sharing_webhooks",
+		Columns:    SharingWebhooksColumns,
+		PrimaryKey: []*schema.Column{SharingWebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SharingWebhooksColumns[6], SharingWebhooksColumns[7]},
+			},
+			{
+				Name:    "webhook_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{SharingWebhooksColumns[6], SharingWebhooksColumns[11]},
+			},
+		},
+	}
+	// SharingWebhookDeliveriesColumns holds the columns for the "sharing_webhook_deliveries" table.
+	SharingWebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "webhook_id", Type: field.TypeString, Size: 36, Comment: "FK to webhook.id"},
+		{Name: "event_id", Type: field.TypeString, Size: 36, Comment: "ID of the event being delivered"},
+		{Name: "event_type", Type: field.TypeString, Size: 64, Comment: "Event type, e.g. share.viewed"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "JSON payload sent to the endpoint"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Delivery status", Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Number of delivery attempts made", Default: 0},
+		{Name: "response_status", Type: field.TypeInt32, Nullable: true, Comment: "HTTP status code of the last attempt"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Error of the last failed attempt"},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true, Comment: "When the next retry is due (PENDING only)"},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true, Comment: "When the delivery succeeded"},
+	}
+	// SharingWebhookDeliveriesTable holds the schema information for the "sharing_webhook_deliveries" table.
+	SharingWebhookDeliveriesTable = &schema.Table{
+		Name:       "sharing_webhook_deliveries",
+		Columns:    SharingWebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{SharingWebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SharingWebhookDeliveriesColumns[5], SharingWebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{SharingWebhookDeliveriesColumns[9], SharingWebhookDeliveriesColumns[13]},
+			},
+			{
+				Name:    "webhookdelivery_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharingWebhookDeliveriesColumns[4]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		SharingEmailTemplatesTable,
+		SharingNotificationPreferencesTable,
+		SharingPolicySetsTable,
+		SharingRiskSettingsTable,
+		SharingShareAccessEventsTable,
 		SharingSharePoliciesTable,
 		SharingSharedLinksTable,
+		SharingSharedLinkPolicySetsTable,
+		SharingWebhooksTable,
+		SharingWebhookDeliveriesTable,
 	}
 )
 
@@ -145,10 +417,31 @@ func init() {
 	SharingEmailTemplatesTable.Annotation = &entsql.Annotation{
 		Table: "sharing_email_templates",
 	}
+	SharingNotificationPreferencesTable.Annotation = &entsql.Annotation{
+		Table: "sharing_notification_preferences",
+	}
+	SharingPolicySetsTable.Annotation = &entsql.Annotation{
+		Table: "sharing_policy_sets",
+	}
+	SharingRiskSettingsTable.Annotation = &entsql.Annotation{
+		Table: "sharing_risk_settings",
+	}
+	SharingShareAccessEventsTable.Annotation = &entsql.Annotation{
+		Table: "sharing_share_access_events",
+	}
 	SharingSharePoliciesTable.Annotation = &entsql.Annotation{
 		Table: "sharing_share_policies",
 	}
 	SharingSharedLinksTable.Annotation = &entsql.Annotation{
 		Table: "sharing_shared_links",
 	}
+	SharingSharedLinkPolicySetsTable.Annotation = &entsql.Annotation{
+		Table: "sharing_shared_link_policy_sets",
+	}
+	SharingWebhooksTable.Annotation = &entsql.Annotation{
+		Table: "sharing_webhooks",
+	}
+	SharingWebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "sharing_webhook_deliveries",
+	}
 }
@@ -12,9 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhookdelivery"
 )
 
 const (
@@ -26,9 +34,16 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeEmailTemplate = "EmailTemplate"
-	TypeSharePolicy   = "SharePolicy"
-	TypeSharedLink    = "SharedLink"
+	TypeEmailTemplate          = "EmailTemplate"
+	TypeNotificationPreference = "NotificationPreference"
+	TypePolicySet              = "PolicySet"
+	TypeRiskSettings           = "RiskSettings"
+	TypeShareAccessEvent       = "ShareAccessEvent"
+	TypeSharePolicy            = "SharePolicy"
+	TypeSharedLink             = "SharedLink"
+	TypeSharedLinkPolicySet    = "SharedLinkPolicySet"
+	TypeWebhook                = "Webhook"
+	TypeWebhookDelivery        = "WebhookDelivery"
 )
 
 // EmailTemplateMutation represents an operation that mutates the EmailTemplate nodes in the graph.
@@ -47,8 +62,10 @@ type EmailTemplateMutation struct {
 	tenant_id     *uint32
 	addtenant_id  *int32
 	name          *string
+	kind          *emailtemplate.Kind
 	subject       *string
 	html_body     *string
+	text_body     *string
 	is_default    *bool
 	clearedFields map[string]struct{}
 	done          bool
@@ -553,6 +570,42 @@ func (m *EmailTemplateMutation) ResetName() {
 	m.name = nil
 }
 
+// SetKind sets the "kind" field.
+func (m *EmailTemplateMutation) SetKind(e emailtemplate.Kind) {
+	m.kind = &e
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *EmailTemplateMutation) Kind() (r emailtemplate.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the EmailTemplate entity.
+// If the EmailTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailTemplateMutation) OldKind(ctx context.Context) (v emailtemplate.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *EmailTemplateMutation) ResetKind() {
+	m.kind = nil
+}
+
 // SetSubject sets the "subject" field.
 func (m *EmailTemplateMutation) SetSubject(s string) {
 	m.subject = &s
@@ -625,6 +678,55 @@ func (m *EmailTemplateMutation) ResetHTMLBody() {
 	m.html_body = nil
 }
 
+// SetTextBody sets the "text_body" field.
+func (m *EmailTemplateMutation) SetTextBody(s string) {
+	m.text_body = &s
+}
+
+// TextBody returns the value of the "text_body" field in the mutation.
+func (m *EmailTemplateMutation) TextBody() (r string, exists bool) {
+	v := m.text_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextBody returns the old "text_body" field's value of the EmailTemplate entity.
+// If the EmailTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailTemplateMutation) OldTextBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextBody: %w", err)
+	}
+	return oldValue.TextBody, nil
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (m *EmailTemplateMutation) ClearTextBody() {
+	m.text_body = nil
+	m.clearedFields[emailtemplate.FieldTextBody] = struct{}{}
+}
+
+// TextBodyCleared returns if the "text_body" field was cleared in this mutation.
+func (m *EmailTemplateMutation) TextBodyCleared() bool {
+	_, ok := m.clearedFields[emailtemplate.FieldTextBody]
+	return ok
+}
+
+// ResetTextBody resets all changes to the "text_body" field.
+func (m *EmailTemplateMutation) ResetTextBody() {
+	m.text_body = nil
+	delete(m.clearedFields, emailtemplate.FieldTextBody)
+}
+
 // SetIsDefault sets the "is_default" field.
 func (m *EmailTemplateMutation) SetIsDefault(b bool) {
 	m.is_default = &b
@@ -695,7 +797,7 @@ func (m *EmailTemplateMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EmailTemplateMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.create_by != nil {
 		fields = append(fields, emailtemplate.FieldCreateBy)
 	}
@@ -717,12 +819,18 @@ func (m *EmailTemplateMutation) Fields() []string {
 	if m.name != nil {
 		fields = append(fields, emailtemplate.FieldName)
 	}
+	if m.kind != nil {
+		fields = append(fields, emailtemplate.FieldKind)
+	}
 	if m.subject != nil {
 		fields = append(fields, emailtemplate.FieldSubject)
 	}
 	if m.html_body != nil {
 		fields = append(fields, emailtemplate.FieldHTMLBody)
 	}
+	if m.text_body != nil {
+		fields = append(fields, emailtemplate.FieldTextBody)
+	}
 	if m.is_default != nil {
 		fields = append(fields, emailtemplate.FieldIsDefault)
 	}
@@ -748,10 +856,14 @@ func (m *EmailTemplateMutation) Field(name string) (ent.Value, bool) {
 		return m.TenantID()
 	case emailtemplate.FieldName:
 		return m.Name()
+	case emailtemplate.FieldKind:
+		return m.Kind()
 	case emailtemplate.FieldSubject:
 		return m.Subject()
 	case emailtemplate.FieldHTMLBody:
 		return m.HTMLBody()
+	case emailtemplate.FieldTextBody:
+		return m.TextBody()
 	case emailtemplate.FieldIsDefault:
 		return m.IsDefault()
 	}
@@ -777,10 +889,14 @@ func (m *EmailTemplateMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldTenantID(ctx)
 	case emailtemplate.FieldName:
 		return m.OldName(ctx)
+	case emailtemplate.FieldKind:
+		return m.OldKind(ctx)
 	case emailtemplate.FieldSubject:
 		return m.OldSubject(ctx)
 	case emailtemplate.FieldHTMLBody:
 		return m.OldHTMLBody(ctx)
+	case emailtemplate.FieldTextBody:
+		return m.OldTextBody(ctx)
 	case emailtemplate.FieldIsDefault:
 		return m.OldIsDefault(ctx)
 	}
@@ -841,6 +957,13 @@ func (m *EmailTemplateMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case emailtemplate.FieldKind:
+		v, ok := value.(emailtemplate.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
 	case emailtemplate.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
@@ -855,6 +978,13 @@ func (m *EmailTemplateMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetHTMLBody(v)
 		return nil
+	case emailtemplate.FieldTextBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextBody(v)
+		return nil
 	case emailtemplate.FieldIsDefault:
 		v, ok := value.(bool)
 		if !ok {
@@ -949,6 +1079,9 @@ func (m *EmailTemplateMutation) ClearedFields() []string {
 	if m.FieldCleared(emailtemplate.FieldTenantID) {
 		fields = append(fields, emailtemplate.FieldTenantID)
 	}
+	if m.FieldCleared(emailtemplate.FieldTextBody) {
+		fields = append(fields, emailtemplate.FieldTextBody)
+	}
 	return fields
 }
 
@@ -981,6 +1114,9 @@ func (m *EmailTemplateMutation) ClearField(name string) error {
 	case emailtemplate.FieldTenantID:
 		m.ClearTenantID()
 		return nil
+	case emailtemplate.FieldTextBody:
+		m.ClearTextBody()
+		return nil
 	}
 	return fmt.Errorf("unknown EmailTemplate nullable field %s", name)
 }
@@ -1010,12 +1146,18 @@ func (m *EmailTemplateMutation) ResetField(name string) error {
 	case emailtemplate.FieldName:
 		m.ResetName()
 		return nil
+	case emailtemplate.FieldKind:
+		m.ResetKind()
+		return nil
 	case emailtemplate.FieldSubject:
 		m.ResetSubject()
 		return nil
 	case emailtemplate.FieldHTMLBody:
 		m.ResetHTMLBody()
 		return nil
+	case emailtemplate.FieldTextBody:
+		m.ResetTextBody()
+		return nil
 	case emailtemplate.FieldIsDefault:
 		m.ResetIsDefault()
 		return nil
@@ -1071,41 +1213,39 @@ func (m *EmailTemplateMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown EmailTemplate edge %s", name)
 }
 
-// SharePolicyMutation represents an operation that mutates the SharePolicy nodes in the graph.
-type SharePolicyMutation struct {
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	create_by     *uint32
-	addcreate_by  *int32
-	create_time   *time.Time
-	update_time   *time.Time
-	delete_time   *time.Time
-	tenant_id     *uint32
-	addtenant_id  *int32
-	share_link_id *string
-	_type         *sharepolicy.Type
-	method        *sharepolicy.Method
-	value         *string
-	reason        *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*SharePolicy, error)
-	predicates    []predicate.SharePolicy
-}
-
-var _ ent.Mutation = (*SharePolicyMutation)(nil)
-
-// sharepolicyOption allows management of the mutation configuration using functional options.
-type sharepolicyOption func(*SharePolicyMutation)
-
-// newSharePolicyMutation creates new mutation for the SharePolicy entity.
-func newSharePolicyMutation(c config, op Op, opts ...sharepolicyOption) *SharePolicyMutation {
-	m := &SharePolicyMutation{
+	op             Op
+	typ            string
+	id             *string
+	create_time    *time.Time
+	update_time    *time.Time
+	delete_time    *time.Time
+	tenant_id      *uint32
+	addtenant_id   *int32
+	user_id        *uint32
+	adduser_id     *int32
+	notify_viewed  *bool
+	notify_denied  *bool
+	notify_expired *bool
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*NotificationPreference, error)
+	predicates     []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSharePolicy,
+		typ:           TypeNotificationPreference,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1114,20 +1254,20 @@ func newSharePolicyMutation(c config, op Op, opts ...sharepolicyOption) *SharePo
 	return m
 }
 
-// withSharePolicyID sets the ID field of the mutation.
-func withSharePolicyID(id string) sharepolicyOption {
-	return func(m *SharePolicyMutation) {
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id string) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SharePolicy
+			value *NotificationPreference
 		)
-		m.oldValue = func(ctx context.Context) (*SharePolicy, error) {
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SharePolicy.Get(ctx, id)
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1136,10 +1276,10 @@ func withSharePolicyID(id string) sharepolicyOption {
 	}
 }
 
-// withSharePolicy sets the old SharePolicy of the mutation.
-func withSharePolicy(node *SharePolicy) sharepolicyOption {
-	return func(m *SharePolicyMutation) {
-		m.oldValue = func(context.Context) (*SharePolicy, error) {
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1148,7 +1288,7 @@ func withSharePolicy(node *SharePolicy) sharepolicyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SharePolicyMutation) Client() *Client {
+func (m NotificationPreferenceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1156,7 +1296,7 @@ func (m SharePolicyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SharePolicyMutation) Tx() (*Tx, error) {
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1166,14 +1306,14 @@ func (m SharePolicyMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SharePolicy entities.
-func (m *SharePolicyMutation) SetID(id string) {
+// operation is only accepted on creation of NotificationPreference entities.
+func (m *NotificationPreferenceMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SharePolicyMutation) ID() (id string, exists bool) {
+func (m *NotificationPreferenceMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1184,7 +1324,7 @@ func (m *SharePolicyMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SharePolicyMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1193,89 +1333,19 @@ func (m *SharePolicyMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SharePolicy.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *SharePolicyMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
-}
-
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *SharePolicyMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreateBy returns the old "create_by" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
-	}
-	return oldValue.CreateBy, nil
-}
-
-// AddCreateBy adds u to the "create_by" field.
-func (m *SharePolicyMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
-	} else {
-		m.addcreate_by = &u
-	}
-}
-
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *SharePolicyMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *SharePolicyMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[sharepolicy.FieldCreateBy] = struct{}{}
-}
-
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *SharePolicyMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldCreateBy]
-	return ok
-}
-
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *SharePolicyMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, sharepolicy.FieldCreateBy)
-}
-
 // SetCreateTime sets the "create_time" field.
-func (m *SharePolicyMutation) SetCreateTime(t time.Time) {
+func (m *NotificationPreferenceMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SharePolicyMutation) CreateTime() (r time.Time, exists bool) {
+func (m *NotificationPreferenceMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1283,10 +1353,10 @@ func (m *SharePolicyMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationPreferenceMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1301,30 +1371,30 @@ func (m *SharePolicyMutation) OldCreateTime(ctx context.Context) (v *time.Time,
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *SharePolicyMutation) ClearCreateTime() {
+func (m *NotificationPreferenceMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[sharepolicy.FieldCreateTime] = struct{}{}
+	m.clearedFields[notificationpreference.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *SharePolicyMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldCreateTime]
+func (m *NotificationPreferenceMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *SharePolicyMutation) ResetCreateTime() {
+func (m *NotificationPreferenceMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, sharepolicy.FieldCreateTime)
+	delete(m.clearedFields, notificationpreference.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *SharePolicyMutation) SetUpdateTime(t time.Time) {
+func (m *NotificationPreferenceMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SharePolicyMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *NotificationPreferenceMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1332,10 +1402,10 @@ func (m *SharePolicyMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationPreferenceMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1350,30 +1420,30 @@ func (m *SharePolicyMutation) OldUpdateTime(ctx context.Context) (v *time.Time,
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *SharePolicyMutation) ClearUpdateTime() {
+func (m *NotificationPreferenceMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[sharepolicy.FieldUpdateTime] = struct{}{}
+	m.clearedFields[notificationpreference.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *SharePolicyMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldUpdateTime]
+func (m *NotificationPreferenceMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SharePolicyMutation) ResetUpdateTime() {
+func (m *NotificationPreferenceMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, sharepolicy.FieldUpdateTime)
+	delete(m.clearedFields, notificationpreference.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *SharePolicyMutation) SetDeleteTime(t time.Time) {
+func (m *NotificationPreferenceMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *SharePolicyMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *NotificationPreferenceMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -1381,10 +1451,10 @@ func (m *SharePolicyMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationPreferenceMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -1399,31 +1469,31 @@ func (m *SharePolicyMutation) OldDeleteTime(ctx context.Context) (v *time.Time,
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *SharePolicyMutation) ClearDeleteTime() {
+func (m *NotificationPreferenceMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[sharepolicy.FieldDeleteTime] = struct{}{}
+	m.clearedFields[notificationpreference.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *SharePolicyMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldDeleteTime]
+func (m *NotificationPreferenceMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *SharePolicyMutation) ResetDeleteTime() {
+func (m *NotificationPreferenceMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, sharepolicy.FieldDeleteTime)
+	delete(m.clearedFields, notificationpreference.FieldDeleteTime)
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *SharePolicyMutation) SetTenantID(u uint32) {
+func (m *NotificationPreferenceMutation) SetTenantID(u uint32) {
 	m.tenant_id = &u
 	m.addtenant_id = nil
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *SharePolicyMutation) TenantID() (r uint32, exists bool) {
+func (m *NotificationPreferenceMutation) TenantID() (r uint32, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -1431,10 +1501,10 @@ func (m *SharePolicyMutation) TenantID() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *NotificationPreferenceMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -1449,7 +1519,7 @@ func (m *SharePolicyMutation) OldTenantID(ctx context.Context) (v *uint32, err e
 }
 
 // AddTenantID adds u to the "tenant_id" field.
-func (m *SharePolicyMutation) AddTenantID(u int32) {
+func (m *NotificationPreferenceMutation) AddTenantID(u int32) {
 	if m.addtenant_id != nil {
 		*m.addtenant_id += u
 	} else {
@@ -1458,7 +1528,7 @@ func (m *SharePolicyMutation) AddTenantID(u int32) {
 }
 
 // AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *SharePolicyMutation) AddedTenantID() (r int32, exists bool) {
+func (m *NotificationPreferenceMutation) AddedTenantID() (r int32, exists bool) {
 	v := m.addtenant_id
 	if v == nil {
 		return
@@ -1467,227 +1537,198 @@ func (m *SharePolicyMutation) AddedTenantID() (r int32, exists bool) {
 }
 
 // ClearTenantID clears the value of the "tenant_id" field.
-func (m *SharePolicyMutation) ClearTenantID() {
+func (m *NotificationPreferenceMutation) ClearTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	m.clearedFields[sharepolicy.FieldTenantID] = struct{}{}
+	m.clearedFields[notificationpreference.FieldTenantID] = struct{}{}
 }
 
 // TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *SharePolicyMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldTenantID]
+func (m *NotificationPreferenceMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldTenantID]
 	return ok
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *SharePolicyMutation) ResetTenantID() {
+func (m *NotificationPreferenceMutation) ResetTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	delete(m.clearedFields, sharepolicy.FieldTenantID)
+	delete(m.clearedFields, notificationpreference.FieldTenantID)
 }
 
-// SetShareLinkID sets the "share_link_id" field.
-func (m *SharePolicyMutation) SetShareLinkID(s string) {
-	m.share_link_id = &s
+// SetUserID sets the "user_id" field.
+func (m *NotificationPreferenceMutation) SetUserID(u uint32) {
+	m.user_id = &u
+	m.adduser_id = nil
 }
 
-// ShareLinkID returns the value of the "share_link_id" field in the mutation.
-func (m *SharePolicyMutation) ShareLinkID() (r string, exists bool) {
-	v := m.share_link_id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationPreferenceMutation) UserID() (r uint32, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShareLinkID returns the old "share_link_id" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldShareLinkID(ctx context.Context) (v string, err error) {
+func (m *NotificationPreferenceMutation) OldUserID(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShareLinkID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShareLinkID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShareLinkID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ShareLinkID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetShareLinkID resets all changes to the "share_link_id" field.
-func (m *SharePolicyMutation) ResetShareLinkID() {
-	m.share_link_id = nil
-}
-
-// SetType sets the "type" field.
-func (m *SharePolicyMutation) SetType(s sharepolicy.Type) {
-	m._type = &s
+// AddUserID adds u to the "user_id" field.
+func (m *NotificationPreferenceMutation) AddUserID(u int32) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *SharePolicyMutation) GetType() (r sharepolicy.Type, exists bool) {
-	v := m._type
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *NotificationPreferenceMutation) AddedUserID() (r int32, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldType(ctx context.Context) (v sharepolicy.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *SharePolicyMutation) ResetType() {
-	m._type = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationPreferenceMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetMethod sets the "method" field.
-func (m *SharePolicyMutation) SetMethod(s sharepolicy.Method) {
-	m.method = &s
+// SetNotifyViewed sets the "notify_viewed" field.
+func (m *NotificationPreferenceMutation) SetNotifyViewed(b bool) {
+	m.notify_viewed = &b
 }
 
-// Method returns the value of the "method" field in the mutation.
-func (m *SharePolicyMutation) Method() (r sharepolicy.Method, exists bool) {
-	v := m.method
+// NotifyViewed returns the value of the "notify_viewed" field in the mutation.
+func (m *NotificationPreferenceMutation) NotifyViewed() (r bool, exists bool) {
+	v := m.notify_viewed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMethod returns the old "method" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifyViewed returns the old "notify_viewed" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldMethod(ctx context.Context) (v sharepolicy.Method, err error) {
+func (m *NotificationPreferenceMutation) OldNotifyViewed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifyViewed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMethod requires an ID field in the mutation")
+		return v, errors.New("OldNotifyViewed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifyViewed: %w", err)
 	}
-	return oldValue.Method, nil
+	return oldValue.NotifyViewed, nil
 }
 
-// ResetMethod resets all changes to the "method" field.
-func (m *SharePolicyMutation) ResetMethod() {
-	m.method = nil
+// ResetNotifyViewed resets all changes to the "notify_viewed" field.
+func (m *NotificationPreferenceMutation) ResetNotifyViewed() {
+	m.notify_viewed = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SharePolicyMutation) SetValue(s string) {
-	m.value = &s
+// SetNotifyDenied sets the "notify_denied" field.
+func (m *NotificationPreferenceMutation) SetNotifyDenied(b bool) {
+	m.notify_denied = &b
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SharePolicyMutation) Value() (r string, exists bool) {
-	v := m.value
+// NotifyDenied returns the value of the "notify_denied" field in the mutation.
+func (m *NotificationPreferenceMutation) NotifyDenied() (r bool, exists bool) {
+	v := m.notify_denied
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifyDenied returns the old "notify_denied" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *NotificationPreferenceMutation) OldNotifyDenied(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifyDenied is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldNotifyDenied requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifyDenied: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.NotifyDenied, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SharePolicyMutation) ResetValue() {
-	m.value = nil
+// ResetNotifyDenied resets all changes to the "notify_denied" field.
+func (m *NotificationPreferenceMutation) ResetNotifyDenied() {
+	m.notify_denied = nil
 }
 
-// SetReason sets the "reason" field.
-func (m *SharePolicyMutation) SetReason(s string) {
-	m.reason = &s
+// SetNotifyExpired sets the "notify_expired" field.
+func (m *NotificationPreferenceMutation) SetNotifyExpired(b bool) {
+	m.notify_expired = &b
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *SharePolicyMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// NotifyExpired returns the value of the "notify_expired" field in the mutation.
+func (m *NotificationPreferenceMutation) NotifyExpired() (r bool, exists bool) {
+	v := m.notify_expired
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the SharePolicy entity.
-// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifyExpired returns the old "notify_expired" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharePolicyMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *NotificationPreferenceMutation) OldNotifyExpired(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifyExpired is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldNotifyExpired requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifyExpired: %w", err)
 	}
-	return oldValue.Reason, nil
-}
-
-// ClearReason clears the value of the "reason" field.
-func (m *SharePolicyMutation) ClearReason() {
-	m.reason = nil
-	m.clearedFields[sharepolicy.FieldReason] = struct{}{}
-}
-
-// ReasonCleared returns if the "reason" field was cleared in this mutation.
-func (m *SharePolicyMutation) ReasonCleared() bool {
-	_, ok := m.clearedFields[sharepolicy.FieldReason]
-	return ok
+	return oldValue.NotifyExpired, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *SharePolicyMutation) ResetReason() {
-	m.reason = nil
-	delete(m.clearedFields, sharepolicy.FieldReason)
+// ResetNotifyExpired resets all changes to the "notify_expired" field.
+func (m *NotificationPreferenceMutation) ResetNotifyExpired() {
+	m.notify_expired = nil
 }
 
-// Where appends a list predicates to the SharePolicyMutation builder.
-func (m *SharePolicyMutation) Where(ps ...predicate.SharePolicy) {
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SharePolicyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationPreferenceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SharePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SharePolicy, len(ps))
+func (m *NotificationPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationPreference, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1695,54 +1736,48 @@ func (m *SharePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SharePolicyMutation) Op() Op {
+func (m *NotificationPreferenceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SharePolicyMutation) SetOp(op Op) {
+func (m *NotificationPreferenceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SharePolicy).
-func (m *SharePolicyMutation) Type() string {
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SharePolicyMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.create_by != nil {
-		fields = append(fields, sharepolicy.FieldCreateBy)
-	}
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, sharepolicy.FieldCreateTime)
+		fields = append(fields, notificationpreference.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, sharepolicy.FieldUpdateTime)
+		fields = append(fields, notificationpreference.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, sharepolicy.FieldDeleteTime)
+		fields = append(fields, notificationpreference.FieldDeleteTime)
 	}
 	if m.tenant_id != nil {
-		fields = append(fields, sharepolicy.FieldTenantID)
-	}
-	if m.share_link_id != nil {
-		fields = append(fields, sharepolicy.FieldShareLinkID)
+		fields = append(fields, notificationpreference.FieldTenantID)
 	}
-	if m._type != nil {
-		fields = append(fields, sharepolicy.FieldType)
+	if m.user_id != nil {
+		fields = append(fields, notificationpreference.FieldUserID)
 	}
-	if m.method != nil {
-		fields = append(fields, sharepolicy.FieldMethod)
+	if m.notify_viewed != nil {
+		fields = append(fields, notificationpreference.FieldNotifyViewed)
 	}
-	if m.value != nil {
-		fields = append(fields, sharepolicy.FieldValue)
+	if m.notify_denied != nil {
+		fields = append(fields, notificationpreference.FieldNotifyDenied)
 	}
-	if m.reason != nil {
-		fields = append(fields, sharepolicy.FieldReason)
+	if m.notify_expired != nil {
+		fields = append(fields, notificationpreference.FieldNotifyExpired)
 	}
 	return fields
 }
@@ -1750,28 +1785,24 @@ func (m *SharePolicyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SharePolicyMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		return m.CreateBy()
-	case sharepolicy.FieldCreateTime:
+	case notificationpreference.FieldCreateTime:
 		return m.CreateTime()
-	case sharepolicy.FieldUpdateTime:
+	case notificationpreference.FieldUpdateTime:
 		return m.UpdateTime()
-	case sharepolicy.FieldDeleteTime:
+	case notificationpreference.FieldDeleteTime:
 		return m.DeleteTime()
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		return m.TenantID()
-	case sharepolicy.FieldShareLinkID:
-		return m.ShareLinkID()
-	case sharepolicy.FieldType:
-		return m.GetType()
-	case sharepolicy.FieldMethod:
-		return m.Method()
-	case sharepolicy.FieldValue:
-		return m.Value()
-	case sharepolicy.FieldReason:
-		return m.Reason()
+	case notificationpreference.FieldUserID:
+		return m.UserID()
+	case notificationpreference.FieldNotifyViewed:
+		return m.NotifyViewed()
+	case notificationpreference.FieldNotifyDenied:
+		return m.NotifyDenied()
+	case notificationpreference.FieldNotifyExpired:
+		return m.NotifyExpired()
 	}
 	return nil, false
 }
@@ -1779,120 +1810,102 @@ func (m *SharePolicyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SharePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case sharepolicy.FieldCreateTime:
+	case notificationpreference.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case sharepolicy.FieldUpdateTime:
+	case notificationpreference.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case sharepolicy.FieldDeleteTime:
+	case notificationpreference.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case sharepolicy.FieldShareLinkID:
-		return m.OldShareLinkID(ctx)
-	case sharepolicy.FieldType:
-		return m.OldType(ctx)
-	case sharepolicy.FieldMethod:
-		return m.OldMethod(ctx)
-	case sharepolicy.FieldValue:
-		return m.OldValue(ctx)
-	case sharepolicy.FieldReason:
-		return m.OldReason(ctx)
+	case notificationpreference.FieldUserID:
+		return m.OldUserID(ctx)
+	case notificationpreference.FieldNotifyViewed:
+		return m.OldNotifyViewed(ctx)
+	case notificationpreference.FieldNotifyDenied:
+		return m.OldNotifyDenied(ctx)
+	case notificationpreference.FieldNotifyExpired:
+		return m.OldNotifyExpired(ctx)
 	}
-	return nil, fmt.Errorf("unknown SharePolicy field %s", name)
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharePolicyMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case sharepolicy.FieldCreateTime:
+	case notificationpreference.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case sharepolicy.FieldUpdateTime:
+	case notificationpreference.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case sharepolicy.FieldDeleteTime:
+	case notificationpreference.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case sharepolicy.FieldShareLinkID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetShareLinkID(v)
-		return nil
-	case sharepolicy.FieldType:
-		v, ok := value.(sharepolicy.Type)
+	case notificationpreference.FieldUserID:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case sharepolicy.FieldMethod:
-		v, ok := value.(sharepolicy.Method)
+	case notificationpreference.FieldNotifyViewed:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMethod(v)
+		m.SetNotifyViewed(v)
 		return nil
-	case sharepolicy.FieldValue:
-		v, ok := value.(string)
+	case notificationpreference.FieldNotifyDenied:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetNotifyDenied(v)
 		return nil
-	case sharepolicy.FieldReason:
-		v, ok := value.(string)
+	case notificationpreference.FieldNotifyExpired:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetNotifyExpired(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SharePolicy field %s", name)
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SharePolicyMutation) AddedFields() []string {
+func (m *NotificationPreferenceMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, sharepolicy.FieldCreateBy)
-	}
 	if m.addtenant_id != nil {
-		fields = append(fields, sharepolicy.FieldTenantID)
+		fields = append(fields, notificationpreference.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, notificationpreference.FieldUserID)
 	}
 	return fields
 }
@@ -1900,12 +1913,12 @@ func (m *SharePolicyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SharePolicyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		return m.AddedCreateBy()
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		return m.AddedTenantID()
+	case notificationpreference.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -1913,213 +1926,188 @@ func (m *SharePolicyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharePolicyMutation) AddField(name string, value ent.Value) error {
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sharepolicy.FieldCreateBy:
+	case notificationpreference.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreateBy(v)
+		m.AddTenantID(v)
 		return nil
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldUserID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTenantID(v)
+		m.AddUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SharePolicy numeric field %s", name)
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SharePolicyMutation) ClearedFields() []string {
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(sharepolicy.FieldCreateBy) {
-		fields = append(fields, sharepolicy.FieldCreateBy)
-	}
-	if m.FieldCleared(sharepolicy.FieldCreateTime) {
-		fields = append(fields, sharepolicy.FieldCreateTime)
+	if m.FieldCleared(notificationpreference.FieldCreateTime) {
+		fields = append(fields, notificationpreference.FieldCreateTime)
 	}
-	if m.FieldCleared(sharepolicy.FieldUpdateTime) {
-		fields = append(fields, sharepolicy.FieldUpdateTime)
-	}
-	if m.FieldCleared(sharepolicy.FieldDeleteTime) {
-		fields = append(fields, sharepolicy.FieldDeleteTime)
+	if m.FieldCleared(notificationpreference.FieldUpdateTime) {
+		fields = append(fields, notificationpreference.FieldUpdateTime)
 	}
-	if m.FieldCleared(sharepolicy.FieldTenantID) {
-		fields = append(fields, sharepolicy.FieldTenantID)
+	if m.FieldCleared(notificationpreference.FieldDeleteTime) {
+		fields = append(fields, notificationpreference.FieldDeleteTime)
 	}
-	if m.FieldCleared(sharepolicy.FieldReason) {
-		fields = append(fields, sharepolicy.FieldReason)
+	if m.FieldCleared(notificationpreference.FieldTenantID) {
+		fields = append(fields, notificationpreference.FieldTenantID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SharePolicyMutation) FieldCleared(name string) bool {
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SharePolicyMutation) ClearField(name string) error {
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case sharepolicy.FieldCreateTime:
+	case notificationpreference.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case sharepolicy.FieldUpdateTime:
+	case notificationpreference.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case sharepolicy.FieldDeleteTime:
+	case notificationpreference.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		m.ClearTenantID()
 		return nil
-	case sharepolicy.FieldReason:
-		m.ClearReason()
-		return nil
 	}
-	return fmt.Errorf("unknown SharePolicy nullable field %s", name)
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SharePolicyMutation) ResetField(name string) error {
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
 	switch name {
-	case sharepolicy.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case sharepolicy.FieldCreateTime:
+	case notificationpreference.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case sharepolicy.FieldUpdateTime:
+	case notificationpreference.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case sharepolicy.FieldDeleteTime:
+	case notificationpreference.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case sharepolicy.FieldTenantID:
+	case notificationpreference.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case sharepolicy.FieldShareLinkID:
-		m.ResetShareLinkID()
-		return nil
-	case sharepolicy.FieldType:
-		m.ResetType()
+	case notificationpreference.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case sharepolicy.FieldMethod:
-		m.ResetMethod()
+	case notificationpreference.FieldNotifyViewed:
+		m.ResetNotifyViewed()
 		return nil
-	case sharepolicy.FieldValue:
-		m.ResetValue()
+	case notificationpreference.FieldNotifyDenied:
+		m.ResetNotifyDenied()
 		return nil
-	case sharepolicy.FieldReason:
-		m.ResetReason()
+	case notificationpreference.FieldNotifyExpired:
+		m.ResetNotifyExpired()
 		return nil
 	}
-	return fmt.Errorf("unknown SharePolicy field %s", name)
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SharePolicyMutation) AddedEdges() []string {
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SharePolicyMutation) AddedIDs(name string) []ent.Value {
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SharePolicyMutation) RemovedEdges() []string {
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SharePolicyMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SharePolicyMutation) ClearedEdges() []string {
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SharePolicyMutation) EdgeCleared(name string) bool {
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SharePolicyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SharePolicy unique edge %s", name)
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SharePolicyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SharePolicy edge %s", name)
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
 }
 
-// SharedLinkMutation represents an operation that mutates the SharedLink nodes in the graph.
-type SharedLinkMutation struct {
+// PolicySetMutation represents an operation that mutates the PolicySet nodes in the graph.
+type PolicySetMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	create_by         *uint32
-	addcreate_by      *int32
-	create_time       *time.Time
-	update_time       *time.Time
-	delete_time       *time.Time
-	tenant_id         *uint32
-	addtenant_id      *int32
-	resource_type     *sharedlink.ResourceType
-	resource_id       *string
-	resource_name     *string
-	token             *string
-	encrypted_content *[]byte
-	encryption_nonce  *[]byte
-	recipient_email   *string
-	message           *string
-	template_id       *string
-	viewed            *bool
-	viewed_at         *time.Time
-	viewed_ip         *string
-	revoked           *bool
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*SharedLink, error)
-	predicates        []predicate.SharedLink
-}
-
-var _ ent.Mutation = (*SharedLinkMutation)(nil)
+	op            Op
+	typ           string
+	id            *string
+	create_by     *uint32
+	addcreate_by  *int32
+	update_by     *uint32
+	addupdate_by  *int32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	name          *string
+	description   *string
+	rules         *[]schema.PolicyRule
+	appendrules   []schema.PolicyRule
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PolicySet, error)
+	predicates    []predicate.PolicySet
+}
 
-// sharedlinkOption allows management of the mutation configuration using functional options.
-type sharedlinkOption func(*SharedLinkMutation)
+var _ ent.Mutation = (*PolicySetMutation)(nil)
 
-// newSharedLinkMutation creates new mutation for the SharedLink entity.
-func newSharedLinkMutation(c config, op Op, opts ...sharedlinkOption) *SharedLinkMutation {
-	m := &SharedLinkMutation{
+// policysetOption allows management of the mutation configuration using functional options.
+type policysetOption func(*PolicySetMutation)
+
+// newPolicySetMutation creates new mutation for the PolicySet entity.
+func newPolicySetMutation(c config, op Op, opts ...policysetOption) *PolicySetMutation {
+	m := &PolicySetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSharedLink,
+		typ:           TypePolicySet,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2128,20 +2116,20 @@ func newSharedLinkMutation(c config, op Op, opts ...sharedlinkOption) *SharedLin
 	return m
 }
 
-// withSharedLinkID sets the ID field of the mutation.
-func withSharedLinkID(id string) sharedlinkOption {
-	return func(m *SharedLinkMutation) {
+// withPolicySetID sets the ID field of the mutation.
+func withPolicySetID(id string) policysetOption {
+	return func(m *PolicySetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SharedLink
+			value *PolicySet
 		)
-		m.oldValue = func(ctx context.Context) (*SharedLink, error) {
+		m.oldValue = func(ctx context.Context) (*PolicySet, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SharedLink.Get(ctx, id)
+					value, err = m.Client().PolicySet.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2150,10 +2138,10 @@ func withSharedLinkID(id string) sharedlinkOption {
 	}
 }
 
-// withSharedLink sets the old SharedLink of the mutation.
-func withSharedLink(node *SharedLink) sharedlinkOption {
-	return func(m *SharedLinkMutation) {
-		m.oldValue = func(context.Context) (*SharedLink, error) {
+// withPolicySet sets the old PolicySet of the mutation.
+func withPolicySet(node *PolicySet) policysetOption {
+	return func(m *PolicySetMutation) {
+		m.oldValue = func(context.Context) (*PolicySet, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2162,7 +2150,7 @@ func withSharedLink(node *SharedLink) sharedlinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SharedLinkMutation) Client() *Client {
+func (m PolicySetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2170,7 +2158,7 @@ func (m SharedLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SharedLinkMutation) Tx() (*Tx, error) {
+func (m PolicySetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2180,14 +2168,14 @@ func (m SharedLinkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SharedLink entities.
-func (m *SharedLinkMutation) SetID(id string) {
+// operation is only accepted on creation of PolicySet entities.
+func (m *PolicySetMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SharedLinkMutation) ID() (id string, exists bool) {
+func (m *PolicySetMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2198,7 +2186,7 @@ func (m *SharedLinkMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SharedLinkMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *PolicySetMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2207,20 +2195,20 @@ func (m *SharedLinkMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SharedLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PolicySet.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateBy sets the "create_by" field.
-func (m *SharedLinkMutation) SetCreateBy(u uint32) {
+func (m *PolicySetMutation) SetCreateBy(u uint32) {
 	m.create_by = &u
 	m.addcreate_by = nil
 }
 
 // CreateBy returns the value of the "create_by" field in the mutation.
-func (m *SharedLinkMutation) CreateBy() (r uint32, exists bool) {
+func (m *PolicySetMutation) CreateBy() (r uint32, exists bool) {
 	v := m.create_by
 	if v == nil {
 		return
@@ -2228,10 +2216,10 @@ func (m *SharedLinkMutation) CreateBy() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *PolicySetMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
@@ -2246,7 +2234,7 @@ func (m *SharedLinkMutation) OldCreateBy(ctx context.Context) (v *uint32, err er
 }
 
 // AddCreateBy adds u to the "create_by" field.
-func (m *SharedLinkMutation) AddCreateBy(u int32) {
+func (m *PolicySetMutation) AddCreateBy(u int32) {
 	if m.addcreate_by != nil {
 		*m.addcreate_by += u
 	} else {
@@ -2255,7 +2243,7 @@ func (m *SharedLinkMutation) AddCreateBy(u int32) {
 }
 
 // AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *SharedLinkMutation) AddedCreateBy() (r int32, exists bool) {
+func (m *PolicySetMutation) AddedCreateBy() (r int32, exists bool) {
 	v := m.addcreate_by
 	if v == nil {
 		return
@@ -2264,32 +2252,102 @@ func (m *SharedLinkMutation) AddedCreateBy() (r int32, exists bool) {
 }
 
 // ClearCreateBy clears the value of the "create_by" field.
-func (m *SharedLinkMutation) ClearCreateBy() {
+func (m *PolicySetMutation) ClearCreateBy() {
 	m.create_by = nil
 	m.addcreate_by = nil
-	m.clearedFields[sharedlink.FieldCreateBy] = struct{}{}
+	m.clearedFields[policyset.FieldCreateBy] = struct{}{}
 }
 
 // CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *SharedLinkMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldCreateBy]
+func (m *PolicySetMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldCreateBy]
 	return ok
 }
 
 // ResetCreateBy resets all changes to the "create_by" field.
-func (m *SharedLinkMutation) ResetCreateBy() {
+func (m *PolicySetMutation) ResetCreateBy() {
 	m.create_by = nil
 	m.addcreate_by = nil
-	delete(m.clearedFields, sharedlink.FieldCreateBy)
+	delete(m.clearedFields, policyset.FieldCreateBy)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *PolicySetMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *PolicySetMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PolicySetMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// AddUpdateBy adds u to the "update_by" field.
+func (m *PolicySetMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
+}
+
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *PolicySetMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *PolicySetMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[policyset.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *PolicySetMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *PolicySetMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, policyset.FieldUpdateBy)
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *SharedLinkMutation) SetCreateTime(t time.Time) {
+func (m *PolicySetMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SharedLinkMutation) CreateTime() (r time.Time, exists bool) {
+func (m *PolicySetMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2297,10 +2355,10 @@ func (m *SharedLinkMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *PolicySetMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2315,30 +2373,30 @@ func (m *SharedLinkMutation) OldCreateTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *SharedLinkMutation) ClearCreateTime() {
+func (m *PolicySetMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[sharedlink.FieldCreateTime] = struct{}{}
+	m.clearedFields[policyset.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *SharedLinkMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldCreateTime]
+func (m *PolicySetMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *SharedLinkMutation) ResetCreateTime() {
+func (m *PolicySetMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, sharedlink.FieldCreateTime)
+	delete(m.clearedFields, policyset.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *SharedLinkMutation) SetUpdateTime(t time.Time) {
+func (m *PolicySetMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SharedLinkMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *PolicySetMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2346,10 +2404,10 @@ func (m *SharedLinkMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *PolicySetMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2364,30 +2422,30 @@ func (m *SharedLinkMutation) OldUpdateTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *SharedLinkMutation) ClearUpdateTime() {
+func (m *PolicySetMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[sharedlink.FieldUpdateTime] = struct{}{}
+	m.clearedFields[policyset.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *SharedLinkMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldUpdateTime]
+func (m *PolicySetMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SharedLinkMutation) ResetUpdateTime() {
+func (m *PolicySetMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, sharedlink.FieldUpdateTime)
+	delete(m.clearedFields, policyset.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *SharedLinkMutation) SetDeleteTime(t time.Time) {
+func (m *PolicySetMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *SharedLinkMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *PolicySetMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -2395,10 +2453,10 @@ func (m *SharedLinkMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *PolicySetMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -2413,31 +2471,31 @@ func (m *SharedLinkMutation) OldDeleteTime(ctx context.Context) (v *time.Time, e
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *SharedLinkMutation) ClearDeleteTime() {
+func (m *PolicySetMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[sharedlink.FieldDeleteTime] = struct{}{}
+	m.clearedFields[policyset.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *SharedLinkMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldDeleteTime]
+func (m *PolicySetMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *SharedLinkMutation) ResetDeleteTime() {
+func (m *PolicySetMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, sharedlink.FieldDeleteTime)
+	delete(m.clearedFields, policyset.FieldDeleteTime)
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *SharedLinkMutation) SetTenantID(u uint32) {
+func (m *PolicySetMutation) SetTenantID(u uint32) {
 	m.tenant_id = &u
 	m.addtenant_id = nil
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *SharedLinkMutation) TenantID() (r uint32, exists bool) {
+func (m *PolicySetMutation) TenantID() (r uint32, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -2445,10 +2503,10 @@ func (m *SharedLinkMutation) TenantID() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *PolicySetMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -2463,7 +2521,7 @@ func (m *SharedLinkMutation) OldTenantID(ctx context.Context) (v *uint32, err er
 }
 
 // AddTenantID adds u to the "tenant_id" field.
-func (m *SharedLinkMutation) AddTenantID(u int32) {
+func (m *PolicySetMutation) AddTenantID(u int32) {
 	if m.addtenant_id != nil {
 		*m.addtenant_id += u
 	} else {
@@ -2472,7 +2530,7 @@ func (m *SharedLinkMutation) AddTenantID(u int32) {
 }
 
 // AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *SharedLinkMutation) AddedTenantID() (r int32, exists bool) {
+func (m *PolicySetMutation) AddedTenantID() (r int32, exists bool) {
 	v := m.addtenant_id
 	if v == nil {
 		return
@@ -2481,580 +2539,9834 @@ func (m *SharedLinkMutation) AddedTenantID() (r int32, exists bool) {
 }
 
 // ClearTenantID clears the value of the "tenant_id" field.
-func (m *SharedLinkMutation) ClearTenantID() {
+func (m *PolicySetMutation) ClearTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	m.clearedFields[sharedlink.FieldTenantID] = struct{}{}
+	m.clearedFields[policyset.FieldTenantID] = struct{}{}
 }
 
 // TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *SharedLinkMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldTenantID]
+func (m *PolicySetMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldTenantID]
 	return ok
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *SharedLinkMutation) ResetTenantID() {
+func (m *PolicySetMutation) ResetTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	delete(m.clearedFields, sharedlink.FieldTenantID)
+	delete(m.clearedFields, policyset.FieldTenantID)
 }
 
-// SetResourceType sets the "resource_type" field.
-func (m *SharedLinkMutation) SetResourceType(st sharedlink.ResourceType) {
-	m.resource_type = &st
+// SetName sets the "name" field.
+func (m *PolicySetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResourceType returns the value of the "resource_type" field in the mutation.
-func (m *SharedLinkMutation) ResourceType() (r sharedlink.ResourceType, exists bool) {
-	v := m.resource_type
+// Name returns the value of the "name" field in the mutation.
+func (m *PolicySetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceType returns the old "resource_type" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldResourceType(ctx context.Context) (v sharedlink.ResourceType, err error) {
+func (m *PolicySetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceType requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ResourceType, nil
+	return oldValue.Name, nil
 }
 
-// ResetResourceType resets all changes to the "resource_type" field.
-func (m *SharedLinkMutation) ResetResourceType() {
-	m.resource_type = nil
+// ResetName resets all changes to the "name" field.
+func (m *PolicySetMutation) ResetName() {
+	m.name = nil
 }
 
-// SetResourceID sets the "resource_id" field.
-func (m *SharedLinkMutation) SetResourceID(s string) {
-	m.resource_id = &s
+// SetDescription sets the "description" field.
+func (m *PolicySetMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ResourceID returns the value of the "resource_id" field in the mutation.
-func (m *SharedLinkMutation) ResourceID() (r string, exists bool) {
-	v := m.resource_id
+// Description returns the value of the "description" field in the mutation.
+func (m *PolicySetMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceID returns the old "resource_id" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldResourceID(ctx context.Context) (v string, err error) {
+func (m *PolicySetMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.ResourceID, nil
+	return oldValue.Description, nil
 }
 
-// ResetResourceID resets all changes to the "resource_id" field.
-func (m *SharedLinkMutation) ResetResourceID() {
-	m.resource_id = nil
+// ClearDescription clears the value of the "description" field.
+func (m *PolicySetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[policyset.FieldDescription] = struct{}{}
 }
 
-// SetResourceName sets the "resource_name" field.
-func (m *SharedLinkMutation) SetResourceName(s string) {
-	m.resource_name = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PolicySetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[policyset.FieldDescription]
+	return ok
 }
 
-// ResourceName returns the value of the "resource_name" field in the mutation.
-func (m *SharedLinkMutation) ResourceName() (r string, exists bool) {
-	v := m.resource_name
+// ResetDescription resets all changes to the "description" field.
+func (m *PolicySetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, policyset.FieldDescription)
+}
+
+// SetRules sets the "rules" field.
+func (m *PolicySetMutation) SetRules(sr []schema.PolicyRule) {
+	m.rules = &sr
+	m.appendrules = nil
+}
+
+// Rules returns the value of the "rules" field in the mutation.
+func (m *PolicySetMutation) Rules() (r []schema.PolicyRule, exists bool) {
+	v := m.rules
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceName returns the old "resource_name" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldRules returns the old "rules" field's value of the PolicySet entity.
+// If the PolicySet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldResourceName(ctx context.Context) (v string, err error) {
+func (m *PolicySetMutation) OldRules(ctx context.Context) (v []schema.PolicyRule, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceName requires an ID field in the mutation")
+		return v, errors.New("OldRules requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
 	}
-	return oldValue.ResourceName, nil
+	return oldValue.Rules, nil
 }
 
-// ResetResourceName resets all changes to the "resource_name" field.
-func (m *SharedLinkMutation) ResetResourceName() {
-	m.resource_name = nil
+// AppendRules adds sr to the "rules" field.
+func (m *PolicySetMutation) AppendRules(sr []schema.PolicyRule) {
+	m.appendrules = append(m.appendrules, sr...)
 }
 
-// SetToken sets the "token" field.
-func (m *SharedLinkMutation) SetToken(s string) {
-	m.token = &s
+// AppendedRules returns the list of values that were appended to the "rules" field in this mutation.
+func (m *PolicySetMutation) AppendedRules() ([]schema.PolicyRule, bool) {
+	if len(m.appendrules) == 0 {
+		return nil, false
+	}
+	return m.appendrules, true
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *SharedLinkMutation) Token() (r string, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetRules resets all changes to the "rules" field.
+func (m *PolicySetMutation) ResetRules() {
+	m.rules = nil
+	m.appendrules = nil
 }
 
-// OldToken returns the old "token" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
-	}
-	return oldValue.Token, nil
+// Where appends a list predicates to the PolicySetMutation builder.
+func (m *PolicySetMutation) Where(ps ...predicate.PolicySet) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *SharedLinkMutation) ResetToken() {
-	m.token = nil
+// WhereP appends storage-level predicates to the PolicySetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PolicySetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PolicySet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetEncryptedContent sets the "encrypted_content" field.
+// Op returns the operation name.
+func (m *PolicySetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PolicySetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PolicySet).
+func (m *PolicySetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PolicySetMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_by != nil {
+		fields = append(fields, policyset.FieldCreateBy)
+	}
+	if m.update_by != nil {
+		fields = append(fields, policyset.FieldUpdateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, policyset.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, policyset.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, policyset.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, policyset.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, policyset.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, policyset.FieldDescription)
+	}
+	if m.rules != nil {
+		fields = append(fields, policyset.FieldRules)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PolicySetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case policyset.FieldCreateBy:
+		return m.CreateBy()
+	case policyset.FieldUpdateBy:
+		return m.UpdateBy()
+	case policyset.FieldCreateTime:
+		return m.CreateTime()
+	case policyset.FieldUpdateTime:
+		return m.UpdateTime()
+	case policyset.FieldDeleteTime:
+		return m.DeleteTime()
+	case policyset.FieldTenantID:
+		return m.TenantID()
+	case policyset.FieldName:
+		return m.Name()
+	case policyset.FieldDescription:
+		return m.Description()
+	case policyset.FieldRules:
+		return m.Rules()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PolicySetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case policyset.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case policyset.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case policyset.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case policyset.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case policyset.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case policyset.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case policyset.FieldName:
+		return m.OldName(ctx)
+	case policyset.FieldDescription:
+		return m.OldDescription(ctx)
+	case policyset.FieldRules:
+		return m.OldRules(ctx)
+	}
+	return nil, fmt.Errorf("unknown PolicySet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PolicySetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case policyset.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case policyset.FieldUpdateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case policyset.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case policyset.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case policyset.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case policyset.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case policyset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case policyset.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case policyset.FieldRules:
+		v, ok := value.([]schema.PolicyRule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRules(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PolicySet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PolicySetMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, policyset.FieldCreateBy)
+	}
+	if m.addupdate_by != nil {
+		fields = append(fields, policyset.FieldUpdateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, policyset.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PolicySetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case policyset.FieldCreateBy:
+		return m.AddedCreateBy()
+	case policyset.FieldUpdateBy:
+		return m.AddedUpdateBy()
+	case policyset.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PolicySetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case policyset.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case policyset.FieldUpdateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdateBy(v)
+		return nil
+	case policyset.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PolicySet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PolicySetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(policyset.FieldCreateBy) {
+		fields = append(fields, policyset.FieldCreateBy)
+	}
+	if m.FieldCleared(policyset.FieldUpdateBy) {
+		fields = append(fields, policyset.FieldUpdateBy)
+	}
+	if m.FieldCleared(policyset.FieldCreateTime) {
+		fields = append(fields, policyset.FieldCreateTime)
+	}
+	if m.FieldCleared(policyset.FieldUpdateTime) {
+		fields = append(fields, policyset.FieldUpdateTime)
+	}
+	if m.FieldCleared(policyset.FieldDeleteTime) {
+		fields = append(fields, policyset.FieldDeleteTime)
+	}
+	if m.FieldCleared(policyset.FieldTenantID) {
+		fields = append(fields, policyset.FieldTenantID)
+	}
+	if m.FieldCleared(policyset.FieldDescription) {
+		fields = append(fields, policyset.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PolicySetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PolicySetMutation) ClearField(name string) error {
+	switch name {
+	case policyset.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case policyset.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case policyset.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case policyset.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case policyset.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case policyset.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case policyset.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown PolicySet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PolicySetMutation) ResetField(name string) error {
+	switch name {
+	case policyset.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case policyset.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case policyset.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case policyset.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case policyset.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case policyset.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case policyset.FieldName:
+		m.ResetName()
+		return nil
+	case policyset.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case policyset.FieldRules:
+		m.ResetRules()
+		return nil
+	}
+	return fmt.Errorf("unknown PolicySet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PolicySetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PolicySetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PolicySetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PolicySetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PolicySetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PolicySetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PolicySetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PolicySet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PolicySetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PolicySet edge %s", name)
+}
+
+// RiskSettingsMutation represents an operation that mutates the RiskSettings nodes in the graph.
+type RiskSettingsMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	create_time          *time.Time
+	update_time          *time.Time
+	delete_time          *time.Time
+	tenant_id            *uint32
+	addtenant_id         *int32
+	enabled              *bool
+	step_up_threshold    *int32
+	addstep_up_threshold *int32
+	deny_threshold       *int32
+	adddeny_threshold    *int32
+	business_hours       *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*RiskSettings, error)
+	predicates           []predicate.RiskSettings
+}
+
+var _ ent.Mutation = (*RiskSettingsMutation)(nil)
+
+// risksettingsOption allows management of the mutation configuration using functional options.
+type risksettingsOption func(*RiskSettingsMutation)
+
+// newRiskSettingsMutation creates new mutation for the RiskSettings entity.
+func newRiskSettingsMutation(c config, op Op, opts ...risksettingsOption) *RiskSettingsMutation {
+	m := &RiskSettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRiskSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRiskSettingsID sets the ID field of the mutation.
+func withRiskSettingsID(id string) risksettingsOption {
+	return func(m *RiskSettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RiskSettings
+		)
+		m.oldValue = func(ctx context.Context) (*RiskSettings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RiskSettings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRiskSettings sets the old RiskSettings of the mutation.
+func withRiskSettings(node *RiskSettings) risksettingsOption {
+	return func(m *RiskSettingsMutation) {
+		m.oldValue = func(context.Context) (*RiskSettings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RiskSettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RiskSettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RiskSettings entities.
+func (m *RiskSettingsMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RiskSettingsMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RiskSettingsMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RiskSettings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RiskSettingsMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RiskSettingsMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *RiskSettingsMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[risksettings.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *RiskSettingsMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[risksettings.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RiskSettingsMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, risksettings.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RiskSettingsMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RiskSettingsMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *RiskSettingsMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[risksettings.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *RiskSettingsMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[risksettings.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RiskSettingsMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, risksettings.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *RiskSettingsMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *RiskSettingsMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *RiskSettingsMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[risksettings.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *RiskSettingsMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[risksettings.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *RiskSettingsMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, risksettings.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RiskSettingsMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RiskSettingsMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *RiskSettingsMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *RiskSettingsMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *RiskSettingsMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[risksettings.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *RiskSettingsMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[risksettings.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RiskSettingsMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, risksettings.FieldTenantID)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *RiskSettingsMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *RiskSettingsMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *RiskSettingsMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (m *RiskSettingsMutation) SetStepUpThreshold(i int32) {
+	m.step_up_threshold = &i
+	m.addstep_up_threshold = nil
+}
+
+// StepUpThreshold returns the value of the "step_up_threshold" field in the mutation.
+func (m *RiskSettingsMutation) StepUpThreshold() (r int32, exists bool) {
+	v := m.step_up_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepUpThreshold returns the old "step_up_threshold" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldStepUpThreshold(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepUpThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepUpThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepUpThreshold: %w", err)
+	}
+	return oldValue.StepUpThreshold, nil
+}
+
+// AddStepUpThreshold adds i to the "step_up_threshold" field.
+func (m *RiskSettingsMutation) AddStepUpThreshold(i int32) {
+	if m.addstep_up_threshold != nil {
+		*m.addstep_up_threshold += i
+	} else {
+		m.addstep_up_threshold = &i
+	}
+}
+
+// AddedStepUpThreshold returns the value that was added to the "step_up_threshold" field in this mutation.
+func (m *RiskSettingsMutation) AddedStepUpThreshold() (r int32, exists bool) {
+	v := m.addstep_up_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepUpThreshold resets all changes to the "step_up_threshold" field.
+func (m *RiskSettingsMutation) ResetStepUpThreshold() {
+	m.step_up_threshold = nil
+	m.addstep_up_threshold = nil
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (m *RiskSettingsMutation) SetDenyThreshold(i int32) {
+	m.deny_threshold = &i
+	m.adddeny_threshold = nil
+}
+
+// DenyThreshold returns the value of the "deny_threshold" field in the mutation.
+func (m *RiskSettingsMutation) DenyThreshold() (r int32, exists bool) {
+	v := m.deny_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDenyThreshold returns the old "deny_threshold" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldDenyThreshold(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDenyThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDenyThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDenyThreshold: %w", err)
+	}
+	return oldValue.DenyThreshold, nil
+}
+
+// AddDenyThreshold adds i to the "deny_threshold" field.
+func (m *RiskSettingsMutation) AddDenyThreshold(i int32) {
+	if m.adddeny_threshold != nil {
+		*m.adddeny_threshold += i
+	} else {
+		m.adddeny_threshold = &i
+	}
+}
+
+// AddedDenyThreshold returns the value that was added to the "deny_threshold" field in this mutation.
+func (m *RiskSettingsMutation) AddedDenyThreshold() (r int32, exists bool) {
+	v := m.adddeny_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDenyThreshold resets all changes to the "deny_threshold" field.
+func (m *RiskSettingsMutation) ResetDenyThreshold() {
+	m.deny_threshold = nil
+	m.adddeny_threshold = nil
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (m *RiskSettingsMutation) SetBusinessHours(s string) {
+	m.business_hours = &s
+}
+
+// BusinessHours returns the value of the "business_hours" field in the mutation.
+func (m *RiskSettingsMutation) BusinessHours() (r string, exists bool) {
+	v := m.business_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBusinessHours returns the old "business_hours" field's value of the RiskSettings entity.
+// If the RiskSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskSettingsMutation) OldBusinessHours(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBusinessHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBusinessHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBusinessHours: %w", err)
+	}
+	return oldValue.BusinessHours, nil
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (m *RiskSettingsMutation) ClearBusinessHours() {
+	m.business_hours = nil
+	m.clearedFields[risksettings.FieldBusinessHours] = struct{}{}
+}
+
+// BusinessHoursCleared returns if the "business_hours" field was cleared in this mutation.
+func (m *RiskSettingsMutation) BusinessHoursCleared() bool {
+	_, ok := m.clearedFields[risksettings.FieldBusinessHours]
+	return ok
+}
+
+// ResetBusinessHours resets all changes to the "business_hours" field.
+func (m *RiskSettingsMutation) ResetBusinessHours() {
+	m.business_hours = nil
+	delete(m.clearedFields, risksettings.FieldBusinessHours)
+}
+
+// Where appends a list predicates to the RiskSettingsMutation builder.
+func (m *RiskSettingsMutation) Where(ps ...predicate.RiskSettings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RiskSettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RiskSettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RiskSettings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RiskSettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RiskSettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RiskSettings).
+func (m *RiskSettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RiskSettingsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, risksettings.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, risksettings.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, risksettings.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, risksettings.FieldTenantID)
+	}
+	if m.enabled != nil {
+		fields = append(fields, risksettings.FieldEnabled)
+	}
+	if m.step_up_threshold != nil {
+		fields = append(fields, risksettings.FieldStepUpThreshold)
+	}
+	if m.deny_threshold != nil {
+		fields = append(fields, risksettings.FieldDenyThreshold)
+	}
+	if m.business_hours != nil {
+		fields = append(fields, risksettings.FieldBusinessHours)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RiskSettingsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case risksettings.FieldCreateTime:
+		return m.CreateTime()
+	case risksettings.FieldUpdateTime:
+		return m.UpdateTime()
+	case risksettings.FieldDeleteTime:
+		return m.DeleteTime()
+	case risksettings.FieldTenantID:
+		return m.TenantID()
+	case risksettings.FieldEnabled:
+		return m.Enabled()
+	case risksettings.FieldStepUpThreshold:
+		return m.StepUpThreshold()
+	case risksettings.FieldDenyThreshold:
+		return m.DenyThreshold()
+	case risksettings.FieldBusinessHours:
+		return m.BusinessHours()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RiskSettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case risksettings.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case risksettings.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case risksettings.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case risksettings.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case risksettings.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case risksettings.FieldStepUpThreshold:
+		return m.OldStepUpThreshold(ctx)
+	case risksettings.FieldDenyThreshold:
+		return m.OldDenyThreshold(ctx)
+	case risksettings.FieldBusinessHours:
+		return m.OldBusinessHours(ctx)
+	}
+	return nil, fmt.Errorf("unknown RiskSettings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskSettingsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case risksettings.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case risksettings.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case risksettings.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case risksettings.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case risksettings.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case risksettings.FieldStepUpThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepUpThreshold(v)
+		return nil
+	case risksettings.FieldDenyThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDenyThreshold(v)
+		return nil
+	case risksettings.FieldBusinessHours:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBusinessHours(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RiskSettings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RiskSettingsMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, risksettings.FieldTenantID)
+	}
+	if m.addstep_up_threshold != nil {
+		fields = append(fields, risksettings.FieldStepUpThreshold)
+	}
+	if m.adddeny_threshold != nil {
+		fields = append(fields, risksettings.FieldDenyThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RiskSettingsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case risksettings.FieldTenantID:
+		return m.AddedTenantID()
+	case risksettings.FieldStepUpThreshold:
+		return m.AddedStepUpThreshold()
+	case risksettings.FieldDenyThreshold:
+		return m.AddedDenyThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskSettingsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case risksettings.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case risksettings.FieldStepUpThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepUpThreshold(v)
+		return nil
+	case risksettings.FieldDenyThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDenyThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RiskSettings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RiskSettingsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(risksettings.FieldCreateTime) {
+		fields = append(fields, risksettings.FieldCreateTime)
+	}
+	if m.FieldCleared(risksettings.FieldUpdateTime) {
+		fields = append(fields, risksettings.FieldUpdateTime)
+	}
+	if m.FieldCleared(risksettings.FieldDeleteTime) {
+		fields = append(fields, risksettings.FieldDeleteTime)
+	}
+	if m.FieldCleared(risksettings.FieldTenantID) {
+		fields = append(fields, risksettings.FieldTenantID)
+	}
+	if m.FieldCleared(risksettings.FieldBusinessHours) {
+		fields = append(fields, risksettings.FieldBusinessHours)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RiskSettingsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RiskSettingsMutation) ClearField(name string) error {
+	switch name {
+	case risksettings.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case risksettings.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case risksettings.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case risksettings.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case risksettings.FieldBusinessHours:
+		m.ClearBusinessHours()
+		return nil
+	}
+	return fmt.Errorf("unknown RiskSettings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RiskSettingsMutation) ResetField(name string) error {
+	switch name {
+	case risksettings.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case risksettings.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case risksettings.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case risksettings.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case risksettings.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case risksettings.FieldStepUpThreshold:
+		m.ResetStepUpThreshold()
+		return nil
+	case risksettings.FieldDenyThreshold:
+		m.ResetDenyThreshold()
+		return nil
+	case risksettings.FieldBusinessHours:
+		m.ResetBusinessHours()
+		return nil
+	}
+	return fmt.Errorf("unknown RiskSettings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RiskSettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RiskSettingsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RiskSettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RiskSettingsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RiskSettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RiskSettingsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RiskSettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RiskSettings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RiskSettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RiskSettings edge %s", name)
+}
+
+// ShareAccessEventMutation represents an operation that mutates the ShareAccessEvent nodes in the graph.
+type ShareAccessEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	create_time     *time.Time
+	update_time     *time.Time
+	delete_time     *time.Time
+	tenant_id       *uint32
+	addtenant_id    *int32
+	share_link_id   *string
+	token_prefix    *string
+	outcome         *shareaccessevent.Outcome
+	policy_id       *string
+	reason          *string
+	client_ip       *string
+	user_agent      *string
+	country         *string
+	reputation_list *string
+	risk_score      *int32
+	addrisk_score   *int32
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ShareAccessEvent, error)
+	predicates      []predicate.ShareAccessEvent
+}
+
+var _ ent.Mutation = (*ShareAccessEventMutation)(nil)
+
+// shareaccesseventOption allows management of the mutation configuration using functional options.
+type shareaccesseventOption func(*ShareAccessEventMutation)
+
+// newShareAccessEventMutation creates new mutation for the ShareAccessEvent entity.
+func newShareAccessEventMutation(c config, op Op, opts ...shareaccesseventOption) *ShareAccessEventMutation {
+	m := &ShareAccessEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareAccessEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareAccessEventID sets the ID field of the mutation.
+func withShareAccessEventID(id string) shareaccesseventOption {
+	return func(m *ShareAccessEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareAccessEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ShareAccessEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareAccessEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareAccessEvent sets the old ShareAccessEvent of the mutation.
+func withShareAccessEvent(node *ShareAccessEvent) shareaccesseventOption {
+	return func(m *ShareAccessEventMutation) {
+		m.oldValue = func(context.Context) (*ShareAccessEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareAccessEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareAccessEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ShareAccessEvent entities.
+func (m *ShareAccessEventMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareAccessEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareAccessEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareAccessEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ShareAccessEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ShareAccessEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *ShareAccessEventMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[shareaccessevent.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ShareAccessEventMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, shareaccessevent.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ShareAccessEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ShareAccessEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *ShareAccessEventMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[shareaccessevent.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ShareAccessEventMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, shareaccessevent.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *ShareAccessEventMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *ShareAccessEventMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *ShareAccessEventMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[shareaccessevent.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *ShareAccessEventMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, shareaccessevent.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ShareAccessEventMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ShareAccessEventMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *ShareAccessEventMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ShareAccessEventMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ShareAccessEventMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[shareaccessevent.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ShareAccessEventMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, shareaccessevent.FieldTenantID)
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (m *ShareAccessEventMutation) SetShareLinkID(s string) {
+	m.share_link_id = &s
+}
+
+// ShareLinkID returns the value of the "share_link_id" field in the mutation.
+func (m *ShareAccessEventMutation) ShareLinkID() (r string, exists bool) {
+	v := m.share_link_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareLinkID returns the old "share_link_id" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldShareLinkID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareLinkID: %w", err)
+	}
+	return oldValue.ShareLinkID, nil
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (m *ShareAccessEventMutation) ClearShareLinkID() {
+	m.share_link_id = nil
+	m.clearedFields[shareaccessevent.FieldShareLinkID] = struct{}{}
+}
+
+// ShareLinkIDCleared returns if the "share_link_id" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) ShareLinkIDCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldShareLinkID]
+	return ok
+}
+
+// ResetShareLinkID resets all changes to the "share_link_id" field.
+func (m *ShareAccessEventMutation) ResetShareLinkID() {
+	m.share_link_id = nil
+	delete(m.clearedFields, shareaccessevent.FieldShareLinkID)
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (m *ShareAccessEventMutation) SetTokenPrefix(s string) {
+	m.token_prefix = &s
+}
+
+// TokenPrefix returns the value of the "token_prefix" field in the mutation.
+func (m *ShareAccessEventMutation) TokenPrefix() (r string, exists bool) {
+	v := m.token_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenPrefix returns the old "token_prefix" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldTokenPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenPrefix: %w", err)
+	}
+	return oldValue.TokenPrefix, nil
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (m *ShareAccessEventMutation) ClearTokenPrefix() {
+	m.token_prefix = nil
+	m.clearedFields[shareaccessevent.FieldTokenPrefix] = struct{}{}
+}
+
+// TokenPrefixCleared returns if the "token_prefix" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) TokenPrefixCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldTokenPrefix]
+	return ok
+}
+
+// ResetTokenPrefix resets all changes to the "token_prefix" field.
+func (m *ShareAccessEventMutation) ResetTokenPrefix() {
+	m.token_prefix = nil
+	delete(m.clearedFields, shareaccessevent.FieldTokenPrefix)
+}
+
+// SetOutcome sets the "outcome" field.
+func (m *ShareAccessEventMutation) SetOutcome(s shareaccessevent.Outcome) {
+	m.outcome = &s
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *ShareAccessEventMutation) Outcome() (r shareaccessevent.Outcome, exists bool) {
+	v := m.outcome
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutcome returns the old "outcome" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldOutcome(ctx context.Context) (v shareaccessevent.Outcome, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
+	}
+	return oldValue.Outcome, nil
+}
+
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *ShareAccessEventMutation) ResetOutcome() {
+	m.outcome = nil
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (m *ShareAccessEventMutation) SetPolicyID(s string) {
+	m.policy_id = &s
+}
+
+// PolicyID returns the value of the "policy_id" field in the mutation.
+func (m *ShareAccessEventMutation) PolicyID() (r string, exists bool) {
+	v := m.policy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyID returns the old "policy_id" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldPolicyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
+	}
+	return oldValue.PolicyID, nil
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (m *ShareAccessEventMutation) ClearPolicyID() {
+	m.policy_id = nil
+	m.clearedFields[shareaccessevent.FieldPolicyID] = struct{}{}
+}
+
+// PolicyIDCleared returns if the "policy_id" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) PolicyIDCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldPolicyID]
+	return ok
+}
+
+// ResetPolicyID resets all changes to the "policy_id" field.
+func (m *ShareAccessEventMutation) ResetPolicyID() {
+	m.policy_id = nil
+	delete(m.clearedFields, shareaccessevent.FieldPolicyID)
+}
+
+// SetReason sets the "reason" field.
+func (m *ShareAccessEventMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ShareAccessEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ShareAccessEventMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[shareaccessevent.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ShareAccessEventMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, shareaccessevent.FieldReason)
+}
+
+// SetClientIP sets the "client_ip" field.
+func (m *ShareAccessEventMutation) SetClientIP(s string) {
+	m.client_ip = &s
+}
+
+// ClientIP returns the value of the "client_ip" field in the mutation.
+func (m *ShareAccessEventMutation) ClientIP() (r string, exists bool) {
+	v := m.client_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientIP returns the old "client_ip" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldClientIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientIP: %w", err)
+	}
+	return oldValue.ClientIP, nil
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (m *ShareAccessEventMutation) ClearClientIP() {
+	m.client_ip = nil
+	m.clearedFields[shareaccessevent.FieldClientIP] = struct{}{}
+}
+
+// ClientIPCleared returns if the "client_ip" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) ClientIPCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldClientIP]
+	return ok
+}
+
+// ResetClientIP resets all changes to the "client_ip" field.
+func (m *ShareAccessEventMutation) ResetClientIP() {
+	m.client_ip = nil
+	delete(m.clearedFields, shareaccessevent.FieldClientIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *ShareAccessEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *ShareAccessEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *ShareAccessEventMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[shareaccessevent.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *ShareAccessEventMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, shareaccessevent.FieldUserAgent)
+}
+
+// SetCountry sets the "country" field.
+func (m *ShareAccessEventMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *ShareAccessEventMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *ShareAccessEventMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[shareaccessevent.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldCountry]
+	return ok
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *ShareAccessEventMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, shareaccessevent.FieldCountry)
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (m *ShareAccessEventMutation) SetReputationList(s string) {
+	m.reputation_list = &s
+}
+
+// ReputationList returns the value of the "reputation_list" field in the mutation.
+func (m *ShareAccessEventMutation) ReputationList() (r string, exists bool) {
+	v := m.reputation_list
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReputationList returns the old "reputation_list" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldReputationList(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReputationList is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReputationList requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReputationList: %w", err)
+	}
+	return oldValue.ReputationList, nil
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (m *ShareAccessEventMutation) ClearReputationList() {
+	m.reputation_list = nil
+	m.clearedFields[shareaccessevent.FieldReputationList] = struct{}{}
+}
+
+// ReputationListCleared returns if the "reputation_list" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) ReputationListCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldReputationList]
+	return ok
+}
+
+// ResetReputationList resets all changes to the "reputation_list" field.
+func (m *ShareAccessEventMutation) ResetReputationList() {
+	m.reputation_list = nil
+	delete(m.clearedFields, shareaccessevent.FieldReputationList)
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (m *ShareAccessEventMutation) SetRiskScore(i int32) {
+	m.risk_score = &i
+	m.addrisk_score = nil
+}
+
+// RiskScore returns the value of the "risk_score" field in the mutation.
+func (m *ShareAccessEventMutation) RiskScore() (r int32, exists bool) {
+	v := m.risk_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRiskScore returns the old "risk_score" field's value of the ShareAccessEvent entity.
+// If the ShareAccessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessEventMutation) OldRiskScore(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRiskScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRiskScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRiskScore: %w", err)
+	}
+	return oldValue.RiskScore, nil
+}
+
+// AddRiskScore adds i to the "risk_score" field.
+func (m *ShareAccessEventMutation) AddRiskScore(i int32) {
+	if m.addrisk_score != nil {
+		*m.addrisk_score += i
+	} else {
+		m.addrisk_score = &i
+	}
+}
+
+// AddedRiskScore returns the value that was added to the "risk_score" field in this mutation.
+func (m *ShareAccessEventMutation) AddedRiskScore() (r int32, exists bool) {
+	v := m.addrisk_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (m *ShareAccessEventMutation) ClearRiskScore() {
+	m.risk_score = nil
+	m.addrisk_score = nil
+	m.clearedFields[shareaccessevent.FieldRiskScore] = struct{}{}
+}
+
+// RiskScoreCleared returns if the "risk_score" field was cleared in this mutation.
+func (m *ShareAccessEventMutation) RiskScoreCleared() bool {
+	_, ok := m.clearedFields[shareaccessevent.FieldRiskScore]
+	return ok
+}
+
+// ResetRiskScore resets all changes to the "risk_score" field.
+func (m *ShareAccessEventMutation) ResetRiskScore() {
+	m.risk_score = nil
+	m.addrisk_score = nil
+	delete(m.clearedFields, shareaccessevent.FieldRiskScore)
+}
+
+// Where appends a list predicates to the ShareAccessEventMutation builder.
+func (m *ShareAccessEventMutation) Where(ps ...predicate.ShareAccessEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareAccessEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareAccessEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareAccessEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareAccessEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareAccessEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareAccessEvent).
+func (m *ShareAccessEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareAccessEventMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, shareaccessevent.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, shareaccessevent.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, shareaccessevent.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, shareaccessevent.FieldTenantID)
+	}
+	if m.share_link_id != nil {
+		fields = append(fields, shareaccessevent.FieldShareLinkID)
+	}
+	if m.token_prefix != nil {
+		fields = append(fields, shareaccessevent.FieldTokenPrefix)
+	}
+	if m.outcome != nil {
+		fields = append(fields, shareaccessevent.FieldOutcome)
+	}
+	if m.policy_id != nil {
+		fields = append(fields, shareaccessevent.FieldPolicyID)
+	}
+	if m.reason != nil {
+		fields = append(fields, shareaccessevent.FieldReason)
+	}
+	if m.client_ip != nil {
+		fields = append(fields, shareaccessevent.FieldClientIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, shareaccessevent.FieldUserAgent)
+	}
+	if m.country != nil {
+		fields = append(fields, shareaccessevent.FieldCountry)
+	}
+	if m.reputation_list != nil {
+		fields = append(fields, shareaccessevent.FieldReputationList)
+	}
+	if m.risk_score != nil {
+		fields = append(fields, shareaccessevent.FieldRiskScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareAccessEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shareaccessevent.FieldCreateTime:
+		return m.CreateTime()
+	case shareaccessevent.FieldUpdateTime:
+		return m.UpdateTime()
+	case shareaccessevent.FieldDeleteTime:
+		return m.DeleteTime()
+	case shareaccessevent.FieldTenantID:
+		return m.TenantID()
+	case shareaccessevent.FieldShareLinkID:
+		return m.ShareLinkID()
+	case shareaccessevent.FieldTokenPrefix:
+		return m.TokenPrefix()
+	case shareaccessevent.FieldOutcome:
+		return m.Outcome()
+	case shareaccessevent.FieldPolicyID:
+		return m.PolicyID()
+	case shareaccessevent.FieldReason:
+		return m.Reason()
+	case shareaccessevent.FieldClientIP:
+		return m.ClientIP()
+	case shareaccessevent.FieldUserAgent:
+		return m.UserAgent()
+	case shareaccessevent.FieldCountry:
+		return m.Country()
+	case shareaccessevent.FieldReputationList:
+		return m.ReputationList()
+	case shareaccessevent.FieldRiskScore:
+		return m.RiskScore()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareAccessEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shareaccessevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case shareaccessevent.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case shareaccessevent.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case shareaccessevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case shareaccessevent.FieldShareLinkID:
+		return m.OldShareLinkID(ctx)
+	case shareaccessevent.FieldTokenPrefix:
+		return m.OldTokenPrefix(ctx)
+	case shareaccessevent.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case shareaccessevent.FieldPolicyID:
+		return m.OldPolicyID(ctx)
+	case shareaccessevent.FieldReason:
+		return m.OldReason(ctx)
+	case shareaccessevent.FieldClientIP:
+		return m.OldClientIP(ctx)
+	case shareaccessevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case shareaccessevent.FieldCountry:
+		return m.OldCountry(ctx)
+	case shareaccessevent.FieldReputationList:
+		return m.OldReputationList(ctx)
+	case shareaccessevent.FieldRiskScore:
+		return m.OldRiskScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareAccessEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shareaccessevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case shareaccessevent.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case shareaccessevent.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case shareaccessevent.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case shareaccessevent.FieldShareLinkID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareLinkID(v)
+		return nil
+	case shareaccessevent.FieldTokenPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenPrefix(v)
+		return nil
+	case shareaccessevent.FieldOutcome:
+		v, ok := value.(shareaccessevent.Outcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case shareaccessevent.FieldPolicyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyID(v)
+		return nil
+	case shareaccessevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case shareaccessevent.FieldClientIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientIP(v)
+		return nil
+	case shareaccessevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case shareaccessevent.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case shareaccessevent.FieldReputationList:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReputationList(v)
+		return nil
+	case shareaccessevent.FieldRiskScore:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRiskScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareAccessEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, shareaccessevent.FieldTenantID)
+	}
+	if m.addrisk_score != nil {
+		fields = append(fields, shareaccessevent.FieldRiskScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareAccessEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case shareaccessevent.FieldTenantID:
+		return m.AddedTenantID()
+	case shareaccessevent.FieldRiskScore:
+		return m.AddedRiskScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case shareaccessevent.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case shareaccessevent.FieldRiskScore:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRiskScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareAccessEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shareaccessevent.FieldCreateTime) {
+		fields = append(fields, shareaccessevent.FieldCreateTime)
+	}
+	if m.FieldCleared(shareaccessevent.FieldUpdateTime) {
+		fields = append(fields, shareaccessevent.FieldUpdateTime)
+	}
+	if m.FieldCleared(shareaccessevent.FieldDeleteTime) {
+		fields = append(fields, shareaccessevent.FieldDeleteTime)
+	}
+	if m.FieldCleared(shareaccessevent.FieldTenantID) {
+		fields = append(fields, shareaccessevent.FieldTenantID)
+	}
+	if m.FieldCleared(shareaccessevent.FieldShareLinkID) {
+		fields = append(fields, shareaccessevent.FieldShareLinkID)
+	}
+	if m.FieldCleared(shareaccessevent.FieldTokenPrefix) {
+		fields = append(fields, shareaccessevent.FieldTokenPrefix)
+	}
+	if m.FieldCleared(shareaccessevent.FieldPolicyID) {
+		fields = append(fields, shareaccessevent.FieldPolicyID)
+	}
+	if m.FieldCleared(shareaccessevent.FieldReason) {
+		fields = append(fields, shareaccessevent.FieldReason)
+	}
+	if m.FieldCleared(shareaccessevent.FieldClientIP) {
+		fields = append(fields, shareaccessevent.FieldClientIP)
+	}
+	if m.FieldCleared(shareaccessevent.FieldUserAgent) {
+		fields = append(fields, shareaccessevent.FieldUserAgent)
+	}
+	if m.FieldCleared(shareaccessevent.FieldCountry) {
+		fields = append(fields, shareaccessevent.FieldCountry)
+	}
+	if m.FieldCleared(shareaccessevent.FieldReputationList) {
+		fields = append(fields, shareaccessevent.FieldReputationList)
+	}
+	if m.FieldCleared(shareaccessevent.FieldRiskScore) {
+		fields = append(fields, shareaccessevent.FieldRiskScore)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareAccessEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareAccessEventMutation) ClearField(name string) error {
+	switch name {
+	case shareaccessevent.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case shareaccessevent.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case shareaccessevent.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case shareaccessevent.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case shareaccessevent.FieldShareLinkID:
+		m.ClearShareLinkID()
+		return nil
+	case shareaccessevent.FieldTokenPrefix:
+		m.ClearTokenPrefix()
+		return nil
+	case shareaccessevent.FieldPolicyID:
+		m.ClearPolicyID()
+		return nil
+	case shareaccessevent.FieldReason:
+		m.ClearReason()
+		return nil
+	case shareaccessevent.FieldClientIP:
+		m.ClearClientIP()
+		return nil
+	case shareaccessevent.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case shareaccessevent.FieldCountry:
+		m.ClearCountry()
+		return nil
+	case shareaccessevent.FieldReputationList:
+		m.ClearReputationList()
+		return nil
+	case shareaccessevent.FieldRiskScore:
+		m.ClearRiskScore()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareAccessEventMutation) ResetField(name string) error {
+	switch name {
+	case shareaccessevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case shareaccessevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case shareaccessevent.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case shareaccessevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case shareaccessevent.FieldShareLinkID:
+		m.ResetShareLinkID()
+		return nil
+	case shareaccessevent.FieldTokenPrefix:
+		m.ResetTokenPrefix()
+		return nil
+	case shareaccessevent.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case shareaccessevent.FieldPolicyID:
+		m.ResetPolicyID()
+		return nil
+	case shareaccessevent.FieldReason:
+		m.ResetReason()
+		return nil
+	case shareaccessevent.FieldClientIP:
+		m.ResetClientIP()
+		return nil
+	case shareaccessevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case shareaccessevent.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case shareaccessevent.FieldReputationList:
+		m.ResetReputationList()
+		return nil
+	case shareaccessevent.FieldRiskScore:
+		m.ResetRiskScore()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareAccessEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareAccessEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareAccessEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareAccessEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareAccessEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareAccessEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareAccessEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ShareAccessEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareAccessEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ShareAccessEvent edge %s", name)
+}
+
+// SharePolicyMutation represents an operation that mutates the SharePolicy nodes in the graph.
+type SharePolicyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *uint32
+	addcreate_by  *int32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	share_link_id *string
+	_type         *sharepolicy.Type
+	method        *sharepolicy.Method
+	value         *string
+	reason        *string
+	priority      *int32
+	addpriority   *int32
+	deprecated    *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SharePolicy, error)
+	predicates    []predicate.SharePolicy
+}
+
+var _ ent.Mutation = (*SharePolicyMutation)(nil)
+
+// sharepolicyOption allows management of the mutation configuration using functional options.
+type sharepolicyOption func(*SharePolicyMutation)
+
+// newSharePolicyMutation creates new mutation for the SharePolicy entity.
+func newSharePolicyMutation(c config, op Op, opts ...sharepolicyOption) *SharePolicyMutation {
+	m := &SharePolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSharePolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSharePolicyID sets the ID field of the mutation.
+func withSharePolicyID(id string) sharepolicyOption {
+	return func(m *SharePolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SharePolicy
+		)
+		m.oldValue = func(ctx context.Context) (*SharePolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SharePolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSharePolicy sets the old SharePolicy of the mutation.
+func withSharePolicy(node *SharePolicy) sharepolicyOption {
+	return func(m *SharePolicyMutation) {
+		m.oldValue = func(context.Context) (*SharePolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SharePolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SharePolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SharePolicy entities.
+func (m *SharePolicyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SharePolicyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SharePolicyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SharePolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SharePolicyMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SharePolicyMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *SharePolicyMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *SharePolicyMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SharePolicyMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[sharepolicy.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SharePolicyMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SharePolicyMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, sharepolicy.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SharePolicyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SharePolicyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *SharePolicyMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[sharepolicy.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *SharePolicyMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SharePolicyMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, sharepolicy.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SharePolicyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SharePolicyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *SharePolicyMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[sharepolicy.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SharePolicyMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SharePolicyMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, sharepolicy.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *SharePolicyMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *SharePolicyMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *SharePolicyMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[sharepolicy.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *SharePolicyMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *SharePolicyMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, sharepolicy.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SharePolicyMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SharePolicyMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *SharePolicyMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *SharePolicyMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *SharePolicyMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[sharepolicy.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *SharePolicyMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SharePolicyMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, sharepolicy.FieldTenantID)
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (m *SharePolicyMutation) SetShareLinkID(s string) {
+	m.share_link_id = &s
+}
+
+// ShareLinkID returns the value of the "share_link_id" field in the mutation.
+func (m *SharePolicyMutation) ShareLinkID() (r string, exists bool) {
+	v := m.share_link_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareLinkID returns the old "share_link_id" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldShareLinkID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareLinkID: %w", err)
+	}
+	return oldValue.ShareLinkID, nil
+}
+
+// ResetShareLinkID resets all changes to the "share_link_id" field.
+func (m *SharePolicyMutation) ResetShareLinkID() {
+	m.share_link_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *SharePolicyMutation) SetType(s sharepolicy.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SharePolicyMutation) GetType() (r sharepolicy.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldType(ctx context.Context) (v sharepolicy.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SharePolicyMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *SharePolicyMutation) SetMethod(s sharepolicy.Method) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *SharePolicyMutation) Method() (r sharepolicy.Method, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldMethod(ctx context.Context) (v sharepolicy.Method, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *SharePolicyMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SharePolicyMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SharePolicyMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SharePolicyMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *SharePolicyMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SharePolicyMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *SharePolicyMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[sharepolicy.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *SharePolicyMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[sharepolicy.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SharePolicyMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, sharepolicy.FieldReason)
+}
+
+// SetPriority sets the "priority" field.
+func (m *SharePolicyMutation) SetPriority(i int32) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *SharePolicyMutation) Priority() (r int32, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldPriority(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *SharePolicyMutation) AddPriority(i int32) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *SharePolicyMutation) AddedPriority() (r int32, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *SharePolicyMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (m *SharePolicyMutation) SetDeprecated(b bool) {
+	m.deprecated = &b
+}
+
+// Deprecated returns the value of the "deprecated" field in the mutation.
+func (m *SharePolicyMutation) Deprecated() (r bool, exists bool) {
+	v := m.deprecated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecated returns the old "deprecated" field's value of the SharePolicy entity.
+// If the SharePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharePolicyMutation) OldDeprecated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecated: %w", err)
+	}
+	return oldValue.Deprecated, nil
+}
+
+// ResetDeprecated resets all changes to the "deprecated" field.
+func (m *SharePolicyMutation) ResetDeprecated() {
+	m.deprecated = nil
+}
+
+// Where appends a list predicates to the SharePolicyMutation builder.
+func (m *SharePolicyMutation) Where(ps ...predicate.SharePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SharePolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SharePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SharePolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SharePolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SharePolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SharePolicy).
+func (m *SharePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SharePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_by != nil {
+		fields = append(fields, sharepolicy.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, sharepolicy.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sharepolicy.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, sharepolicy.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, sharepolicy.FieldTenantID)
+	}
+	if m.share_link_id != nil {
+		fields = append(fields, sharepolicy.FieldShareLinkID)
+	}
+	if m._type != nil {
+		fields = append(fields, sharepolicy.FieldType)
+	}
+	if m.method != nil {
+		fields = append(fields, sharepolicy.FieldMethod)
+	}
+	if m.value != nil {
+		fields = append(fields, sharepolicy.FieldValue)
+	}
+	if m.reason != nil {
+		fields = append(fields, sharepolicy.FieldReason)
+	}
+	if m.priority != nil {
+		fields = append(fields, sharepolicy.FieldPriority)
+	}
+	if m.deprecated != nil {
+		fields = append(fields, sharepolicy.FieldDeprecated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SharePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		return m.CreateBy()
+	case sharepolicy.FieldCreateTime:
+		return m.CreateTime()
+	case sharepolicy.FieldUpdateTime:
+		return m.UpdateTime()
+	case sharepolicy.FieldDeleteTime:
+		return m.DeleteTime()
+	case sharepolicy.FieldTenantID:
+		return m.TenantID()
+	case sharepolicy.FieldShareLinkID:
+		return m.ShareLinkID()
+	case sharepolicy.FieldType:
+		return m.GetType()
+	case sharepolicy.FieldMethod:
+		return m.Method()
+	case sharepolicy.FieldValue:
+		return m.Value()
+	case sharepolicy.FieldReason:
+		return m.Reason()
+	case sharepolicy.FieldPriority:
+		return m.Priority()
+	case sharepolicy.FieldDeprecated:
+		return m.Deprecated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SharePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case sharepolicy.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sharepolicy.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sharepolicy.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case sharepolicy.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case sharepolicy.FieldShareLinkID:
+		return m.OldShareLinkID(ctx)
+	case sharepolicy.FieldType:
+		return m.OldType(ctx)
+	case sharepolicy.FieldMethod:
+		return m.OldMethod(ctx)
+	case sharepolicy.FieldValue:
+		return m.OldValue(ctx)
+	case sharepolicy.FieldReason:
+		return m.OldReason(ctx)
+	case sharepolicy.FieldPriority:
+		return m.OldPriority(ctx)
+	case sharepolicy.FieldDeprecated:
+		return m.OldDeprecated(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case sharepolicy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sharepolicy.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sharepolicy.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case sharepolicy.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case sharepolicy.FieldShareLinkID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareLinkID(v)
+		return nil
+	case sharepolicy.FieldType:
+		v, ok := value.(sharepolicy.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case sharepolicy.FieldMethod:
+		v, ok := value.(sharepolicy.Method)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case sharepolicy.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case sharepolicy.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case sharepolicy.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case sharepolicy.FieldDeprecated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SharePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, sharepolicy.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, sharepolicy.FieldTenantID)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, sharepolicy.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SharePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		return m.AddedCreateBy()
+	case sharepolicy.FieldTenantID:
+		return m.AddedTenantID()
+	case sharepolicy.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case sharepolicy.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case sharepolicy.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SharePolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharepolicy.FieldCreateBy) {
+		fields = append(fields, sharepolicy.FieldCreateBy)
+	}
+	if m.FieldCleared(sharepolicy.FieldCreateTime) {
+		fields = append(fields, sharepolicy.FieldCreateTime)
+	}
+	if m.FieldCleared(sharepolicy.FieldUpdateTime) {
+		fields = append(fields, sharepolicy.FieldUpdateTime)
+	}
+	if m.FieldCleared(sharepolicy.FieldDeleteTime) {
+		fields = append(fields, sharepolicy.FieldDeleteTime)
+	}
+	if m.FieldCleared(sharepolicy.FieldTenantID) {
+		fields = append(fields, sharepolicy.FieldTenantID)
+	}
+	if m.FieldCleared(sharepolicy.FieldReason) {
+		fields = append(fields, sharepolicy.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SharePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SharePolicyMutation) ClearField(name string) error {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case sharepolicy.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case sharepolicy.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case sharepolicy.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case sharepolicy.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case sharepolicy.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown SharePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SharePolicyMutation) ResetField(name string) error {
+	switch name {
+	case sharepolicy.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case sharepolicy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sharepolicy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sharepolicy.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case sharepolicy.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case sharepolicy.FieldShareLinkID:
+		m.ResetShareLinkID()
+		return nil
+	case sharepolicy.FieldType:
+		m.ResetType()
+		return nil
+	case sharepolicy.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case sharepolicy.FieldValue:
+		m.ResetValue()
+		return nil
+	case sharepolicy.FieldReason:
+		m.ResetReason()
+		return nil
+	case sharepolicy.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case sharepolicy.FieldDeprecated:
+		m.ResetDeprecated()
+		return nil
+	}
+	return fmt.Errorf("unknown SharePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SharePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SharePolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SharePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SharePolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SharePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SharePolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SharePolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SharePolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharePolicy edge %s", name)
+}
+
+// SharedLinkMutation represents an operation that mutates the SharedLink nodes in the graph.
+type SharedLinkMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *string
+	create_by                     *uint32
+	addcreate_by                  *int32
+	create_time                   *time.Time
+	update_time                   *time.Time
+	delete_time                   *time.Time
+	tenant_id                     *uint32
+	addtenant_id                  *int32
+	resource_type                 *sharedlink.ResourceType
+	resource_id                   *string
+	resource_name                 *string
+	token                         *string
+	encrypted_content             *[]byte
+	encryption_nonce              *[]byte
+	recipient_email               *string
+	sender_email                  *string
+	message                       *string
+	template_id                   *string
+	viewed                        *bool
+	viewed_at                     *time.Time
+	viewed_ip                     *string
+	revoked                       *bool
+	expires_at                    *time.Time
+	expiry_notified               *bool
+	expiry_notice_attempts        *int32
+	addexpiry_notice_attempts     *int32
+	expiry_notice_next_attempt_at *time.Time
+	authorized_by                 *uint32
+	addauthorized_by              *int32
+	authorized_via                *string
+	authorized_at                 *time.Time
+	policy_mode                   *sharedlink.PolicyMode
+	policy_default                *sharedlink.PolicyDefault
+	max_views                     *int32
+	addmax_views                  *int32
+	view_count                    *int32
+	addview_count                 *int32
+	bind_device                   *bool
+	device_binding                *string
+	device_bound_at               *time.Time
+	passphrase_hash               *string
+	verification_code_hash        *string
+	verification_code_expires_at  *time.Time
+	step_up_attempts              *int32
+	addstep_up_attempts           *int32
+	passphrase_attempts           *int32
+	addpassphrase_attempts        *int32
+	step_up_challenged_at         *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*SharedLink, error)
+	predicates                    []predicate.SharedLink
+}
+
+var _ ent.Mutation = (*SharedLinkMutation)(nil)
+
+// sharedlinkOption allows management of the mutation configuration using functional options.
+type sharedlinkOption func(*SharedLinkMutation)
+
+// newSharedLinkMutation creates new mutation for the SharedLink entity.
+func newSharedLinkMutation(c config, op Op, opts ...sharedlinkOption) *SharedLinkMutation {
+	m := &SharedLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSharedLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSharedLinkID sets the ID field of the mutation.
+func withSharedLinkID(id string) sharedlinkOption {
+	return func(m *SharedLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SharedLink
+		)
+		m.oldValue = func(ctx context.Context) (*SharedLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SharedLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSharedLink sets the old SharedLink of the mutation.
+func withSharedLink(node *SharedLink) sharedlinkOption {
+	return func(m *SharedLinkMutation) {
+		m.oldValue = func(context.Context) (*SharedLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SharedLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SharedLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SharedLink entities.
+func (m *SharedLinkMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SharedLinkMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SharedLinkMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SharedLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SharedLinkMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SharedLinkMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *SharedLinkMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *SharedLinkMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SharedLinkMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[sharedlink.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SharedLinkMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SharedLinkMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, sharedlink.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SharedLinkMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SharedLinkMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *SharedLinkMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[sharedlink.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *SharedLinkMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SharedLinkMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, sharedlink.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SharedLinkMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SharedLinkMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *SharedLinkMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[sharedlink.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SharedLinkMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SharedLinkMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, sharedlink.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *SharedLinkMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *SharedLinkMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *SharedLinkMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[sharedlink.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *SharedLinkMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *SharedLinkMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, sharedlink.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SharedLinkMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SharedLinkMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *SharedLinkMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *SharedLinkMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *SharedLinkMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[sharedlink.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *SharedLinkMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SharedLinkMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, sharedlink.FieldTenantID)
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *SharedLinkMutation) SetResourceType(st sharedlink.ResourceType) {
+	m.resource_type = &st
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *SharedLinkMutation) ResourceType() (r sharedlink.ResourceType, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldResourceType(ctx context.Context) (v sharedlink.ResourceType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *SharedLinkMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *SharedLinkMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *SharedLinkMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *SharedLinkMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetResourceName sets the "resource_name" field.
+func (m *SharedLinkMutation) SetResourceName(s string) {
+	m.resource_name = &s
+}
+
+// ResourceName returns the value of the "resource_name" field in the mutation.
+func (m *SharedLinkMutation) ResourceName() (r string, exists bool) {
+	v := m.resource_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceName returns the old "resource_name" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldResourceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
+	}
+	return oldValue.ResourceName, nil
+}
+
+// ResetResourceName resets all changes to the "resource_name" field.
+func (m *SharedLinkMutation) ResetResourceName() {
+	m.resource_name = nil
+}
+
+// SetToken sets the "token" field.
+func (m *SharedLinkMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *SharedLinkMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SharedLinkMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetEncryptedContent sets the "encrypted_content" field.
 func (m *SharedLinkMutation) SetEncryptedContent(b []byte) {
 	m.encrypted_content = &b
 }
 
-// EncryptedContent returns the value of the "encrypted_content" field in the mutation.
-func (m *SharedLinkMutation) EncryptedContent() (r []byte, exists bool) {
-	v := m.encrypted_content
+// EncryptedContent returns the value of the "encrypted_content" field in the mutation.
+func (m *SharedLinkMutation) EncryptedContent() (r []byte, exists bool) {
+	v := m.encrypted_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedContent returns the old "encrypted_content" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldEncryptedContent(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedContent: %w", err)
+	}
+	return oldValue.EncryptedContent, nil
+}
+
+// ClearEncryptedContent clears the value of the "encrypted_content" field.
+func (m *SharedLinkMutation) ClearEncryptedContent() {
+	m.encrypted_content = nil
+	m.clearedFields[sharedlink.FieldEncryptedContent] = struct{}{}
+}
+
+// EncryptedContentCleared returns if the "encrypted_content" field was cleared in this mutation.
+func (m *SharedLinkMutation) EncryptedContentCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldEncryptedContent]
+	return ok
+}
+
+// ResetEncryptedContent resets all changes to the "encrypted_content" field.
+func (m *SharedLinkMutation) ResetEncryptedContent() {
+	m.encrypted_content = nil
+	delete(m.clearedFields, sharedlink.FieldEncryptedContent)
+}
+
+// SetEncryptionNonce sets the "encryption_nonce" field.
+func (m *SharedLinkMutation) SetEncryptionNonce(b []byte) {
+	m.encryption_nonce = &b
+}
+
+// EncryptionNonce returns the value of the "encryption_nonce" field in the mutation.
+func (m *SharedLinkMutation) EncryptionNonce() (r []byte, exists bool) {
+	v := m.encryption_nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionNonce returns the old "encryption_nonce" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldEncryptionNonce(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionNonce: %w", err)
+	}
+	return oldValue.EncryptionNonce, nil
+}
+
+// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
+func (m *SharedLinkMutation) ClearEncryptionNonce() {
+	m.encryption_nonce = nil
+	m.clearedFields[sharedlink.FieldEncryptionNonce] = struct{}{}
+}
+
+// EncryptionNonceCleared returns if the "encryption_nonce" field was cleared in this mutation.
+func (m *SharedLinkMutation) EncryptionNonceCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldEncryptionNonce]
+	return ok
+}
+
+// ResetEncryptionNonce resets all changes to the "encryption_nonce" field.
+func (m *SharedLinkMutation) ResetEncryptionNonce() {
+	m.encryption_nonce = nil
+	delete(m.clearedFields, sharedlink.FieldEncryptionNonce)
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (m *SharedLinkMutation) SetRecipientEmail(s string) {
+	m.recipient_email = &s
+}
+
+// RecipientEmail returns the value of the "recipient_email" field in the mutation.
+func (m *SharedLinkMutation) RecipientEmail() (r string, exists bool) {
+	v := m.recipient_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipientEmail returns the old "recipient_email" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldRecipientEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipientEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipientEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipientEmail: %w", err)
+	}
+	return oldValue.RecipientEmail, nil
+}
+
+// ResetRecipientEmail resets all changes to the "recipient_email" field.
+func (m *SharedLinkMutation) ResetRecipientEmail() {
+	m.recipient_email = nil
+}
+
+// SetSenderEmail sets the "sender_email" field.
+func (m *SharedLinkMutation) SetSenderEmail(s string) {
+	m.sender_email = &s
+}
+
+// SenderEmail returns the value of the "sender_email" field in the mutation.
+func (m *SharedLinkMutation) SenderEmail() (r string, exists bool) {
+	v := m.sender_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderEmail returns the old "sender_email" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldSenderEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderEmail: %w", err)
+	}
+	return oldValue.SenderEmail, nil
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (m *SharedLinkMutation) ClearSenderEmail() {
+	m.sender_email = nil
+	m.clearedFields[sharedlink.FieldSenderEmail] = struct{}{}
+}
+
+// SenderEmailCleared returns if the "sender_email" field was cleared in this mutation.
+func (m *SharedLinkMutation) SenderEmailCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldSenderEmail]
+	return ok
+}
+
+// ResetSenderEmail resets all changes to the "sender_email" field.
+func (m *SharedLinkMutation) ResetSenderEmail() {
+	m.sender_email = nil
+	delete(m.clearedFields, sharedlink.FieldSenderEmail)
+}
+
+// SetMessage sets the "message" field.
+func (m *SharedLinkMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *SharedLinkMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *SharedLinkMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[sharedlink.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *SharedLinkMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *SharedLinkMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, sharedlink.FieldMessage)
+}
+
+// SetTemplateID sets the "template_id" field.
+func (m *SharedLinkMutation) SetTemplateID(s string) {
+	m.template_id = &s
+}
+
+// TemplateID returns the value of the "template_id" field in the mutation.
+func (m *SharedLinkMutation) TemplateID() (r string, exists bool) {
+	v := m.template_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateID returns the old "template_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldTemplateID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
+	}
+	return oldValue.TemplateID, nil
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (m *SharedLinkMutation) ClearTemplateID() {
+	m.template_id = nil
+	m.clearedFields[sharedlink.FieldTemplateID] = struct{}{}
+}
+
+// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
+func (m *SharedLinkMutation) TemplateIDCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldTemplateID]
+	return ok
+}
+
+// ResetTemplateID resets all changes to the "template_id" field.
+func (m *SharedLinkMutation) ResetTemplateID() {
+	m.template_id = nil
+	delete(m.clearedFields, sharedlink.FieldTemplateID)
+}
+
+// SetViewed sets the "viewed" field.
+func (m *SharedLinkMutation) SetViewed(b bool) {
+	m.viewed = &b
+}
+
+// Viewed returns the value of the "viewed" field in the mutation.
+func (m *SharedLinkMutation) Viewed() (r bool, exists bool) {
+	v := m.viewed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewed returns the old "viewed" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldViewed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewed: %w", err)
+	}
+	return oldValue.Viewed, nil
+}
+
+// ResetViewed resets all changes to the "viewed" field.
+func (m *SharedLinkMutation) ResetViewed() {
+	m.viewed = nil
+}
+
+// SetViewedAt sets the "viewed_at" field.
+func (m *SharedLinkMutation) SetViewedAt(t time.Time) {
+	m.viewed_at = &t
+}
+
+// ViewedAt returns the value of the "viewed_at" field in the mutation.
+func (m *SharedLinkMutation) ViewedAt() (r time.Time, exists bool) {
+	v := m.viewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewedAt returns the old "viewed_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldViewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewedAt: %w", err)
+	}
+	return oldValue.ViewedAt, nil
+}
+
+// ClearViewedAt clears the value of the "viewed_at" field.
+func (m *SharedLinkMutation) ClearViewedAt() {
+	m.viewed_at = nil
+	m.clearedFields[sharedlink.FieldViewedAt] = struct{}{}
+}
+
+// ViewedAtCleared returns if the "viewed_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) ViewedAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldViewedAt]
+	return ok
+}
+
+// ResetViewedAt resets all changes to the "viewed_at" field.
+func (m *SharedLinkMutation) ResetViewedAt() {
+	m.viewed_at = nil
+	delete(m.clearedFields, sharedlink.FieldViewedAt)
+}
+
+// SetViewedIP sets the "viewed_ip" field.
+func (m *SharedLinkMutation) SetViewedIP(s string) {
+	m.viewed_ip = &s
+}
+
+// ViewedIP returns the value of the "viewed_ip" field in the mutation.
+func (m *SharedLinkMutation) ViewedIP() (r string, exists bool) {
+	v := m.viewed_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewedIP returns the old "viewed_ip" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldViewedIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewedIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewedIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewedIP: %w", err)
+	}
+	return oldValue.ViewedIP, nil
+}
+
+// ClearViewedIP clears the value of the "viewed_ip" field.
+func (m *SharedLinkMutation) ClearViewedIP() {
+	m.viewed_ip = nil
+	m.clearedFields[sharedlink.FieldViewedIP] = struct{}{}
+}
+
+// ViewedIPCleared returns if the "viewed_ip" field was cleared in this mutation.
+func (m *SharedLinkMutation) ViewedIPCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldViewedIP]
+	return ok
+}
+
+// ResetViewedIP resets all changes to the "viewed_ip" field.
+func (m *SharedLinkMutation) ResetViewedIP() {
+	m.viewed_ip = nil
+	delete(m.clearedFields, sharedlink.FieldViewedIP)
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *SharedLinkMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *SharedLinkMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *SharedLinkMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SharedLinkMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SharedLinkMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SharedLinkMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[sharedlink.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SharedLinkMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, sharedlink.FieldExpiresAt)
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (m *SharedLinkMutation) SetExpiryNotified(b bool) {
+	m.expiry_notified = &b
+}
+
+// ExpiryNotified returns the value of the "expiry_notified" field in the mutation.
+func (m *SharedLinkMutation) ExpiryNotified() (r bool, exists bool) {
+	v := m.expiry_notified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryNotified returns the old "expiry_notified" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldExpiryNotified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryNotified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryNotified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryNotified: %w", err)
+	}
+	return oldValue.ExpiryNotified, nil
+}
+
+// ResetExpiryNotified resets all changes to the "expiry_notified" field.
+func (m *SharedLinkMutation) ResetExpiryNotified() {
+	m.expiry_notified = nil
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (m *SharedLinkMutation) SetExpiryNoticeAttempts(i int32) {
+	m.expiry_notice_attempts = &i
+	m.addexpiry_notice_attempts = nil
+}
+
+// ExpiryNoticeAttempts returns the value of the "expiry_notice_attempts" field in the mutation.
+func (m *SharedLinkMutation) ExpiryNoticeAttempts() (r int32, exists bool) {
+	v := m.expiry_notice_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryNoticeAttempts returns the old "expiry_notice_attempts" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldExpiryNoticeAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryNoticeAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryNoticeAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryNoticeAttempts: %w", err)
+	}
+	return oldValue.ExpiryNoticeAttempts, nil
+}
+
+// AddExpiryNoticeAttempts adds i to the "expiry_notice_attempts" field.
+func (m *SharedLinkMutation) AddExpiryNoticeAttempts(i int32) {
+	if m.addexpiry_notice_attempts != nil {
+		*m.addexpiry_notice_attempts += i
+	} else {
+		m.addexpiry_notice_attempts = &i
+	}
+}
+
+// AddedExpiryNoticeAttempts returns the value that was added to the "expiry_notice_attempts" field in this mutation.
+func (m *SharedLinkMutation) AddedExpiryNoticeAttempts() (r int32, exists bool) {
+	v := m.addexpiry_notice_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryNoticeAttempts resets all changes to the "expiry_notice_attempts" field.
+func (m *SharedLinkMutation) ResetExpiryNoticeAttempts() {
+	m.expiry_notice_attempts = nil
+	m.addexpiry_notice_attempts = nil
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (m *SharedLinkMutation) SetExpiryNoticeNextAttemptAt(t time.Time) {
+	m.expiry_notice_next_attempt_at = &t
+}
+
+// ExpiryNoticeNextAttemptAt returns the value of the "expiry_notice_next_attempt_at" field in the mutation.
+func (m *SharedLinkMutation) ExpiryNoticeNextAttemptAt() (r time.Time, exists bool) {
+	v := m.expiry_notice_next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryNoticeNextAttemptAt returns the old "expiry_notice_next_attempt_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldExpiryNoticeNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryNoticeNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryNoticeNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryNoticeNextAttemptAt: %w", err)
+	}
+	return oldValue.ExpiryNoticeNextAttemptAt, nil
+}
+
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (m *SharedLinkMutation) ClearExpiryNoticeNextAttemptAt() {
+	m.expiry_notice_next_attempt_at = nil
+	m.clearedFields[sharedlink.FieldExpiryNoticeNextAttemptAt] = struct{}{}
+}
+
+// ExpiryNoticeNextAttemptAtCleared returns if the "expiry_notice_next_attempt_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) ExpiryNoticeNextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldExpiryNoticeNextAttemptAt]
+	return ok
+}
+
+// ResetExpiryNoticeNextAttemptAt resets all changes to the "expiry_notice_next_attempt_at" field.
+func (m *SharedLinkMutation) ResetExpiryNoticeNextAttemptAt() {
+	m.expiry_notice_next_attempt_at = nil
+	delete(m.clearedFields, sharedlink.FieldExpiryNoticeNextAttemptAt)
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (m *SharedLinkMutation) SetAuthorizedBy(u uint32) {
+	m.authorized_by = &u
+	m.addauthorized_by = nil
+}
+
+// AuthorizedBy returns the value of the "authorized_by" field in the mutation.
+func (m *SharedLinkMutation) AuthorizedBy() (r uint32, exists bool) {
+	v := m.authorized_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorizedBy returns the old "authorized_by" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldAuthorizedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorizedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorizedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorizedBy: %w", err)
+	}
+	return oldValue.AuthorizedBy, nil
+}
+
+// AddAuthorizedBy adds u to the "authorized_by" field.
+func (m *SharedLinkMutation) AddAuthorizedBy(u int32) {
+	if m.addauthorized_by != nil {
+		*m.addauthorized_by += u
+	} else {
+		m.addauthorized_by = &u
+	}
+}
+
+// AddedAuthorizedBy returns the value that was added to the "authorized_by" field in this mutation.
+func (m *SharedLinkMutation) AddedAuthorizedBy() (r int32, exists bool) {
+	v := m.addauthorized_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (m *SharedLinkMutation) ClearAuthorizedBy() {
+	m.authorized_by = nil
+	m.addauthorized_by = nil
+	m.clearedFields[sharedlink.FieldAuthorizedBy] = struct{}{}
+}
+
+// AuthorizedByCleared returns if the "authorized_by" field was cleared in this mutation.
+func (m *SharedLinkMutation) AuthorizedByCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldAuthorizedBy]
+	return ok
+}
+
+// ResetAuthorizedBy resets all changes to the "authorized_by" field.
+func (m *SharedLinkMutation) ResetAuthorizedBy() {
+	m.authorized_by = nil
+	m.addauthorized_by = nil
+	delete(m.clearedFields, sharedlink.FieldAuthorizedBy)
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (m *SharedLinkMutation) SetAuthorizedVia(s string) {
+	m.authorized_via = &s
+}
+
+// AuthorizedVia returns the value of the "authorized_via" field in the mutation.
+func (m *SharedLinkMutation) AuthorizedVia() (r string, exists bool) {
+	v := m.authorized_via
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorizedVia returns the old "authorized_via" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldAuthorizedVia(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorizedVia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorizedVia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorizedVia: %w", err)
+	}
+	return oldValue.AuthorizedVia, nil
+}
+
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (m *SharedLinkMutation) ClearAuthorizedVia() {
+	m.authorized_via = nil
+	m.clearedFields[sharedlink.FieldAuthorizedVia] = struct{}{}
+}
+
+// AuthorizedViaCleared returns if the "authorized_via" field was cleared in this mutation.
+func (m *SharedLinkMutation) AuthorizedViaCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldAuthorizedVia]
+	return ok
+}
+
+// ResetAuthorizedVia resets all changes to the "authorized_via" field.
+func (m *SharedLinkMutation) ResetAuthorizedVia() {
+	m.authorized_via = nil
+	delete(m.clearedFields, sharedlink.FieldAuthorizedVia)
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (m *SharedLinkMutation) SetAuthorizedAt(t time.Time) {
+	m.authorized_at = &t
+}
+
+// AuthorizedAt returns the value of the "authorized_at" field in the mutation.
+func (m *SharedLinkMutation) AuthorizedAt() (r time.Time, exists bool) {
+	v := m.authorized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorizedAt returns the old "authorized_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldAuthorizedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorizedAt: %w", err)
+	}
+	return oldValue.AuthorizedAt, nil
+}
+
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (m *SharedLinkMutation) ClearAuthorizedAt() {
+	m.authorized_at = nil
+	m.clearedFields[sharedlink.FieldAuthorizedAt] = struct{}{}
+}
+
+// AuthorizedAtCleared returns if the "authorized_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) AuthorizedAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldAuthorizedAt]
+	return ok
+}
+
+// ResetAuthorizedAt resets all changes to the "authorized_at" field.
+func (m *SharedLinkMutation) ResetAuthorizedAt() {
+	m.authorized_at = nil
+	delete(m.clearedFields, sharedlink.FieldAuthorizedAt)
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (m *SharedLinkMutation) SetPolicyMode(sm sharedlink.PolicyMode) {
+	m.policy_mode = &sm
+}
+
+// PolicyMode returns the value of the "policy_mode" field in the mutation.
+func (m *SharedLinkMutation) PolicyMode() (r sharedlink.PolicyMode, exists bool) {
+	v := m.policy_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyMode returns the old "policy_mode" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldPolicyMode(ctx context.Context) (v sharedlink.PolicyMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyMode: %w", err)
+	}
+	return oldValue.PolicyMode, nil
+}
+
+// ResetPolicyMode resets all changes to the "policy_mode" field.
+func (m *SharedLinkMutation) ResetPolicyMode() {
+	m.policy_mode = nil
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (m *SharedLinkMutation) SetPolicyDefault(sd sharedlink.PolicyDefault) {
+	m.policy_default = &sd
+}
+
+// PolicyDefault returns the value of the "policy_default" field in the mutation.
+func (m *SharedLinkMutation) PolicyDefault() (r sharedlink.PolicyDefault, exists bool) {
+	v := m.policy_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyDefault returns the old "policy_default" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldPolicyDefault(ctx context.Context) (v sharedlink.PolicyDefault, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyDefault: %w", err)
+	}
+	return oldValue.PolicyDefault, nil
+}
+
+// ResetPolicyDefault resets all changes to the "policy_default" field.
+func (m *SharedLinkMutation) ResetPolicyDefault() {
+	m.policy_default = nil
+}
+
+// SetMaxViews sets the "max_views" field.
+func (m *SharedLinkMutation) SetMaxViews(i int32) {
+	m.max_views = &i
+	m.addmax_views = nil
+}
+
+// MaxViews returns the value of the "max_views" field in the mutation.
+func (m *SharedLinkMutation) MaxViews() (r int32, exists bool) {
+	v := m.max_views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxViews returns the old "max_views" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldMaxViews(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxViews: %w", err)
+	}
+	return oldValue.MaxViews, nil
+}
+
+// AddMaxViews adds i to the "max_views" field.
+func (m *SharedLinkMutation) AddMaxViews(i int32) {
+	if m.addmax_views != nil {
+		*m.addmax_views += i
+	} else {
+		m.addmax_views = &i
+	}
+}
+
+// AddedMaxViews returns the value that was added to the "max_views" field in this mutation.
+func (m *SharedLinkMutation) AddedMaxViews() (r int32, exists bool) {
+	v := m.addmax_views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxViews resets all changes to the "max_views" field.
+func (m *SharedLinkMutation) ResetMaxViews() {
+	m.max_views = nil
+	m.addmax_views = nil
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *SharedLinkMutation) SetViewCount(i int32) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *SharedLinkMutation) ViewCount() (r int32, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewCount returns the old "view_count" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldViewCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (m *SharedLinkMutation) AddViewCount(i int32) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
+}
+
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *SharedLinkMutation) AddedViewCount() (r int32, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *SharedLinkMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (m *SharedLinkMutation) SetBindDevice(b bool) {
+	m.bind_device = &b
+}
+
+// BindDevice returns the value of the "bind_device" field in the mutation.
+func (m *SharedLinkMutation) BindDevice() (r bool, exists bool) {
+	v := m.bind_device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBindDevice returns the old "bind_device" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldBindDevice(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBindDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBindDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBindDevice: %w", err)
+	}
+	return oldValue.BindDevice, nil
+}
+
+// ResetBindDevice resets all changes to the "bind_device" field.
+func (m *SharedLinkMutation) ResetBindDevice() {
+	m.bind_device = nil
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (m *SharedLinkMutation) SetDeviceBinding(s string) {
+	m.device_binding = &s
+}
+
+// DeviceBinding returns the value of the "device_binding" field in the mutation.
+func (m *SharedLinkMutation) DeviceBinding() (r string, exists bool) {
+	v := m.device_binding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceBinding returns the old "device_binding" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldDeviceBinding(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceBinding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceBinding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceBinding: %w", err)
+	}
+	return oldValue.DeviceBinding, nil
+}
+
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (m *SharedLinkMutation) ClearDeviceBinding() {
+	m.device_binding = nil
+	m.clearedFields[sharedlink.FieldDeviceBinding] = struct{}{}
+}
+
+// DeviceBindingCleared returns if the "device_binding" field was cleared in this mutation.
+func (m *SharedLinkMutation) DeviceBindingCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldDeviceBinding]
+	return ok
+}
+
+// ResetDeviceBinding resets all changes to the "device_binding" field.
+func (m *SharedLinkMutation) ResetDeviceBinding() {
+	m.device_binding = nil
+	delete(m.clearedFields, sharedlink.FieldDeviceBinding)
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (m *SharedLinkMutation) SetDeviceBoundAt(t time.Time) {
+	m.device_bound_at = &t
+}
+
+// DeviceBoundAt returns the value of the "device_bound_at" field in the mutation.
+func (m *SharedLinkMutation) DeviceBoundAt() (r time.Time, exists bool) {
+	v := m.device_bound_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceBoundAt returns the old "device_bound_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldDeviceBoundAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceBoundAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceBoundAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceBoundAt: %w", err)
+	}
+	return oldValue.DeviceBoundAt, nil
+}
+
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (m *SharedLinkMutation) ClearDeviceBoundAt() {
+	m.device_bound_at = nil
+	m.clearedFields[sharedlink.FieldDeviceBoundAt] = struct{}{}
+}
+
+// DeviceBoundAtCleared returns if the "device_bound_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) DeviceBoundAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldDeviceBoundAt]
+	return ok
+}
+
+// ResetDeviceBoundAt resets all changes to the "device_bound_at" field.
+func (m *SharedLinkMutation) ResetDeviceBoundAt() {
+	m.device_bound_at = nil
+	delete(m.clearedFields, sharedlink.FieldDeviceBoundAt)
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (m *SharedLinkMutation) SetPassphraseHash(s string) {
+	m.passphrase_hash = &s
+}
+
+// PassphraseHash returns the value of the "passphrase_hash" field in the mutation.
+func (m *SharedLinkMutation) PassphraseHash() (r string, exists bool) {
+	v := m.passphrase_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassphraseHash returns the old "passphrase_hash" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldPassphraseHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassphraseHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassphraseHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassphraseHash: %w", err)
+	}
+	return oldValue.PassphraseHash, nil
+}
+
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (m *SharedLinkMutation) ClearPassphraseHash() {
+	m.passphrase_hash = nil
+	m.clearedFields[sharedlink.FieldPassphraseHash] = struct{}{}
+}
+
+// PassphraseHashCleared returns if the "passphrase_hash" field was cleared in this mutation.
+func (m *SharedLinkMutation) PassphraseHashCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldPassphraseHash]
+	return ok
+}
+
+// ResetPassphraseHash resets all changes to the "passphrase_hash" field.
+func (m *SharedLinkMutation) ResetPassphraseHash() {
+	m.passphrase_hash = nil
+	delete(m.clearedFields, sharedlink.FieldPassphraseHash)
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (m *SharedLinkMutation) SetVerificationCodeHash(s string) {
+	m.verification_code_hash = &s
+}
+
+// VerificationCodeHash returns the value of the "verification_code_hash" field in the mutation.
+func (m *SharedLinkMutation) VerificationCodeHash() (r string, exists bool) {
+	v := m.verification_code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCodeHash returns the old "verification_code_hash" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldVerificationCodeHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCodeHash: %w", err)
+	}
+	return oldValue.VerificationCodeHash, nil
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (m *SharedLinkMutation) ClearVerificationCodeHash() {
+	m.verification_code_hash = nil
+	m.clearedFields[sharedlink.FieldVerificationCodeHash] = struct{}{}
+}
+
+// VerificationCodeHashCleared returns if the "verification_code_hash" field was cleared in this mutation.
+func (m *SharedLinkMutation) VerificationCodeHashCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldVerificationCodeHash]
+	return ok
+}
+
+// ResetVerificationCodeHash resets all changes to the "verification_code_hash" field.
+func (m *SharedLinkMutation) ResetVerificationCodeHash() {
+	m.verification_code_hash = nil
+	delete(m.clearedFields, sharedlink.FieldVerificationCodeHash)
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (m *SharedLinkMutation) SetVerificationCodeExpiresAt(t time.Time) {
+	m.verification_code_expires_at = &t
+}
+
+// VerificationCodeExpiresAt returns the value of the "verification_code_expires_at" field in the mutation.
+func (m *SharedLinkMutation) VerificationCodeExpiresAt() (r time.Time, exists bool) {
+	v := m.verification_code_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCodeExpiresAt returns the old "verification_code_expires_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldVerificationCodeExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCodeExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCodeExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCodeExpiresAt: %w", err)
+	}
+	return oldValue.VerificationCodeExpiresAt, nil
+}
+
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (m *SharedLinkMutation) ClearVerificationCodeExpiresAt() {
+	m.verification_code_expires_at = nil
+	m.clearedFields[sharedlink.FieldVerificationCodeExpiresAt] = struct{}{}
+}
+
+// VerificationCodeExpiresAtCleared returns if the "verification_code_expires_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) VerificationCodeExpiresAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldVerificationCodeExpiresAt]
+	return ok
+}
+
+// ResetVerificationCodeExpiresAt resets all changes to the "verification_code_expires_at" field.
+func (m *SharedLinkMutation) ResetVerificationCodeExpiresAt() {
+	m.verification_code_expires_at = nil
+	delete(m.clearedFields, sharedlink.FieldVerificationCodeExpiresAt)
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (m *SharedLinkMutation) SetStepUpAttempts(i int32) {
+	m.step_up_attempts = &i
+	m.addstep_up_attempts = nil
+}
+
+// StepUpAttempts returns the value of the "step_up_attempts" field in the mutation.
+func (m *SharedLinkMutation) StepUpAttempts() (r int32, exists bool) {
+	v := m.step_up_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepUpAttempts returns the old "step_up_attempts" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldStepUpAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepUpAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepUpAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepUpAttempts: %w", err)
+	}
+	return oldValue.StepUpAttempts, nil
+}
+
+// AddStepUpAttempts adds i to the "step_up_attempts" field.
+func (m *SharedLinkMutation) AddStepUpAttempts(i int32) {
+	if m.addstep_up_attempts != nil {
+		*m.addstep_up_attempts += i
+	} else {
+		m.addstep_up_attempts = &i
+	}
+}
+
+// AddedStepUpAttempts returns the value that was added to the "step_up_attempts" field in this mutation.
+func (m *SharedLinkMutation) AddedStepUpAttempts() (r int32, exists bool) {
+	v := m.addstep_up_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepUpAttempts resets all changes to the "step_up_attempts" field.
+func (m *SharedLinkMutation) ResetStepUpAttempts() {
+	m.step_up_attempts = nil
+	m.addstep_up_attempts = nil
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (m *SharedLinkMutation) SetPassphraseAttempts(i int32) {
+	m.passphrase_attempts = &i
+	m.addpassphrase_attempts = nil
+}
+
+// PassphraseAttempts returns the value of the "passphrase_attempts" field in the mutation.
+func (m *SharedLinkMutation) PassphraseAttempts() (r int32, exists bool) {
+	v := m.passphrase_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassphraseAttempts returns the old "passphrase_attempts" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldPassphraseAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassphraseAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassphraseAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassphraseAttempts: %w", err)
+	}
+	return oldValue.PassphraseAttempts, nil
+}
+
+// AddPassphraseAttempts adds i to the "passphrase_attempts" field.
+func (m *SharedLinkMutation) AddPassphraseAttempts(i int32) {
+	if m.addpassphrase_attempts != nil {
+		*m.addpassphrase_attempts += i
+	} else {
+		m.addpassphrase_attempts = &i
+	}
+}
+
+// AddedPassphraseAttempts returns the value that was added to the "passphrase_attempts" field in this mutation.
+func (m *SharedLinkMutation) AddedPassphraseAttempts() (r int32, exists bool) {
+	v := m.addpassphrase_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPassphraseAttempts resets all changes to the "passphrase_attempts" field.
+func (m *SharedLinkMutation) ResetPassphraseAttempts() {
+	m.passphrase_attempts = nil
+	m.addpassphrase_attempts = nil
+}
+
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (m *SharedLinkMutation) SetStepUpChallengedAt(t time.Time) {
+	m.step_up_challenged_at = &t
+}
+
+// StepUpChallengedAt returns the value of the "step_up_challenged_at" field in the mutation.
+func (m *SharedLinkMutation) StepUpChallengedAt() (r time.Time, exists bool) {
+	v := m.step_up_challenged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepUpChallengedAt returns the old "step_up_challenged_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldStepUpChallengedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepUpChallengedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepUpChallengedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepUpChallengedAt: %w", err)
+	}
+	return oldValue.StepUpChallengedAt, nil
+}
+
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (m *SharedLinkMutation) ClearStepUpChallengedAt() {
+	m.step_up_challenged_at = nil
+	m.clearedFields[sharedlink.FieldStepUpChallengedAt] = struct{}{}
+}
+
+// StepUpChallengedAtCleared returns if the "step_up_challenged_at" field was cleared in this mutation.
+func (m *SharedLinkMutation) StepUpChallengedAtCleared() bool {
+	_, ok := m.clearedFields[sharedlink.FieldStepUpChallengedAt]
+	return ok
+}
+
+// ResetStepUpChallengedAt resets all changes to the "step_up_challenged_at" field.
+func (m *SharedLinkMutation) ResetStepUpChallengedAt() {
+	m.step_up_challenged_at = nil
+	delete(m.clearedFields, sharedlink.FieldStepUpChallengedAt)
+}
+
+// Where appends a list predicates to the SharedLinkMutation builder.
+func (m *SharedLinkMutation) Where(ps ...predicate.SharedLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SharedLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SharedLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SharedLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SharedLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SharedLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SharedLink).
+func (m *SharedLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SharedLinkMutation) Fields() []string {
+	fields := make([]string, 0, 39)
+	if m.create_by != nil {
+		fields = append(fields, sharedlink.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, sharedlink.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sharedlink.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, sharedlink.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, sharedlink.FieldTenantID)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, sharedlink.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, sharedlink.FieldResourceID)
+	}
+	if m.resource_name != nil {
+		fields = append(fields, sharedlink.FieldResourceName)
+	}
+	if m.token != nil {
+		fields = append(fields, sharedlink.FieldToken)
+	}
+	if m.encrypted_content != nil {
+		fields = append(fields, sharedlink.FieldEncryptedContent)
+	}
+	if m.encryption_nonce != nil {
+		fields = append(fields, sharedlink.FieldEncryptionNonce)
+	}
+	if m.recipient_email != nil {
+		fields = append(fields, sharedlink.FieldRecipientEmail)
+	}
+	if m.sender_email != nil {
+		fields = append(fields, sharedlink.FieldSenderEmail)
+	}
+	if m.message != nil {
+		fields = append(fields, sharedlink.FieldMessage)
+	}
+	if m.template_id != nil {
+		fields = append(fields, sharedlink.FieldTemplateID)
+	}
+	if m.viewed != nil {
+		fields = append(fields, sharedlink.FieldViewed)
+	}
+	if m.viewed_at != nil {
+		fields = append(fields, sharedlink.FieldViewedAt)
+	}
+	if m.viewed_ip != nil {
+		fields = append(fields, sharedlink.FieldViewedIP)
+	}
+	if m.revoked != nil {
+		fields = append(fields, sharedlink.FieldRevoked)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, sharedlink.FieldExpiresAt)
+	}
+	if m.expiry_notified != nil {
+		fields = append(fields, sharedlink.FieldExpiryNotified)
+	}
+	if m.expiry_notice_attempts != nil {
+		fields = append(fields, sharedlink.FieldExpiryNoticeAttempts)
+	}
+	if m.expiry_notice_next_attempt_at != nil {
+		fields = append(fields, sharedlink.FieldExpiryNoticeNextAttemptAt)
+	}
+	if m.authorized_by != nil {
+		fields = append(fields, sharedlink.FieldAuthorizedBy)
+	}
+	if m.authorized_via != nil {
+		fields = append(fields, sharedlink.FieldAuthorizedVia)
+	}
+	if m.authorized_at != nil {
+		fields = append(fields, sharedlink.FieldAuthorizedAt)
+	}
+	if m.policy_mode != nil {
+		fields = append(fields, sharedlink.FieldPolicyMode)
+	}
+	if m.policy_default != nil {
+		fields = append(fields, sharedlink.FieldPolicyDefault)
+	}
+	if m.max_views != nil {
+		fields = append(fields, sharedlink.FieldMaxViews)
+	}
+	if m.view_count != nil {
+		fields = append(fields, sharedlink.FieldViewCount)
+	}
+	if m.bind_device != nil {
+		fields = append(fields, sharedlink.FieldBindDevice)
+	}
+	if m.device_binding != nil {
+		fields = append(fields, sharedlink.FieldDeviceBinding)
+	}
+	if m.device_bound_at != nil {
+		fields = append(fields, sharedlink.FieldDeviceBoundAt)
+	}
+	if m.passphrase_hash != nil {
+		fields = append(fields, sharedlink.FieldPassphraseHash)
+	}
+	if m.verification_code_hash != nil {
+		fields = append(fields, sharedlink.FieldVerificationCodeHash)
+	}
+	if m.verification_code_expires_at != nil {
+		fields = append(fields, sharedlink.FieldVerificationCodeExpiresAt)
+	}
+	if m.step_up_attempts != nil {
+		fields = append(fields, sharedlink.FieldStepUpAttempts)
+	}
+	if m.passphrase_attempts != nil {
+		fields = append(fields, sharedlink.FieldPassphraseAttempts)
+	}
+	if m.step_up_challenged_at != nil {
+		fields = append(fields, sharedlink.FieldStepUpChallengedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SharedLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		return m.CreateBy()
+	case sharedlink.FieldCreateTime:
+		return m.CreateTime()
+	case sharedlink.FieldUpdateTime:
+		return m.UpdateTime()
+	case sharedlink.FieldDeleteTime:
+		return m.DeleteTime()
+	case sharedlink.FieldTenantID:
+		return m.TenantID()
+	case sharedlink.FieldResourceType:
+		return m.ResourceType()
+	case sharedlink.FieldResourceID:
+		return m.ResourceID()
+	case sharedlink.FieldResourceName:
+		return m.ResourceName()
+	case sharedlink.FieldToken:
+		return m.Token()
+	case sharedlink.FieldEncryptedContent:
+		return m.EncryptedContent()
+	case sharedlink.FieldEncryptionNonce:
+		return m.EncryptionNonce()
+	case sharedlink.FieldRecipientEmail:
+		return m.RecipientEmail()
+	case sharedlink.FieldSenderEmail:
+		return m.SenderEmail()
+	case sharedlink.FieldMessage:
+		return m.Message()
+	case sharedlink.FieldTemplateID:
+		return m.TemplateID()
+	case sharedlink.FieldViewed:
+		return m.Viewed()
+	case sharedlink.FieldViewedAt:
+		return m.ViewedAt()
+	case sharedlink.FieldViewedIP:
+		return m.ViewedIP()
+	case sharedlink.FieldRevoked:
+		return m.Revoked()
+	case sharedlink.FieldExpiresAt:
+		return m.ExpiresAt()
+	case sharedlink.FieldExpiryNotified:
+		return m.ExpiryNotified()
+	case sharedlink.FieldExpiryNoticeAttempts:
+		return m.ExpiryNoticeAttempts()
+	case sharedlink.FieldExpiryNoticeNextAttemptAt:
+		return m.ExpiryNoticeNextAttemptAt()
+	case sharedlink.FieldAuthorizedBy:
+		return m.AuthorizedBy()
+	case sharedlink.FieldAuthorizedVia:
+		return m.AuthorizedVia()
+	case sharedlink.FieldAuthorizedAt:
+		return m.AuthorizedAt()
+	case sharedlink.FieldPolicyMode:
+		return m.PolicyMode()
+	case sharedlink.FieldPolicyDefault:
+		return m.PolicyDefault()
+	case sharedlink.FieldMaxViews:
+		return m.MaxViews()
+	case sharedlink.FieldViewCount:
+		return m.ViewCount()
+	case sharedlink.FieldBindDevice:
+		return m.BindDevice()
+	case sharedlink.FieldDeviceBinding:
+		return m.DeviceBinding()
+	case sharedlink.FieldDeviceBoundAt:
+		return m.DeviceBoundAt()
+	case sharedlink.FieldPassphraseHash:
+		return m.PassphraseHash()
+	case sharedlink.FieldVerificationCodeHash:
+		return m.VerificationCodeHash()
+	case sharedlink.FieldVerificationCodeExpiresAt:
+		return m.VerificationCodeExpiresAt()
+	case sharedlink.FieldStepUpAttempts:
+		return m.StepUpAttempts()
+	case sharedlink.FieldPassphraseAttempts:
+		return m.PassphraseAttempts()
+	case sharedlink.FieldStepUpChallengedAt:
+		return m.StepUpChallengedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SharedLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case sharedlink.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sharedlink.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sharedlink.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case sharedlink.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case sharedlink.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case sharedlink.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case sharedlink.FieldResourceName:
+		return m.OldResourceName(ctx)
+	case sharedlink.FieldToken:
+		return m.OldToken(ctx)
+	case sharedlink.FieldEncryptedContent:
+		return m.OldEncryptedContent(ctx)
+	case sharedlink.FieldEncryptionNonce:
+		return m.OldEncryptionNonce(ctx)
+	case sharedlink.FieldRecipientEmail:
+		return m.OldRecipientEmail(ctx)
+	case sharedlink.FieldSenderEmail:
+		return m.OldSenderEmail(ctx)
+	case sharedlink.FieldMessage:
+		return m.OldMessage(ctx)
+	case sharedlink.FieldTemplateID:
+		return m.OldTemplateID(ctx)
+	case sharedlink.FieldViewed:
+		return m.OldViewed(ctx)
+	case sharedlink.FieldViewedAt:
+		return m.OldViewedAt(ctx)
+	case sharedlink.FieldViewedIP:
+		return m.OldViewedIP(ctx)
+	case sharedlink.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case sharedlink.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case sharedlink.FieldExpiryNotified:
+		return m.OldExpiryNotified(ctx)
+	case sharedlink.FieldExpiryNoticeAttempts:
+		return m.OldExpiryNoticeAttempts(ctx)
+	case sharedlink.FieldExpiryNoticeNextAttemptAt:
+		return m.OldExpiryNoticeNextAttemptAt(ctx)
+	case sharedlink.FieldAuthorizedBy:
+		return m.OldAuthorizedBy(ctx)
+	case sharedlink.FieldAuthorizedVia:
+		return m.OldAuthorizedVia(ctx)
+	case sharedlink.FieldAuthorizedAt:
+		return m.OldAuthorizedAt(ctx)
+	case sharedlink.FieldPolicyMode:
+		return m.OldPolicyMode(ctx)
+	case sharedlink.FieldPolicyDefault:
+		return m.OldPolicyDefault(ctx)
+	case sharedlink.FieldMaxViews:
+		return m.OldMaxViews(ctx)
+	case sharedlink.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case sharedlink.FieldBindDevice:
+		return m.OldBindDevice(ctx)
+	case sharedlink.FieldDeviceBinding:
+		return m.OldDeviceBinding(ctx)
+	case sharedlink.FieldDeviceBoundAt:
+		return m.OldDeviceBoundAt(ctx)
+	case sharedlink.FieldPassphraseHash:
+		return m.OldPassphraseHash(ctx)
+	case sharedlink.FieldVerificationCodeHash:
+		return m.OldVerificationCodeHash(ctx)
+	case sharedlink.FieldVerificationCodeExpiresAt:
+		return m.OldVerificationCodeExpiresAt(ctx)
+	case sharedlink.FieldStepUpAttempts:
+		return m.OldStepUpAttempts(ctx)
+	case sharedlink.FieldPassphraseAttempts:
+		return m.OldPassphraseAttempts(ctx)
+	case sharedlink.FieldStepUpChallengedAt:
+		return m.OldStepUpChallengedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case sharedlink.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sharedlink.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sharedlink.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case sharedlink.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case sharedlink.FieldResourceType:
+		v, ok := value.(sharedlink.ResourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case sharedlink.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case sharedlink.FieldResourceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceName(v)
+		return nil
+	case sharedlink.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case sharedlink.FieldEncryptedContent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedContent(v)
+		return nil
+	case sharedlink.FieldEncryptionNonce:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionNonce(v)
+		return nil
+	case sharedlink.FieldRecipientEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipientEmail(v)
+		return nil
+	case sharedlink.FieldSenderEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderEmail(v)
+		return nil
+	case sharedlink.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case sharedlink.FieldTemplateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateID(v)
+		return nil
+	case sharedlink.FieldViewed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewed(v)
+		return nil
+	case sharedlink.FieldViewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewedAt(v)
+		return nil
+	case sharedlink.FieldViewedIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewedIP(v)
+		return nil
+	case sharedlink.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case sharedlink.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case sharedlink.FieldExpiryNotified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryNotified(v)
+		return nil
+	case sharedlink.FieldExpiryNoticeAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryNoticeAttempts(v)
+		return nil
+	case sharedlink.FieldExpiryNoticeNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryNoticeNextAttemptAt(v)
+		return nil
+	case sharedlink.FieldAuthorizedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorizedBy(v)
+		return nil
+	case sharedlink.FieldAuthorizedVia:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorizedVia(v)
+		return nil
+	case sharedlink.FieldAuthorizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorizedAt(v)
+		return nil
+	case sharedlink.FieldPolicyMode:
+		v, ok := value.(sharedlink.PolicyMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyMode(v)
+		return nil
+	case sharedlink.FieldPolicyDefault:
+		v, ok := value.(sharedlink.PolicyDefault)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyDefault(v)
+		return nil
+	case sharedlink.FieldMaxViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxViews(v)
+		return nil
+	case sharedlink.FieldViewCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case sharedlink.FieldBindDevice:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBindDevice(v)
+		return nil
+	case sharedlink.FieldDeviceBinding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceBinding(v)
+		return nil
+	case sharedlink.FieldDeviceBoundAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceBoundAt(v)
+		return nil
+	case sharedlink.FieldPassphraseHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassphraseHash(v)
+		return nil
+	case sharedlink.FieldVerificationCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationCodeHash(v)
+		return nil
+	case sharedlink.FieldVerificationCodeExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationCodeExpiresAt(v)
+		return nil
+	case sharedlink.FieldStepUpAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepUpAttempts(v)
+		return nil
+	case sharedlink.FieldPassphraseAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassphraseAttempts(v)
+		return nil
+	case sharedlink.FieldStepUpChallengedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepUpChallengedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SharedLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, sharedlink.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, sharedlink.FieldTenantID)
+	}
+	if m.addexpiry_notice_attempts != nil {
+		fields = append(fields, sharedlink.FieldExpiryNoticeAttempts)
+	}
+	if m.addauthorized_by != nil {
+		fields = append(fields, sharedlink.FieldAuthorizedBy)
+	}
+	if m.addmax_views != nil {
+		fields = append(fields, sharedlink.FieldMaxViews)
+	}
+	if m.addview_count != nil {
+		fields = append(fields, sharedlink.FieldViewCount)
+	}
+	if m.addstep_up_attempts != nil {
+		fields = append(fields, sharedlink.FieldStepUpAttempts)
+	}
+	if m.addpassphrase_attempts != nil {
+		fields = append(fields, sharedlink.FieldPassphraseAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SharedLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		return m.AddedCreateBy()
+	case sharedlink.FieldTenantID:
+		return m.AddedTenantID()
+	case sharedlink.FieldExpiryNoticeAttempts:
+		return m.AddedExpiryNoticeAttempts()
+	case sharedlink.FieldAuthorizedBy:
+		return m.AddedAuthorizedBy()
+	case sharedlink.FieldMaxViews:
+		return m.AddedMaxViews()
+	case sharedlink.FieldViewCount:
+		return m.AddedViewCount()
+	case sharedlink.FieldStepUpAttempts:
+		return m.AddedStepUpAttempts()
+	case sharedlink.FieldPassphraseAttempts:
+		return m.AddedPassphraseAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case sharedlink.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case sharedlink.FieldExpiryNoticeAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryNoticeAttempts(v)
+		return nil
+	case sharedlink.FieldAuthorizedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAuthorizedBy(v)
+		return nil
+	case sharedlink.FieldMaxViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxViews(v)
+		return nil
+	case sharedlink.FieldViewCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	case sharedlink.FieldStepUpAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepUpAttempts(v)
+		return nil
+	case sharedlink.FieldPassphraseAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPassphraseAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SharedLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharedlink.FieldCreateBy) {
+		fields = append(fields, sharedlink.FieldCreateBy)
+	}
+	if m.FieldCleared(sharedlink.FieldCreateTime) {
+		fields = append(fields, sharedlink.FieldCreateTime)
+	}
+	if m.FieldCleared(sharedlink.FieldUpdateTime) {
+		fields = append(fields, sharedlink.FieldUpdateTime)
+	}
+	if m.FieldCleared(sharedlink.FieldDeleteTime) {
+		fields = append(fields, sharedlink.FieldDeleteTime)
+	}
+	if m.FieldCleared(sharedlink.FieldTenantID) {
+		fields = append(fields, sharedlink.FieldTenantID)
+	}
+	if m.FieldCleared(sharedlink.FieldEncryptedContent) {
+		fields = append(fields, sharedlink.FieldEncryptedContent)
+	}
+	if m.FieldCleared(sharedlink.FieldEncryptionNonce) {
+		fields = append(fields, sharedlink.FieldEncryptionNonce)
+	}
+	if m.FieldCleared(sharedlink.FieldSenderEmail) {
+		fields = append(fields, sharedlink.FieldSenderEmail)
+	}
+	if m.FieldCleared(sharedlink.FieldMessage) {
+		fields = append(fields, sharedlink.FieldMessage)
+	}
+	if m.FieldCleared(sharedlink.FieldTemplateID) {
+		fields = append(fields, sharedlink.FieldTemplateID)
+	}
+	if m.FieldCleared(sharedlink.FieldViewedAt) {
+		fields = append(fields, sharedlink.FieldViewedAt)
+	}
+	if m.FieldCleared(sharedlink.FieldViewedIP) {
+		fields = append(fields, sharedlink.FieldViewedIP)
+	}
+	if m.FieldCleared(sharedlink.FieldExpiresAt) {
+		fields = append(fields, sharedlink.FieldExpiresAt)
+	}
+	if m.FieldCleared(sharedlink.FieldExpiryNoticeNextAttemptAt) {
+		fields = append(fields, sharedlink.FieldExpiryNoticeNextAttemptAt)
+	}
+	if m.FieldCleared(sharedlink.FieldAuthorizedBy) {
+		fields = append(fields, sharedlink.FieldAuthorizedBy)
+	}
+	if m.FieldCleared(sharedlink.FieldAuthorizedVia) {
+		fields = append(fields, sharedlink.FieldAuthorizedVia)
+	}
+	if m.FieldCleared(sharedlink.FieldAuthorizedAt) {
+		fields = append(fields, sharedlink.FieldAuthorizedAt)
+	}
+	if m.FieldCleared(sharedlink.FieldDeviceBinding) {
+		fields = append(fields, sharedlink.FieldDeviceBinding)
+	}
+	if m.FieldCleared(sharedlink.FieldDeviceBoundAt) {
+		fields = append(fields, sharedlink.FieldDeviceBoundAt)
+	}
+	if m.FieldCleared(sharedlink.FieldPassphraseHash) {
+		fields = append(fields, sharedlink.FieldPassphraseHash)
+	}
+	if m.FieldCleared(sharedlink.FieldVerificationCodeHash) {
+		fields = append(fields, sharedlink.FieldVerificationCodeHash)
+	}
+	if m.FieldCleared(sharedlink.FieldVerificationCodeExpiresAt) {
+		fields = append(fields, sharedlink.FieldVerificationCodeExpiresAt)
+	}
+	if m.FieldCleared(sharedlink.FieldStepUpChallengedAt) {
+		fields = append(fields, sharedlink.FieldStepUpChallengedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SharedLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SharedLinkMutation) ClearField(name string) error {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case sharedlink.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case sharedlink.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case sharedlink.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case sharedlink.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case sharedlink.FieldEncryptedContent:
+		m.ClearEncryptedContent()
+		return nil
+	case sharedlink.FieldEncryptionNonce:
+		m.ClearEncryptionNonce()
+		return nil
+	case sharedlink.FieldSenderEmail:
+		m.ClearSenderEmail()
+		return nil
+	case sharedlink.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case sharedlink.FieldTemplateID:
+		m.ClearTemplateID()
+		return nil
+	case sharedlink.FieldViewedAt:
+		m.ClearViewedAt()
+		return nil
+	case sharedlink.FieldViewedIP:
+		m.ClearViewedIP()
+		return nil
+	case sharedlink.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case sharedlink.FieldExpiryNoticeNextAttemptAt:
+		m.ClearExpiryNoticeNextAttemptAt()
+		return nil
+	case sharedlink.FieldAuthorizedBy:
+		m.ClearAuthorizedBy()
+		return nil
+	case sharedlink.FieldAuthorizedVia:
+		m.ClearAuthorizedVia()
+		return nil
+	case sharedlink.FieldAuthorizedAt:
+		m.ClearAuthorizedAt()
+		return nil
+	case sharedlink.FieldDeviceBinding:
+		m.ClearDeviceBinding()
+		return nil
+	case sharedlink.FieldDeviceBoundAt:
+		m.ClearDeviceBoundAt()
+		return nil
+	case sharedlink.FieldPassphraseHash:
+		m.ClearPassphraseHash()
+		return nil
+	case sharedlink.FieldVerificationCodeHash:
+		m.ClearVerificationCodeHash()
+		return nil
+	case sharedlink.FieldVerificationCodeExpiresAt:
+		m.ClearVerificationCodeExpiresAt()
+		return nil
+	case sharedlink.FieldStepUpChallengedAt:
+		m.ClearStepUpChallengedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SharedLinkMutation) ResetField(name string) error {
+	switch name {
+	case sharedlink.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case sharedlink.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sharedlink.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sharedlink.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case sharedlink.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case sharedlink.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case sharedlink.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case sharedlink.FieldResourceName:
+		m.ResetResourceName()
+		return nil
+	case sharedlink.FieldToken:
+		m.ResetToken()
+		return nil
+	case sharedlink.FieldEncryptedContent:
+		m.ResetEncryptedContent()
+		return nil
+	case sharedlink.FieldEncryptionNonce:
+		m.ResetEncryptionNonce()
+		return nil
+	case sharedlink.FieldRecipientEmail:
+		m.ResetRecipientEmail()
+		return nil
+	case sharedlink.FieldSenderEmail:
+		m.ResetSenderEmail()
+		return nil
+	case sharedlink.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case sharedlink.FieldTemplateID:
+		m.ResetTemplateID()
+		return nil
+	case sharedlink.FieldViewed:
+		m.ResetViewed()
+		return nil
+	case sharedlink.FieldViewedAt:
+		m.ResetViewedAt()
+		return nil
+	case sharedlink.FieldViewedIP:
+		m.ResetViewedIP()
+		return nil
+	case sharedlink.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case sharedlink.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case sharedlink.FieldExpiryNotified:
+		m.ResetExpiryNotified()
+		return nil
+	case sharedlink.FieldExpiryNoticeAttempts:
+		m.ResetExpiryNoticeAttempts()
+		return nil
+	case sharedlink.FieldExpiryNoticeNextAttemptAt:
+		m.ResetExpiryNoticeNextAttemptAt()
+		return nil
+	case sharedlink.FieldAuthorizedBy:
+		m.ResetAuthorizedBy()
+		return nil
+	case sharedlink.FieldAuthorizedVia:
+		m.ResetAuthorizedVia()
+		return nil
+	case sharedlink.FieldAuthorizedAt:
+		m.ResetAuthorizedAt()
+		return nil
+	case sharedlink.FieldPolicyMode:
+		m.ResetPolicyMode()
+		return nil
+	case sharedlink.FieldPolicyDefault:
+		m.ResetPolicyDefault()
+		return nil
+	case sharedlink.FieldMaxViews:
+		m.ResetMaxViews()
+		return nil
+	case sharedlink.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case sharedlink.FieldBindDevice:
+		m.ResetBindDevice()
+		return nil
+	case sharedlink.FieldDeviceBinding:
+		m.ResetDeviceBinding()
+		return nil
+	case sharedlink.FieldDeviceBoundAt:
+		m.ResetDeviceBoundAt()
+		return nil
+	case sharedlink.FieldPassphraseHash:
+		m.ResetPassphraseHash()
+		return nil
+	case sharedlink.FieldVerificationCodeHash:
+		m.ResetVerificationCodeHash()
+		return nil
+	case sharedlink.FieldVerificationCodeExpiresAt:
+		m.ResetVerificationCodeExpiresAt()
+		return nil
+	case sharedlink.FieldStepUpAttempts:
+		m.ResetStepUpAttempts()
+		return nil
+	case sharedlink.FieldPassphraseAttempts:
+		m.ResetPassphraseAttempts()
+		return nil
+	case sharedlink.FieldStepUpChallengedAt:
+		m.ResetStepUpChallengedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SharedLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SharedLinkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SharedLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SharedLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SharedLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SharedLinkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SharedLinkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SharedLinkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedLink edge %s", name)
+}
+
+// SharedLinkPolicySetMutation represents an operation that mutates the SharedLinkPolicySet nodes in the graph.
+type SharedLinkPolicySetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *uint32
+	addcreate_by  *int32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	share_link_id *string
+	policy_set_id *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SharedLinkPolicySet, error)
+	predicates    []predicate.SharedLinkPolicySet
+}
+
+var _ ent.Mutation = (*SharedLinkPolicySetMutation)(nil)
+
+// sharedlinkpolicysetOption allows management of the mutation configuration using functional options.
+type sharedlinkpolicysetOption func(*SharedLinkPolicySetMutation)
+
+// newSharedLinkPolicySetMutation creates new mutation for the SharedLinkPolicySet entity.
+func newSharedLinkPolicySetMutation(c config, op Op, opts ...sharedlinkpolicysetOption) *SharedLinkPolicySetMutation {
+	m := &SharedLinkPolicySetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSharedLinkPolicySet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSharedLinkPolicySetID sets the ID field of the mutation.
+func withSharedLinkPolicySetID(id string) sharedlinkpolicysetOption {
+	return func(m *SharedLinkPolicySetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SharedLinkPolicySet
+		)
+		m.oldValue = func(ctx context.Context) (*SharedLinkPolicySet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SharedLinkPolicySet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSharedLinkPolicySet sets the old SharedLinkPolicySet of the mutation.
+func withSharedLinkPolicySet(node *SharedLinkPolicySet) sharedlinkpolicysetOption {
+	return func(m *SharedLinkPolicySetMutation) {
+		m.oldValue = func(context.Context) (*SharedLinkPolicySet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SharedLinkPolicySetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SharedLinkPolicySetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SharedLinkPolicySet entities.
+func (m *SharedLinkPolicySetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SharedLinkPolicySetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SharedLinkPolicySetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SharedLinkPolicySet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SharedLinkPolicySetMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SharedLinkPolicySetMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *SharedLinkPolicySetMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *SharedLinkPolicySetMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SharedLinkPolicySetMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[sharedlinkpolicyset.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[sharedlinkpolicyset.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SharedLinkPolicySetMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, sharedlinkpolicyset.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SharedLinkPolicySetMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SharedLinkPolicySetMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *SharedLinkPolicySetMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[sharedlinkpolicyset.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlinkpolicyset.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SharedLinkPolicySetMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, sharedlinkpolicyset.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SharedLinkPolicySetMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SharedLinkPolicySetMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *SharedLinkPolicySetMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[sharedlinkpolicyset.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlinkpolicyset.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SharedLinkPolicySetMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, sharedlinkpolicyset.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *SharedLinkPolicySetMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *SharedLinkPolicySetMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *SharedLinkPolicySetMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[sharedlinkpolicyset.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[sharedlinkpolicyset.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *SharedLinkPolicySetMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, sharedlinkpolicyset.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SharedLinkPolicySetMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SharedLinkPolicySetMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *SharedLinkPolicySetMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *SharedLinkPolicySetMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *SharedLinkPolicySetMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[sharedlinkpolicyset.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[sharedlinkpolicyset.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SharedLinkPolicySetMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, sharedlinkpolicyset.FieldTenantID)
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (m *SharedLinkPolicySetMutation) SetShareLinkID(s string) {
+	m.share_link_id = &s
+}
+
+// ShareLinkID returns the value of the "share_link_id" field in the mutation.
+func (m *SharedLinkPolicySetMutation) ShareLinkID() (r string, exists bool) {
+	v := m.share_link_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareLinkID returns the old "share_link_id" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldShareLinkID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareLinkID: %w", err)
+	}
+	return oldValue.ShareLinkID, nil
+}
+
+// ResetShareLinkID resets all changes to the "share_link_id" field.
+func (m *SharedLinkPolicySetMutation) ResetShareLinkID() {
+	m.share_link_id = nil
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (m *SharedLinkPolicySetMutation) SetPolicySetID(s string) {
+	m.policy_set_id = &s
+}
+
+// PolicySetID returns the value of the "policy_set_id" field in the mutation.
+func (m *SharedLinkPolicySetMutation) PolicySetID() (r string, exists bool) {
+	v := m.policy_set_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicySetID returns the old "policy_set_id" field's value of the SharedLinkPolicySet entity.
+// If the SharedLinkPolicySet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkPolicySetMutation) OldPolicySetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicySetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicySetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicySetID: %w", err)
+	}
+	return oldValue.PolicySetID, nil
+}
+
+// ResetPolicySetID resets all changes to the "policy_set_id" field.
+func (m *SharedLinkPolicySetMutation) ResetPolicySetID() {
+	m.policy_set_id = nil
+}
+
+// Where appends a list predicates to the SharedLinkPolicySetMutation builder.
+func (m *SharedLinkPolicySetMutation) Where(ps ...predicate.SharedLinkPolicySet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SharedLinkPolicySetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SharedLinkPolicySetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SharedLinkPolicySet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SharedLinkPolicySetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SharedLinkPolicySetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SharedLinkPolicySet).
+func (m *SharedLinkPolicySetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SharedLinkPolicySetMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_by != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldTenantID)
+	}
+	if m.share_link_id != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldShareLinkID)
+	}
+	if m.policy_set_id != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldPolicySetID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SharedLinkPolicySetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		return m.CreateBy()
+	case sharedlinkpolicyset.FieldCreateTime:
+		return m.CreateTime()
+	case sharedlinkpolicyset.FieldUpdateTime:
+		return m.UpdateTime()
+	case sharedlinkpolicyset.FieldDeleteTime:
+		return m.DeleteTime()
+	case sharedlinkpolicyset.FieldTenantID:
+		return m.TenantID()
+	case sharedlinkpolicyset.FieldShareLinkID:
+		return m.ShareLinkID()
+	case sharedlinkpolicyset.FieldPolicySetID:
+		return m.PolicySetID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SharedLinkPolicySetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case sharedlinkpolicyset.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sharedlinkpolicyset.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sharedlinkpolicyset.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case sharedlinkpolicyset.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case sharedlinkpolicyset.FieldShareLinkID:
+		return m.OldShareLinkID(ctx)
+	case sharedlinkpolicyset.FieldPolicySetID:
+		return m.OldPolicySetID(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedLinkPolicySet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkPolicySetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case sharedlinkpolicyset.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sharedlinkpolicyset.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sharedlinkpolicyset.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case sharedlinkpolicyset.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case sharedlinkpolicyset.FieldShareLinkID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareLinkID(v)
+		return nil
+	case sharedlinkpolicyset.FieldPolicySetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicySetID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLinkPolicySet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SharedLinkPolicySetMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, sharedlinkpolicyset.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SharedLinkPolicySetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		return m.AddedCreateBy()
+	case sharedlinkpolicyset.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkPolicySetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case sharedlinkpolicyset.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLinkPolicySet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SharedLinkPolicySetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharedlinkpolicyset.FieldCreateBy) {
+		fields = append(fields, sharedlinkpolicyset.FieldCreateBy)
+	}
+	if m.FieldCleared(sharedlinkpolicyset.FieldCreateTime) {
+		fields = append(fields, sharedlinkpolicyset.FieldCreateTime)
+	}
+	if m.FieldCleared(sharedlinkpolicyset.FieldUpdateTime) {
+		fields = append(fields, sharedlinkpolicyset.FieldUpdateTime)
+	}
+	if m.FieldCleared(sharedlinkpolicyset.FieldDeleteTime) {
+		fields = append(fields, sharedlinkpolicyset.FieldDeleteTime)
+	}
+	if m.FieldCleared(sharedlinkpolicyset.FieldTenantID) {
+		fields = append(fields, sharedlinkpolicyset.FieldTenantID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SharedLinkPolicySetMutation) ClearField(name string) error {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case sharedlinkpolicyset.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case sharedlinkpolicyset.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case sharedlinkpolicyset.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case sharedlinkpolicyset.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLinkPolicySet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SharedLinkPolicySetMutation) ResetField(name string) error {
+	switch name {
+	case sharedlinkpolicyset.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case sharedlinkpolicyset.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sharedlinkpolicyset.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sharedlinkpolicyset.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case sharedlinkpolicyset.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case sharedlinkpolicyset.FieldShareLinkID:
+		m.ResetShareLinkID()
+		return nil
+	case sharedlinkpolicyset.FieldPolicySetID:
+		m.ResetPolicySetID()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLinkPolicySet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SharedLinkPolicySetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SharedLinkPolicySetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SharedLinkPolicySetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SharedLinkPolicySetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SharedLinkPolicySetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SharedLinkPolicySetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedLinkPolicySet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SharedLinkPolicySetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedLinkPolicySet edge %s", name)
+}
+
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *uint32
+	addcreate_by  *int32
+	update_by     *uint32
+	addupdate_by  *int32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	name          *string
+	url           *string
+	secret        *string
+	events        *[]string
+	appendevents  []string
+	enabled       *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Webhook, error)
+	predicates    []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id string) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *WebhookMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *WebhookMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *WebhookMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *WebhookMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *WebhookMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[webhook.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *WebhookMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *WebhookMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, webhook.FieldCreateBy)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *WebhookMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *WebhookMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// AddUpdateBy adds u to the "update_by" field.
+func (m *WebhookMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
+}
+
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *WebhookMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *WebhookMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[webhook.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *WebhookMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *WebhookMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, webhook.FieldUpdateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *WebhookMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[webhook.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *WebhookMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, webhook.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *WebhookMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[webhook.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *WebhookMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, webhook.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *WebhookMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *WebhookMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *WebhookMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[webhook.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *WebhookMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *WebhookMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, webhook.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *WebhookMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *WebhookMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *WebhookMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[webhook.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *WebhookMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, webhook.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, webhook.FieldEvents)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_by != nil {
+		fields = append(fields, webhook.FieldCreateBy)
+	}
+	if m.update_by != nil {
+		fields = append(fields, webhook.FieldUpdateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, webhook.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, webhook.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, webhook.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, webhook.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldCreateBy:
+		return m.CreateBy()
+	case webhook.FieldUpdateBy:
+		return m.UpdateBy()
+	case webhook.FieldCreateTime:
+		return m.CreateTime()
+	case webhook.FieldUpdateTime:
+		return m.UpdateTime()
+	case webhook.FieldDeleteTime:
+		return m.DeleteTime()
+	case webhook.FieldTenantID:
+		return m.TenantID()
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case webhook.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case webhook.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case webhook.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case webhook.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case webhook.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case webhook.FieldUpdateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case webhook.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case webhook.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case webhook.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case webhook.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case webhook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, webhook.FieldCreateBy)
+	}
+	if m.addupdate_by != nil {
+		fields = append(fields, webhook.FieldUpdateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, webhook.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldCreateBy:
+		return m.AddedCreateBy()
+	case webhook.FieldUpdateBy:
+		return m.AddedUpdateBy()
+	case webhook.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case webhook.FieldUpdateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdateBy(v)
+		return nil
+	case webhook.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldCreateBy) {
+		fields = append(fields, webhook.FieldCreateBy)
+	}
+	if m.FieldCleared(webhook.FieldUpdateBy) {
+		fields = append(fields, webhook.FieldUpdateBy)
+	}
+	if m.FieldCleared(webhook.FieldCreateTime) {
+		fields = append(fields, webhook.FieldCreateTime)
+	}
+	if m.FieldCleared(webhook.FieldUpdateTime) {
+		fields = append(fields, webhook.FieldUpdateTime)
+	}
+	if m.FieldCleared(webhook.FieldDeleteTime) {
+		fields = append(fields, webhook.FieldDeleteTime)
+	}
+	if m.FieldCleared(webhook.FieldTenantID) {
+		fields = append(fields, webhook.FieldTenantID)
+	}
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case webhook.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case webhook.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case webhook.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case webhook.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case webhook.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case webhook.FieldEvents:
+		m.ClearEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case webhook.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case webhook.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case webhook.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case webhook.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case webhook.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	create_time        *time.Time
+	update_time        *time.Time
+	delete_time        *time.Time
+	tenant_id          *uint32
+	addtenant_id       *int32
+	webhook_id         *string
+	event_id           *string
+	event_type         *string
+	payload            *string
+	status             *webhookdelivery.Status
+	attempts           *int32
+	addattempts        *int32
+	response_status    *int32
+	addresponse_status *int32
+	last_error         *string
+	next_attempt_at    *time.Time
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id string) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedContent returns the old "encrypted_content" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldEncryptedContent(ctx context.Context) (v *[]byte, err error) {
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedContent requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.EncryptedContent, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearEncryptedContent clears the value of the "encrypted_content" field.
-func (m *SharedLinkMutation) ClearEncryptedContent() {
-	m.encrypted_content = nil
-	m.clearedFields[sharedlink.FieldEncryptedContent] = struct{}{}
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *WebhookDeliveryMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[webhookdelivery.FieldCreateTime] = struct{}{}
 }
 
-// EncryptedContentCleared returns if the "encrypted_content" field was cleared in this mutation.
-func (m *SharedLinkMutation) EncryptedContentCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldEncryptedContent]
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldCreateTime]
 	return ok
 }
 
-// ResetEncryptedContent resets all changes to the "encrypted_content" field.
-func (m *SharedLinkMutation) ResetEncryptedContent() {
-	m.encrypted_content = nil
-	delete(m.clearedFields, sharedlink.FieldEncryptedContent)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldCreateTime)
 }
 
-// SetEncryptionNonce sets the "encryption_nonce" field.
-func (m *SharedLinkMutation) SetEncryptionNonce(b []byte) {
-	m.encryption_nonce = &b
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *WebhookDeliveryMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[webhookdelivery.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *WebhookDeliveryMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *WebhookDeliveryMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *WebhookDeliveryMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[webhookdelivery.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *WebhookDeliveryMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookDeliveryMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookDeliveryMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *WebhookDeliveryMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *WebhookDeliveryMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[webhookdelivery.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookDeliveryMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, webhookdelivery.FieldTenantID)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(s string) {
+	m.webhook_id = &s
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r string, exists bool) {
+	v := m.webhook_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook_id = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// EncryptionNonce returns the value of the "encryption_nonce" field in the mutation.
-func (m *SharedLinkMutation) EncryptionNonce() (r []byte, exists bool) {
-	v := m.encryption_nonce
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionNonce returns the old "encryption_nonce" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldEncryptionNonce(ctx context.Context) (v *[]byte, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionNonce is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionNonce requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionNonce: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.EncryptionNonce, nil
-}
-
-// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
-func (m *SharedLinkMutation) ClearEncryptionNonce() {
-	m.encryption_nonce = nil
-	m.clearedFields[sharedlink.FieldEncryptionNonce] = struct{}{}
+	return oldValue.EventID, nil
 }
 
-// EncryptionNonceCleared returns if the "encryption_nonce" field was cleared in this mutation.
-func (m *SharedLinkMutation) EncryptionNonceCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldEncryptionNonce]
-	return ok
-}
-
-// ResetEncryptionNonce resets all changes to the "encryption_nonce" field.
-func (m *SharedLinkMutation) ResetEncryptionNonce() {
-	m.encryption_nonce = nil
-	delete(m.clearedFields, sharedlink.FieldEncryptionNonce)
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetRecipientEmail sets the "recipient_email" field.
-func (m *SharedLinkMutation) SetRecipientEmail(s string) {
-	m.recipient_email = &s
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// RecipientEmail returns the value of the "recipient_email" field in the mutation.
-func (m *SharedLinkMutation) RecipientEmail() (r string, exists bool) {
-	v := m.recipient_email
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRecipientEmail returns the old "recipient_email" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldRecipientEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecipientEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecipientEmail requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecipientEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.RecipientEmail, nil
+	return oldValue.EventType, nil
 }
 
-// ResetRecipientEmail resets all changes to the "recipient_email" field.
-func (m *SharedLinkMutation) ResetRecipientEmail() {
-	m.recipient_email = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetMessage sets the "message" field.
-func (m *SharedLinkMutation) SetMessage(s string) {
-	m.message = &s
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *SharedLinkMutation) Message() (r string, exists bool) {
-	v := m.message
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.Payload, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *SharedLinkMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[sharedlink.FieldMessage] = struct{}{}
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *SharedLinkMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldMessage]
-	return ok
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *SharedLinkMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, sharedlink.FieldMessage)
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetTemplateID sets the "template_id" field.
-func (m *SharedLinkMutation) SetTemplateID(s string) {
-	m.template_id = &s
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// TemplateID returns the value of the "template_id" field in the mutation.
-func (m *SharedLinkMutation) TemplateID() (r string, exists bool) {
-	v := m.template_id
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTemplateID returns the old "template_id" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldTemplateID(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTemplateID requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.TemplateID, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearTemplateID clears the value of the "template_id" field.
-func (m *SharedLinkMutation) ClearTemplateID() {
-	m.template_id = nil
-	m.clearedFields[sharedlink.FieldTemplateID] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
-func (m *SharedLinkMutation) TemplateIDCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldTemplateID]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTemplateID resets all changes to the "template_id" field.
-func (m *SharedLinkMutation) ResetTemplateID() {
-	m.template_id = nil
-	delete(m.clearedFields, sharedlink.FieldTemplateID)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetViewed sets the "viewed" field.
-func (m *SharedLinkMutation) SetViewed(b bool) {
-	m.viewed = &b
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int32) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// Viewed returns the value of the "viewed" field in the mutation.
-func (m *SharedLinkMutation) Viewed() (r bool, exists bool) {
-	v := m.viewed
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int32, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewed returns the old "viewed" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldViewed(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewed is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewed requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewed: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.Viewed, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ResetViewed resets all changes to the "viewed" field.
-func (m *SharedLinkMutation) ResetViewed() {
-	m.viewed = nil
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int32) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// SetViewedAt sets the "viewed_at" field.
-func (m *SharedLinkMutation) SetViewedAt(t time.Time) {
-	m.viewed_at = &t
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int32, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ViewedAt returns the value of the "viewed_at" field in the mutation.
-func (m *SharedLinkMutation) ViewedAt() (r time.Time, exists bool) {
-	v := m.viewed_at
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewedAt returns the old "viewed_at" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldViewedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.ViewedAt, nil
+	return oldValue.LastError, nil
 }
 
-// ClearViewedAt clears the value of the "viewed_at" field.
-func (m *SharedLinkMutation) ClearViewedAt() {
-	m.viewed_at = nil
-	m.clearedFields[sharedlink.FieldViewedAt] = struct{}{}
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// ViewedAtCleared returns if the "viewed_at" field was cleared in this mutation.
-func (m *SharedLinkMutation) ViewedAtCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldViewedAt]
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
 	return ok
 }
 
-// ResetViewedAt resets all changes to the "viewed_at" field.
-func (m *SharedLinkMutation) ResetViewedAt() {
-	m.viewed_at = nil
-	delete(m.clearedFields, sharedlink.FieldViewedAt)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
-// SetViewedIP sets the "viewed_ip" field.
-func (m *SharedLinkMutation) SetViewedIP(s string) {
-	m.viewed_ip = &s
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// ViewedIP returns the value of the "viewed_ip" field in the mutation.
-func (m *SharedLinkMutation) ViewedIP() (r string, exists bool) {
-	v := m.viewed_ip
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewedIP returns the old "viewed_ip" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldViewedIP(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewedIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewedIP requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewedIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.ViewedIP, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ClearViewedIP clears the value of the "viewed_ip" field.
-func (m *SharedLinkMutation) ClearViewedIP() {
-	m.viewed_ip = nil
-	m.clearedFields[sharedlink.FieldViewedIP] = struct{}{}
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// ViewedIPCleared returns if the "viewed_ip" field was cleared in this mutation.
-func (m *SharedLinkMutation) ViewedIPCleared() bool {
-	_, ok := m.clearedFields[sharedlink.FieldViewedIP]
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
 	return ok
 }
 
-// ResetViewedIP resets all changes to the "viewed_ip" field.
-func (m *SharedLinkMutation) ResetViewedIP() {
-	m.viewed_ip = nil
-	delete(m.clearedFields, sharedlink.FieldViewedIP)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *SharedLinkMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *SharedLinkMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the SharedLink entity.
-// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLinkMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *SharedLinkMutation) ResetRevoked() {
-	m.revoked = nil
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// Where appends a list predicates to the SharedLinkMutation builder.
-func (m *SharedLinkMutation) Where(ps ...predicate.SharedLink) {
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SharedLinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SharedLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SharedLink, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3062,78 +12374,66 @@ func (m *SharedLinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SharedLinkMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SharedLinkMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SharedLink).
-func (m *SharedLinkMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SharedLinkMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.create_by != nil {
-		fields = append(fields, sharedlink.FieldCreateBy)
-	}
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, sharedlink.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, sharedlink.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, sharedlink.FieldDeleteTime)
+		fields = append(fields, webhookdelivery.FieldDeleteTime)
 	}
 	if m.tenant_id != nil {
-		fields = append(fields, sharedlink.FieldTenantID)
-	}
-	if m.resource_type != nil {
-		fields = append(fields, sharedlink.FieldResourceType)
-	}
-	if m.resource_id != nil {
-		fields = append(fields, sharedlink.FieldResourceID)
-	}
-	if m.resource_name != nil {
-		fields = append(fields, sharedlink.FieldResourceName)
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m.token != nil {
-		fields = append(fields, sharedlink.FieldToken)
+	if m.webhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.encrypted_content != nil {
-		fields = append(fields, sharedlink.FieldEncryptedContent)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
-	if m.encryption_nonce != nil {
-		fields = append(fields, sharedlink.FieldEncryptionNonce)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.recipient_email != nil {
-		fields = append(fields, sharedlink.FieldRecipientEmail)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.message != nil {
-		fields = append(fields, sharedlink.FieldMessage)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.template_id != nil {
-		fields = append(fields, sharedlink.FieldTemplateID)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.viewed != nil {
-		fields = append(fields, sharedlink.FieldViewed)
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.viewed_at != nil {
-		fields = append(fields, sharedlink.FieldViewedAt)
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.viewed_ip != nil {
-		fields = append(fields, sharedlink.FieldViewedIP)
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.revoked != nil {
-		fields = append(fields, sharedlink.FieldRevoked)
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -3141,44 +12441,36 @@ func (m *SharedLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SharedLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		return m.CreateBy()
-	case sharedlink.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case sharedlink.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case sharedlink.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		return m.DeleteTime()
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		return m.TenantID()
-	case sharedlink.FieldResourceType:
-		return m.ResourceType()
-	case sharedlink.FieldResourceID:
-		return m.ResourceID()
-	case sharedlink.FieldResourceName:
-		return m.ResourceName()
-	case sharedlink.FieldToken:
-		return m.Token()
-	case sharedlink.FieldEncryptedContent:
-		return m.EncryptedContent()
-	case sharedlink.FieldEncryptionNonce:
-		return m.EncryptionNonce()
-	case sharedlink.FieldRecipientEmail:
-		return m.RecipientEmail()
-	case sharedlink.FieldMessage:
-		return m.Message()
-	case sharedlink.FieldTemplateID:
-		return m.TemplateID()
-	case sharedlink.FieldViewed:
-		return m.Viewed()
-	case sharedlink.FieldViewedAt:
-		return m.ViewedAt()
-	case sharedlink.FieldViewedIP:
-		return m.ViewedIP()
-	case sharedlink.FieldRevoked:
-		return m.Revoked()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -3186,192 +12478,159 @@ func (m *SharedLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SharedLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case sharedlink.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case sharedlink.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case sharedlink.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case sharedlink.FieldResourceType:
-		return m.OldResourceType(ctx)
-	case sharedlink.FieldResourceID:
-		return m.OldResourceID(ctx)
-	case sharedlink.FieldResourceName:
-		return m.OldResourceName(ctx)
-	case sharedlink.FieldToken:
-		return m.OldToken(ctx)
-	case sharedlink.FieldEncryptedContent:
-		return m.OldEncryptedContent(ctx)
-	case sharedlink.FieldEncryptionNonce:
-		return m.OldEncryptionNonce(ctx)
-	case sharedlink.FieldRecipientEmail:
-		return m.OldRecipientEmail(ctx)
-	case sharedlink.FieldMessage:
-		return m.OldMessage(ctx)
-	case sharedlink.FieldTemplateID:
-		return m.OldTemplateID(ctx)
-	case sharedlink.FieldViewed:
-		return m.OldViewed(ctx)
-	case sharedlink.FieldViewedAt:
-		return m.OldViewedAt(ctx)
-	case sharedlink.FieldViewedIP:
-		return m.OldViewedIP(ctx)
-	case sharedlink.FieldRevoked:
-		return m.OldRevoked(ctx)
-	}
-	return nil, fmt.Errorf("unknown SharedLink field %s", name)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharedLinkMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case sharedlink.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case sharedlink.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case sharedlink.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case sharedlink.FieldResourceType:
-		v, ok := value.(sharedlink.ResourceType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResourceType(v)
-		return nil
-	case sharedlink.FieldResourceID:
+	case webhookdelivery.FieldWebhookID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceID(v)
+		m.SetWebhookID(v)
 		return nil
-	case sharedlink.FieldResourceName:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceName(v)
+		m.SetEventID(v)
 		return nil
-	case sharedlink.FieldToken:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetEventType(v)
 		return nil
-	case sharedlink.FieldEncryptedContent:
-		v, ok := value.([]byte)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedContent(v)
+		m.SetPayload(v)
 		return nil
-	case sharedlink.FieldEncryptionNonce:
-		v, ok := value.([]byte)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionNonce(v)
+		m.SetStatus(v)
 		return nil
-	case sharedlink.FieldRecipientEmail:
-		v, ok := value.(string)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRecipientEmail(v)
+		m.SetAttempts(v)
 		return nil
-	case sharedlink.FieldMessage:
-		v, ok := value.(string)
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetResponseStatus(v)
 		return nil
-	case sharedlink.FieldTemplateID:
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTemplateID(v)
-		return nil
-	case sharedlink.FieldViewed:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewed(v)
+		m.SetLastError(v)
 		return nil
-	case sharedlink.FieldViewedAt:
+	case webhookdelivery.FieldNextAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViewedAt(v)
-		return nil
-	case sharedlink.FieldViewedIP:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewedIP(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case sharedlink.FieldRevoked:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevoked(v)
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLink field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SharedLinkMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, sharedlink.FieldCreateBy)
-	}
 	if m.addtenant_id != nil {
-		fields = append(fields, sharedlink.FieldTenantID)
+		fields = append(fields, webhookdelivery.FieldTenantID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
 	return fields
 }
@@ -3379,12 +12638,14 @@ func (m *SharedLinkMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SharedLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		return m.AddedCreateBy()
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		return m.AddedTenantID()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
 	}
 	return nil, false
 }
@@ -3392,220 +12653,197 @@ func (m *SharedLinkMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharedLinkMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sharedlink.FieldCreateBy:
+	case webhookdelivery.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreateBy(v)
+		m.AddTenantID(v)
 		return nil
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldAttempts:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTenantID(v)
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLink numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SharedLinkMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(sharedlink.FieldCreateBy) {
-		fields = append(fields, sharedlink.FieldCreateBy)
-	}
-	if m.FieldCleared(sharedlink.FieldCreateTime) {
-		fields = append(fields, sharedlink.FieldCreateTime)
-	}
-	if m.FieldCleared(sharedlink.FieldUpdateTime) {
-		fields = append(fields, sharedlink.FieldUpdateTime)
-	}
-	if m.FieldCleared(sharedlink.FieldDeleteTime) {
-		fields = append(fields, sharedlink.FieldDeleteTime)
+	if m.FieldCleared(webhookdelivery.FieldCreateTime) {
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
-	if m.FieldCleared(sharedlink.FieldTenantID) {
-		fields = append(fields, sharedlink.FieldTenantID)
+	if m.FieldCleared(webhookdelivery.FieldUpdateTime) {
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.FieldCleared(sharedlink.FieldEncryptedContent) {
-		fields = append(fields, sharedlink.FieldEncryptedContent)
+	if m.FieldCleared(webhookdelivery.FieldDeleteTime) {
+		fields = append(fields, webhookdelivery.FieldDeleteTime)
 	}
-	if m.FieldCleared(sharedlink.FieldEncryptionNonce) {
-		fields = append(fields, sharedlink.FieldEncryptionNonce)
+	if m.FieldCleared(webhookdelivery.FieldTenantID) {
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m.FieldCleared(sharedlink.FieldMessage) {
-		fields = append(fields, sharedlink.FieldMessage)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.FieldCleared(sharedlink.FieldTemplateID) {
-		fields = append(fields, sharedlink.FieldTemplateID)
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.FieldCleared(sharedlink.FieldViewedAt) {
-		fields = append(fields, sharedlink.FieldViewedAt)
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.FieldCleared(sharedlink.FieldViewedIP) {
-		fields = append(fields, sharedlink.FieldViewedIP)
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SharedLinkMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SharedLinkMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case sharedlink.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case sharedlink.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case sharedlink.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		m.ClearTenantID()
 		return nil
-	case sharedlink.FieldEncryptedContent:
-		m.ClearEncryptedContent()
-		return nil
-	case sharedlink.FieldEncryptionNonce:
-		m.ClearEncryptionNonce()
-		return nil
-	case sharedlink.FieldMessage:
-		m.ClearMessage()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
 		return nil
-	case sharedlink.FieldTemplateID:
-		m.ClearTemplateID()
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
-	case sharedlink.FieldViewedAt:
-		m.ClearViewedAt()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
 		return nil
-	case sharedlink.FieldViewedIP:
-		m.ClearViewedIP()
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLink nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SharedLinkMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case sharedlink.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case sharedlink.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case sharedlink.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case sharedlink.FieldDeleteTime:
+	case webhookdelivery.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case sharedlink.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case sharedlink.FieldResourceType:
-		m.ResetResourceType()
-		return nil
-	case sharedlink.FieldResourceID:
-		m.ResetResourceID()
-		return nil
-	case sharedlink.FieldResourceName:
-		m.ResetResourceName()
-		return nil
-	case sharedlink.FieldToken:
-		m.ResetToken()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case sharedlink.FieldEncryptedContent:
-		m.ResetEncryptedContent()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case sharedlink.FieldEncryptionNonce:
-		m.ResetEncryptionNonce()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case sharedlink.FieldRecipientEmail:
-		m.ResetRecipientEmail()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case sharedlink.FieldMessage:
-		m.ResetMessage()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case sharedlink.FieldTemplateID:
-		m.ResetTemplateID()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case sharedlink.FieldViewed:
-		m.ResetViewed()
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
 		return nil
-	case sharedlink.FieldViewedAt:
-		m.ResetViewedAt()
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case sharedlink.FieldViewedIP:
-		m.ResetViewedIP()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
 		return nil
-	case sharedlink.FieldRevoked:
-		m.ResetRevoked()
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLink field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SharedLinkMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SharedLinkMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SharedLinkMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SharedLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SharedLinkMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SharedLinkMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SharedLinkMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SharedLink unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SharedLinkMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SharedLink edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
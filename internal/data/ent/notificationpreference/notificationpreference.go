@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldNotifyViewed holds the string denoting the notify_viewed field in the database.
+	FieldNotifyViewed = "notify_viewed"
+	// FieldNotifyDenied holds the string denoting the notify_denied field in the database.
+	FieldNotifyDenied = "notify_denied"
+	// FieldNotifyExpired holds the string denoting the notify_expired field in the database.
+	FieldNotifyExpired = "notify_expired"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "sharing_notification_preferences"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldUserID,
+	FieldNotifyViewed,
+	FieldNotifyDenied,
+	FieldNotifyExpired,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultNotifyViewed holds the default value on creation for the "notify_viewed" field.
+	DefaultNotifyViewed bool
+	// DefaultNotifyDenied holds the default value on creation for the "notify_denied" field.
+	DefaultNotifyDenied bool
+	// DefaultNotifyExpired holds the default value on creation for the "notify_expired" field.
+	DefaultNotifyExpired bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the NotificationPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByNotifyViewed orders the results by the notify_viewed field.
+func ByNotifyViewed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyViewed, opts...).ToFunc()
+}
+
+// ByNotifyDenied orders the results by the notify_denied field.
+func ByNotifyDenied(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyDenied, opts...).ToFunc()
+}
+
+// ByNotifyExpired orders the results by the notify_expired field.
+func ByNotifyExpired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyExpired, opts...).ToFunc()
+}
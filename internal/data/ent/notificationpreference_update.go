@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationPreferenceUpdate) SetUpdateTime(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableUpdateTime(v *time.Time) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *NotificationPreferenceUpdate) ClearUpdateTime() *NotificationPreferenceUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *NotificationPreferenceUpdate) SetDeleteTime(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableDeleteTime(v *time.Time) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *NotificationPreferenceUpdate) ClearDeleteTime() *NotificationPreferenceUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationPreferenceUpdate) SetUserID(v uint32) *NotificationPreferenceUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableUserID(v *uint32) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *NotificationPreferenceUpdate) AddUserID(v int32) *NotificationPreferenceUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetNotifyViewed sets the "notify_viewed" field.
+func (_u *NotificationPreferenceUpdate) SetNotifyViewed(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetNotifyViewed(v)
+	return _u
+}
+
+// SetNillableNotifyViewed sets the "notify_viewed" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableNotifyViewed(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetNotifyViewed(*v)
+	}
+	return _u
+}
+
+// SetNotifyDenied sets the "notify_denied" field.
+func (_u *NotificationPreferenceUpdate) SetNotifyDenied(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetNotifyDenied(v)
+	return _u
+}
+
+// SetNillableNotifyDenied sets the "notify_denied" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableNotifyDenied(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetNotifyDenied(*v)
+	}
+	return _u
+}
+
+// SetNotifyExpired sets the "notify_expired" field.
+func (_u *NotificationPreferenceUpdate) SetNotifyExpired(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetNotifyExpired(v)
+	return _u
+}
+
+// SetNillableNotifyExpired sets the "notify_expired" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableNotifyExpired(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetNotifyExpired(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationPreferenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationPreferenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(notificationpreference.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(notificationpreference.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(notificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(notificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.NotifyViewed(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyViewed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyDenied(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyDenied, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyExpired(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyExpired, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdateTime(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableUpdateTime(v *time.Time) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *NotificationPreferenceUpdateOne) ClearUpdateTime() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *NotificationPreferenceUpdateOne) SetDeleteTime(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableDeleteTime(v *time.Time) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *NotificationPreferenceUpdateOne) ClearDeleteTime() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationPreferenceUpdateOne) SetUserID(v uint32) *NotificationPreferenceUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableUserID(v *uint32) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *NotificationPreferenceUpdateOne) AddUserID(v int32) *NotificationPreferenceUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetNotifyViewed sets the "notify_viewed" field.
+func (_u *NotificationPreferenceUpdateOne) SetNotifyViewed(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetNotifyViewed(v)
+	return _u
+}
+
+// SetNillableNotifyViewed sets the "notify_viewed" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableNotifyViewed(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetNotifyViewed(*v)
+	}
+	return _u
+}
+
+// SetNotifyDenied sets the "notify_denied" field.
+func (_u *NotificationPreferenceUpdateOne) SetNotifyDenied(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetNotifyDenied(v)
+	return _u
+}
+
+// SetNillableNotifyDenied sets the "notify_denied" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableNotifyDenied(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetNotifyDenied(*v)
+	}
+	return _u
+}
+
+// SetNotifyExpired sets the "notify_expired" field.
+func (_u *NotificationPreferenceUpdateOne) SetNotifyExpired(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetNotifyExpired(v)
+	return _u
+}
+
+// SetNillableNotifyExpired sets the "notify_expired" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableNotifyExpired(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetNotifyExpired(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationPreferenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationPreferenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(notificationpreference.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(notificationpreference.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(notificationpreference.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(notificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(notificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.NotifyViewed(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyViewed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyDenied(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyDenied, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyExpired(); ok {
+		_spec.SetField(notificationpreference.FieldNotifyExpired, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
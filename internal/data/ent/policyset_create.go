@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+)
+
+// PolicySetCreate is the builder for creating a PolicySet entity.
+type PolicySetCreate struct {
+	config
+	mutation *PolicySetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *PolicySetCreate) SetCreateBy(v uint32) *PolicySetCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableCreateBy(v *uint32) *PolicySetCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *PolicySetCreate) SetUpdateBy(v uint32) *PolicySetCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableUpdateBy(v *uint32) *PolicySetCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *PolicySetCreate) SetCreateTime(v time.Time) *PolicySetCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableCreateTime(v *time.Time) *PolicySetCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *PolicySetCreate) SetUpdateTime(v time.Time) *PolicySetCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableUpdateTime(v *time.Time) *PolicySetCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *PolicySetCreate) SetDeleteTime(v time.Time) *PolicySetCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableDeleteTime(v *time.Time) *PolicySetCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PolicySetCreate) SetTenantID(v uint32) *PolicySetCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableTenantID(v *uint32) *PolicySetCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PolicySetCreate) SetName(v string) *PolicySetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PolicySetCreate) SetDescription(v string) *PolicySetCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PolicySetCreate) SetNillableDescription(v *string) *PolicySetCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetRules sets the "rules" field.
+func (_c *PolicySetCreate) SetRules(v []schema.PolicyRule) *PolicySetCreate {
+	_c.mutation.SetRules(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicySetCreate) SetID(v string) *PolicySetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PolicySetMutation object of the builder.
+func (_c *PolicySetCreate) Mutation() *PolicySetMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicySet in the database.
+func (_c *PolicySetCreate) Save(ctx context.Context) (*PolicySet, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicySetCreate) SaveX(ctx context.Context) *PolicySet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicySetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicySetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicySetCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := policyset.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicySetCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PolicySet.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := policyset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PolicySet.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := policyset.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "PolicySet.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "PolicySet.rules"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := policyset.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PolicySet.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PolicySetCreate) sqlSave(ctx context.Context) (*PolicySet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PolicySet.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicySetCreate) createSpec() (*PolicySet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicySet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyset.Table, sqlgraph.NewFieldSpec(policyset.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(policyset.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(policyset.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(policyset.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(policyset.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(policyset.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(policyset.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(policyset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(policyset.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Rules(); ok {
+		_spec.SetField(policyset.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicySet.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicySetUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicySetCreate) OnConflict(opts ...sql.ConflictOption) *PolicySetUpsertOne {
+	_c.conflict = opts
+	return &PolicySetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicySetCreate) OnConflictColumns(columns ...string) *PolicySetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicySetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicySetUpsertOne is the builder for "upsert"-ing
+	//  one PolicySet node.
+	PolicySetUpsertOne struct {
+		create *PolicySetCreate
+	}
+
+	// PolicySetUpsert is the "OnConflict" setter.
+	PolicySetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *PolicySetUpsert) SetCreateBy(v uint32) *PolicySetUpsert {
+	u.Set(policyset.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateCreateBy() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *PolicySetUpsert) AddCreateBy(v uint32) *PolicySetUpsert {
+	u.Add(policyset.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *PolicySetUpsert) ClearCreateBy() *PolicySetUpsert {
+	u.SetNull(policyset.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *PolicySetUpsert) SetUpdateBy(v uint32) *PolicySetUpsert {
+	u.Set(policyset.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateUpdateBy() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *PolicySetUpsert) AddUpdateBy(v uint32) *PolicySetUpsert {
+	u.Add(policyset.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *PolicySetUpsert) ClearUpdateBy() *PolicySetUpsert {
+	u.SetNull(policyset.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PolicySetUpsert) SetUpdateTime(v time.Time) *PolicySetUpsert {
+	u.Set(policyset.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateUpdateTime() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PolicySetUpsert) ClearUpdateTime() *PolicySetUpsert {
+	u.SetNull(policyset.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PolicySetUpsert) SetDeleteTime(v time.Time) *PolicySetUpsert {
+	u.Set(policyset.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateDeleteTime() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PolicySetUpsert) ClearDeleteTime() *PolicySetUpsert {
+	u.SetNull(policyset.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PolicySetUpsert) SetName(v string) *PolicySetUpsert {
+	u.Set(policyset.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateName() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PolicySetUpsert) SetDescription(v string) *PolicySetUpsert {
+	u.Set(policyset.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateDescription() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PolicySetUpsert) ClearDescription() *PolicySetUpsert {
+	u.SetNull(policyset.FieldDescription)
+	return u
+}
+
+// SetRules sets the "rules" field.
+func (u *PolicySetUpsert) SetRules(v []schema.PolicyRule) *PolicySetUpsert {
+	u.Set(policyset.FieldRules, v)
+	return u
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *PolicySetUpsert) UpdateRules() *PolicySetUpsert {
+	u.SetExcluded(policyset.FieldRules)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicySetUpsertOne) UpdateNewValues() *PolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(policyset.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(policyset.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicySetUpsertOne) Ignore() *PolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicySetUpsertOne) DoNothing() *PolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicySetCreate.OnConflict
+// documentation for more info.
+func (u *PolicySetUpsertOne) Update(set func(*PolicySetUpsert)) *PolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicySetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *PolicySetUpsertOne) SetCreateBy(v uint32) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *PolicySetUpsertOne) AddCreateBy(v uint32) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateCreateBy() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *PolicySetUpsertOne) ClearCreateBy() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *PolicySetUpsertOne) SetUpdateBy(v uint32) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *PolicySetUpsertOne) AddUpdateBy(v uint32) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateUpdateBy() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *PolicySetUpsertOne) ClearUpdateBy() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PolicySetUpsertOne) SetUpdateTime(v time.Time) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateUpdateTime() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PolicySetUpsertOne) ClearUpdateTime() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PolicySetUpsertOne) SetDeleteTime(v time.Time) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateDeleteTime() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PolicySetUpsertOne) ClearDeleteTime() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PolicySetUpsertOne) SetName(v string) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateName() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PolicySetUpsertOne) SetDescription(v string) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateDescription() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PolicySetUpsertOne) ClearDescription() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *PolicySetUpsertOne) SetRules(v []schema.PolicyRule) *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *PolicySetUpsertOne) UpdateRules() *PolicySetUpsertOne {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicySetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicySetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicySetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicySetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicySetUpsertOne.ID is not supported by MySQL driver. Use PolicySetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicySetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicySetCreateBulk is the builder for creating many PolicySet entities in bulk.
+type PolicySetCreateBulk struct {
+	config
+	err      error
+	builders []*PolicySetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicySet entities in the database.
+func (_c *PolicySetCreateBulk) Save(ctx context.Context) ([]*PolicySet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicySet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicySetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicySetCreateBulk) SaveX(ctx context.Context) []*PolicySet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicySetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicySetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicySet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicySetUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicySetCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicySetUpsertBulk {
+	_c.conflict = opts
+	return &PolicySetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicySetCreateBulk) OnConflictColumns(columns ...string) *PolicySetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicySetUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicySetUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicySet nodes.
+type PolicySetUpsertBulk struct {
+	create *PolicySetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicySetUpsertBulk) UpdateNewValues() *PolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyset.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(policyset.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(policyset.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicySet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicySetUpsertBulk) Ignore() *PolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicySetUpsertBulk) DoNothing() *PolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicySetCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicySetUpsertBulk) Update(set func(*PolicySetUpsert)) *PolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicySetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *PolicySetUpsertBulk) SetCreateBy(v uint32) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *PolicySetUpsertBulk) AddCreateBy(v uint32) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateCreateBy() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *PolicySetUpsertBulk) ClearCreateBy() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *PolicySetUpsertBulk) SetUpdateBy(v uint32) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *PolicySetUpsertBulk) AddUpdateBy(v uint32) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateUpdateBy() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *PolicySetUpsertBulk) ClearUpdateBy() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PolicySetUpsertBulk) SetUpdateTime(v time.Time) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateUpdateTime() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *PolicySetUpsertBulk) ClearUpdateTime() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *PolicySetUpsertBulk) SetDeleteTime(v time.Time) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateDeleteTime() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *PolicySetUpsertBulk) ClearDeleteTime() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PolicySetUpsertBulk) SetName(v string) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateName() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PolicySetUpsertBulk) SetDescription(v string) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateDescription() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PolicySetUpsertBulk) ClearDescription() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *PolicySetUpsertBulk) SetRules(v []schema.PolicyRule) *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *PolicySetUpsertBulk) UpdateRules() *PolicySetUpsertBulk {
+	return u.Update(func(s *PolicySetUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicySetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicySetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicySetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicySetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,8 +9,29 @@ import (
 // EmailTemplate is the predicate function for emailtemplate builders.
 type EmailTemplate func(*sql.Selector)
 
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// PolicySet is the predicate function for policyset builders.
+type PolicySet func(*sql.Selector)
+
+// RiskSettings is the predicate function for risksettings builders.
+type RiskSettings func(*sql.Selector)
+
+// ShareAccessEvent is the predicate function for shareaccessevent builders.
+type ShareAccessEvent func(*sql.Selector)
+
 // SharePolicy is the predicate function for sharepolicy builders.
 type SharePolicy func(*sql.Selector)
 
 // SharedLink is the predicate function for sharedlink builders.
 type SharedLink func(*sql.Selector)
+
+// SharedLinkPolicySet is the predicate function for sharedlinkpolicyset builders.
+type SharedLinkPolicySet func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
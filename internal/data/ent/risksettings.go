@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+)
+
+// RiskSettings is the model entity for the RiskSettings schema.
+type RiskSettings struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Whether access attempts are scored for risk
+	Enabled bool `json:"enabled,omitempty"`
+	// Score from which a passphrase or email code is required
+	StepUpThreshold int32 `json:"step_up_threshold,omitempty"`
+	// Score from which access is denied (101 never denies)
+	DenyThreshold int32 `json:"deny_threshold,omitempty"`
+	// Business hours of the tenant's senders, in TIME policy syntax
+	BusinessHours string `json:"business_hours,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RiskSettings) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case risksettings.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case risksettings.FieldTenantID, risksettings.FieldStepUpThreshold, risksettings.FieldDenyThreshold:
+			values[i] = new(sql.NullInt64)
+		case risksettings.FieldID, risksettings.FieldBusinessHours:
+			values[i] = new(sql.NullString)
+		case risksettings.FieldCreateTime, risksettings.FieldUpdateTime, risksettings.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RiskSettings fields.
+func (_m *RiskSettings) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case risksettings.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case risksettings.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case risksettings.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case risksettings.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case risksettings.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case risksettings.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case risksettings.FieldStepUpThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_up_threshold", values[i])
+			} else if value.Valid {
+				_m.StepUpThreshold = int32(value.Int64)
+			}
+		case risksettings.FieldDenyThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deny_threshold", values[i])
+			} else if value.Valid {
+				_m.DenyThreshold = int32(value.Int64)
+			}
+		case risksettings.FieldBusinessHours:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field business_hours", values[i])
+			} else if value.Valid {
+				_m.BusinessHours = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RiskSettings.
+// This includes values selected through modifiers, order, etc.
+func (_m *RiskSettings) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RiskSettings.
+// Note that you need to call RiskSettings.Unwrap() before calling this method if this RiskSettings
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RiskSettings) Update() *RiskSettingsUpdateOne {
+	return NewRiskSettingsClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RiskSettings entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RiskSettings) Unwrap() *RiskSettings {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RiskSettings is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RiskSettings) String() string {
+	var builder strings.Builder
+	builder.WriteString("RiskSettings(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("step_up_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StepUpThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("deny_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DenyThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("business_hours=")
+	builder.WriteString(_m.BusinessHours)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RiskSettingsSlice is a parsable slice of RiskSettings.
+type RiskSettingsSlice []*RiskSettings
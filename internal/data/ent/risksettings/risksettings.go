@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package risksettings
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the risksettings type in the database.
+	Label = "risk_settings"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldStepUpThreshold holds the string denoting the step_up_threshold field in the database.
+	FieldStepUpThreshold = "step_up_threshold"
+	// FieldDenyThreshold holds the string denoting the deny_threshold field in the database.
+	FieldDenyThreshold = "deny_threshold"
+	// FieldBusinessHours holds the string denoting the business_hours field in the database.
+	FieldBusinessHours = "business_hours"
+	// Table holds the table name of the risksettings in the database.
+	Table = "sharing_risk_settings"
+)
+
+// Columns holds all SQL columns for risksettings fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldEnabled,
+	FieldStepUpThreshold,
+	FieldDenyThreshold,
+	FieldBusinessHours,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultStepUpThreshold holds the default value on creation for the "step_up_threshold" field.
+	DefaultStepUpThreshold int32
+	// StepUpThresholdValidator is a validator for the "step_up_threshold" field. It is called by the builders before save.
+	StepUpThresholdValidator func(int32) error
+	// DefaultDenyThreshold holds the default value on creation for the "deny_threshold" field.
+	DefaultDenyThreshold int32
+	// DenyThresholdValidator is a validator for the "deny_threshold" field. It is called by the builders before save.
+	DenyThresholdValidator func(int32) error
+	// BusinessHoursValidator is a validator for the "business_hours" field. It is called by the builders before save.
+	BusinessHoursValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RiskSettings queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByStepUpThreshold orders the results by the step_up_threshold field.
+func ByStepUpThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepUpThreshold, opts...).ToFunc()
+}
+
+// ByDenyThreshold orders the results by the deny_threshold field.
+func ByDenyThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDenyThreshold, opts...).ToFunc()
+}
+
+// ByBusinessHours orders the results by the business_hours field.
+func ByBusinessHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessHours, opts...).ToFunc()
+}
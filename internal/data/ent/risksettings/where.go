@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package risksettings
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldEnabled, v))
+}
+
+// StepUpThreshold applies equality check predicate on the "step_up_threshold" field. It's identical to StepUpThresholdEQ.
+func StepUpThreshold(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldStepUpThreshold, v))
+}
+
+// DenyThreshold applies equality check predicate on the "deny_threshold" field. It's identical to DenyThresholdEQ.
+func DenyThreshold(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldDenyThreshold, v))
+}
+
+// BusinessHours applies equality check predicate on the "business_hours" field. It's identical to BusinessHoursEQ.
+func BusinessHours(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldBusinessHours, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotNull(FieldTenantID))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// StepUpThresholdEQ applies the EQ predicate on the "step_up_threshold" field.
+func StepUpThresholdEQ(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldStepUpThreshold, v))
+}
+
+// StepUpThresholdNEQ applies the NEQ predicate on the "step_up_threshold" field.
+func StepUpThresholdNEQ(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldStepUpThreshold, v))
+}
+
+// StepUpThresholdIn applies the In predicate on the "step_up_threshold" field.
+func StepUpThresholdIn(vs ...int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldStepUpThreshold, vs...))
+}
+
+// StepUpThresholdNotIn applies the NotIn predicate on the "step_up_threshold" field.
+func StepUpThresholdNotIn(vs ...int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldStepUpThreshold, vs...))
+}
+
+// StepUpThresholdGT applies the GT predicate on the "step_up_threshold" field.
+func StepUpThresholdGT(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldStepUpThreshold, v))
+}
+
+// StepUpThresholdGTE applies the GTE predicate on the "step_up_threshold" field.
+func StepUpThresholdGTE(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldStepUpThreshold, v))
+}
+
+// StepUpThresholdLT applies the LT predicate on the "step_up_threshold" field.
+func StepUpThresholdLT(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldStepUpThreshold, v))
+}
+
+// StepUpThresholdLTE applies the LTE predicate on the "step_up_threshold" field.
+func StepUpThresholdLTE(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldStepUpThreshold, v))
+}
+
+// DenyThresholdEQ applies the EQ predicate on the "deny_threshold" field.
+func DenyThresholdEQ(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldDenyThreshold, v))
+}
+
+// DenyThresholdNEQ applies the NEQ predicate on the "deny_threshold" field.
+func DenyThresholdNEQ(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldDenyThreshold, v))
+}
+
+// DenyThresholdIn applies the In predicate on the "deny_threshold" field.
+func DenyThresholdIn(vs ...int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldDenyThreshold, vs...))
+}
+
+// DenyThresholdNotIn applies the NotIn predicate on the "deny_threshold" field.
+func DenyThresholdNotIn(vs ...int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldDenyThreshold, vs...))
+}
+
+// DenyThresholdGT applies the GT predicate on the "deny_threshold" field.
+func DenyThresholdGT(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldDenyThreshold, v))
+}
+
+// DenyThresholdGTE applies the GTE predicate on the "deny_threshold" field.
+func DenyThresholdGTE(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldDenyThreshold, v))
+}
+
+// DenyThresholdLT applies the LT predicate on the "deny_threshold" field.
+func DenyThresholdLT(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldDenyThreshold, v))
+}
+
+// DenyThresholdLTE applies the LTE predicate on the "deny_threshold" field.
+func DenyThresholdLTE(v int32) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldDenyThreshold, v))
+}
+
+// BusinessHoursEQ applies the EQ predicate on the "business_hours" field.
+func BusinessHoursEQ(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEQ(FieldBusinessHours, v))
+}
+
+// BusinessHoursNEQ applies the NEQ predicate on the "business_hours" field.
+func BusinessHoursNEQ(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNEQ(FieldBusinessHours, v))
+}
+
+// BusinessHoursIn applies the In predicate on the "business_hours" field.
+func BusinessHoursIn(vs ...string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIn(FieldBusinessHours, vs...))
+}
+
+// BusinessHoursNotIn applies the NotIn predicate on the "business_hours" field.
+func BusinessHoursNotIn(vs ...string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotIn(FieldBusinessHours, vs...))
+}
+
+// BusinessHoursGT applies the GT predicate on the "business_hours" field.
+func BusinessHoursGT(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGT(FieldBusinessHours, v))
+}
+
+// BusinessHoursGTE applies the GTE predicate on the "business_hours" field.
+func BusinessHoursGTE(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldGTE(FieldBusinessHours, v))
+}
+
+// BusinessHoursLT applies the LT predicate on the "business_hours" field.
+func BusinessHoursLT(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLT(FieldBusinessHours, v))
+}
+
+// BusinessHoursLTE applies the LTE predicate on the "business_hours" field.
+func BusinessHoursLTE(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldLTE(FieldBusinessHours, v))
+}
+
+// BusinessHoursContains applies the Contains predicate on the "business_hours" field.
+func BusinessHoursContains(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldContains(FieldBusinessHours, v))
+}
+
+// BusinessHoursHasPrefix applies the HasPrefix predicate on the "business_hours" field.
+func BusinessHoursHasPrefix(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldHasPrefix(FieldBusinessHours, v))
+}
+
+// BusinessHoursHasSuffix applies the HasSuffix predicate on the "business_hours" field.
+func BusinessHoursHasSuffix(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldHasSuffix(FieldBusinessHours, v))
+}
+
+// BusinessHoursIsNil applies the IsNil predicate on the "business_hours" field.
+func BusinessHoursIsNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldIsNull(FieldBusinessHours))
+}
+
+// BusinessHoursNotNil applies the NotNil predicate on the "business_hours" field.
+func BusinessHoursNotNil() predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldNotNull(FieldBusinessHours))
+}
+
+// BusinessHoursEqualFold applies the EqualFold predicate on the "business_hours" field.
+func BusinessHoursEqualFold(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldEqualFold(FieldBusinessHours, v))
+}
+
+// BusinessHoursContainsFold applies the ContainsFold predicate on the "business_hours" field.
+func BusinessHoursContainsFold(v string) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.FieldContainsFold(FieldBusinessHours, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RiskSettings) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RiskSettings) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RiskSettings) predicate.RiskSettings {
+	return predicate.RiskSettings(sql.NotPredicates(p))
+}
@@ -0,0 +1,987 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+)
+
+// RiskSettingsCreate is the builder for creating a RiskSettings entity.
+type RiskSettingsCreate struct {
+	config
+	mutation *RiskSettingsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *RiskSettingsCreate) SetCreateTime(v time.Time) *RiskSettingsCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableCreateTime(v *time.Time) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *RiskSettingsCreate) SetUpdateTime(v time.Time) *RiskSettingsCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableUpdateTime(v *time.Time) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *RiskSettingsCreate) SetDeleteTime(v time.Time) *RiskSettingsCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableDeleteTime(v *time.Time) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RiskSettingsCreate) SetTenantID(v uint32) *RiskSettingsCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableTenantID(v *uint32) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RiskSettingsCreate) SetEnabled(v bool) *RiskSettingsCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableEnabled(v *bool) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (_c *RiskSettingsCreate) SetStepUpThreshold(v int32) *RiskSettingsCreate {
+	_c.mutation.SetStepUpThreshold(v)
+	return _c
+}
+
+// SetNillableStepUpThreshold sets the "step_up_threshold" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableStepUpThreshold(v *int32) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetStepUpThreshold(*v)
+	}
+	return _c
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (_c *RiskSettingsCreate) SetDenyThreshold(v int32) *RiskSettingsCreate {
+	_c.mutation.SetDenyThreshold(v)
+	return _c
+}
+
+// SetNillableDenyThreshold sets the "deny_threshold" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableDenyThreshold(v *int32) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetDenyThreshold(*v)
+	}
+	return _c
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (_c *RiskSettingsCreate) SetBusinessHours(v string) *RiskSettingsCreate {
+	_c.mutation.SetBusinessHours(v)
+	return _c
+}
+
+// SetNillableBusinessHours sets the "business_hours" field if the given value is not nil.
+func (_c *RiskSettingsCreate) SetNillableBusinessHours(v *string) *RiskSettingsCreate {
+	if v != nil {
+		_c.SetBusinessHours(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RiskSettingsCreate) SetID(v string) *RiskSettingsCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RiskSettingsMutation object of the builder.
+func (_c *RiskSettingsCreate) Mutation() *RiskSettingsMutation {
+	return _c.mutation
+}
+
+// Save creates the RiskSettings in the database.
+func (_c *RiskSettingsCreate) Save(ctx context.Context) (*RiskSettings, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RiskSettingsCreate) SaveX(ctx context.Context) *RiskSettings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskSettingsCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskSettingsCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RiskSettingsCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := risksettings.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := risksettings.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.StepUpThreshold(); !ok {
+		v := risksettings.DefaultStepUpThreshold
+		_c.mutation.SetStepUpThreshold(v)
+	}
+	if _, ok := _c.mutation.DenyThreshold(); !ok {
+		v := risksettings.DefaultDenyThreshold
+		_c.mutation.SetDenyThreshold(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RiskSettingsCreate) check() error {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RiskSettings.enabled"`)}
+	}
+	if _, ok := _c.mutation.StepUpThreshold(); !ok {
+		return &ValidationError{Name: "step_up_threshold", err: errors.New(`ent: missing required field "RiskSettings.step_up_threshold"`)}
+	}
+	if v, ok := _c.mutation.StepUpThreshold(); ok {
+		if err := risksettings.StepUpThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "step_up_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.step_up_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DenyThreshold(); !ok {
+		return &ValidationError{Name: "deny_threshold", err: errors.New(`ent: missing required field "RiskSettings.deny_threshold"`)}
+	}
+	if v, ok := _c.mutation.DenyThreshold(); ok {
+		if err := risksettings.DenyThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "deny_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.deny_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BusinessHours(); ok {
+		if err := risksettings.BusinessHoursValidator(v); err != nil {
+			return &ValidationError{Name: "business_hours", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.business_hours": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := risksettings.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RiskSettingsCreate) sqlSave(ctx context.Context) (*RiskSettings, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RiskSettings.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RiskSettingsCreate) createSpec() (*RiskSettings, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RiskSettings{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(risksettings.Table, sqlgraph.NewFieldSpec(risksettings.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(risksettings.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(risksettings.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(risksettings.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(risksettings.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(risksettings.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.StepUpThreshold(); ok {
+		_spec.SetField(risksettings.FieldStepUpThreshold, field.TypeInt32, value)
+		_node.StepUpThreshold = value
+	}
+	if value, ok := _c.mutation.DenyThreshold(); ok {
+		_spec.SetField(risksettings.FieldDenyThreshold, field.TypeInt32, value)
+		_node.DenyThreshold = value
+	}
+	if value, ok := _c.mutation.BusinessHours(); ok {
+		_spec.SetField(risksettings.FieldBusinessHours, field.TypeString, value)
+		_node.BusinessHours = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RiskSettings.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RiskSettingsUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RiskSettingsCreate) OnConflict(opts ...sql.ConflictOption) *RiskSettingsUpsertOne {
+	_c.conflict = opts
+	return &RiskSettingsUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RiskSettingsCreate) OnConflictColumns(columns ...string) *RiskSettingsUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RiskSettingsUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RiskSettingsUpsertOne is the builder for "upsert"-ing
+	//  one RiskSettings node.
+	RiskSettingsUpsertOne struct {
+		create *RiskSettingsCreate
+	}
+
+	// RiskSettingsUpsert is the "OnConflict" setter.
+	RiskSettingsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RiskSettingsUpsert) SetUpdateTime(v time.Time) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateUpdateTime() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *RiskSettingsUpsert) ClearUpdateTime() *RiskSettingsUpsert {
+	u.SetNull(risksettings.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *RiskSettingsUpsert) SetDeleteTime(v time.Time) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateDeleteTime() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *RiskSettingsUpsert) ClearDeleteTime() *RiskSettingsUpsert {
+	u.SetNull(risksettings.FieldDeleteTime)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RiskSettingsUpsert) SetEnabled(v bool) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateEnabled() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldEnabled)
+	return u
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (u *RiskSettingsUpsert) SetStepUpThreshold(v int32) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldStepUpThreshold, v)
+	return u
+}
+
+// UpdateStepUpThreshold sets the "step_up_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateStepUpThreshold() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldStepUpThreshold)
+	return u
+}
+
+// AddStepUpThreshold adds v to the "step_up_threshold" field.
+func (u *RiskSettingsUpsert) AddStepUpThreshold(v int32) *RiskSettingsUpsert {
+	u.Add(risksettings.FieldStepUpThreshold, v)
+	return u
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (u *RiskSettingsUpsert) SetDenyThreshold(v int32) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldDenyThreshold, v)
+	return u
+}
+
+// UpdateDenyThreshold sets the "deny_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateDenyThreshold() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldDenyThreshold)
+	return u
+}
+
+// AddDenyThreshold adds v to the "deny_threshold" field.
+func (u *RiskSettingsUpsert) AddDenyThreshold(v int32) *RiskSettingsUpsert {
+	u.Add(risksettings.FieldDenyThreshold, v)
+	return u
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (u *RiskSettingsUpsert) SetBusinessHours(v string) *RiskSettingsUpsert {
+	u.Set(risksettings.FieldBusinessHours, v)
+	return u
+}
+
+// UpdateBusinessHours sets the "business_hours" field to the value that was provided on create.
+func (u *RiskSettingsUpsert) UpdateBusinessHours() *RiskSettingsUpsert {
+	u.SetExcluded(risksettings.FieldBusinessHours)
+	return u
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (u *RiskSettingsUpsert) ClearBusinessHours() *RiskSettingsUpsert {
+	u.SetNull(risksettings.FieldBusinessHours)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(risksettings.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RiskSettingsUpsertOne) UpdateNewValues() *RiskSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(risksettings.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(risksettings.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(risksettings.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RiskSettingsUpsertOne) Ignore() *RiskSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RiskSettingsUpsertOne) DoNothing() *RiskSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RiskSettingsCreate.OnConflict
+// documentation for more info.
+func (u *RiskSettingsUpsertOne) Update(set func(*RiskSettingsUpsert)) *RiskSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RiskSettingsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RiskSettingsUpsertOne) SetUpdateTime(v time.Time) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateUpdateTime() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *RiskSettingsUpsertOne) ClearUpdateTime() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *RiskSettingsUpsertOne) SetDeleteTime(v time.Time) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateDeleteTime() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *RiskSettingsUpsertOne) ClearDeleteTime() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RiskSettingsUpsertOne) SetEnabled(v bool) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateEnabled() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (u *RiskSettingsUpsertOne) SetStepUpThreshold(v int32) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetStepUpThreshold(v)
+	})
+}
+
+// AddStepUpThreshold adds v to the "step_up_threshold" field.
+func (u *RiskSettingsUpsertOne) AddStepUpThreshold(v int32) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.AddStepUpThreshold(v)
+	})
+}
+
+// UpdateStepUpThreshold sets the "step_up_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateStepUpThreshold() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateStepUpThreshold()
+	})
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (u *RiskSettingsUpsertOne) SetDenyThreshold(v int32) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetDenyThreshold(v)
+	})
+}
+
+// AddDenyThreshold adds v to the "deny_threshold" field.
+func (u *RiskSettingsUpsertOne) AddDenyThreshold(v int32) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.AddDenyThreshold(v)
+	})
+}
+
+// UpdateDenyThreshold sets the "deny_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateDenyThreshold() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateDenyThreshold()
+	})
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (u *RiskSettingsUpsertOne) SetBusinessHours(v string) *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetBusinessHours(v)
+	})
+}
+
+// UpdateBusinessHours sets the "business_hours" field to the value that was provided on create.
+func (u *RiskSettingsUpsertOne) UpdateBusinessHours() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateBusinessHours()
+	})
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (u *RiskSettingsUpsertOne) ClearBusinessHours() *RiskSettingsUpsertOne {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearBusinessHours()
+	})
+}
+
+// Exec executes the query.
+func (u *RiskSettingsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RiskSettingsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RiskSettingsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RiskSettingsUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RiskSettingsUpsertOne.ID is not supported by MySQL driver. Use RiskSettingsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RiskSettingsUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RiskSettingsCreateBulk is the builder for creating many RiskSettings entities in bulk.
+type RiskSettingsCreateBulk struct {
+	config
+	err      error
+	builders []*RiskSettingsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RiskSettings entities in the database.
+func (_c *RiskSettingsCreateBulk) Save(ctx context.Context) ([]*RiskSettings, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RiskSettings, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RiskSettingsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RiskSettingsCreateBulk) SaveX(ctx context.Context) []*RiskSettings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskSettingsCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskSettingsCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RiskSettings.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RiskSettingsUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RiskSettingsCreateBulk) OnConflict(opts ...sql.ConflictOption) *RiskSettingsUpsertBulk {
+	_c.conflict = opts
+	return &RiskSettingsUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RiskSettingsCreateBulk) OnConflictColumns(columns ...string) *RiskSettingsUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RiskSettingsUpsertBulk{
+		create: _c,
+	}
+}
+
+// RiskSettingsUpsertBulk is the builder for "upsert"-ing
+// a bulk of RiskSettings nodes.
+type RiskSettingsUpsertBulk struct {
+	create *RiskSettingsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(risksettings.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RiskSettingsUpsertBulk) UpdateNewValues() *RiskSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(risksettings.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(risksettings.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(risksettings.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RiskSettings.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RiskSettingsUpsertBulk) Ignore() *RiskSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RiskSettingsUpsertBulk) DoNothing() *RiskSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RiskSettingsCreateBulk.OnConflict
+// documentation for more info.
+func (u *RiskSettingsUpsertBulk) Update(set func(*RiskSettingsUpsert)) *RiskSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RiskSettingsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RiskSettingsUpsertBulk) SetUpdateTime(v time.Time) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateUpdateTime() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *RiskSettingsUpsertBulk) ClearUpdateTime() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *RiskSettingsUpsertBulk) SetDeleteTime(v time.Time) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateDeleteTime() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *RiskSettingsUpsertBulk) ClearDeleteTime() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RiskSettingsUpsertBulk) SetEnabled(v bool) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateEnabled() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (u *RiskSettingsUpsertBulk) SetStepUpThreshold(v int32) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetStepUpThreshold(v)
+	})
+}
+
+// AddStepUpThreshold adds v to the "step_up_threshold" field.
+func (u *RiskSettingsUpsertBulk) AddStepUpThreshold(v int32) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.AddStepUpThreshold(v)
+	})
+}
+
+// UpdateStepUpThreshold sets the "step_up_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateStepUpThreshold() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateStepUpThreshold()
+	})
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (u *RiskSettingsUpsertBulk) SetDenyThreshold(v int32) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetDenyThreshold(v)
+	})
+}
+
+// AddDenyThreshold adds v to the "deny_threshold" field.
+func (u *RiskSettingsUpsertBulk) AddDenyThreshold(v int32) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.AddDenyThreshold(v)
+	})
+}
+
+// UpdateDenyThreshold sets the "deny_threshold" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateDenyThreshold() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateDenyThreshold()
+	})
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (u *RiskSettingsUpsertBulk) SetBusinessHours(v string) *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.SetBusinessHours(v)
+	})
+}
+
+// UpdateBusinessHours sets the "business_hours" field to the value that was provided on create.
+func (u *RiskSettingsUpsertBulk) UpdateBusinessHours() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.UpdateBusinessHours()
+	})
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (u *RiskSettingsUpsertBulk) ClearBusinessHours() *RiskSettingsUpsertBulk {
+	return u.Update(func(s *RiskSettingsUpsert) {
+		s.ClearBusinessHours()
+	})
+}
+
+// Exec executes the query.
+func (u *RiskSettingsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RiskSettingsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RiskSettingsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RiskSettingsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+)
+
+// RiskSettingsDelete is the builder for deleting a RiskSettings entity.
+type RiskSettingsDelete struct {
+	config
+	hooks    []Hook
+	mutation *RiskSettingsMutation
+}
+
+// Where appends a list predicates to the RiskSettingsDelete builder.
+func (_d *RiskSettingsDelete) Where(ps ...predicate.RiskSettings) *RiskSettingsDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RiskSettingsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RiskSettingsDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RiskSettingsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(risksettings.Table, sqlgraph.NewFieldSpec(risksettings.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RiskSettingsDeleteOne is the builder for deleting a single RiskSettings entity.
+type RiskSettingsDeleteOne struct {
+	_d *RiskSettingsDelete
+}
+
+// Where appends a list predicates to the RiskSettingsDelete builder.
+func (_d *RiskSettingsDeleteOne) Where(ps ...predicate.RiskSettings) *RiskSettingsDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RiskSettingsDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{risksettings.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RiskSettingsDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
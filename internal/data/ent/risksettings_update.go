@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+)
+
+// RiskSettingsUpdate is the builder for updating RiskSettings entities.
+type RiskSettingsUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RiskSettingsMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RiskSettingsUpdate builder.
+func (_u *RiskSettingsUpdate) Where(ps ...predicate.RiskSettings) *RiskSettingsUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RiskSettingsUpdate) SetUpdateTime(v time.Time) *RiskSettingsUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableUpdateTime(v *time.Time) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *RiskSettingsUpdate) ClearUpdateTime() *RiskSettingsUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *RiskSettingsUpdate) SetDeleteTime(v time.Time) *RiskSettingsUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableDeleteTime(v *time.Time) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *RiskSettingsUpdate) ClearDeleteTime() *RiskSettingsUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RiskSettingsUpdate) SetEnabled(v bool) *RiskSettingsUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableEnabled(v *bool) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (_u *RiskSettingsUpdate) SetStepUpThreshold(v int32) *RiskSettingsUpdate {
+	_u.mutation.ResetStepUpThreshold()
+	_u.mutation.SetStepUpThreshold(v)
+	return _u
+}
+
+// SetNillableStepUpThreshold sets the "step_up_threshold" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableStepUpThreshold(v *int32) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetStepUpThreshold(*v)
+	}
+	return _u
+}
+
+// AddStepUpThreshold adds value to the "step_up_threshold" field.
+func (_u *RiskSettingsUpdate) AddStepUpThreshold(v int32) *RiskSettingsUpdate {
+	_u.mutation.AddStepUpThreshold(v)
+	return _u
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (_u *RiskSettingsUpdate) SetDenyThreshold(v int32) *RiskSettingsUpdate {
+	_u.mutation.ResetDenyThreshold()
+	_u.mutation.SetDenyThreshold(v)
+	return _u
+}
+
+// SetNillableDenyThreshold sets the "deny_threshold" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableDenyThreshold(v *int32) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetDenyThreshold(*v)
+	}
+	return _u
+}
+
+// AddDenyThreshold adds value to the "deny_threshold" field.
+func (_u *RiskSettingsUpdate) AddDenyThreshold(v int32) *RiskSettingsUpdate {
+	_u.mutation.AddDenyThreshold(v)
+	return _u
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (_u *RiskSettingsUpdate) SetBusinessHours(v string) *RiskSettingsUpdate {
+	_u.mutation.SetBusinessHours(v)
+	return _u
+}
+
+// SetNillableBusinessHours sets the "business_hours" field if the given value is not nil.
+func (_u *RiskSettingsUpdate) SetNillableBusinessHours(v *string) *RiskSettingsUpdate {
+	if v != nil {
+		_u.SetBusinessHours(*v)
+	}
+	return _u
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (_u *RiskSettingsUpdate) ClearBusinessHours() *RiskSettingsUpdate {
+	_u.mutation.ClearBusinessHours()
+	return _u
+}
+
+// Mutation returns the RiskSettingsMutation object of the builder.
+func (_u *RiskSettingsUpdate) Mutation() *RiskSettingsMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RiskSettingsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskSettingsUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RiskSettingsUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskSettingsUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskSettingsUpdate) check() error {
+	if v, ok := _u.mutation.StepUpThreshold(); ok {
+		if err := risksettings.StepUpThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "step_up_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.step_up_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DenyThreshold(); ok {
+		if err := risksettings.DenyThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "deny_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.deny_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessHours(); ok {
+		if err := risksettings.BusinessHoursValidator(v); err != nil {
+			return &ValidationError{Name: "business_hours", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.business_hours": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RiskSettingsUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RiskSettingsUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RiskSettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(risksettings.Table, risksettings.Columns, sqlgraph.NewFieldSpec(risksettings.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(risksettings.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(risksettings.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(risksettings.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(risksettings.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(risksettings.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(risksettings.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(risksettings.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StepUpThreshold(); ok {
+		_spec.SetField(risksettings.FieldStepUpThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedStepUpThreshold(); ok {
+		_spec.AddField(risksettings.FieldStepUpThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.DenyThreshold(); ok {
+		_spec.SetField(risksettings.FieldDenyThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedDenyThreshold(); ok {
+		_spec.AddField(risksettings.FieldDenyThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.BusinessHours(); ok {
+		_spec.SetField(risksettings.FieldBusinessHours, field.TypeString, value)
+	}
+	if _u.mutation.BusinessHoursCleared() {
+		_spec.ClearField(risksettings.FieldBusinessHours, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{risksettings.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RiskSettingsUpdateOne is the builder for updating a single RiskSettings entity.
+type RiskSettingsUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RiskSettingsMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RiskSettingsUpdateOne) SetUpdateTime(v time.Time) *RiskSettingsUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableUpdateTime(v *time.Time) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *RiskSettingsUpdateOne) ClearUpdateTime() *RiskSettingsUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *RiskSettingsUpdateOne) SetDeleteTime(v time.Time) *RiskSettingsUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableDeleteTime(v *time.Time) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *RiskSettingsUpdateOne) ClearDeleteTime() *RiskSettingsUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RiskSettingsUpdateOne) SetEnabled(v bool) *RiskSettingsUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableEnabled(v *bool) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetStepUpThreshold sets the "step_up_threshold" field.
+func (_u *RiskSettingsUpdateOne) SetStepUpThreshold(v int32) *RiskSettingsUpdateOne {
+	_u.mutation.ResetStepUpThreshold()
+	_u.mutation.SetStepUpThreshold(v)
+	return _u
+}
+
+// SetNillableStepUpThreshold sets the "step_up_threshold" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableStepUpThreshold(v *int32) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetStepUpThreshold(*v)
+	}
+	return _u
+}
+
+// AddStepUpThreshold adds value to the "step_up_threshold" field.
+func (_u *RiskSettingsUpdateOne) AddStepUpThreshold(v int32) *RiskSettingsUpdateOne {
+	_u.mutation.AddStepUpThreshold(v)
+	return _u
+}
+
+// SetDenyThreshold sets the "deny_threshold" field.
+func (_u *RiskSettingsUpdateOne) SetDenyThreshold(v int32) *RiskSettingsUpdateOne {
+	_u.mutation.ResetDenyThreshold()
+	_u.mutation.SetDenyThreshold(v)
+	return _u
+}
+
+// SetNillableDenyThreshold sets the "deny_threshold" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableDenyThreshold(v *int32) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetDenyThreshold(*v)
+	}
+	return _u
+}
+
+// AddDenyThreshold adds value to the "deny_threshold" field.
+func (_u *RiskSettingsUpdateOne) AddDenyThreshold(v int32) *RiskSettingsUpdateOne {
+	_u.mutation.AddDenyThreshold(v)
+	return _u
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (_u *RiskSettingsUpdateOne) SetBusinessHours(v string) *RiskSettingsUpdateOne {
+	_u.mutation.SetBusinessHours(v)
+	return _u
+}
+
+// SetNillableBusinessHours sets the "business_hours" field if the given value is not nil.
+func (_u *RiskSettingsUpdateOne) SetNillableBusinessHours(v *string) *RiskSettingsUpdateOne {
+	if v != nil {
+		_u.SetBusinessHours(*v)
+	}
+	return _u
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (_u *RiskSettingsUpdateOne) ClearBusinessHours() *RiskSettingsUpdateOne {
+	_u.mutation.ClearBusinessHours()
+	return _u
+}
+
+// Mutation returns the RiskSettingsMutation object of the builder.
+func (_u *RiskSettingsUpdateOne) Mutation() *RiskSettingsMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RiskSettingsUpdate builder.
+func (_u *RiskSettingsUpdateOne) Where(ps ...predicate.RiskSettings) *RiskSettingsUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RiskSettingsUpdateOne) Select(field string, fields ...string) *RiskSettingsUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RiskSettings entity.
+func (_u *RiskSettingsUpdateOne) Save(ctx context.Context) (*RiskSettings, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskSettingsUpdateOne) SaveX(ctx context.Context) *RiskSettings {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RiskSettingsUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskSettingsUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskSettingsUpdateOne) check() error {
+	if v, ok := _u.mutation.StepUpThreshold(); ok {
+		if err := risksettings.StepUpThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "step_up_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.step_up_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DenyThreshold(); ok {
+		if err := risksettings.DenyThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "deny_threshold", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.deny_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessHours(); ok {
+		if err := risksettings.BusinessHoursValidator(v); err != nil {
+			return &ValidationError{Name: "business_hours", err: fmt.Errorf(`ent: validator failed for field "RiskSettings.business_hours": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RiskSettingsUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RiskSettingsUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RiskSettingsUpdateOne) sqlSave(ctx context.Context) (_node *RiskSettings, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(risksettings.Table, risksettings.Columns, sqlgraph.NewFieldSpec(risksettings.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RiskSettings.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, risksettings.FieldID)
+		for _, f := range fields {
+			if !risksettings.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != risksettings.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(risksettings.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(risksettings.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(risksettings.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(risksettings.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(risksettings.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(risksettings.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(risksettings.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StepUpThreshold(); ok {
+		_spec.SetField(risksettings.FieldStepUpThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedStepUpThreshold(); ok {
+		_spec.AddField(risksettings.FieldStepUpThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.DenyThreshold(); ok {
+		_spec.SetField(risksettings.FieldDenyThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedDenyThreshold(); ok {
+		_spec.AddField(risksettings.FieldDenyThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.BusinessHours(); ok {
+		_spec.SetField(risksettings.FieldBusinessHours, field.TypeString, value)
+	}
+	if _u.mutation.BusinessHoursCleared() {
+		_spec.ClearField(risksettings.FieldBusinessHours, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RiskSettings{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{risksettings.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
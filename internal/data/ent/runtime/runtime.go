@@ -6,9 +6,16 @@ import (
 	"context"
 
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhook"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -28,6 +35,9 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	emailtemplateMixinHooks4 := emailtemplateMixin[4].Hooks()
+
+	emailtemplate.Hooks[1] = emailtemplateMixinHooks4[0]
 	emailtemplateMixinFields3 := emailtemplateMixin[3].Fields()
 	_ = emailtemplateMixinFields3
 	emailtemplateFields := schema.EmailTemplate{}.Fields()
@@ -55,7 +65,7 @@ func init() {
 		}
 	}()
 	// emailtemplateDescSubject is the schema descriptor for subject field.
-	emailtemplateDescSubject := emailtemplateFields[2].Descriptor()
+	emailtemplateDescSubject := emailtemplateFields[3].Descriptor()
 	// emailtemplate.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
 	emailtemplate.SubjectValidator = func() func(string) error {
 		validators := emailtemplateDescSubject.Validators
@@ -73,17 +83,200 @@ func init() {
 		}
 	}()
 	// emailtemplateDescHTMLBody is the schema descriptor for html_body field.
-	emailtemplateDescHTMLBody := emailtemplateFields[3].Descriptor()
+	emailtemplateDescHTMLBody := emailtemplateFields[4].Descriptor()
 	// emailtemplate.HTMLBodyValidator is a validator for the "html_body" field. It is called by the builders before save.
 	emailtemplate.HTMLBodyValidator = emailtemplateDescHTMLBody.Validators[0].(func(string) error)
 	// emailtemplateDescIsDefault is the schema descriptor for is_default field.
-	emailtemplateDescIsDefault := emailtemplateFields[4].Descriptor()
+	emailtemplateDescIsDefault := emailtemplateFields[6].Descriptor()
 	// emailtemplate.DefaultIsDefault holds the default value on creation for the is_default field.
 	emailtemplate.DefaultIsDefault = emailtemplateDescIsDefault.Default.(bool)
 	// emailtemplateDescID is the schema descriptor for id field.
 	emailtemplateDescID := emailtemplateFields[0].Descriptor()
 	// emailtemplate.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	emailtemplate.IDValidator = emailtemplateDescID.Validators[0].(func(string) error)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreference.Policy = privacy.NewPolicies(notificationpreferenceMixin[1], schema.NotificationPreference{})
+	notificationpreference.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := notificationpreference.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	notificationpreferenceMixinHooks2 := notificationpreferenceMixin[2].Hooks()
+
+	notificationpreference.Hooks[1] = notificationpreferenceMixinHooks2[0]
+	notificationpreferenceMixinFields1 := notificationpreferenceMixin[1].Fields()
+	_ = notificationpreferenceMixinFields1
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescTenantID is the schema descriptor for tenant_id field.
+	notificationpreferenceDescTenantID := notificationpreferenceMixinFields1[0].Descriptor()
+	// notificationpreference.DefaultTenantID holds the default value on creation for the tenant_id field.
+	notificationpreference.DefaultTenantID = notificationpreferenceDescTenantID.Default.(uint32)
+	// notificationpreferenceDescNotifyViewed is the schema descriptor for notify_viewed field.
+	notificationpreferenceDescNotifyViewed := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultNotifyViewed holds the default value on creation for the notify_viewed field.
+	notificationpreference.DefaultNotifyViewed = notificationpreferenceDescNotifyViewed.Default.(bool)
+	// notificationpreferenceDescNotifyDenied is the schema descriptor for notify_denied field.
+	notificationpreferenceDescNotifyDenied := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultNotifyDenied holds the default value on creation for the notify_denied field.
+	notificationpreference.DefaultNotifyDenied = notificationpreferenceDescNotifyDenied.Default.(bool)
+	// notificationpreferenceDescNotifyExpired is the schema descriptor for notify_expired field.
+	notificationpreferenceDescNotifyExpired := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.DefaultNotifyExpired holds the default value on creation for the notify_expired field.
+	notificationpreference.DefaultNotifyExpired = notificationpreferenceDescNotifyExpired.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = notificationpreferenceDescID.Validators[0].(func(string) error)
+	policysetMixin := schema.PolicySet{}.Mixin()
+	policyset.Policy = privacy.NewPolicies(policysetMixin[3], schema.PolicySet{})
+	policyset.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := policyset.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	policysetMixinHooks4 := policysetMixin[4].Hooks()
+
+	policyset.Hooks[1] = policysetMixinHooks4[0]
+	policysetMixinFields3 := policysetMixin[3].Fields()
+	_ = policysetMixinFields3
+	policysetFields := schema.PolicySet{}.Fields()
+	_ = policysetFields
+	// policysetDescTenantID is the schema descriptor for tenant_id field.
+	policysetDescTenantID := policysetMixinFields3[0].Descriptor()
+	// policyset.DefaultTenantID holds the default value on creation for the tenant_id field.
+	policyset.DefaultTenantID = policysetDescTenantID.Default.(uint32)
+	// policysetDescName is the schema descriptor for name field.
+	policysetDescName := policysetFields[1].Descriptor()
+	// policyset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	policyset.NameValidator = func() func(string) error {
+		validators := policysetDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// policysetDescDescription is the schema descriptor for description field.
+	policysetDescDescription := policysetFields[2].Descriptor()
+	// policyset.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	policyset.DescriptionValidator = policysetDescDescription.Validators[0].(func(string) error)
+	// policysetDescID is the schema descriptor for id field.
+	policysetDescID := policysetFields[0].Descriptor()
+	// policyset.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	policyset.IDValidator = policysetDescID.Validators[0].(func(string) error)
+	risksettingsMixin := schema.RiskSettings{}.Mixin()
+	risksettings.Policy = privacy.NewPolicies(risksettingsMixin[1], schema.RiskSettings{})
+	risksettings.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := risksettings.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	risksettingsMixinHooks2 := risksettingsMixin[2].Hooks()
+
+	risksettings.Hooks[1] = risksettingsMixinHooks2[0]
+	risksettingsMixinFields1 := risksettingsMixin[1].Fields()
+	_ = risksettingsMixinFields1
+	risksettingsFields := schema.RiskSettings{}.Fields()
+	_ = risksettingsFields
+	// risksettingsDescTenantID is the schema descriptor for tenant_id field.
+	risksettingsDescTenantID := risksettingsMixinFields1[0].Descriptor()
+	// risksettings.DefaultTenantID holds the default value on creation for the tenant_id field.
+	risksettings.DefaultTenantID = risksettingsDescTenantID.Default.(uint32)
+	// risksettingsDescEnabled is the schema descriptor for enabled field.
+	risksettingsDescEnabled := risksettingsFields[1].Descriptor()
+	// risksettings.DefaultEnabled holds the default value on creation for the enabled field.
+	risksettings.DefaultEnabled = risksettingsDescEnabled.Default.(bool)
+	// risksettingsDescStepUpThreshold is the schema descriptor for step_up_threshold field.
+	risksettingsDescStepUpThreshold := risksettingsFields[2].Descriptor()
+	// risksettings.DefaultStepUpThreshold holds the default value on creation for the step_up_threshold field.
+	risksettings.DefaultStepUpThreshold = risksettingsDescStepUpThreshold.Default.(int32)
+	// risksettings.StepUpThresholdValidator is a validator for the "step_up_threshold" field. It is called by the builders before save.
+	risksettings.StepUpThresholdValidator = risksettingsDescStepUpThreshold.Validators[0].(func(int32) error)
+	// risksettingsDescDenyThreshold is the schema descriptor for deny_threshold field.
+	risksettingsDescDenyThreshold := risksettingsFields[3].Descriptor()
+	// risksettings.DefaultDenyThreshold holds the default value on creation for the deny_threshold field.
+	risksettings.DefaultDenyThreshold = risksettingsDescDenyThreshold.Default.(int32)
+	// risksettings.DenyThresholdValidator is a validator for the "deny_threshold" field. It is called by the builders before save.
+	risksettings.DenyThresholdValidator = risksettingsDescDenyThreshold.Validators[0].(func(int32) error)
+	// risksettingsDescBusinessHours is the schema descriptor for business_hours field.
+	risksettingsDescBusinessHours := risksettingsFields[4].Descriptor()
+	// risksettings.BusinessHoursValidator is a validator for the "business_hours" field. It is called by the builders before save.
+	risksettings.BusinessHoursValidator = risksettingsDescBusinessHours.Validators[0].(func(string) error)
+	// risksettingsDescID is the schema descriptor for id field.
+	risksettingsDescID := risksettingsFields[0].Descriptor()
+	// risksettings.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	risksettings.IDValidator = risksettingsDescID.Validators[0].(func(string) error)
+	shareaccesseventMixin := schema.ShareAccessEvent{}.Mixin()
+	shareaccessevent.Policy = privacy.NewPolicies(shareaccesseventMixin[1], schema.ShareAccessEvent{})
+	shareaccessevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := shareaccessevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	shareaccesseventMixinFields1 := shareaccesseventMixin[1].Fields()
+	_ = shareaccesseventMixinFields1
+	shareaccesseventFields := schema.ShareAccessEvent{}.Fields()
+	_ = shareaccesseventFields
+	// shareaccesseventDescTenantID is the schema descriptor for tenant_id field.
+	shareaccesseventDescTenantID := shareaccesseventMixinFields1[0].Descriptor()
+	// shareaccessevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	shareaccessevent.DefaultTenantID = shareaccesseventDescTenantID.Default.(uint32)
+	// shareaccesseventDescShareLinkID is the schema descriptor for share_link_id field.
+	shareaccesseventDescShareLinkID := shareaccesseventFields[1].Descriptor()
+	// shareaccessevent.ShareLinkIDValidator is a validator for the "share_link_id" field. It is called by the builders before save.
+	shareaccessevent.ShareLinkIDValidator = shareaccesseventDescShareLinkID.Validators[0].(func(string) error)
+	// shareaccesseventDescTokenPrefix is the schema descriptor for token_prefix field.
+	shareaccesseventDescTokenPrefix := shareaccesseventFields[2].Descriptor()
+	// shareaccessevent.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	shareaccessevent.TokenPrefixValidator = shareaccesseventDescTokenPrefix.Validators[0].(func(string) error)
+	// shareaccesseventDescPolicyID is the schema descriptor for policy_id field.
+	shareaccesseventDescPolicyID := shareaccesseventFields[4].Descriptor()
+	// shareaccessevent.PolicyIDValidator is a validator for the "policy_id" field. It is called by the builders before save.
+	shareaccessevent.PolicyIDValidator = shareaccesseventDescPolicyID.Validators[0].(func(string) error)
+	// shareaccesseventDescReason is the schema descriptor for reason field.
+	shareaccesseventDescReason := shareaccesseventFields[5].Descriptor()
+	// shareaccessevent.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	shareaccessevent.ReasonValidator = shareaccesseventDescReason.Validators[0].(func(string) error)
+	// shareaccesseventDescClientIP is the schema descriptor for client_ip field.
+	shareaccesseventDescClientIP := shareaccesseventFields[6].Descriptor()
+	// shareaccessevent.ClientIPValidator is a validator for the "client_ip" field. It is called by the builders before save.
+	shareaccessevent.ClientIPValidator = shareaccesseventDescClientIP.Validators[0].(func(string) error)
+	// shareaccesseventDescUserAgent is the schema descriptor for user_agent field.
+	shareaccesseventDescUserAgent := shareaccesseventFields[7].Descriptor()
+	// shareaccessevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	shareaccessevent.UserAgentValidator = shareaccesseventDescUserAgent.Validators[0].(func(string) error)
+	// shareaccesseventDescCountry is the schema descriptor for country field.
+	shareaccesseventDescCountry := shareaccesseventFields[8].Descriptor()
+	// shareaccessevent.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	shareaccessevent.CountryValidator = shareaccesseventDescCountry.Validators[0].(func(string) error)
+	// shareaccesseventDescReputationList is the schema descriptor for reputation_list field.
+	shareaccesseventDescReputationList := shareaccesseventFields[9].Descriptor()
+	// shareaccessevent.ReputationListValidator is a validator for the "reputation_list" field. It is called by the builders before save.
+	shareaccessevent.ReputationListValidator = shareaccesseventDescReputationList.Validators[0].(func(string) error)
+	// shareaccesseventDescID is the schema descriptor for id field.
+	shareaccesseventDescID := shareaccesseventFields[0].Descriptor()
+	// shareaccessevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	shareaccessevent.IDValidator = shareaccesseventDescID.Validators[0].(func(string) error)
 	sharepolicyMixin := schema.SharePolicy{}.Mixin()
 	sharepolicy.Policy = privacy.NewPolicies(sharepolicyMixin[2], schema.SharePolicy{})
 	sharepolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -94,6 +287,9 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	sharepolicyMixinHooks3 := sharepolicyMixin[3].Hooks()
+
+	sharepolicy.Hooks[1] = sharepolicyMixinHooks3[0]
 	sharepolicyMixinFields2 := sharepolicyMixin[2].Fields()
 	_ = sharepolicyMixinFields2
 	sharepolicyFields := schema.SharePolicy{}.Fields()
@@ -142,6 +338,14 @@ func init() {
 	sharepolicyDescReason := sharepolicyFields[5].Descriptor()
 	// sharepolicy.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	sharepolicy.ReasonValidator = sharepolicyDescReason.Validators[0].(func(string) error)
+	// sharepolicyDescPriority is the schema descriptor for priority field.
+	sharepolicyDescPriority := sharepolicyFields[6].Descriptor()
+	// sharepolicy.DefaultPriority holds the default value on creation for the priority field.
+	sharepolicy.DefaultPriority = sharepolicyDescPriority.Default.(int32)
+	// sharepolicyDescDeprecated is the schema descriptor for deprecated field.
+	sharepolicyDescDeprecated := sharepolicyFields[7].Descriptor()
+	// sharepolicy.DefaultDeprecated holds the default value on creation for the deprecated field.
+	sharepolicy.DefaultDeprecated = sharepolicyDescDeprecated.Default.(bool)
 	// sharepolicyDescID is the schema descriptor for id field.
 	sharepolicyDescID := sharepolicyFields[0].Descriptor()
 	// sharepolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -156,6 +360,9 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	sharedlinkMixinHooks3 := sharedlinkMixin[3].Hooks()
+
+	sharedlink.Hooks[1] = sharedlinkMixinHooks3[0]
 	sharedlinkMixinFields2 := sharedlinkMixin[2].Fields()
 	_ = sharedlinkMixinFields2
 	sharedlinkFields := schema.SharedLink{}.Fields()
@@ -236,30 +443,314 @@ func init() {
 			return nil
 		}
 	}()
+	// sharedlinkDescSenderEmail is the schema descriptor for sender_email field.
+	sharedlinkDescSenderEmail := sharedlinkFields[8].Descriptor()
+	// sharedlink.SenderEmailValidator is a validator for the "sender_email" field. It is called by the builders before save.
+	sharedlink.SenderEmailValidator = sharedlinkDescSenderEmail.Validators[0].(func(string) error)
 	// sharedlinkDescMessage is the schema descriptor for message field.
-	sharedlinkDescMessage := sharedlinkFields[8].Descriptor()
+	sharedlinkDescMessage := sharedlinkFields[9].Descriptor()
 	// sharedlink.MessageValidator is a validator for the "message" field. It is called by the builders before save.
 	sharedlink.MessageValidator = sharedlinkDescMessage.Validators[0].(func(string) error)
 	// sharedlinkDescTemplateID is the schema descriptor for template_id field.
-	sharedlinkDescTemplateID := sharedlinkFields[9].Descriptor()
+	sharedlinkDescTemplateID := sharedlinkFields[10].Descriptor()
 	// sharedlink.TemplateIDValidator is a validator for the "template_id" field. It is called by the builders before save.
 	sharedlink.TemplateIDValidator = sharedlinkDescTemplateID.Validators[0].(func(string) error)
 	// sharedlinkDescViewed is the schema descriptor for viewed field.
-	sharedlinkDescViewed := sharedlinkFields[10].Descriptor()
+	sharedlinkDescViewed := sharedlinkFields[11].Descriptor()
 	// sharedlink.DefaultViewed holds the default value on creation for the viewed field.
 	sharedlink.DefaultViewed = sharedlinkDescViewed.Default.(bool)
 	// sharedlinkDescViewedIP is the schema descriptor for viewed_ip field.
-	sharedlinkDescViewedIP := sharedlinkFields[12].Descriptor()
+	sharedlinkDescViewedIP := sharedlinkFields[13].Descriptor()
 	// sharedlink.ViewedIPValidator is a validator for the "viewed_ip" field. It is called by the builders before save.
 	sharedlink.ViewedIPValidator = sharedlinkDescViewedIP.Validators[0].(func(string) error)
 	// sharedlinkDescRevoked is the schema descriptor for revoked field.
-	sharedlinkDescRevoked := sharedlinkFields[13].Descriptor()
+	sharedlinkDescRevoked := sharedlinkFields[14].Descriptor()
 	// sharedlink.DefaultRevoked holds the default value on creation for the revoked field.
 	sharedlink.DefaultRevoked = sharedlinkDescRevoked.Default.(bool)
+	// sharedlinkDescExpiryNotified is the schema descriptor for expiry_notified field.
+	sharedlinkDescExpiryNotified := sharedlinkFields[16].Descriptor()
+	// sharedlink.DefaultExpiryNotified holds the default value on creation for the expiry_notified field.
+	sharedlink.DefaultExpiryNotified = sharedlinkDescExpiryNotified.Default.(bool)
+	// sharedlinkDescExpiryNoticeAttempts is the schema descriptor for expiry_notice_attempts field.
+	sharedlinkDescExpiryNoticeAttempts := sharedlinkFields[17].Descriptor()
+	// sharedlink.DefaultExpiryNoticeAttempts holds the default value on creation for the expiry_notice_attempts field.
+	sharedlink.DefaultExpiryNoticeAttempts = sharedlinkDescExpiryNoticeAttempts.Default.(int32)
+	// sharedlinkDescAuthorizedVia is the schema descriptor for authorized_via field.
+	sharedlinkDescAuthorizedVia := sharedlinkFields[20].Descriptor()
+	// sharedlink.AuthorizedViaValidator is a validator for the "authorized_via" field. It is called by the builders before save.
+	sharedlink.AuthorizedViaValidator = sharedlinkDescAuthorizedVia.Validators[0].(func(string) error)
+	// sharedlinkDescMaxViews is the schema descriptor for max_views field.
+	sharedlinkDescMaxViews := sharedlinkFields[24].Descriptor()
+	// sharedlink.DefaultMaxViews holds the default value on creation for the max_views field.
+	sharedlink.DefaultMaxViews = sharedlinkDescMaxViews.Default.(int32)
+	// sharedlink.MaxViewsValidator is a validator for the "max_views" field. It is called by the builders before save.
+	sharedlink.MaxViewsValidator = sharedlinkDescMaxViews.Validators[0].(func(int32) error)
+	// sharedlinkDescViewCount is the schema descriptor for view_count field.
+	sharedlinkDescViewCount := sharedlinkFields[25].Descriptor()
+	// sharedlink.DefaultViewCount holds the default value on creation for the view_count field.
+	sharedlink.DefaultViewCount = sharedlinkDescViewCount.Default.(int32)
+	// sharedlink.ViewCountValidator is a validator for the "view_count" field. It is called by the builders before save.
+	sharedlink.ViewCountValidator = sharedlinkDescViewCount.Validators[0].(func(int32) error)
+	// sharedlinkDescBindDevice is the schema descriptor for bind_device field.
+	sharedlinkDescBindDevice := sharedlinkFields[26].Descriptor()
+	// sharedlink.DefaultBindDevice holds the default value on creation for the bind_device field.
+	sharedlink.DefaultBindDevice = sharedlinkDescBindDevice.Default.(bool)
+	// sharedlinkDescDeviceBinding is the schema descriptor for device_binding field.
+	sharedlinkDescDeviceBinding := sharedlinkFields[27].Descriptor()
+	// sharedlink.DeviceBindingValidator is a validator for the "device_binding" field. It is called by the builders before save.
+	sharedlink.DeviceBindingValidator = sharedlinkDescDeviceBinding.Validators[0].(func(string) error)
+	// sharedlinkDescPassphraseHash is the schema descriptor for passphrase_hash field.
+	sharedlinkDescPassphraseHash := sharedlinkFields[29].Descriptor()
+	// sharedlink.PassphraseHashValidator is a validator for the "passphrase_hash" field. It is called by the builders before save.
+	sharedlink.PassphraseHashValidator = sharedlinkDescPassphraseHash.Validators[0].(func(string) error)
+	// sharedlinkDescVerificationCodeHash is the schema descriptor for verification_code_hash field.
+	sharedlinkDescVerificationCodeHash := sharedlinkFields[30].Descriptor()
+	// sharedlink.VerificationCodeHashValidator is a validator for the "verification_code_hash" field. It is called by the builders before save.
+	sharedlink.VerificationCodeHashValidator = sharedlinkDescVerificationCodeHash.Validators[0].(func(string) error)
+	// sharedlinkDescStepUpAttempts is the schema descriptor for step_up_attempts field.
+	sharedlinkDescStepUpAttempts := sharedlinkFields[32].Descriptor()
+	// sharedlink.DefaultStepUpAttempts holds the default value on creation for the step_up_attempts field.
+	sharedlink.DefaultStepUpAttempts = sharedlinkDescStepUpAttempts.Default.(int32)
+	// sharedlinkDescPassphraseAttempts is the schema descriptor for passphrase_attempts field.
+	sharedlinkDescPassphraseAttempts := sharedlinkFields[33].Descriptor()
+	// sharedlink.DefaultPassphraseAttempts holds the default value on creation for the passphrase_attempts field.
+	sharedlink.DefaultPassphraseAttempts = sharedlinkDescPassphraseAttempts.Default.(int32)
 	// sharedlinkDescID is the schema descriptor for id field.
 	sharedlinkDescID := sharedlinkFields[0].Descriptor()
 	// sharedlink.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	sharedlink.IDValidator = sharedlinkDescID.Validators[0].(func(string) error)
+	sharedlinkpolicysetMixin := schema.SharedLinkPolicySet{}.Mixin()
+	sharedlinkpolicyset.Policy = privacy.NewPolicies(sharedlinkpolicysetMixin[2], schema.SharedLinkPolicySet{})
+	sharedlinkpolicyset.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := sharedlinkpolicyset.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	sharedlinkpolicysetMixinHooks3 := sharedlinkpolicysetMixin[3].Hooks()
+
+	sharedlinkpolicyset.Hooks[1] = sharedlinkpolicysetMixinHooks3[0]
+	sharedlinkpolicysetMixinFields2 := sharedlinkpolicysetMixin[2].Fields()
+	_ = sharedlinkpolicysetMixinFields2
+	sharedlinkpolicysetFields := schema.SharedLinkPolicySet{}.Fields()
+	_ = sharedlinkpolicysetFields
+	// sharedlinkpolicysetDescTenantID is the schema descriptor for tenant_id field.
+	sharedlinkpolicysetDescTenantID := sharedlinkpolicysetMixinFields2[0].Descriptor()
+	// sharedlinkpolicyset.DefaultTenantID holds the default value on creation for the tenant_id field.
+	sharedlinkpolicyset.DefaultTenantID = sharedlinkpolicysetDescTenantID.Default.(uint32)
+	// sharedlinkpolicysetDescShareLinkID is the schema descriptor for share_link_id field.
+	sharedlinkpolicysetDescShareLinkID := sharedlinkpolicysetFields[1].Descriptor()
+	// sharedlinkpolicyset.ShareLinkIDValidator is a validator for the "share_link_id" field. It is called by the builders before save.
+	sharedlinkpolicyset.ShareLinkIDValidator = func() func(string) error {
+		validators := sharedlinkpolicysetDescShareLinkID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(share_link_id string) error {
+			for _, fn := range fns {
+				if err := fn(share_link_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sharedlinkpolicysetDescPolicySetID is the schema descriptor for policy_set_id field.
+	sharedlinkpolicysetDescPolicySetID := sharedlinkpolicysetFields[2].Descriptor()
+	// sharedlinkpolicyset.PolicySetIDValidator is a validator for the "policy_set_id" field. It is called by the builders before save.
+	sharedlinkpolicyset.PolicySetIDValidator = func() func(string) error {
+		validators := sharedlinkpolicysetDescPolicySetID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(policy_set_id string) error {
+			for _, fn := range fns {
+				if err := fn(policy_set_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sharedlinkpolicysetDescID is the schema descriptor for id field.
+	sharedlinkpolicysetDescID := sharedlinkpolicysetFields[0].Descriptor()
+	// sharedlinkpolicyset.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	sharedlinkpolicyset.IDValidator = sharedlinkpolicysetDescID.Validators[0].(func(string) error)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhook.Policy = privacy.NewPolicies(webhookMixin[3], schema.Webhook{})
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookMixinHooks4 := webhookMixin[4].Hooks()
+
+	webhook.Hooks[1] = webhookMixinHooks4[0]
+	webhookMixinFields3 := webhookMixin[3].Fields()
+	_ = webhookMixinFields3
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescTenantID is the schema descriptor for tenant_id field.
+	webhookDescTenantID := webhookMixinFields3[0].Descriptor()
+	// webhook.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhook.DefaultTenantID = webhookDescTenantID.Default.(uint32)
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = func() func(string) error {
+		validators := webhookDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = func() func(string) error {
+		validators := webhookDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[5].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhook.IDValidator = webhookDescID.Validators[0].(func(string) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(webhookdeliveryMixin[1], schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhookdelivery.DefaultTenantID = webhookdeliveryDescTenantID.Default.(uint32)
+	// webhookdeliveryDescWebhookID is the schema descriptor for webhook_id field.
+	webhookdeliveryDescWebhookID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.WebhookIDValidator is a validator for the "webhook_id" field. It is called by the builders before save.
+	webhookdelivery.WebhookIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescWebhookID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(webhook_id string) error {
+			for _, fn := range fns {
+				if err := fn(webhook_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_id string) error {
+			for _, fn := range fns {
+				if err := fn(event_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescPayload is the schema descriptor for payload field.
+	webhookdeliveryDescPayload := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	webhookdelivery.PayloadValidator = webhookdeliveryDescPayload.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int32)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(string) error)
 }
 
 const (
@@ -36,18 +36,27 @@ func (EmailTemplate) Fields() []ent.Field {
 			MaxLen(255).
 			Comment("Template name"),
 
+		field.Enum("kind").
+			Values("SHARE", "SHARE_VIEWED", "SHARE_DENIED", "SHARE_EXPIRED").
+			Default("SHARE").
+			Comment("Template kind: SHARE (recipient email) or a sender notification event"),
+
 		field.String("subject").
 			NotEmpty().
 			MaxLen(1024).
-			Comment("Email subject (Go template)"),
+			Comment("Email subject (Go text/template)"),
 
 		field.Text("html_body").
 			NotEmpty().
 			Comment("Email HTML body (Go html/template)"),
 
+		field.Text("text_body").
+			Optional().
+			Comment("Email plain text body (Go text/template), generated from the HTML body when empty"),
+
 		field.Bool("is_default").
 			Default(false).
-			Comment("Whether this is the default template for the tenant"),
+			Comment("Whether this is the default template of its kind for the tenant"),
 	}
 }
 
@@ -63,6 +72,7 @@ func (EmailTemplate) Mixin() []ent.Mixin {
 		mixin.UpdateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
@@ -70,7 +80,7 @@ func (EmailTemplate) Mixin() []ent.Mixin {
 func (EmailTemplate) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id", "name").Unique(),
-		index.Fields("tenant_id", "is_default"),
+		index.Fields("tenant_id", "kind", "is_default"),
 		index.Fields("tenant_id"),
 	}
 }
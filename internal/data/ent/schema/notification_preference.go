@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+// NotificationPreferences store which share events a user wants to be emailed about.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Annotations of the NotificationPreference.
+func (NotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_notification_preferences"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.Uint32("user_id").
+			Comment("User the preferences belong to"),
+
+		field.Bool("notify_viewed").
+			Default(true).
+			Comment("Notify when a share is viewed"),
+
+		field.Bool("notify_denied").
+			Default(true).
+			Comment("Notify when an access attempt is denied by policy"),
+
+		field.Bool("notify_expired").
+			Default(true).
+			Comment("Notify when a share expires unviewed"),
+	}
+}
+
+// Edges of the NotificationPreference.
+func (NotificationPreference) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").Unique(),
+	}
+}
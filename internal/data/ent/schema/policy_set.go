@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// PolicyRule is a single access restriction rule of a policy set.
+// Type and Method hold the same values as the SharePolicy enums.
+type PolicyRule struct {
+	Type   string `json:"type"`
+	Method string `json:"method"`
+	Value  string `json:"value"`
+	Reason string `json:"reason,omitempty"`
+	// Priority orders the rule like SharePolicy.priority
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// PolicySet holds the schema definition for the PolicySet entity.
+// PolicySets are named, tenant-wide groups of policy rules that shares can reference.
+type PolicySet struct {
+	ent.Schema
+}
+
+// Annotations of the PolicySet.
+func (PolicySet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_policy_sets"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the PolicySet.
+func (PolicySet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Policy set name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(1024).
+			Comment("What the policy set is for"),
+
+		field.JSON("rules", []PolicyRule{}).
+			Comment("Policy rules, evaluated like policies attached to the share"),
+	}
+}
+
+// Edges of the PolicySet.
+func (PolicySet) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the PolicySet.
+func (PolicySet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the PolicySet.
+func (PolicySet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+		index.Fields("tenant_id"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RiskSettings holds the schema definition for the RiskSettings entity.
+// RiskSettings configure risk-based step-up challenges for the public endpoint of a tenant.
+type RiskSettings struct {
+	ent.Schema
+}
+
+// Annotations of the RiskSettings.
+func (RiskSettings) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_risk_settings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the RiskSettings.
+func (RiskSettings) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.Bool("enabled").
+			Default(false).
+			Comment("Whether access attempts are scored for risk"),
+
+		field.Int32("step_up_threshold").
+			Default(30).
+			Range(1, 100).
+			Comment("Score from which a passphrase or email code is required"),
+
+		field.Int32("deny_threshold").
+			Default(70).
+			Range(1, 101).
+			Comment("Score from which access is denied (101 never denies)"),
+
+		field.String("business_hours").
+			Optional().
+			MaxLen(1024).
+			Comment("Business hours of the tenant's senders, in TIME policy syntax"),
+	}
+}
+
+// Edges of the RiskSettings.
+func (RiskSettings) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the RiskSettings.
+func (RiskSettings) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the RiskSettings.
+func (RiskSettings) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ShareAccessEvent holds the schema definition for the ShareAccessEvent entity.
+// ShareAccessEvents record every attempt to open a shared link, granted or not.
+type ShareAccessEvent struct {
+	ent.Schema
+}
+
+// Annotations of the ShareAccessEvent.
+func (ShareAccessEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_share_access_events"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ShareAccessEvent.
+func (ShareAccessEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("share_link_id").
+			Optional().
+			MaxLen(36).
+			Comment("FK to shared_link.id (empty when the token did not match a share)"),
+
+		field.String("token_prefix").
+			Optional().
+			MaxLen(16).
+			Comment("Leading characters of the presented token, for correlating unknown tokens"),
+
+		field.Enum("outcome").
+			Values("GRANTED", "POLICY_DENIED", "ALREADY_VIEWED", "REVOKED", "NOT_FOUND", "ERROR", "EXPIRED", "DEVICE_MISMATCH", "STEP_UP_REQUIRED", "STEP_UP_FAILED", "RISK_DENIED").
+			Comment("Result of the access attempt"),
+
+		field.String("policy_id").
+			Optional().
+			MaxLen(36).
+			Comment("ID of the policy that decided the outcome, if any"),
+
+		field.String("reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Human readable reason for the outcome"),
+
+		field.String("client_ip").
+			Optional().
+			MaxLen(45).
+			Comment("Client IP address"),
+
+		field.String("user_agent").
+			Optional().
+			MaxLen(1024).
+			Comment("Client User-Agent header"),
+
+		field.String("country").
+			Optional().
+			MaxLen(2).
+			Comment("ISO 3166-1 alpha-2 country resolved from the client IP"),
+
+		field.String("reputation_list").
+			Optional().
+			MaxLen(64).
+			Comment("Reputation list the client IP matched when a REPUTATION policy decided the outcome"),
+
+		field.Int32("risk_score").
+			Optional().
+			Nillable().
+			Comment("Risk score of the attempt (0-100), set when risk-based access is enabled"),
+	}
+}
+
+// Edges of the ShareAccessEvent.
+func (ShareAccessEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ShareAccessEvent.
+func (ShareAccessEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ShareAccessEvent.
+func (ShareAccessEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_link_id"),
+		index.Fields("tenant_id", "create_time"),
+		index.Fields("tenant_id", "outcome"),
+		index.Fields("client_ip"),
+	}
+}
@@ -41,18 +41,26 @@ func (SharePolicy) Fields() []ent.Field {
 			Comment("Restriction type: BLACKLIST (deny) or WHITELIST (allow)"),
 
 		field.Enum("method").
-			Values("IP", "MAC", "REGION", "TIME", "DEVICE", "NETWORK").
+			Values("IP", "MAC", "REGION", "TIME", "DEVICE", "NETWORK", "EXPRESSION", "CERTIFICATE", "REPUTATION").
 			Comment("Restriction method"),
 
 		field.String("value").
 			NotEmpty().
 			MaxLen(512).
-			Comment("Restriction value (IP, CIDR range, MAC, region code, time range, device ID)"),
+			Comment("Restriction value (IP, CIDR range, MAC, region code, time range, device rule, CEL expression, certificate rule)"),
 
 		field.String("reason").
 			Optional().
 			MaxLen(1024).
 			Comment("Explanation for this restriction"),
+
+		field.Int32("priority").
+			Default(0).
+			Comment("Evaluation order, lower priorities first"),
+
+		field.Bool("deprecated").
+			Default(false).
+			Comment("Whether the policy uses a deprecated method and should be replaced"),
 	}
 }
 
@@ -67,6 +75,7 @@ func (SharePolicy) Mixin() []ent.Mixin {
 		mixin.CreateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
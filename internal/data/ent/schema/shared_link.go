@@ -10,7 +10,8 @@ import (
 )
 
 // SharedLink holds the schema definition for the SharedLink entity.
-// SharedLinks store one-time share tokens for secrets and documents.
+// SharedLinks store share tokens for secrets and documents, viewable once
+// unless the sender allows more views.
 type SharedLink struct {
 	ent.Schema
 }
@@ -49,7 +50,7 @@ func (SharedLink) Fields() []ent.Field {
 			NotEmpty().
 			MaxLen(64).
 			Unique().
-			Comment("Unique share token (64 hex chars)"),
+			Comment("Unique share token (tgs_ prefix + base62 + CRC32 checksum, or legacy 64 hex chars)"),
 
 		field.Bytes("encrypted_content").
 			Optional().
@@ -66,6 +67,11 @@ func (SharedLink) Fields() []ent.Field {
 			MaxLen(320).
 			Comment("Recipient email address"),
 
+		field.String("sender_email").
+			Optional().
+			MaxLen(320).
+			Comment("Sender email address for share notifications"),
+
 		field.String("message").
 			Optional().
 			MaxLen(2048).
@@ -84,7 +90,7 @@ func (SharedLink) Fields() []ent.Field {
 		field.Time("viewed_at").
 			Optional().
 			Nillable().
-			Comment("When the share was viewed"),
+			Comment("When the share was first viewed"),
 
 		field.String("viewed_ip").
 			Optional().
@@ -94,6 +100,106 @@ func (SharedLink) Fields() []ent.Field {
 		field.Bool("revoked").
 			Default(false).
 			Comment("Whether the share has been revoked"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("When the share expires if not viewed"),
+
+		field.Bool("expiry_notified").
+			Default(false).
+			Comment("Whether the sender has been notified that the share expired"),
+
+		field.Int32("expiry_notice_attempts").
+			Default(0).
+			Comment("Number of attempts to notify the sender that the share expired"),
+
+		field.Time("expiry_notice_next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next expiry notification attempt is due"),
+
+		field.Uint32("authorized_by").
+			Optional().
+			Nillable().
+			Comment("User the upstream service authorized to read the resource"),
+
+		field.String("authorized_via").
+			Optional().
+			MaxLen(64).
+			Comment("Upstream service and permission that authorized the share"),
+
+		field.Time("authorized_at").
+			Optional().
+			Nillable().
+			Comment("When the upstream service authorized the share"),
+
+		field.Enum("policy_mode").
+			Values("ALL_MUST_PASS", "ANY_WHITELIST", "FIRST_MATCH").
+			Default("ALL_MUST_PASS").
+			Comment("How the access policies are combined"),
+
+		field.Enum("policy_default").
+			Values("ALLOW", "DENY").
+			Default("ALLOW").
+			Comment("Decision when no access policy decides"),
+
+		field.Int32("max_views").
+			Default(1).
+			Positive().
+			Comment("How many times the share can be viewed"),
+
+		field.Int32("view_count").
+			Default(0).
+			NonNegative().
+			Comment("How many times the share has been viewed"),
+
+		field.Bool("bind_device").
+			Default(false).
+			Comment("Whether all views must come from the device of the first view"),
+
+		field.String("device_binding").
+			Optional().
+			Nillable().
+			Sensitive().
+			MaxLen(64).
+			Comment("SHA-256 of the device ID the share is bound to"),
+
+		field.Time("device_bound_at").
+			Optional().
+			Nillable().
+			Comment("When the share was bound to a device"),
+
+		field.String("passphrase_hash").
+			Optional().
+			Sensitive().
+			MaxLen(255).
+			Comment("PBKDF2 hash of the passphrase accepted as a step-up challenge"),
+
+		field.String("verification_code_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			MaxLen(64).
+			Comment("HMAC of the pending email verification code"),
+
+		field.Time("verification_code_expires_at").
+			Optional().
+			Nillable().
+			Comment("When the pending email verification code expires"),
+
+		field.Int32("step_up_attempts").
+			Default(0).
+			Comment("Verification code answers given since the last code was sent"),
+
+		field.Int32("passphrase_attempts").
+			Default(0).
+			Comment("Passphrase answers given since the last passed step-up challenge"),
+
+		field.Time("step_up_challenged_at").
+			Optional().
+			Nillable().
+			Comment("When an access attempt was last asked to step up"),
 	}
 }
 
@@ -108,6 +214,7 @@ func (SharedLink) Mixin() []ent.Mixin {
 		mixin.CreateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
@@ -119,5 +226,7 @@ func (SharedLink) Indexes() []ent.Index {
 		index.Fields("tenant_id"),
 		index.Fields("recipient_email"),
 		index.Fields("tenant_id", "viewed"),
+		index.Fields("expires_at", "viewed", "revoked"),
+		index.Fields("tenant_id", "create_time", "id"),
 	}
 }
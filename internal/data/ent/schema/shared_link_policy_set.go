@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SharedLinkPolicySet holds the schema definition for the SharedLinkPolicySet entity.
+// SharedLinkPolicySets link shared links to the policy sets they reference.
+type SharedLinkPolicySet struct {
+	ent.Schema
+}
+
+// Annotations of the SharedLinkPolicySet.
+func (SharedLinkPolicySet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_shared_link_policy_sets"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the SharedLinkPolicySet.
+func (SharedLinkPolicySet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("share_link_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to shared_link.id"),
+
+		field.String("policy_set_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to policy_set.id"),
+	}
+}
+
+// Edges of the SharedLinkPolicySet.
+func (SharedLinkPolicySet) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the SharedLinkPolicySet.
+func (SharedLinkPolicySet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the SharedLinkPolicySet.
+func (SharedLinkPolicySet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_link_id", "policy_set_id").Unique(),
+		index.Fields("policy_set_id"),
+	}
+}
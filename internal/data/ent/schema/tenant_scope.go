@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/tx7do/go-crud/viewer"
+)
+
+// TenantScope restricts updates and deletes to rows of the viewer's tenant.
+// The TenantID mixin only scopes queries and creates, so without this hook
+// UpdateOneID and DeleteOneID can modify rows of another tenant.
+type TenantScope struct {
+	mixin.Schema
+}
+
+// Hooks of the TenantScope mixin.
+func (TenantScope) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne) {
+					return next.Mutate(ctx, m)
+				}
+
+				vc, ok := viewer.FromContext(ctx)
+				if !ok {
+					return nil, fmt.Errorf("security: missing ViewerContext in context")
+				}
+				if vc.IsPlatformContext() || vc.IsSystemContext() {
+					return next.Mutate(ctx, m)
+				}
+
+				w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("security: unable to scope %s mutation to tenant", m.Type())
+				}
+				w.WhereP(sql.FieldEQ("tenant_id", uint32(vc.TenantID())))
+
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// Webhooks are tenant-configured endpoints that receive signed share lifecycle events.
+type Webhook struct {
+	ent.Schema
+}
+
+// Annotations of the Webhook.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_webhooks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Webhook name"),
+
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("Endpoint URL receiving the events"),
+
+		field.String("secret").
+			NotEmpty().
+			MaxLen(255).
+			Sensitive().
+			Comment("Shared secret used to sign payloads (HMAC-SHA256)"),
+
+		field.Strings("events").
+			Optional().
+			Comment("Subscribed event types (empty means all events)"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether deliveries are sent to this webhook"),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+		index.Fields("tenant_id", "enabled"),
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// WebhookDeliveries log every event sent to a webhook and drive retries.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sharing_webhook_deliveries"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("webhook_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to webhook.id"),
+
+		field.String("event_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("ID of the event being delivered"),
+
+		field.String("event_type").
+			NotEmpty().
+			MaxLen(64).
+			Comment("Event type, e.g. share.viewed"),
+
+		field.Text("payload").
+			NotEmpty().
+			Comment("JSON payload sent to the endpoint"),
+
+		field.Enum("status").
+			Values("PENDING", "SUCCEEDED", "FAILED").
+			Default("PENDING").
+			Comment("Delivery status"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Number of delivery attempts made"),
+
+		field.Int32("response_status").
+			Optional().
+			Comment("HTTP status code of the last attempt"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Error of the last failed attempt"),
+
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next retry is due (PENDING only)"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the delivery succeeded"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "create_time"),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("tenant_id"),
+	}
+}
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+)
+
+// ShareAccessEvent is the model entity for the ShareAccessEvent schema.
+type ShareAccessEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to shared_link.id (empty when the token did not match a share)
+	ShareLinkID string `json:"share_link_id,omitempty"`
+	// Leading characters of the presented token, for correlating unknown tokens
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// Result of the access attempt
+	Outcome shareaccessevent.Outcome `json:"outcome,omitempty"`
+	// ID of the policy that decided the outcome, if any
+	PolicyID string `json:"policy_id,omitempty"`
+	// Human readable reason for the outcome
+	Reason string `json:"reason,omitempty"`
+	// Client IP address
+	ClientIP string `json:"client_ip,omitempty"`
+	// Client User-Agent header
+	UserAgent string `json:"user_agent,omitempty"`
+	// ISO 3166-1 alpha-2 country resolved from the client IP
+	Country string `json:"country,omitempty"`
+	// Reputation list the client IP matched when a REPUTATION policy decided the outcome
+	ReputationList string `json:"reputation_list,omitempty"`
+	// Risk score of the attempt (0-100), set when risk-based access is enabled
+	RiskScore    *int32 `json:"risk_score,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareAccessEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shareaccessevent.FieldTenantID, shareaccessevent.FieldRiskScore:
+			values[i] = new(sql.NullInt64)
+		case shareaccessevent.FieldID, shareaccessevent.FieldShareLinkID, shareaccessevent.FieldTokenPrefix, shareaccessevent.FieldOutcome, shareaccessevent.FieldPolicyID, shareaccessevent.FieldReason, shareaccessevent.FieldClientIP, shareaccessevent.FieldUserAgent, shareaccessevent.FieldCountry, shareaccessevent.FieldReputationList:
+			values[i] = new(sql.NullString)
+		case shareaccessevent.FieldCreateTime, shareaccessevent.FieldUpdateTime, shareaccessevent.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareAccessEvent fields.
+func (_m *ShareAccessEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shareaccessevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case shareaccessevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case shareaccessevent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case shareaccessevent.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case shareaccessevent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case shareaccessevent.FieldShareLinkID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_link_id", values[i])
+			} else if value.Valid {
+				_m.ShareLinkID = value.String
+			}
+		case shareaccessevent.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				_m.TokenPrefix = value.String
+			}
+		case shareaccessevent.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = shareaccessevent.Outcome(value.String)
+			}
+		case shareaccessevent.FieldPolicyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[i])
+			} else if value.Valid {
+				_m.PolicyID = value.String
+			}
+		case shareaccessevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case shareaccessevent.FieldClientIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ip", values[i])
+			} else if value.Valid {
+				_m.ClientIP = value.String
+			}
+		case shareaccessevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case shareaccessevent.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case shareaccessevent.FieldReputationList:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reputation_list", values[i])
+			} else if value.Valid {
+				_m.ReputationList = value.String
+			}
+		case shareaccessevent.FieldRiskScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_score", values[i])
+			} else if value.Valid {
+				_m.RiskScore = new(int32)
+				*_m.RiskScore = int32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareAccessEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShareAccessEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ShareAccessEvent.
+// Note that you need to call ShareAccessEvent.Unwrap() before calling this method if this ShareAccessEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShareAccessEvent) Update() *ShareAccessEventUpdateOne {
+	return NewShareAccessEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShareAccessEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShareAccessEvent) Unwrap() *ShareAccessEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareAccessEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShareAccessEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareAccessEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("share_link_id=")
+	builder.WriteString(_m.ShareLinkID)
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(_m.TokenPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("policy_id=")
+	builder.WriteString(_m.PolicyID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("client_ip=")
+	builder.WriteString(_m.ClientIP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("reputation_list=")
+	builder.WriteString(_m.ReputationList)
+	builder.WriteString(", ")
+	if v := _m.RiskScore; v != nil {
+		builder.WriteString("risk_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareAccessEvents is a parsable slice of ShareAccessEvent.
+type ShareAccessEvents []*ShareAccessEvent
@@ -0,0 +1,1015 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shareaccessevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ShareLinkID applies equality check predicate on the "share_link_id" field. It's identical to ShareLinkIDEQ.
+func ShareLinkID(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// TokenPrefix applies equality check predicate on the "token_prefix" field. It's identical to TokenPrefixEQ.
+func TokenPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldTokenPrefix, v))
+}
+
+// PolicyID applies equality check predicate on the "policy_id" field. It's identical to PolicyIDEQ.
+func PolicyID(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ClientIP applies equality check predicate on the "client_ip" field. It's identical to ClientIPEQ.
+func ClientIP(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldClientIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldCountry, v))
+}
+
+// ReputationList applies equality check predicate on the "reputation_list" field. It's identical to ReputationListEQ.
+func ReputationList(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldReputationList, v))
+}
+
+// RiskScore applies equality check predicate on the "risk_score" field. It's identical to RiskScoreEQ.
+func RiskScore(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldTenantID))
+}
+
+// ShareLinkIDEQ applies the EQ predicate on the "share_link_id" field.
+func ShareLinkIDEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDNEQ applies the NEQ predicate on the "share_link_id" field.
+func ShareLinkIDNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDIn applies the In predicate on the "share_link_id" field.
+func ShareLinkIDIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldShareLinkID, vs...))
+}
+
+// ShareLinkIDNotIn applies the NotIn predicate on the "share_link_id" field.
+func ShareLinkIDNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldShareLinkID, vs...))
+}
+
+// ShareLinkIDGT applies the GT predicate on the "share_link_id" field.
+func ShareLinkIDGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldShareLinkID, v))
+}
+
+// ShareLinkIDGTE applies the GTE predicate on the "share_link_id" field.
+func ShareLinkIDGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldShareLinkID, v))
+}
+
+// ShareLinkIDLT applies the LT predicate on the "share_link_id" field.
+func ShareLinkIDLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldShareLinkID, v))
+}
+
+// ShareLinkIDLTE applies the LTE predicate on the "share_link_id" field.
+func ShareLinkIDLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldShareLinkID, v))
+}
+
+// ShareLinkIDContains applies the Contains predicate on the "share_link_id" field.
+func ShareLinkIDContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldShareLinkID, v))
+}
+
+// ShareLinkIDHasPrefix applies the HasPrefix predicate on the "share_link_id" field.
+func ShareLinkIDHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldShareLinkID, v))
+}
+
+// ShareLinkIDHasSuffix applies the HasSuffix predicate on the "share_link_id" field.
+func ShareLinkIDHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldShareLinkID, v))
+}
+
+// ShareLinkIDIsNil applies the IsNil predicate on the "share_link_id" field.
+func ShareLinkIDIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldShareLinkID))
+}
+
+// ShareLinkIDNotNil applies the NotNil predicate on the "share_link_id" field.
+func ShareLinkIDNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldShareLinkID))
+}
+
+// ShareLinkIDEqualFold applies the EqualFold predicate on the "share_link_id" field.
+func ShareLinkIDEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldShareLinkID, v))
+}
+
+// ShareLinkIDContainsFold applies the ContainsFold predicate on the "share_link_id" field.
+func ShareLinkIDContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldShareLinkID, v))
+}
+
+// TokenPrefixEQ applies the EQ predicate on the "token_prefix" field.
+func TokenPrefixEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldTokenPrefix, v))
+}
+
+// TokenPrefixNEQ applies the NEQ predicate on the "token_prefix" field.
+func TokenPrefixNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldTokenPrefix, v))
+}
+
+// TokenPrefixIn applies the In predicate on the "token_prefix" field.
+func TokenPrefixIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldTokenPrefix, vs...))
+}
+
+// TokenPrefixNotIn applies the NotIn predicate on the "token_prefix" field.
+func TokenPrefixNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldTokenPrefix, vs...))
+}
+
+// TokenPrefixGT applies the GT predicate on the "token_prefix" field.
+func TokenPrefixGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldTokenPrefix, v))
+}
+
+// TokenPrefixGTE applies the GTE predicate on the "token_prefix" field.
+func TokenPrefixGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldTokenPrefix, v))
+}
+
+// TokenPrefixLT applies the LT predicate on the "token_prefix" field.
+func TokenPrefixLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldTokenPrefix, v))
+}
+
+// TokenPrefixLTE applies the LTE predicate on the "token_prefix" field.
+func TokenPrefixLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldTokenPrefix, v))
+}
+
+// TokenPrefixContains applies the Contains predicate on the "token_prefix" field.
+func TokenPrefixContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldTokenPrefix, v))
+}
+
+// TokenPrefixHasPrefix applies the HasPrefix predicate on the "token_prefix" field.
+func TokenPrefixHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldTokenPrefix, v))
+}
+
+// TokenPrefixHasSuffix applies the HasSuffix predicate on the "token_prefix" field.
+func TokenPrefixHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldTokenPrefix, v))
+}
+
+// TokenPrefixIsNil applies the IsNil predicate on the "token_prefix" field.
+func TokenPrefixIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldTokenPrefix))
+}
+
+// TokenPrefixNotNil applies the NotNil predicate on the "token_prefix" field.
+func TokenPrefixNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldTokenPrefix))
+}
+
+// TokenPrefixEqualFold applies the EqualFold predicate on the "token_prefix" field.
+func TokenPrefixEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldTokenPrefix, v))
+}
+
+// TokenPrefixContainsFold applies the ContainsFold predicate on the "token_prefix" field.
+func TokenPrefixContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldTokenPrefix, v))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// PolicyIDEQ applies the EQ predicate on the "policy_id" field.
+func PolicyIDEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// PolicyIDNEQ applies the NEQ predicate on the "policy_id" field.
+func PolicyIDNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldPolicyID, v))
+}
+
+// PolicyIDIn applies the In predicate on the "policy_id" field.
+func PolicyIDIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDNotIn applies the NotIn predicate on the "policy_id" field.
+func PolicyIDNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDGT applies the GT predicate on the "policy_id" field.
+func PolicyIDGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldPolicyID, v))
+}
+
+// PolicyIDGTE applies the GTE predicate on the "policy_id" field.
+func PolicyIDGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldPolicyID, v))
+}
+
+// PolicyIDLT applies the LT predicate on the "policy_id" field.
+func PolicyIDLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldPolicyID, v))
+}
+
+// PolicyIDLTE applies the LTE predicate on the "policy_id" field.
+func PolicyIDLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldPolicyID, v))
+}
+
+// PolicyIDContains applies the Contains predicate on the "policy_id" field.
+func PolicyIDContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldPolicyID, v))
+}
+
+// PolicyIDHasPrefix applies the HasPrefix predicate on the "policy_id" field.
+func PolicyIDHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldPolicyID, v))
+}
+
+// PolicyIDHasSuffix applies the HasSuffix predicate on the "policy_id" field.
+func PolicyIDHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldPolicyID, v))
+}
+
+// PolicyIDIsNil applies the IsNil predicate on the "policy_id" field.
+func PolicyIDIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldPolicyID))
+}
+
+// PolicyIDNotNil applies the NotNil predicate on the "policy_id" field.
+func PolicyIDNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldPolicyID))
+}
+
+// PolicyIDEqualFold applies the EqualFold predicate on the "policy_id" field.
+func PolicyIDEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldPolicyID, v))
+}
+
+// PolicyIDContainsFold applies the ContainsFold predicate on the "policy_id" field.
+func PolicyIDContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldPolicyID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ClientIPEQ applies the EQ predicate on the "client_ip" field.
+func ClientIPEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldClientIP, v))
+}
+
+// ClientIPNEQ applies the NEQ predicate on the "client_ip" field.
+func ClientIPNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldClientIP, v))
+}
+
+// ClientIPIn applies the In predicate on the "client_ip" field.
+func ClientIPIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldClientIP, vs...))
+}
+
+// ClientIPNotIn applies the NotIn predicate on the "client_ip" field.
+func ClientIPNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldClientIP, vs...))
+}
+
+// ClientIPGT applies the GT predicate on the "client_ip" field.
+func ClientIPGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldClientIP, v))
+}
+
+// ClientIPGTE applies the GTE predicate on the "client_ip" field.
+func ClientIPGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldClientIP, v))
+}
+
+// ClientIPLT applies the LT predicate on the "client_ip" field.
+func ClientIPLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldClientIP, v))
+}
+
+// ClientIPLTE applies the LTE predicate on the "client_ip" field.
+func ClientIPLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldClientIP, v))
+}
+
+// ClientIPContains applies the Contains predicate on the "client_ip" field.
+func ClientIPContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldClientIP, v))
+}
+
+// ClientIPHasPrefix applies the HasPrefix predicate on the "client_ip" field.
+func ClientIPHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldClientIP, v))
+}
+
+// ClientIPHasSuffix applies the HasSuffix predicate on the "client_ip" field.
+func ClientIPHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldClientIP, v))
+}
+
+// ClientIPIsNil applies the IsNil predicate on the "client_ip" field.
+func ClientIPIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldClientIP))
+}
+
+// ClientIPNotNil applies the NotNil predicate on the "client_ip" field.
+func ClientIPNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldClientIP))
+}
+
+// ClientIPEqualFold applies the EqualFold predicate on the "client_ip" field.
+func ClientIPEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldClientIP, v))
+}
+
+// ClientIPContainsFold applies the ContainsFold predicate on the "client_ip" field.
+func ClientIPContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldClientIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// ReputationListEQ applies the EQ predicate on the "reputation_list" field.
+func ReputationListEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldReputationList, v))
+}
+
+// ReputationListNEQ applies the NEQ predicate on the "reputation_list" field.
+func ReputationListNEQ(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldReputationList, v))
+}
+
+// ReputationListIn applies the In predicate on the "reputation_list" field.
+func ReputationListIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldReputationList, vs...))
+}
+
+// ReputationListNotIn applies the NotIn predicate on the "reputation_list" field.
+func ReputationListNotIn(vs ...string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldReputationList, vs...))
+}
+
+// ReputationListGT applies the GT predicate on the "reputation_list" field.
+func ReputationListGT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldReputationList, v))
+}
+
+// ReputationListGTE applies the GTE predicate on the "reputation_list" field.
+func ReputationListGTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldReputationList, v))
+}
+
+// ReputationListLT applies the LT predicate on the "reputation_list" field.
+func ReputationListLT(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldReputationList, v))
+}
+
+// ReputationListLTE applies the LTE predicate on the "reputation_list" field.
+func ReputationListLTE(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldReputationList, v))
+}
+
+// ReputationListContains applies the Contains predicate on the "reputation_list" field.
+func ReputationListContains(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContains(FieldReputationList, v))
+}
+
+// ReputationListHasPrefix applies the HasPrefix predicate on the "reputation_list" field.
+func ReputationListHasPrefix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasPrefix(FieldReputationList, v))
+}
+
+// ReputationListHasSuffix applies the HasSuffix predicate on the "reputation_list" field.
+func ReputationListHasSuffix(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldHasSuffix(FieldReputationList, v))
+}
+
+// ReputationListIsNil applies the IsNil predicate on the "reputation_list" field.
+func ReputationListIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldReputationList))
+}
+
+// ReputationListNotNil applies the NotNil predicate on the "reputation_list" field.
+func ReputationListNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldReputationList))
+}
+
+// ReputationListEqualFold applies the EqualFold predicate on the "reputation_list" field.
+func ReputationListEqualFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEqualFold(FieldReputationList, v))
+}
+
+// ReputationListContainsFold applies the ContainsFold predicate on the "reputation_list" field.
+func ReputationListContainsFold(v string) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldContainsFold(FieldReputationList, v))
+}
+
+// RiskScoreEQ applies the EQ predicate on the "risk_score" field.
+func RiskScoreEQ(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// RiskScoreNEQ applies the NEQ predicate on the "risk_score" field.
+func RiskScoreNEQ(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNEQ(FieldRiskScore, v))
+}
+
+// RiskScoreIn applies the In predicate on the "risk_score" field.
+func RiskScoreIn(vs ...int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreNotIn applies the NotIn predicate on the "risk_score" field.
+func RiskScoreNotIn(vs ...int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreGT applies the GT predicate on the "risk_score" field.
+func RiskScoreGT(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGT(FieldRiskScore, v))
+}
+
+// RiskScoreGTE applies the GTE predicate on the "risk_score" field.
+func RiskScoreGTE(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldGTE(FieldRiskScore, v))
+}
+
+// RiskScoreLT applies the LT predicate on the "risk_score" field.
+func RiskScoreLT(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLT(FieldRiskScore, v))
+}
+
+// RiskScoreLTE applies the LTE predicate on the "risk_score" field.
+func RiskScoreLTE(v int32) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldLTE(FieldRiskScore, v))
+}
+
+// RiskScoreIsNil applies the IsNil predicate on the "risk_score" field.
+func RiskScoreIsNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldIsNull(FieldRiskScore))
+}
+
+// RiskScoreNotNil applies the NotNil predicate on the "risk_score" field.
+func RiskScoreNotNil() predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.FieldNotNull(FieldRiskScore))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShareAccessEvent) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShareAccessEvent) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShareAccessEvent) predicate.ShareAccessEvent {
+	return predicate.ShareAccessEvent(sql.NotPredicates(p))
+}
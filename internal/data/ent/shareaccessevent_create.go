@@ -0,0 +1,1479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+)
+
+// ShareAccessEventCreate is the builder for creating a ShareAccessEvent entity.
+type ShareAccessEventCreate struct {
+	config
+	mutation *ShareAccessEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ShareAccessEventCreate) SetCreateTime(v time.Time) *ShareAccessEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableCreateTime(v *time.Time) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ShareAccessEventCreate) SetUpdateTime(v time.Time) *ShareAccessEventCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableUpdateTime(v *time.Time) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ShareAccessEventCreate) SetDeleteTime(v time.Time) *ShareAccessEventCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableDeleteTime(v *time.Time) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ShareAccessEventCreate) SetTenantID(v uint32) *ShareAccessEventCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableTenantID(v *uint32) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_c *ShareAccessEventCreate) SetShareLinkID(v string) *ShareAccessEventCreate {
+	_c.mutation.SetShareLinkID(v)
+	return _c
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableShareLinkID(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetShareLinkID(*v)
+	}
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *ShareAccessEventCreate) SetTokenPrefix(v string) *ShareAccessEventCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableTokenPrefix(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetTokenPrefix(*v)
+	}
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *ShareAccessEventCreate) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_c *ShareAccessEventCreate) SetPolicyID(v string) *ShareAccessEventCreate {
+	_c.mutation.SetPolicyID(v)
+	return _c
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillablePolicyID(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetPolicyID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ShareAccessEventCreate) SetReason(v string) *ShareAccessEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableReason(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetClientIP sets the "client_ip" field.
+func (_c *ShareAccessEventCreate) SetClientIP(v string) *ShareAccessEventCreate {
+	_c.mutation.SetClientIP(v)
+	return _c
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableClientIP(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetClientIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *ShareAccessEventCreate) SetUserAgent(v string) *ShareAccessEventCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableUserAgent(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *ShareAccessEventCreate) SetCountry(v string) *ShareAccessEventCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableCountry(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (_c *ShareAccessEventCreate) SetReputationList(v string) *ShareAccessEventCreate {
+	_c.mutation.SetReputationList(v)
+	return _c
+}
+
+// SetNillableReputationList sets the "reputation_list" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableReputationList(v *string) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetReputationList(*v)
+	}
+	return _c
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_c *ShareAccessEventCreate) SetRiskScore(v int32) *ShareAccessEventCreate {
+	_c.mutation.SetRiskScore(v)
+	return _c
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_c *ShareAccessEventCreate) SetNillableRiskScore(v *int32) *ShareAccessEventCreate {
+	if v != nil {
+		_c.SetRiskScore(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ShareAccessEventCreate) SetID(v string) *ShareAccessEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ShareAccessEventMutation object of the builder.
+func (_c *ShareAccessEventCreate) Mutation() *ShareAccessEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ShareAccessEvent in the database.
+func (_c *ShareAccessEventCreate) Save(ctx context.Context) (*ShareAccessEvent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShareAccessEventCreate) SaveX(ctx context.Context) *ShareAccessEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareAccessEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareAccessEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShareAccessEventCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := shareaccessevent.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShareAccessEventCreate) check() error {
+	if v, ok := _c.mutation.ShareLinkID(); ok {
+		if err := shareaccessevent.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.share_link_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TokenPrefix(); ok {
+		if err := shareaccessevent.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.token_prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "ShareAccessEvent.outcome"`)}
+	}
+	if v, ok := _c.mutation.Outcome(); ok {
+		if err := shareaccessevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.outcome": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PolicyID(); ok {
+		if err := shareaccessevent.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := shareaccessevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientIP(); ok {
+		if err := shareaccessevent.ClientIPValidator(v); err != nil {
+			return &ValidationError{Name: "client_ip", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.client_ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := shareaccessevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := shareaccessevent.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.country": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReputationList(); ok {
+		if err := shareaccessevent.ReputationListValidator(v); err != nil {
+			return &ValidationError{Name: "reputation_list", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reputation_list": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := shareaccessevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ShareAccessEventCreate) sqlSave(ctx context.Context) (*ShareAccessEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ShareAccessEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShareAccessEventCreate) createSpec() (*ShareAccessEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareAccessEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(shareaccessevent.Table, sqlgraph.NewFieldSpec(shareaccessevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(shareaccessevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(shareaccessevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(shareaccessevent.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(shareaccessevent.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ShareLinkID(); ok {
+		_spec.SetField(shareaccessevent.FieldShareLinkID, field.TypeString, value)
+		_node.ShareLinkID = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(shareaccessevent.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(shareaccessevent.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := _c.mutation.PolicyID(); ok {
+		_spec.SetField(shareaccessevent.FieldPolicyID, field.TypeString, value)
+		_node.PolicyID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(shareaccessevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ClientIP(); ok {
+		_spec.SetField(shareaccessevent.FieldClientIP, field.TypeString, value)
+		_node.ClientIP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccessevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(shareaccessevent.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.ReputationList(); ok {
+		_spec.SetField(shareaccessevent.FieldReputationList, field.TypeString, value)
+		_node.ReputationList = value
+	}
+	if value, ok := _c.mutation.RiskScore(); ok {
+		_spec.SetField(shareaccessevent.FieldRiskScore, field.TypeInt32, value)
+		_node.RiskScore = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShareAccessEvent.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareAccessEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ShareAccessEventCreate) OnConflict(opts ...sql.ConflictOption) *ShareAccessEventUpsertOne {
+	_c.conflict = opts
+	return &ShareAccessEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ShareAccessEventCreate) OnConflictColumns(columns ...string) *ShareAccessEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ShareAccessEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ShareAccessEventUpsertOne is the builder for "upsert"-ing
+	//  one ShareAccessEvent node.
+	ShareAccessEventUpsertOne struct {
+		create *ShareAccessEventCreate
+	}
+
+	// ShareAccessEventUpsert is the "OnConflict" setter.
+	ShareAccessEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ShareAccessEventUpsert) SetUpdateTime(v time.Time) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateUpdateTime() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ShareAccessEventUpsert) ClearUpdateTime() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ShareAccessEventUpsert) SetDeleteTime(v time.Time) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateDeleteTime() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ShareAccessEventUpsert) ClearDeleteTime() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldDeleteTime)
+	return u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *ShareAccessEventUpsert) SetShareLinkID(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldShareLinkID, v)
+	return u
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateShareLinkID() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldShareLinkID)
+	return u
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (u *ShareAccessEventUpsert) ClearShareLinkID() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldShareLinkID)
+	return u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (u *ShareAccessEventUpsert) SetTokenPrefix(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldTokenPrefix, v)
+	return u
+}
+
+// UpdateTokenPrefix sets the "token_prefix" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateTokenPrefix() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldTokenPrefix)
+	return u
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (u *ShareAccessEventUpsert) ClearTokenPrefix() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldTokenPrefix)
+	return u
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *ShareAccessEventUpsert) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldOutcome, v)
+	return u
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateOutcome() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldOutcome)
+	return u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (u *ShareAccessEventUpsert) SetPolicyID(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldPolicyID, v)
+	return u
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdatePolicyID() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldPolicyID)
+	return u
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *ShareAccessEventUpsert) ClearPolicyID() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldPolicyID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ShareAccessEventUpsert) SetReason(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateReason() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShareAccessEventUpsert) ClearReason() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldReason)
+	return u
+}
+
+// SetClientIP sets the "client_ip" field.
+func (u *ShareAccessEventUpsert) SetClientIP(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldClientIP, v)
+	return u
+}
+
+// UpdateClientIP sets the "client_ip" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateClientIP() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldClientIP)
+	return u
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (u *ShareAccessEventUpsert) ClearClientIP() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldClientIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessEventUpsert) SetUserAgent(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateUserAgent() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessEventUpsert) ClearUserAgent() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldUserAgent)
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *ShareAccessEventUpsert) SetCountry(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateCountry() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldCountry)
+	return u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ShareAccessEventUpsert) ClearCountry() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldCountry)
+	return u
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (u *ShareAccessEventUpsert) SetReputationList(v string) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldReputationList, v)
+	return u
+}
+
+// UpdateReputationList sets the "reputation_list" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateReputationList() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldReputationList)
+	return u
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (u *ShareAccessEventUpsert) ClearReputationList() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldReputationList)
+	return u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *ShareAccessEventUpsert) SetRiskScore(v int32) *ShareAccessEventUpsert {
+	u.Set(shareaccessevent.FieldRiskScore, v)
+	return u
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *ShareAccessEventUpsert) UpdateRiskScore() *ShareAccessEventUpsert {
+	u.SetExcluded(shareaccessevent.FieldRiskScore)
+	return u
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *ShareAccessEventUpsert) AddRiskScore(v int32) *ShareAccessEventUpsert {
+	u.Add(shareaccessevent.FieldRiskScore, v)
+	return u
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *ShareAccessEventUpsert) ClearRiskScore() *ShareAccessEventUpsert {
+	u.SetNull(shareaccessevent.FieldRiskScore)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shareaccessevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareAccessEventUpsertOne) UpdateNewValues() *ShareAccessEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(shareaccessevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(shareaccessevent.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(shareaccessevent.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShareAccessEventUpsertOne) Ignore() *ShareAccessEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareAccessEventUpsertOne) DoNothing() *ShareAccessEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareAccessEventCreate.OnConflict
+// documentation for more info.
+func (u *ShareAccessEventUpsertOne) Update(set func(*ShareAccessEventUpsert)) *ShareAccessEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareAccessEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ShareAccessEventUpsertOne) SetUpdateTime(v time.Time) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateUpdateTime() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ShareAccessEventUpsertOne) ClearUpdateTime() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ShareAccessEventUpsertOne) SetDeleteTime(v time.Time) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateDeleteTime() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ShareAccessEventUpsertOne) ClearDeleteTime() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *ShareAccessEventUpsertOne) SetShareLinkID(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetShareLinkID(v)
+	})
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateShareLinkID() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateShareLinkID()
+	})
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (u *ShareAccessEventUpsertOne) ClearShareLinkID() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearShareLinkID()
+	})
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (u *ShareAccessEventUpsertOne) SetTokenPrefix(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetTokenPrefix(v)
+	})
+}
+
+// UpdateTokenPrefix sets the "token_prefix" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateTokenPrefix() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateTokenPrefix()
+	})
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (u *ShareAccessEventUpsertOne) ClearTokenPrefix() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearTokenPrefix()
+	})
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *ShareAccessEventUpsertOne) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetOutcome(v)
+	})
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateOutcome() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateOutcome()
+	})
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (u *ShareAccessEventUpsertOne) SetPolicyID(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetPolicyID(v)
+	})
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdatePolicyID() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdatePolicyID()
+	})
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *ShareAccessEventUpsertOne) ClearPolicyID() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearPolicyID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ShareAccessEventUpsertOne) SetReason(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateReason() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShareAccessEventUpsertOne) ClearReason() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetClientIP sets the "client_ip" field.
+func (u *ShareAccessEventUpsertOne) SetClientIP(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetClientIP(v)
+	})
+}
+
+// UpdateClientIP sets the "client_ip" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateClientIP() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateClientIP()
+	})
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (u *ShareAccessEventUpsertOne) ClearClientIP() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearClientIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessEventUpsertOne) SetUserAgent(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateUserAgent() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessEventUpsertOne) ClearUserAgent() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ShareAccessEventUpsertOne) SetCountry(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateCountry() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ShareAccessEventUpsertOne) ClearCountry() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearCountry()
+	})
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (u *ShareAccessEventUpsertOne) SetReputationList(v string) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetReputationList(v)
+	})
+}
+
+// UpdateReputationList sets the "reputation_list" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateReputationList() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateReputationList()
+	})
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (u *ShareAccessEventUpsertOne) ClearReputationList() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearReputationList()
+	})
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *ShareAccessEventUpsertOne) SetRiskScore(v int32) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetRiskScore(v)
+	})
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *ShareAccessEventUpsertOne) AddRiskScore(v int32) *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.AddRiskScore(v)
+	})
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertOne) UpdateRiskScore() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateRiskScore()
+	})
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *ShareAccessEventUpsertOne) ClearRiskScore() *ShareAccessEventUpsertOne {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearRiskScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareAccessEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareAccessEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareAccessEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShareAccessEventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ShareAccessEventUpsertOne.ID is not supported by MySQL driver. Use ShareAccessEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShareAccessEventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShareAccessEventCreateBulk is the builder for creating many ShareAccessEvent entities in bulk.
+type ShareAccessEventCreateBulk struct {
+	config
+	err      error
+	builders []*ShareAccessEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ShareAccessEvent entities in the database.
+func (_c *ShareAccessEventCreateBulk) Save(ctx context.Context) ([]*ShareAccessEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShareAccessEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareAccessEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShareAccessEventCreateBulk) SaveX(ctx context.Context) []*ShareAccessEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareAccessEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareAccessEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShareAccessEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareAccessEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ShareAccessEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShareAccessEventUpsertBulk {
+	_c.conflict = opts
+	return &ShareAccessEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ShareAccessEventCreateBulk) OnConflictColumns(columns ...string) *ShareAccessEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ShareAccessEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ShareAccessEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ShareAccessEvent nodes.
+type ShareAccessEventUpsertBulk struct {
+	create *ShareAccessEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shareaccessevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareAccessEventUpsertBulk) UpdateNewValues() *ShareAccessEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(shareaccessevent.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(shareaccessevent.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(shareaccessevent.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccessEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShareAccessEventUpsertBulk) Ignore() *ShareAccessEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareAccessEventUpsertBulk) DoNothing() *ShareAccessEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareAccessEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShareAccessEventUpsertBulk) Update(set func(*ShareAccessEventUpsert)) *ShareAccessEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareAccessEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ShareAccessEventUpsertBulk) SetUpdateTime(v time.Time) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateUpdateTime() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ShareAccessEventUpsertBulk) ClearUpdateTime() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ShareAccessEventUpsertBulk) SetDeleteTime(v time.Time) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateDeleteTime() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ShareAccessEventUpsertBulk) ClearDeleteTime() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *ShareAccessEventUpsertBulk) SetShareLinkID(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetShareLinkID(v)
+	})
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateShareLinkID() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateShareLinkID()
+	})
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (u *ShareAccessEventUpsertBulk) ClearShareLinkID() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearShareLinkID()
+	})
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (u *ShareAccessEventUpsertBulk) SetTokenPrefix(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetTokenPrefix(v)
+	})
+}
+
+// UpdateTokenPrefix sets the "token_prefix" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateTokenPrefix() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateTokenPrefix()
+	})
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (u *ShareAccessEventUpsertBulk) ClearTokenPrefix() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearTokenPrefix()
+	})
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *ShareAccessEventUpsertBulk) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetOutcome(v)
+	})
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateOutcome() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateOutcome()
+	})
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (u *ShareAccessEventUpsertBulk) SetPolicyID(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetPolicyID(v)
+	})
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdatePolicyID() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdatePolicyID()
+	})
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *ShareAccessEventUpsertBulk) ClearPolicyID() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearPolicyID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ShareAccessEventUpsertBulk) SetReason(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateReason() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShareAccessEventUpsertBulk) ClearReason() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetClientIP sets the "client_ip" field.
+func (u *ShareAccessEventUpsertBulk) SetClientIP(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetClientIP(v)
+	})
+}
+
+// UpdateClientIP sets the "client_ip" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateClientIP() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateClientIP()
+	})
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (u *ShareAccessEventUpsertBulk) ClearClientIP() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearClientIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessEventUpsertBulk) SetUserAgent(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateUserAgent() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessEventUpsertBulk) ClearUserAgent() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ShareAccessEventUpsertBulk) SetCountry(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateCountry() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ShareAccessEventUpsertBulk) ClearCountry() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearCountry()
+	})
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (u *ShareAccessEventUpsertBulk) SetReputationList(v string) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetReputationList(v)
+	})
+}
+
+// UpdateReputationList sets the "reputation_list" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateReputationList() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateReputationList()
+	})
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (u *ShareAccessEventUpsertBulk) ClearReputationList() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearReputationList()
+	})
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *ShareAccessEventUpsertBulk) SetRiskScore(v int32) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.SetRiskScore(v)
+	})
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *ShareAccessEventUpsertBulk) AddRiskScore(v int32) *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.AddRiskScore(v)
+	})
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *ShareAccessEventUpsertBulk) UpdateRiskScore() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.UpdateRiskScore()
+	})
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *ShareAccessEventUpsertBulk) ClearRiskScore() *ShareAccessEventUpsertBulk {
+	return u.Update(func(s *ShareAccessEventUpsert) {
+		s.ClearRiskScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareAccessEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShareAccessEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareAccessEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareAccessEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
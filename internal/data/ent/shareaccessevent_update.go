@@ -0,0 +1,936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+)
+
+// ShareAccessEventUpdate is the builder for updating ShareAccessEvent entities.
+type ShareAccessEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ShareAccessEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ShareAccessEventUpdate builder.
+func (_u *ShareAccessEventUpdate) Where(ps ...predicate.ShareAccessEvent) *ShareAccessEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ShareAccessEventUpdate) SetUpdateTime(v time.Time) *ShareAccessEventUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableUpdateTime(v *time.Time) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ShareAccessEventUpdate) ClearUpdateTime() *ShareAccessEventUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ShareAccessEventUpdate) SetDeleteTime(v time.Time) *ShareAccessEventUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableDeleteTime(v *time.Time) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ShareAccessEventUpdate) ClearDeleteTime() *ShareAccessEventUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_u *ShareAccessEventUpdate) SetShareLinkID(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetShareLinkID(v)
+	return _u
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableShareLinkID(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetShareLinkID(*v)
+	}
+	return _u
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (_u *ShareAccessEventUpdate) ClearShareLinkID() *ShareAccessEventUpdate {
+	_u.mutation.ClearShareLinkID()
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *ShareAccessEventUpdate) SetTokenPrefix(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableTokenPrefix(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (_u *ShareAccessEventUpdate) ClearTokenPrefix() *ShareAccessEventUpdate {
+	_u.mutation.ClearTokenPrefix()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *ShareAccessEventUpdate) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableOutcome(v *shareaccessevent.Outcome) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *ShareAccessEventUpdate) SetPolicyID(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillablePolicyID(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (_u *ShareAccessEventUpdate) ClearPolicyID() *ShareAccessEventUpdate {
+	_u.mutation.ClearPolicyID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ShareAccessEventUpdate) SetReason(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableReason(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ShareAccessEventUpdate) ClearReason() *ShareAccessEventUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetClientIP sets the "client_ip" field.
+func (_u *ShareAccessEventUpdate) SetClientIP(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetClientIP(v)
+	return _u
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableClientIP(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetClientIP(*v)
+	}
+	return _u
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (_u *ShareAccessEventUpdate) ClearClientIP() *ShareAccessEventUpdate {
+	_u.mutation.ClearClientIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ShareAccessEventUpdate) SetUserAgent(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableUserAgent(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *ShareAccessEventUpdate) ClearUserAgent() *ShareAccessEventUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ShareAccessEventUpdate) SetCountry(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableCountry(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *ShareAccessEventUpdate) ClearCountry() *ShareAccessEventUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (_u *ShareAccessEventUpdate) SetReputationList(v string) *ShareAccessEventUpdate {
+	_u.mutation.SetReputationList(v)
+	return _u
+}
+
+// SetNillableReputationList sets the "reputation_list" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableReputationList(v *string) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetReputationList(*v)
+	}
+	return _u
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (_u *ShareAccessEventUpdate) ClearReputationList() *ShareAccessEventUpdate {
+	_u.mutation.ClearReputationList()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *ShareAccessEventUpdate) SetRiskScore(v int32) *ShareAccessEventUpdate {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *ShareAccessEventUpdate) SetNillableRiskScore(v *int32) *ShareAccessEventUpdate {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *ShareAccessEventUpdate) AddRiskScore(v int32) *ShareAccessEventUpdate {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (_u *ShareAccessEventUpdate) ClearRiskScore() *ShareAccessEventUpdate {
+	_u.mutation.ClearRiskScore()
+	return _u
+}
+
+// Mutation returns the ShareAccessEventMutation object of the builder.
+func (_u *ShareAccessEventUpdate) Mutation() *ShareAccessEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShareAccessEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareAccessEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShareAccessEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareAccessEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareAccessEventUpdate) check() error {
+	if v, ok := _u.mutation.ShareLinkID(); ok {
+		if err := shareaccessevent.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.share_link_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenPrefix(); ok {
+		if err := shareaccessevent.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.token_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := shareaccessevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.outcome": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := shareaccessevent.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := shareaccessevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientIP(); ok {
+		if err := shareaccessevent.ClientIPValidator(v); err != nil {
+			return &ValidationError{Name: "client_ip", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.client_ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := shareaccessevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := shareaccessevent.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReputationList(); ok {
+		if err := shareaccessevent.ReputationListValidator(v); err != nil {
+			return &ValidationError{Name: "reputation_list", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reputation_list": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ShareAccessEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareAccessEventUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ShareAccessEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccessevent.Table, shareaccessevent.Columns, sqlgraph.NewFieldSpec(shareaccessevent.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(shareaccessevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(shareaccessevent.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ShareLinkID(); ok {
+		_spec.SetField(shareaccessevent.FieldShareLinkID, field.TypeString, value)
+	}
+	if _u.mutation.ShareLinkIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldShareLinkID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(shareaccessevent.FieldTokenPrefix, field.TypeString, value)
+	}
+	if _u.mutation.TokenPrefixCleared() {
+		_spec.ClearField(shareaccessevent.FieldTokenPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(shareaccessevent.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(shareaccessevent.FieldPolicyID, field.TypeString, value)
+	}
+	if _u.mutation.PolicyIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldPolicyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(shareaccessevent.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(shareaccessevent.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIP(); ok {
+		_spec.SetField(shareaccessevent.FieldClientIP, field.TypeString, value)
+	}
+	if _u.mutation.ClientIPCleared() {
+		_spec.ClearField(shareaccessevent.FieldClientIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccessevent.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccessevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(shareaccessevent.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(shareaccessevent.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReputationList(); ok {
+		_spec.SetField(shareaccessevent.FieldReputationList, field.TypeString, value)
+	}
+	if _u.mutation.ReputationListCleared() {
+		_spec.ClearField(shareaccessevent.FieldReputationList, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(shareaccessevent.FieldRiskScore, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(shareaccessevent.FieldRiskScore, field.TypeInt32, value)
+	}
+	if _u.mutation.RiskScoreCleared() {
+		_spec.ClearField(shareaccessevent.FieldRiskScore, field.TypeInt32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccessevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShareAccessEventUpdateOne is the builder for updating a single ShareAccessEvent entity.
+type ShareAccessEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ShareAccessEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ShareAccessEventUpdateOne) SetUpdateTime(v time.Time) *ShareAccessEventUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableUpdateTime(v *time.Time) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ShareAccessEventUpdateOne) ClearUpdateTime() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ShareAccessEventUpdateOne) SetDeleteTime(v time.Time) *ShareAccessEventUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableDeleteTime(v *time.Time) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ShareAccessEventUpdateOne) ClearDeleteTime() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_u *ShareAccessEventUpdateOne) SetShareLinkID(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetShareLinkID(v)
+	return _u
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableShareLinkID(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetShareLinkID(*v)
+	}
+	return _u
+}
+
+// ClearShareLinkID clears the value of the "share_link_id" field.
+func (_u *ShareAccessEventUpdateOne) ClearShareLinkID() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearShareLinkID()
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *ShareAccessEventUpdateOne) SetTokenPrefix(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableTokenPrefix(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (_u *ShareAccessEventUpdateOne) ClearTokenPrefix() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearTokenPrefix()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *ShareAccessEventUpdateOne) SetOutcome(v shareaccessevent.Outcome) *ShareAccessEventUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableOutcome(v *shareaccessevent.Outcome) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *ShareAccessEventUpdateOne) SetPolicyID(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillablePolicyID(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (_u *ShareAccessEventUpdateOne) ClearPolicyID() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearPolicyID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ShareAccessEventUpdateOne) SetReason(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableReason(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ShareAccessEventUpdateOne) ClearReason() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetClientIP sets the "client_ip" field.
+func (_u *ShareAccessEventUpdateOne) SetClientIP(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetClientIP(v)
+	return _u
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableClientIP(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetClientIP(*v)
+	}
+	return _u
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (_u *ShareAccessEventUpdateOne) ClearClientIP() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearClientIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ShareAccessEventUpdateOne) SetUserAgent(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableUserAgent(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *ShareAccessEventUpdateOne) ClearUserAgent() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ShareAccessEventUpdateOne) SetCountry(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableCountry(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *ShareAccessEventUpdateOne) ClearCountry() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetReputationList sets the "reputation_list" field.
+func (_u *ShareAccessEventUpdateOne) SetReputationList(v string) *ShareAccessEventUpdateOne {
+	_u.mutation.SetReputationList(v)
+	return _u
+}
+
+// SetNillableReputationList sets the "reputation_list" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableReputationList(v *string) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetReputationList(*v)
+	}
+	return _u
+}
+
+// ClearReputationList clears the value of the "reputation_list" field.
+func (_u *ShareAccessEventUpdateOne) ClearReputationList() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearReputationList()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *ShareAccessEventUpdateOne) SetRiskScore(v int32) *ShareAccessEventUpdateOne {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *ShareAccessEventUpdateOne) SetNillableRiskScore(v *int32) *ShareAccessEventUpdateOne {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *ShareAccessEventUpdateOne) AddRiskScore(v int32) *ShareAccessEventUpdateOne {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (_u *ShareAccessEventUpdateOne) ClearRiskScore() *ShareAccessEventUpdateOne {
+	_u.mutation.ClearRiskScore()
+	return _u
+}
+
+// Mutation returns the ShareAccessEventMutation object of the builder.
+func (_u *ShareAccessEventUpdateOne) Mutation() *ShareAccessEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ShareAccessEventUpdate builder.
+func (_u *ShareAccessEventUpdateOne) Where(ps ...predicate.ShareAccessEvent) *ShareAccessEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShareAccessEventUpdateOne) Select(field string, fields ...string) *ShareAccessEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShareAccessEvent entity.
+func (_u *ShareAccessEventUpdateOne) Save(ctx context.Context) (*ShareAccessEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareAccessEventUpdateOne) SaveX(ctx context.Context) *ShareAccessEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShareAccessEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareAccessEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareAccessEventUpdateOne) check() error {
+	if v, ok := _u.mutation.ShareLinkID(); ok {
+		if err := shareaccessevent.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.share_link_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenPrefix(); ok {
+		if err := shareaccessevent.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.token_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := shareaccessevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.outcome": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := shareaccessevent.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := shareaccessevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientIP(); ok {
+		if err := shareaccessevent.ClientIPValidator(v); err != nil {
+			return &ValidationError{Name: "client_ip", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.client_ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := shareaccessevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := shareaccessevent.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReputationList(); ok {
+		if err := shareaccessevent.ReputationListValidator(v); err != nil {
+			return &ValidationError{Name: "reputation_list", err: fmt.Errorf(`ent: validator failed for field "ShareAccessEvent.reputation_list": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ShareAccessEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareAccessEventUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ShareAccessEventUpdateOne) sqlSave(ctx context.Context) (_node *ShareAccessEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccessevent.Table, shareaccessevent.Columns, sqlgraph.NewFieldSpec(shareaccessevent.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareAccessEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareaccessevent.FieldID)
+		for _, f := range fields {
+			if !shareaccessevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shareaccessevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(shareaccessevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(shareaccessevent.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(shareaccessevent.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ShareLinkID(); ok {
+		_spec.SetField(shareaccessevent.FieldShareLinkID, field.TypeString, value)
+	}
+	if _u.mutation.ShareLinkIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldShareLinkID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(shareaccessevent.FieldTokenPrefix, field.TypeString, value)
+	}
+	if _u.mutation.TokenPrefixCleared() {
+		_spec.ClearField(shareaccessevent.FieldTokenPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(shareaccessevent.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(shareaccessevent.FieldPolicyID, field.TypeString, value)
+	}
+	if _u.mutation.PolicyIDCleared() {
+		_spec.ClearField(shareaccessevent.FieldPolicyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(shareaccessevent.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(shareaccessevent.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIP(); ok {
+		_spec.SetField(shareaccessevent.FieldClientIP, field.TypeString, value)
+	}
+	if _u.mutation.ClientIPCleared() {
+		_spec.ClearField(shareaccessevent.FieldClientIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccessevent.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccessevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(shareaccessevent.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(shareaccessevent.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReputationList(); ok {
+		_spec.SetField(shareaccessevent.FieldReputationList, field.TypeString, value)
+	}
+	if _u.mutation.ReputationListCleared() {
+		_spec.ClearField(shareaccessevent.FieldReputationList, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(shareaccessevent.FieldRiskScore, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(shareaccessevent.FieldRiskScore, field.TypeInt32, value)
+	}
+	if _u.mutation.RiskScoreCleared() {
+		_spec.ClearField(shareaccessevent.FieldRiskScore, field.TypeInt32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ShareAccessEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccessevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -34,7 +34,7 @@ type SharedLink struct {
 	ResourceID string `json:"resource_id,omitempty"`
 	// Display name of the shared resource
 	ResourceName string `json:"resource_name,omitempty"`
-	// Unique share token (64 hex chars)
+	// Unique share token (tgs_ prefix + base62 + CRC32 checksum, or legacy 64 hex chars)
 	Token string `json:"token,omitempty"`
 	// AES-256-GCM encrypted content
 	EncryptedContent *[]byte `json:"encrypted_content,omitempty"`
@@ -42,19 +42,61 @@ type SharedLink struct {
 	EncryptionNonce *[]byte `json:"encryption_nonce,omitempty"`
 	// Recipient email address
 	RecipientEmail string `json:"recipient_email,omitempty"`
+	// Sender email address for share notifications
+	SenderEmail string `json:"sender_email,omitempty"`
 	// Optional message to recipient
 	Message string `json:"message,omitempty"`
 	// Email template ID used
 	TemplateID *string `json:"template_id,omitempty"`
 	// Whether the share has been viewed
 	Viewed bool `json:"viewed,omitempty"`
-	// When the share was viewed
+	// When the share was first viewed
 	ViewedAt *time.Time `json:"viewed_at,omitempty"`
 	// IP address of viewer
 	ViewedIP string `json:"viewed_ip,omitempty"`
 	// Whether the share has been revoked
-	Revoked      bool `json:"revoked,omitempty"`
-	selectValues sql.SelectValues
+	Revoked bool `json:"revoked,omitempty"`
+	// When the share expires if not viewed
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Whether the sender has been notified that the share expired
+	ExpiryNotified bool `json:"expiry_notified,omitempty"`
+	// Number of attempts to notify the sender that the share expired
+	ExpiryNoticeAttempts int32 `json:"expiry_notice_attempts,omitempty"`
+	// When the next expiry notification attempt is due
+	ExpiryNoticeNextAttemptAt *time.Time `json:"expiry_notice_next_attempt_at,omitempty"`
+	// User the upstream service authorized to read the resource
+	AuthorizedBy *uint32 `json:"authorized_by,omitempty"`
+	// Upstream service and permission that authorized the share
+	AuthorizedVia string `json:"authorized_via,omitempty"`
+	// When the upstream service authorized the share
+	AuthorizedAt *time.Time `json:"authorized_at,omitempty"`
+	// How the access policies are combined
+	PolicyMode sharedlink.PolicyMode `json:"policy_mode,omitempty"`
+	// Decision when no access policy decides
+	PolicyDefault sharedlink.PolicyDefault `json:"policy_default,omitempty"`
+	// How many times the share can be viewed
+	MaxViews int32 `json:"max_views,omitempty"`
+	// How many times the share has been viewed
+	ViewCount int32 `json:"view_count,omitempty"`
+	// Whether all views must come from the device of the first view
+	BindDevice bool `json:"bind_device,omitempty"`
+	// SHA-256 of the device ID the share is bound to
+	DeviceBinding *string `json:"-"`
+	// When the share was bound to a device
+	DeviceBoundAt *time.Time `json:"device_bound_at,omitempty"`
+	// PBKDF2 hash of the passphrase accepted as a step-up challenge
+	PassphraseHash string `json:"-"`
+	// HMAC of the pending email verification code
+	VerificationCodeHash *string `json:"-"`
+	// When the pending email verification code expires
+	VerificationCodeExpiresAt *time.Time `json:"verification_code_expires_at,omitempty"`
+	// Verification code answers given since the last code was sent
+	StepUpAttempts int32 `json:"step_up_attempts,omitempty"`
+	// Passphrase answers given since the last passed step-up challenge
+	PassphraseAttempts int32 `json:"passphrase_attempts,omitempty"`
+	// When an access attempt was last asked to step up
+	StepUpChallengedAt *time.Time `json:"step_up_challenged_at,omitempty"`
+	selectValues       sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -64,13 +106,13 @@ func (*SharedLink) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case sharedlink.FieldEncryptedContent, sharedlink.FieldEncryptionNonce:
 			values[i] = new([]byte)
-		case sharedlink.FieldViewed, sharedlink.FieldRevoked:
+		case sharedlink.FieldViewed, sharedlink.FieldRevoked, sharedlink.FieldExpiryNotified, sharedlink.FieldBindDevice:
 			values[i] = new(sql.NullBool)
-		case sharedlink.FieldCreateBy, sharedlink.FieldTenantID:
+		case sharedlink.FieldCreateBy, sharedlink.FieldTenantID, sharedlink.FieldExpiryNoticeAttempts, sharedlink.FieldAuthorizedBy, sharedlink.FieldMaxViews, sharedlink.FieldViewCount, sharedlink.FieldStepUpAttempts, sharedlink.FieldPassphraseAttempts:
 			values[i] = new(sql.NullInt64)
-		case sharedlink.FieldID, sharedlink.FieldResourceType, sharedlink.FieldResourceID, sharedlink.FieldResourceName, sharedlink.FieldToken, sharedlink.FieldRecipientEmail, sharedlink.FieldMessage, sharedlink.FieldTemplateID, sharedlink.FieldViewedIP:
+		case sharedlink.FieldID, sharedlink.FieldResourceType, sharedlink.FieldResourceID, sharedlink.FieldResourceName, sharedlink.FieldToken, sharedlink.FieldRecipientEmail, sharedlink.FieldSenderEmail, sharedlink.FieldMessage, sharedlink.FieldTemplateID, sharedlink.FieldViewedIP, sharedlink.FieldAuthorizedVia, sharedlink.FieldPolicyMode, sharedlink.FieldPolicyDefault, sharedlink.FieldDeviceBinding, sharedlink.FieldPassphraseHash, sharedlink.FieldVerificationCodeHash:
 			values[i] = new(sql.NullString)
-		case sharedlink.FieldCreateTime, sharedlink.FieldUpdateTime, sharedlink.FieldDeleteTime, sharedlink.FieldViewedAt:
+		case sharedlink.FieldCreateTime, sharedlink.FieldUpdateTime, sharedlink.FieldDeleteTime, sharedlink.FieldViewedAt, sharedlink.FieldExpiresAt, sharedlink.FieldExpiryNoticeNextAttemptAt, sharedlink.FieldAuthorizedAt, sharedlink.FieldDeviceBoundAt, sharedlink.FieldVerificationCodeExpiresAt, sharedlink.FieldStepUpChallengedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -170,6 +212,12 @@ func (_m *SharedLink) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RecipientEmail = value.String
 			}
+		case sharedlink.FieldSenderEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_email", values[i])
+			} else if value.Valid {
+				_m.SenderEmail = value.String
+			}
 		case sharedlink.FieldMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field message", values[i])
@@ -208,6 +256,135 @@ func (_m *SharedLink) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Revoked = value.Bool
 			}
+		case sharedlink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case sharedlink.FieldExpiryNotified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_notified", values[i])
+			} else if value.Valid {
+				_m.ExpiryNotified = value.Bool
+			}
+		case sharedlink.FieldExpiryNoticeAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_notice_attempts", values[i])
+			} else if value.Valid {
+				_m.ExpiryNoticeAttempts = int32(value.Int64)
+			}
+		case sharedlink.FieldExpiryNoticeNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_notice_next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.ExpiryNoticeNextAttemptAt = new(time.Time)
+				*_m.ExpiryNoticeNextAttemptAt = value.Time
+			}
+		case sharedlink.FieldAuthorizedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field authorized_by", values[i])
+			} else if value.Valid {
+				_m.AuthorizedBy = new(uint32)
+				*_m.AuthorizedBy = uint32(value.Int64)
+			}
+		case sharedlink.FieldAuthorizedVia:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field authorized_via", values[i])
+			} else if value.Valid {
+				_m.AuthorizedVia = value.String
+			}
+		case sharedlink.FieldAuthorizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field authorized_at", values[i])
+			} else if value.Valid {
+				_m.AuthorizedAt = new(time.Time)
+				*_m.AuthorizedAt = value.Time
+			}
+		case sharedlink.FieldPolicyMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_mode", values[i])
+			} else if value.Valid {
+				_m.PolicyMode = sharedlink.PolicyMode(value.String)
+			}
+		case sharedlink.FieldPolicyDefault:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_default", values[i])
+			} else if value.Valid {
+				_m.PolicyDefault = sharedlink.PolicyDefault(value.String)
+			}
+		case sharedlink.FieldMaxViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_views", values[i])
+			} else if value.Valid {
+				_m.MaxViews = int32(value.Int64)
+			}
+		case sharedlink.FieldViewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field view_count", values[i])
+			} else if value.Valid {
+				_m.ViewCount = int32(value.Int64)
+			}
+		case sharedlink.FieldBindDevice:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_device", values[i])
+			} else if value.Valid {
+				_m.BindDevice = value.Bool
+			}
+		case sharedlink.FieldDeviceBinding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_binding", values[i])
+			} else if value.Valid {
+				_m.DeviceBinding = new(string)
+				*_m.DeviceBinding = value.String
+			}
+		case sharedlink.FieldDeviceBoundAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field device_bound_at", values[i])
+			} else if value.Valid {
+				_m.DeviceBoundAt = new(time.Time)
+				*_m.DeviceBoundAt = value.Time
+			}
+		case sharedlink.FieldPassphraseHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field passphrase_hash", values[i])
+			} else if value.Valid {
+				_m.PassphraseHash = value.String
+			}
+		case sharedlink.FieldVerificationCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_code_hash", values[i])
+			} else if value.Valid {
+				_m.VerificationCodeHash = new(string)
+				*_m.VerificationCodeHash = value.String
+			}
+		case sharedlink.FieldVerificationCodeExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_code_expires_at", values[i])
+			} else if value.Valid {
+				_m.VerificationCodeExpiresAt = new(time.Time)
+				*_m.VerificationCodeExpiresAt = value.Time
+			}
+		case sharedlink.FieldStepUpAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_up_attempts", values[i])
+			} else if value.Valid {
+				_m.StepUpAttempts = int32(value.Int64)
+			}
+		case sharedlink.FieldPassphraseAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field passphrase_attempts", values[i])
+			} else if value.Valid {
+				_m.PassphraseAttempts = int32(value.Int64)
+			}
+		case sharedlink.FieldStepUpChallengedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field step_up_challenged_at", values[i])
+			} else if value.Valid {
+				_m.StepUpChallengedAt = new(time.Time)
+				*_m.StepUpChallengedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -294,6 +471,9 @@ func (_m *SharedLink) String() string {
 	builder.WriteString("recipient_email=")
 	builder.WriteString(_m.RecipientEmail)
 	builder.WriteString(", ")
+	builder.WriteString("sender_email=")
+	builder.WriteString(_m.SenderEmail)
+	builder.WriteString(", ")
 	builder.WriteString("message=")
 	builder.WriteString(_m.Message)
 	builder.WriteString(", ")
@@ -315,6 +495,77 @@ func (_m *SharedLink) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("revoked=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Revoked))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expiry_notified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiryNotified))
+	builder.WriteString(", ")
+	builder.WriteString("expiry_notice_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiryNoticeAttempts))
+	builder.WriteString(", ")
+	if v := _m.ExpiryNoticeNextAttemptAt; v != nil {
+		builder.WriteString("expiry_notice_next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AuthorizedBy; v != nil {
+		builder.WriteString("authorized_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("authorized_via=")
+	builder.WriteString(_m.AuthorizedVia)
+	builder.WriteString(", ")
+	if v := _m.AuthorizedAt; v != nil {
+		builder.WriteString("authorized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("policy_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyMode))
+	builder.WriteString(", ")
+	builder.WriteString("policy_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyDefault))
+	builder.WriteString(", ")
+	builder.WriteString("max_views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxViews))
+	builder.WriteString(", ")
+	builder.WriteString("view_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViewCount))
+	builder.WriteString(", ")
+	builder.WriteString("bind_device=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BindDevice))
+	builder.WriteString(", ")
+	builder.WriteString("device_binding=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.DeviceBoundAt; v != nil {
+		builder.WriteString("device_bound_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("passphrase_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("verification_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.VerificationCodeExpiresAt; v != nil {
+		builder.WriteString("verification_code_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("step_up_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StepUpAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("passphrase_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PassphraseAttempts))
+	builder.WriteString(", ")
+	if v := _m.StepUpChallengedAt; v != nil {
+		builder.WriteString("step_up_challenged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -38,6 +38,8 @@ const (
 	FieldEncryptionNonce = "encryption_nonce"
 	// FieldRecipientEmail holds the string denoting the recipient_email field in the database.
 	FieldRecipientEmail = "recipient_email"
+	// FieldSenderEmail holds the string denoting the sender_email field in the database.
+	FieldSenderEmail = "sender_email"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
 	// FieldTemplateID holds the string denoting the template_id field in the database.
@@ -50,6 +52,46 @@ const (
 	FieldViewedIP = "viewed_ip"
 	// FieldRevoked holds the string denoting the revoked field in the database.
 	FieldRevoked = "revoked"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldExpiryNotified holds the string denoting the expiry_notified field in the database.
+	FieldExpiryNotified = "expiry_notified"
+	// FieldExpiryNoticeAttempts holds the string denoting the expiry_notice_attempts field in the database.
+	FieldExpiryNoticeAttempts = "expiry_notice_attempts"
+	// FieldExpiryNoticeNextAttemptAt holds the string denoting the expiry_notice_next_attempt_at field in the database.
+	FieldExpiryNoticeNextAttemptAt = "expiry_notice_next_attempt_at"
+	// FieldAuthorizedBy holds the string denoting the authorized_by field in the database.
+	FieldAuthorizedBy = "authorized_by"
+	// FieldAuthorizedVia holds the string denoting the authorized_via field in the database.
+	FieldAuthorizedVia = "authorized_via"
+	// FieldAuthorizedAt holds the string denoting the authorized_at field in the database.
+	FieldAuthorizedAt = "authorized_at"
+	// FieldPolicyMode holds the string denoting the policy_mode field in the database.
+	FieldPolicyMode = "policy_mode"
+	// FieldPolicyDefault holds the string denoting the policy_default field in the database.
+	FieldPolicyDefault = "policy_default"
+	// FieldMaxViews holds the string denoting the max_views field in the database.
+	FieldMaxViews = "max_views"
+	// FieldViewCount holds the string denoting the view_count field in the database.
+	FieldViewCount = "view_count"
+	// FieldBindDevice holds the string denoting the bind_device field in the database.
+	FieldBindDevice = "bind_device"
+	// FieldDeviceBinding holds the string denoting the device_binding field in the database.
+	FieldDeviceBinding = "device_binding"
+	// FieldDeviceBoundAt holds the string denoting the device_bound_at field in the database.
+	FieldDeviceBoundAt = "device_bound_at"
+	// FieldPassphraseHash holds the string denoting the passphrase_hash field in the database.
+	FieldPassphraseHash = "passphrase_hash"
+	// FieldVerificationCodeHash holds the string denoting the verification_code_hash field in the database.
+	FieldVerificationCodeHash = "verification_code_hash"
+	// FieldVerificationCodeExpiresAt holds the string denoting the verification_code_expires_at field in the database.
+	FieldVerificationCodeExpiresAt = "verification_code_expires_at"
+	// FieldStepUpAttempts holds the string denoting the step_up_attempts field in the database.
+	FieldStepUpAttempts = "step_up_attempts"
+	// FieldPassphraseAttempts holds the string denoting the passphrase_attempts field in the database.
+	FieldPassphraseAttempts = "passphrase_attempts"
+	// FieldStepUpChallengedAt holds the string denoting the step_up_challenged_at field in the database.
+	FieldStepUpChallengedAt = "step_up_challenged_at"
 	// Table holds the table name of the sharedlink in the database.
 	Table = "sharing_shared_links"
 )
@@ -69,12 +111,33 @@ var Columns = []string{
 	FieldEncryptedContent,
 	FieldEncryptionNonce,
 	FieldRecipientEmail,
+	FieldSenderEmail,
 	FieldMessage,
 	FieldTemplateID,
 	FieldViewed,
 	FieldViewedAt,
 	FieldViewedIP,
 	FieldRevoked,
+	FieldExpiresAt,
+	FieldExpiryNotified,
+	FieldExpiryNoticeAttempts,
+	FieldExpiryNoticeNextAttemptAt,
+	FieldAuthorizedBy,
+	FieldAuthorizedVia,
+	FieldAuthorizedAt,
+	FieldPolicyMode,
+	FieldPolicyDefault,
+	FieldMaxViews,
+	FieldViewCount,
+	FieldBindDevice,
+	FieldDeviceBinding,
+	FieldDeviceBoundAt,
+	FieldPassphraseHash,
+	FieldVerificationCodeHash,
+	FieldVerificationCodeExpiresAt,
+	FieldStepUpAttempts,
+	FieldPassphraseAttempts,
+	FieldStepUpChallengedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,7 +156,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
 var (
-	Hooks  [1]ent.Hook
+	Hooks  [2]ent.Hook
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
@@ -105,6 +168,8 @@ var (
 	TokenValidator func(string) error
 	// RecipientEmailValidator is a validator for the "recipient_email" field. It is called by the builders before save.
 	RecipientEmailValidator func(string) error
+	// SenderEmailValidator is a validator for the "sender_email" field. It is called by the builders before save.
+	SenderEmailValidator func(string) error
 	// MessageValidator is a validator for the "message" field. It is called by the builders before save.
 	MessageValidator func(string) error
 	// TemplateIDValidator is a validator for the "template_id" field. It is called by the builders before save.
@@ -115,6 +180,32 @@ var (
 	ViewedIPValidator func(string) error
 	// DefaultRevoked holds the default value on creation for the "revoked" field.
 	DefaultRevoked bool
+	// DefaultExpiryNotified holds the default value on creation for the "expiry_notified" field.
+	DefaultExpiryNotified bool
+	// DefaultExpiryNoticeAttempts holds the default value on creation for the "expiry_notice_attempts" field.
+	DefaultExpiryNoticeAttempts int32
+	// AuthorizedViaValidator is a validator for the "authorized_via" field. It is called by the builders before save.
+	AuthorizedViaValidator func(string) error
+	// DefaultMaxViews holds the default value on creation for the "max_views" field.
+	DefaultMaxViews int32
+	// MaxViewsValidator is a validator for the "max_views" field. It is called by the builders before save.
+	MaxViewsValidator func(int32) error
+	// DefaultViewCount holds the default value on creation for the "view_count" field.
+	DefaultViewCount int32
+	// ViewCountValidator is a validator for the "view_count" field. It is called by the builders before save.
+	ViewCountValidator func(int32) error
+	// DefaultBindDevice holds the default value on creation for the "bind_device" field.
+	DefaultBindDevice bool
+	// DeviceBindingValidator is a validator for the "device_binding" field. It is called by the builders before save.
+	DeviceBindingValidator func(string) error
+	// PassphraseHashValidator is a validator for the "passphrase_hash" field. It is called by the builders before save.
+	PassphraseHashValidator func(string) error
+	// VerificationCodeHashValidator is a validator for the "verification_code_hash" field. It is called by the builders before save.
+	VerificationCodeHashValidator func(string) error
+	// DefaultStepUpAttempts holds the default value on creation for the "step_up_attempts" field.
+	DefaultStepUpAttempts int32
+	// DefaultPassphraseAttempts holds the default value on creation for the "passphrase_attempts" field.
+	DefaultPassphraseAttempts int32
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -142,6 +233,59 @@ func ResourceTypeValidator(rt ResourceType) error {
 	}
 }
 
+// PolicyMode defines the type for the "policy_mode" enum field.
+type PolicyMode string
+
+// PolicyModeALL_MUST_PASS is the default value of the PolicyMode enum.
+const DefaultPolicyMode = PolicyModeALL_MUST_PASS
+
+// PolicyMode values.
+const (
+	PolicyModeALL_MUST_PASS PolicyMode = "ALL_MUST_PASS"
+	PolicyModeANY_WHITELIST PolicyMode = "ANY_WHITELIST"
+	PolicyModeFIRST_MATCH   PolicyMode = "FIRST_MATCH"
+)
+
+func (pm PolicyMode) String() string {
+	return string(pm)
+}
+
+// PolicyModeValidator is a validator for the "policy_mode" field enum values. It is called by the builders before save.
+func PolicyModeValidator(pm PolicyMode) error {
+	switch pm {
+	case PolicyModeALL_MUST_PASS, PolicyModeANY_WHITELIST, PolicyModeFIRST_MATCH:
+		return nil
+	default:
+		return fmt.Errorf("sharedlink: invalid enum value for policy_mode field: %q", pm)
+	}
+}
+
+// PolicyDefault defines the type for the "policy_default" enum field.
+type PolicyDefault string
+
+// PolicyDefaultALLOW is the default value of the PolicyDefault enum.
+const DefaultPolicyDefault = PolicyDefaultALLOW
+
+// PolicyDefault values.
+const (
+	PolicyDefaultALLOW PolicyDefault = "ALLOW"
+	PolicyDefaultDENY  PolicyDefault = "DENY"
+)
+
+func (pd PolicyDefault) String() string {
+	return string(pd)
+}
+
+// PolicyDefaultValidator is a validator for the "policy_default" field enum values. It is called by the builders before save.
+func PolicyDefaultValidator(pd PolicyDefault) error {
+	switch pd {
+	case PolicyDefaultALLOW, PolicyDefaultDENY:
+		return nil
+	default:
+		return fmt.Errorf("sharedlink: invalid enum value for policy_default field: %q", pd)
+	}
+}
+
 // OrderOption defines the ordering options for the SharedLink queries.
 type OrderOption func(*sql.Selector)
 
@@ -200,6 +344,11 @@ func ByRecipientEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRecipientEmail, opts...).ToFunc()
 }
 
+// BySenderEmail orders the results by the sender_email field.
+func BySenderEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderEmail, opts...).ToFunc()
+}
+
 // ByMessage orders the results by the message field.
 func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
@@ -229,3 +378,103 @@ func ByViewedIP(opts ...sql.OrderTermOption) OrderOption {
 func ByRevoked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRevoked, opts...).ToFunc()
 }
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByExpiryNotified orders the results by the expiry_notified field.
+func ByExpiryNotified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryNotified, opts...).ToFunc()
+}
+
+// ByExpiryNoticeAttempts orders the results by the expiry_notice_attempts field.
+func ByExpiryNoticeAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryNoticeAttempts, opts...).ToFunc()
+}
+
+// ByExpiryNoticeNextAttemptAt orders the results by the expiry_notice_next_attempt_at field.
+func ByExpiryNoticeNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryNoticeNextAttemptAt, opts...).ToFunc()
+}
+
+// ByAuthorizedBy orders the results by the authorized_by field.
+func ByAuthorizedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorizedBy, opts...).ToFunc()
+}
+
+// ByAuthorizedVia orders the results by the authorized_via field.
+func ByAuthorizedVia(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorizedVia, opts...).ToFunc()
+}
+
+// ByAuthorizedAt orders the results by the authorized_at field.
+func ByAuthorizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorizedAt, opts...).ToFunc()
+}
+
+// ByPolicyMode orders the results by the policy_mode field.
+func ByPolicyMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyMode, opts...).ToFunc()
+}
+
+// ByPolicyDefault orders the results by the policy_default field.
+func ByPolicyDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyDefault, opts...).ToFunc()
+}
+
+// ByMaxViews orders the results by the max_views field.
+func ByMaxViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxViews, opts...).ToFunc()
+}
+
+// ByViewCount orders the results by the view_count field.
+func ByViewCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViewCount, opts...).ToFunc()
+}
+
+// ByBindDevice orders the results by the bind_device field.
+func ByBindDevice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindDevice, opts...).ToFunc()
+}
+
+// ByDeviceBinding orders the results by the device_binding field.
+func ByDeviceBinding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceBinding, opts...).ToFunc()
+}
+
+// ByDeviceBoundAt orders the results by the device_bound_at field.
+func ByDeviceBoundAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceBoundAt, opts...).ToFunc()
+}
+
+// ByPassphraseHash orders the results by the passphrase_hash field.
+func ByPassphraseHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassphraseHash, opts...).ToFunc()
+}
+
+// ByVerificationCodeHash orders the results by the verification_code_hash field.
+func ByVerificationCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationCodeHash, opts...).ToFunc()
+}
+
+// ByVerificationCodeExpiresAt orders the results by the verification_code_expires_at field.
+func ByVerificationCodeExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationCodeExpiresAt, opts...).ToFunc()
+}
+
+// ByStepUpAttempts orders the results by the step_up_attempts field.
+func ByStepUpAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepUpAttempts, opts...).ToFunc()
+}
+
+// ByPassphraseAttempts orders the results by the passphrase_attempts field.
+func ByPassphraseAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassphraseAttempts, opts...).ToFunc()
+}
+
+// ByStepUpChallengedAt orders the results by the step_up_challenged_at field.
+func ByStepUpChallengedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepUpChallengedAt, opts...).ToFunc()
+}
@@ -119,6 +119,11 @@ func RecipientEmail(v string) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldEQ(FieldRecipientEmail, v))
 }
 
+// SenderEmail applies equality check predicate on the "sender_email" field. It's identical to SenderEmailEQ.
+func SenderEmail(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldSenderEmail, v))
+}
+
 // Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
 func Message(v string) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldEQ(FieldMessage, v))
@@ -149,6 +154,96 @@ func Revoked(v bool) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldEQ(FieldRevoked, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiryNotified applies equality check predicate on the "expiry_notified" field. It's identical to ExpiryNotifiedEQ.
+func ExpiryNotified(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNotified, v))
+}
+
+// ExpiryNoticeAttempts applies equality check predicate on the "expiry_notice_attempts" field. It's identical to ExpiryNoticeAttemptsEQ.
+func ExpiryNoticeAttempts(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeNextAttemptAt applies equality check predicate on the "expiry_notice_next_attempt_at" field. It's identical to ExpiryNoticeNextAttemptAtEQ.
+func ExpiryNoticeNextAttemptAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// AuthorizedBy applies equality check predicate on the "authorized_by" field. It's identical to AuthorizedByEQ.
+func AuthorizedBy(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedBy, v))
+}
+
+// AuthorizedVia applies equality check predicate on the "authorized_via" field. It's identical to AuthorizedViaEQ.
+func AuthorizedVia(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedVia, v))
+}
+
+// AuthorizedAt applies equality check predicate on the "authorized_at" field. It's identical to AuthorizedAtEQ.
+func AuthorizedAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedAt, v))
+}
+
+// MaxViews applies equality check predicate on the "max_views" field. It's identical to MaxViewsEQ.
+func MaxViews(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldMaxViews, v))
+}
+
+// ViewCount applies equality check predicate on the "view_count" field. It's identical to ViewCountEQ.
+func ViewCount(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldViewCount, v))
+}
+
+// BindDevice applies equality check predicate on the "bind_device" field. It's identical to BindDeviceEQ.
+func BindDevice(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldBindDevice, v))
+}
+
+// DeviceBinding applies equality check predicate on the "device_binding" field. It's identical to DeviceBindingEQ.
+func DeviceBinding(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldDeviceBinding, v))
+}
+
+// DeviceBoundAt applies equality check predicate on the "device_bound_at" field. It's identical to DeviceBoundAtEQ.
+func DeviceBoundAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldDeviceBoundAt, v))
+}
+
+// PassphraseHash applies equality check predicate on the "passphrase_hash" field. It's identical to PassphraseHashEQ.
+func PassphraseHash(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPassphraseHash, v))
+}
+
+// VerificationCodeHash applies equality check predicate on the "verification_code_hash" field. It's identical to VerificationCodeHashEQ.
+func VerificationCodeHash(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeExpiresAt applies equality check predicate on the "verification_code_expires_at" field. It's identical to VerificationCodeExpiresAtEQ.
+func VerificationCodeExpiresAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldVerificationCodeExpiresAt, v))
+}
+
+// StepUpAttempts applies equality check predicate on the "step_up_attempts" field. It's identical to StepUpAttemptsEQ.
+func StepUpAttempts(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldStepUpAttempts, v))
+}
+
+// PassphraseAttempts applies equality check predicate on the "passphrase_attempts" field. It's identical to PassphraseAttemptsEQ.
+func PassphraseAttempts(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPassphraseAttempts, v))
+}
+
+// StepUpChallengedAt applies equality check predicate on the "step_up_challenged_at" field. It's identical to StepUpChallengedAtEQ.
+func StepUpChallengedAt(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldStepUpChallengedAt, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldEQ(FieldCreateBy, v))
@@ -779,6 +874,81 @@ func RecipientEmailContainsFold(v string) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldContainsFold(FieldRecipientEmail, v))
 }
 
+// SenderEmailEQ applies the EQ predicate on the "sender_email" field.
+func SenderEmailEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldSenderEmail, v))
+}
+
+// SenderEmailNEQ applies the NEQ predicate on the "sender_email" field.
+func SenderEmailNEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldSenderEmail, v))
+}
+
+// SenderEmailIn applies the In predicate on the "sender_email" field.
+func SenderEmailIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldSenderEmail, vs...))
+}
+
+// SenderEmailNotIn applies the NotIn predicate on the "sender_email" field.
+func SenderEmailNotIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldSenderEmail, vs...))
+}
+
+// SenderEmailGT applies the GT predicate on the "sender_email" field.
+func SenderEmailGT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldSenderEmail, v))
+}
+
+// SenderEmailGTE applies the GTE predicate on the "sender_email" field.
+func SenderEmailGTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldSenderEmail, v))
+}
+
+// SenderEmailLT applies the LT predicate on the "sender_email" field.
+func SenderEmailLT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldSenderEmail, v))
+}
+
+// SenderEmailLTE applies the LTE predicate on the "sender_email" field.
+func SenderEmailLTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldSenderEmail, v))
+}
+
+// SenderEmailContains applies the Contains predicate on the "sender_email" field.
+func SenderEmailContains(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContains(FieldSenderEmail, v))
+}
+
+// SenderEmailHasPrefix applies the HasPrefix predicate on the "sender_email" field.
+func SenderEmailHasPrefix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasPrefix(FieldSenderEmail, v))
+}
+
+// SenderEmailHasSuffix applies the HasSuffix predicate on the "sender_email" field.
+func SenderEmailHasSuffix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasSuffix(FieldSenderEmail, v))
+}
+
+// SenderEmailIsNil applies the IsNil predicate on the "sender_email" field.
+func SenderEmailIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldSenderEmail))
+}
+
+// SenderEmailNotNil applies the NotNil predicate on the "sender_email" field.
+func SenderEmailNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldSenderEmail))
+}
+
+// SenderEmailEqualFold applies the EqualFold predicate on the "sender_email" field.
+func SenderEmailEqualFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEqualFold(FieldSenderEmail, v))
+}
+
+// SenderEmailContainsFold applies the ContainsFold predicate on the "sender_email" field.
+func SenderEmailContainsFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContainsFold(FieldSenderEmail, v))
+}
+
 // MessageEQ applies the EQ predicate on the "message" field.
 func MessageEQ(v string) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldEQ(FieldMessage, v))
@@ -1074,6 +1244,916 @@ func RevokedNEQ(v bool) predicate.SharedLink {
 	return predicate.SharedLink(sql.FieldNEQ(FieldRevoked, v))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ExpiryNotifiedEQ applies the EQ predicate on the "expiry_notified" field.
+func ExpiryNotifiedEQ(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNotified, v))
+}
+
+// ExpiryNotifiedNEQ applies the NEQ predicate on the "expiry_notified" field.
+func ExpiryNotifiedNEQ(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldExpiryNotified, v))
+}
+
+// ExpiryNoticeAttemptsEQ applies the EQ predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeAttemptsNEQ applies the NEQ predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsNEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeAttemptsIn applies the In predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldExpiryNoticeAttempts, vs...))
+}
+
+// ExpiryNoticeAttemptsNotIn applies the NotIn predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsNotIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldExpiryNoticeAttempts, vs...))
+}
+
+// ExpiryNoticeAttemptsGT applies the GT predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsGT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeAttemptsGTE applies the GTE predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsGTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeAttemptsLT applies the LT predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsLT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeAttemptsLTE applies the LTE predicate on the "expiry_notice_attempts" field.
+func ExpiryNoticeAttemptsLTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldExpiryNoticeAttempts, v))
+}
+
+// ExpiryNoticeNextAttemptAtEQ applies the EQ predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtNEQ applies the NEQ predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtIn applies the In predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldExpiryNoticeNextAttemptAt, vs...))
+}
+
+// ExpiryNoticeNextAttemptAtNotIn applies the NotIn predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldExpiryNoticeNextAttemptAt, vs...))
+}
+
+// ExpiryNoticeNextAttemptAtGT applies the GT predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtGTE applies the GTE predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtLT applies the LT predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtLTE applies the LTE predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldExpiryNoticeNextAttemptAt, v))
+}
+
+// ExpiryNoticeNextAttemptAtIsNil applies the IsNil predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldExpiryNoticeNextAttemptAt))
+}
+
+// ExpiryNoticeNextAttemptAtNotNil applies the NotNil predicate on the "expiry_notice_next_attempt_at" field.
+func ExpiryNoticeNextAttemptAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldExpiryNoticeNextAttemptAt))
+}
+
+// AuthorizedByEQ applies the EQ predicate on the "authorized_by" field.
+func AuthorizedByEQ(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByNEQ applies the NEQ predicate on the "authorized_by" field.
+func AuthorizedByNEQ(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByIn applies the In predicate on the "authorized_by" field.
+func AuthorizedByIn(vs ...uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldAuthorizedBy, vs...))
+}
+
+// AuthorizedByNotIn applies the NotIn predicate on the "authorized_by" field.
+func AuthorizedByNotIn(vs ...uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldAuthorizedBy, vs...))
+}
+
+// AuthorizedByGT applies the GT predicate on the "authorized_by" field.
+func AuthorizedByGT(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByGTE applies the GTE predicate on the "authorized_by" field.
+func AuthorizedByGTE(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByLT applies the LT predicate on the "authorized_by" field.
+func AuthorizedByLT(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByLTE applies the LTE predicate on the "authorized_by" field.
+func AuthorizedByLTE(v uint32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldAuthorizedBy, v))
+}
+
+// AuthorizedByIsNil applies the IsNil predicate on the "authorized_by" field.
+func AuthorizedByIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldAuthorizedBy))
+}
+
+// AuthorizedByNotNil applies the NotNil predicate on the "authorized_by" field.
+func AuthorizedByNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldAuthorizedBy))
+}
+
+// AuthorizedViaEQ applies the EQ predicate on the "authorized_via" field.
+func AuthorizedViaEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaNEQ applies the NEQ predicate on the "authorized_via" field.
+func AuthorizedViaNEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaIn applies the In predicate on the "authorized_via" field.
+func AuthorizedViaIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldAuthorizedVia, vs...))
+}
+
+// AuthorizedViaNotIn applies the NotIn predicate on the "authorized_via" field.
+func AuthorizedViaNotIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldAuthorizedVia, vs...))
+}
+
+// AuthorizedViaGT applies the GT predicate on the "authorized_via" field.
+func AuthorizedViaGT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaGTE applies the GTE predicate on the "authorized_via" field.
+func AuthorizedViaGTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaLT applies the LT predicate on the "authorized_via" field.
+func AuthorizedViaLT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaLTE applies the LTE predicate on the "authorized_via" field.
+func AuthorizedViaLTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaContains applies the Contains predicate on the "authorized_via" field.
+func AuthorizedViaContains(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContains(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaHasPrefix applies the HasPrefix predicate on the "authorized_via" field.
+func AuthorizedViaHasPrefix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasPrefix(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaHasSuffix applies the HasSuffix predicate on the "authorized_via" field.
+func AuthorizedViaHasSuffix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasSuffix(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaIsNil applies the IsNil predicate on the "authorized_via" field.
+func AuthorizedViaIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldAuthorizedVia))
+}
+
+// AuthorizedViaNotNil applies the NotNil predicate on the "authorized_via" field.
+func AuthorizedViaNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldAuthorizedVia))
+}
+
+// AuthorizedViaEqualFold applies the EqualFold predicate on the "authorized_via" field.
+func AuthorizedViaEqualFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEqualFold(FieldAuthorizedVia, v))
+}
+
+// AuthorizedViaContainsFold applies the ContainsFold predicate on the "authorized_via" field.
+func AuthorizedViaContainsFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContainsFold(FieldAuthorizedVia, v))
+}
+
+// AuthorizedAtEQ applies the EQ predicate on the "authorized_at" field.
+func AuthorizedAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtNEQ applies the NEQ predicate on the "authorized_at" field.
+func AuthorizedAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtIn applies the In predicate on the "authorized_at" field.
+func AuthorizedAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldAuthorizedAt, vs...))
+}
+
+// AuthorizedAtNotIn applies the NotIn predicate on the "authorized_at" field.
+func AuthorizedAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldAuthorizedAt, vs...))
+}
+
+// AuthorizedAtGT applies the GT predicate on the "authorized_at" field.
+func AuthorizedAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtGTE applies the GTE predicate on the "authorized_at" field.
+func AuthorizedAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtLT applies the LT predicate on the "authorized_at" field.
+func AuthorizedAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtLTE applies the LTE predicate on the "authorized_at" field.
+func AuthorizedAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldAuthorizedAt, v))
+}
+
+// AuthorizedAtIsNil applies the IsNil predicate on the "authorized_at" field.
+func AuthorizedAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldAuthorizedAt))
+}
+
+// AuthorizedAtNotNil applies the NotNil predicate on the "authorized_at" field.
+func AuthorizedAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldAuthorizedAt))
+}
+
+// PolicyModeEQ applies the EQ predicate on the "policy_mode" field.
+func PolicyModeEQ(v PolicyMode) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPolicyMode, v))
+}
+
+// PolicyModeNEQ applies the NEQ predicate on the "policy_mode" field.
+func PolicyModeNEQ(v PolicyMode) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldPolicyMode, v))
+}
+
+// PolicyModeIn applies the In predicate on the "policy_mode" field.
+func PolicyModeIn(vs ...PolicyMode) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldPolicyMode, vs...))
+}
+
+// PolicyModeNotIn applies the NotIn predicate on the "policy_mode" field.
+func PolicyModeNotIn(vs ...PolicyMode) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldPolicyMode, vs...))
+}
+
+// PolicyDefaultEQ applies the EQ predicate on the "policy_default" field.
+func PolicyDefaultEQ(v PolicyDefault) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPolicyDefault, v))
+}
+
+// PolicyDefaultNEQ applies the NEQ predicate on the "policy_default" field.
+func PolicyDefaultNEQ(v PolicyDefault) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldPolicyDefault, v))
+}
+
+// PolicyDefaultIn applies the In predicate on the "policy_default" field.
+func PolicyDefaultIn(vs ...PolicyDefault) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldPolicyDefault, vs...))
+}
+
+// PolicyDefaultNotIn applies the NotIn predicate on the "policy_default" field.
+func PolicyDefaultNotIn(vs ...PolicyDefault) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldPolicyDefault, vs...))
+}
+
+// MaxViewsEQ applies the EQ predicate on the "max_views" field.
+func MaxViewsEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldMaxViews, v))
+}
+
+// MaxViewsNEQ applies the NEQ predicate on the "max_views" field.
+func MaxViewsNEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldMaxViews, v))
+}
+
+// MaxViewsIn applies the In predicate on the "max_views" field.
+func MaxViewsIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldMaxViews, vs...))
+}
+
+// MaxViewsNotIn applies the NotIn predicate on the "max_views" field.
+func MaxViewsNotIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldMaxViews, vs...))
+}
+
+// MaxViewsGT applies the GT predicate on the "max_views" field.
+func MaxViewsGT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldMaxViews, v))
+}
+
+// MaxViewsGTE applies the GTE predicate on the "max_views" field.
+func MaxViewsGTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldMaxViews, v))
+}
+
+// MaxViewsLT applies the LT predicate on the "max_views" field.
+func MaxViewsLT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldMaxViews, v))
+}
+
+// MaxViewsLTE applies the LTE predicate on the "max_views" field.
+func MaxViewsLTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldMaxViews, v))
+}
+
+// ViewCountEQ applies the EQ predicate on the "view_count" field.
+func ViewCountEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldViewCount, v))
+}
+
+// ViewCountNEQ applies the NEQ predicate on the "view_count" field.
+func ViewCountNEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldViewCount, v))
+}
+
+// ViewCountIn applies the In predicate on the "view_count" field.
+func ViewCountIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldViewCount, vs...))
+}
+
+// ViewCountNotIn applies the NotIn predicate on the "view_count" field.
+func ViewCountNotIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldViewCount, vs...))
+}
+
+// ViewCountGT applies the GT predicate on the "view_count" field.
+func ViewCountGT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldViewCount, v))
+}
+
+// ViewCountGTE applies the GTE predicate on the "view_count" field.
+func ViewCountGTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldViewCount, v))
+}
+
+// ViewCountLT applies the LT predicate on the "view_count" field.
+func ViewCountLT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldViewCount, v))
+}
+
+// ViewCountLTE applies the LTE predicate on the "view_count" field.
+func ViewCountLTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldViewCount, v))
+}
+
+// BindDeviceEQ applies the EQ predicate on the "bind_device" field.
+func BindDeviceEQ(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldBindDevice, v))
+}
+
+// BindDeviceNEQ applies the NEQ predicate on the "bind_device" field.
+func BindDeviceNEQ(v bool) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldBindDevice, v))
+}
+
+// DeviceBindingEQ applies the EQ predicate on the "device_binding" field.
+func DeviceBindingEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldDeviceBinding, v))
+}
+
+// DeviceBindingNEQ applies the NEQ predicate on the "device_binding" field.
+func DeviceBindingNEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldDeviceBinding, v))
+}
+
+// DeviceBindingIn applies the In predicate on the "device_binding" field.
+func DeviceBindingIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldDeviceBinding, vs...))
+}
+
+// DeviceBindingNotIn applies the NotIn predicate on the "device_binding" field.
+func DeviceBindingNotIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldDeviceBinding, vs...))
+}
+
+// DeviceBindingGT applies the GT predicate on the "device_binding" field.
+func DeviceBindingGT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldDeviceBinding, v))
+}
+
+// DeviceBindingGTE applies the GTE predicate on the "device_binding" field.
+func DeviceBindingGTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldDeviceBinding, v))
+}
+
+// DeviceBindingLT applies the LT predicate on the "device_binding" field.
+func DeviceBindingLT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldDeviceBinding, v))
+}
+
+// DeviceBindingLTE applies the LTE predicate on the "device_binding" field.
+func DeviceBindingLTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldDeviceBinding, v))
+}
+
+// DeviceBindingContains applies the Contains predicate on the "device_binding" field.
+func DeviceBindingContains(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContains(FieldDeviceBinding, v))
+}
+
+// DeviceBindingHasPrefix applies the HasPrefix predicate on the "device_binding" field.
+func DeviceBindingHasPrefix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasPrefix(FieldDeviceBinding, v))
+}
+
+// DeviceBindingHasSuffix applies the HasSuffix predicate on the "device_binding" field.
+func DeviceBindingHasSuffix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasSuffix(FieldDeviceBinding, v))
+}
+
+// DeviceBindingIsNil applies the IsNil predicate on the "device_binding" field.
+func DeviceBindingIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldDeviceBinding))
+}
+
+// DeviceBindingNotNil applies the NotNil predicate on the "device_binding" field.
+func DeviceBindingNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldDeviceBinding))
+}
+
+// DeviceBindingEqualFold applies the EqualFold predicate on the "device_binding" field.
+func DeviceBindingEqualFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEqualFold(FieldDeviceBinding, v))
+}
+
+// DeviceBindingContainsFold applies the ContainsFold predicate on the "device_binding" field.
+func DeviceBindingContainsFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContainsFold(FieldDeviceBinding, v))
+}
+
+// DeviceBoundAtEQ applies the EQ predicate on the "device_bound_at" field.
+func DeviceBoundAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtNEQ applies the NEQ predicate on the "device_bound_at" field.
+func DeviceBoundAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtIn applies the In predicate on the "device_bound_at" field.
+func DeviceBoundAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldDeviceBoundAt, vs...))
+}
+
+// DeviceBoundAtNotIn applies the NotIn predicate on the "device_bound_at" field.
+func DeviceBoundAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldDeviceBoundAt, vs...))
+}
+
+// DeviceBoundAtGT applies the GT predicate on the "device_bound_at" field.
+func DeviceBoundAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtGTE applies the GTE predicate on the "device_bound_at" field.
+func DeviceBoundAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtLT applies the LT predicate on the "device_bound_at" field.
+func DeviceBoundAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtLTE applies the LTE predicate on the "device_bound_at" field.
+func DeviceBoundAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldDeviceBoundAt, v))
+}
+
+// DeviceBoundAtIsNil applies the IsNil predicate on the "device_bound_at" field.
+func DeviceBoundAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldDeviceBoundAt))
+}
+
+// DeviceBoundAtNotNil applies the NotNil predicate on the "device_bound_at" field.
+func DeviceBoundAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldDeviceBoundAt))
+}
+
+// PassphraseHashEQ applies the EQ predicate on the "passphrase_hash" field.
+func PassphraseHashEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPassphraseHash, v))
+}
+
+// PassphraseHashNEQ applies the NEQ predicate on the "passphrase_hash" field.
+func PassphraseHashNEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldPassphraseHash, v))
+}
+
+// PassphraseHashIn applies the In predicate on the "passphrase_hash" field.
+func PassphraseHashIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldPassphraseHash, vs...))
+}
+
+// PassphraseHashNotIn applies the NotIn predicate on the "passphrase_hash" field.
+func PassphraseHashNotIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldPassphraseHash, vs...))
+}
+
+// PassphraseHashGT applies the GT predicate on the "passphrase_hash" field.
+func PassphraseHashGT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldPassphraseHash, v))
+}
+
+// PassphraseHashGTE applies the GTE predicate on the "passphrase_hash" field.
+func PassphraseHashGTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldPassphraseHash, v))
+}
+
+// PassphraseHashLT applies the LT predicate on the "passphrase_hash" field.
+func PassphraseHashLT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldPassphraseHash, v))
+}
+
+// PassphraseHashLTE applies the LTE predicate on the "passphrase_hash" field.
+func PassphraseHashLTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldPassphraseHash, v))
+}
+
+// PassphraseHashContains applies the Contains predicate on the "passphrase_hash" field.
+func PassphraseHashContains(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContains(FieldPassphraseHash, v))
+}
+
+// PassphraseHashHasPrefix applies the HasPrefix predicate on the "passphrase_hash" field.
+func PassphraseHashHasPrefix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasPrefix(FieldPassphraseHash, v))
+}
+
+// PassphraseHashHasSuffix applies the HasSuffix predicate on the "passphrase_hash" field.
+func PassphraseHashHasSuffix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasSuffix(FieldPassphraseHash, v))
+}
+
+// PassphraseHashIsNil applies the IsNil predicate on the "passphrase_hash" field.
+func PassphraseHashIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldPassphraseHash))
+}
+
+// PassphraseHashNotNil applies the NotNil predicate on the "passphrase_hash" field.
+func PassphraseHashNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldPassphraseHash))
+}
+
+// PassphraseHashEqualFold applies the EqualFold predicate on the "passphrase_hash" field.
+func PassphraseHashEqualFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEqualFold(FieldPassphraseHash, v))
+}
+
+// PassphraseHashContainsFold applies the ContainsFold predicate on the "passphrase_hash" field.
+func PassphraseHashContainsFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContainsFold(FieldPassphraseHash, v))
+}
+
+// VerificationCodeHashEQ applies the EQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashNEQ applies the NEQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashNEQ(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIn applies the In predicate on the "verification_code_hash" field.
+func VerificationCodeHashIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashNotIn applies the NotIn predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotIn(vs ...string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashGT applies the GT predicate on the "verification_code_hash" field.
+func VerificationCodeHashGT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashGTE applies the GTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashGTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLT applies the LT predicate on the "verification_code_hash" field.
+func VerificationCodeHashLT(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLTE applies the LTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashLTE(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContains applies the Contains predicate on the "verification_code_hash" field.
+func VerificationCodeHashContains(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContains(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasPrefix applies the HasPrefix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasPrefix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasPrefix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasSuffix applies the HasSuffix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasSuffix(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldHasSuffix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIsNil applies the IsNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashNotNil applies the NotNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashEqualFold applies the EqualFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashEqualFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEqualFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContainsFold applies the ContainsFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashContainsFold(v string) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldContainsFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeExpiresAtEQ applies the EQ predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtNEQ applies the NEQ predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtIn applies the In predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldVerificationCodeExpiresAt, vs...))
+}
+
+// VerificationCodeExpiresAtNotIn applies the NotIn predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldVerificationCodeExpiresAt, vs...))
+}
+
+// VerificationCodeExpiresAtGT applies the GT predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtGTE applies the GTE predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtLT applies the LT predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtLTE applies the LTE predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldVerificationCodeExpiresAt, v))
+}
+
+// VerificationCodeExpiresAtIsNil applies the IsNil predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldVerificationCodeExpiresAt))
+}
+
+// VerificationCodeExpiresAtNotNil applies the NotNil predicate on the "verification_code_expires_at" field.
+func VerificationCodeExpiresAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldVerificationCodeExpiresAt))
+}
+
+// StepUpAttemptsEQ applies the EQ predicate on the "step_up_attempts" field.
+func StepUpAttemptsEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldStepUpAttempts, v))
+}
+
+// StepUpAttemptsNEQ applies the NEQ predicate on the "step_up_attempts" field.
+func StepUpAttemptsNEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldStepUpAttempts, v))
+}
+
+// StepUpAttemptsIn applies the In predicate on the "step_up_attempts" field.
+func StepUpAttemptsIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldStepUpAttempts, vs...))
+}
+
+// StepUpAttemptsNotIn applies the NotIn predicate on the "step_up_attempts" field.
+func StepUpAttemptsNotIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldStepUpAttempts, vs...))
+}
+
+// StepUpAttemptsGT applies the GT predicate on the "step_up_attempts" field.
+func StepUpAttemptsGT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldStepUpAttempts, v))
+}
+
+// StepUpAttemptsGTE applies the GTE predicate on the "step_up_attempts" field.
+func StepUpAttemptsGTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldStepUpAttempts, v))
+}
+
+// StepUpAttemptsLT applies the LT predicate on the "step_up_attempts" field.
+func StepUpAttemptsLT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldStepUpAttempts, v))
+}
+
+// StepUpAttemptsLTE applies the LTE predicate on the "step_up_attempts" field.
+func StepUpAttemptsLTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldStepUpAttempts, v))
+}
+
+// PassphraseAttemptsEQ applies the EQ predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldPassphraseAttempts, v))
+}
+
+// PassphraseAttemptsNEQ applies the NEQ predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsNEQ(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldPassphraseAttempts, v))
+}
+
+// PassphraseAttemptsIn applies the In predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldPassphraseAttempts, vs...))
+}
+
+// PassphraseAttemptsNotIn applies the NotIn predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsNotIn(vs ...int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldPassphraseAttempts, vs...))
+}
+
+// PassphraseAttemptsGT applies the GT predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsGT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldPassphraseAttempts, v))
+}
+
+// PassphraseAttemptsGTE applies the GTE predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsGTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldPassphraseAttempts, v))
+}
+
+// PassphraseAttemptsLT applies the LT predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsLT(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldPassphraseAttempts, v))
+}
+
+// PassphraseAttemptsLTE applies the LTE predicate on the "passphrase_attempts" field.
+func PassphraseAttemptsLTE(v int32) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldPassphraseAttempts, v))
+}
+
+// StepUpChallengedAtEQ applies the EQ predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldEQ(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtNEQ applies the NEQ predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtNEQ(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNEQ(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtIn applies the In predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIn(FieldStepUpChallengedAt, vs...))
+}
+
+// StepUpChallengedAtNotIn applies the NotIn predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtNotIn(vs ...time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotIn(FieldStepUpChallengedAt, vs...))
+}
+
+// StepUpChallengedAtGT applies the GT predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtGT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGT(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtGTE applies the GTE predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtGTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldGTE(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtLT applies the LT predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtLT(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLT(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtLTE applies the LTE predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtLTE(v time.Time) predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldLTE(FieldStepUpChallengedAt, v))
+}
+
+// StepUpChallengedAtIsNil applies the IsNil predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtIsNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldIsNull(FieldStepUpChallengedAt))
+}
+
+// StepUpChallengedAtNotNil applies the NotNil predicate on the "step_up_challenged_at" field.
+func StepUpChallengedAtNotNil() predicate.SharedLink {
+	return predicate.SharedLink(sql.FieldNotNull(FieldStepUpChallengedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SharedLink) predicate.SharedLink {
 	return predicate.SharedLink(sql.AndPredicates(predicates...))
@@ -135,6 +135,20 @@ func (_c *SharedLinkCreate) SetRecipientEmail(v string) *SharedLinkCreate {
 	return _c
 }
 
+// SetSenderEmail sets the "sender_email" field.
+func (_c *SharedLinkCreate) SetSenderEmail(v string) *SharedLinkCreate {
+	_c.mutation.SetSenderEmail(v)
+	return _c
+}
+
+// SetNillableSenderEmail sets the "sender_email" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableSenderEmail(v *string) *SharedLinkCreate {
+	if v != nil {
+		_c.SetSenderEmail(*v)
+	}
+	return _c
+}
+
 // SetMessage sets the "message" field.
 func (_c *SharedLinkCreate) SetMessage(v string) *SharedLinkCreate {
 	_c.mutation.SetMessage(v)
@@ -219,6 +233,286 @@ func (_c *SharedLinkCreate) SetNillableRevoked(v *bool) *SharedLinkCreate {
 	return _c
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_c *SharedLinkCreate) SetExpiresAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableExpiresAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (_c *SharedLinkCreate) SetExpiryNotified(v bool) *SharedLinkCreate {
+	_c.mutation.SetExpiryNotified(v)
+	return _c
+}
+
+// SetNillableExpiryNotified sets the "expiry_notified" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableExpiryNotified(v *bool) *SharedLinkCreate {
+	if v != nil {
+		_c.SetExpiryNotified(*v)
+	}
+	return _c
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (_c *SharedLinkCreate) SetExpiryNoticeAttempts(v int32) *SharedLinkCreate {
+	_c.mutation.SetExpiryNoticeAttempts(v)
+	return _c
+}
+
+// SetNillableExpiryNoticeAttempts sets the "expiry_notice_attempts" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableExpiryNoticeAttempts(v *int32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetExpiryNoticeAttempts(*v)
+	}
+	return _c
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (_c *SharedLinkCreate) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetExpiryNoticeNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableExpiryNoticeNextAttemptAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetExpiryNoticeNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (_c *SharedLinkCreate) SetAuthorizedBy(v uint32) *SharedLinkCreate {
+	_c.mutation.SetAuthorizedBy(v)
+	return _c
+}
+
+// SetNillableAuthorizedBy sets the "authorized_by" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableAuthorizedBy(v *uint32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetAuthorizedBy(*v)
+	}
+	return _c
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (_c *SharedLinkCreate) SetAuthorizedVia(v string) *SharedLinkCreate {
+	_c.mutation.SetAuthorizedVia(v)
+	return _c
+}
+
+// SetNillableAuthorizedVia sets the "authorized_via" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableAuthorizedVia(v *string) *SharedLinkCreate {
+	if v != nil {
+		_c.SetAuthorizedVia(*v)
+	}
+	return _c
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (_c *SharedLinkCreate) SetAuthorizedAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetAuthorizedAt(v)
+	return _c
+}
+
+// SetNillableAuthorizedAt sets the "authorized_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableAuthorizedAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetAuthorizedAt(*v)
+	}
+	return _c
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (_c *SharedLinkCreate) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkCreate {
+	_c.mutation.SetPolicyMode(v)
+	return _c
+}
+
+// SetNillablePolicyMode sets the "policy_mode" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillablePolicyMode(v *sharedlink.PolicyMode) *SharedLinkCreate {
+	if v != nil {
+		_c.SetPolicyMode(*v)
+	}
+	return _c
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (_c *SharedLinkCreate) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkCreate {
+	_c.mutation.SetPolicyDefault(v)
+	return _c
+}
+
+// SetNillablePolicyDefault sets the "policy_default" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillablePolicyDefault(v *sharedlink.PolicyDefault) *SharedLinkCreate {
+	if v != nil {
+		_c.SetPolicyDefault(*v)
+	}
+	return _c
+}
+
+// SetMaxViews sets the "max_views" field.
+func (_c *SharedLinkCreate) SetMaxViews(v int32) *SharedLinkCreate {
+	_c.mutation.SetMaxViews(v)
+	return _c
+}
+
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableMaxViews(v *int32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetMaxViews(*v)
+	}
+	return _c
+}
+
+// SetViewCount sets the "view_count" field.
+func (_c *SharedLinkCreate) SetViewCount(v int32) *SharedLinkCreate {
+	_c.mutation.SetViewCount(v)
+	return _c
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableViewCount(v *int32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetViewCount(*v)
+	}
+	return _c
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (_c *SharedLinkCreate) SetBindDevice(v bool) *SharedLinkCreate {
+	_c.mutation.SetBindDevice(v)
+	return _c
+}
+
+// SetNillableBindDevice sets the "bind_device" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableBindDevice(v *bool) *SharedLinkCreate {
+	if v != nil {
+		_c.SetBindDevice(*v)
+	}
+	return _c
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (_c *SharedLinkCreate) SetDeviceBinding(v string) *SharedLinkCreate {
+	_c.mutation.SetDeviceBinding(v)
+	return _c
+}
+
+// SetNillableDeviceBinding sets the "device_binding" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableDeviceBinding(v *string) *SharedLinkCreate {
+	if v != nil {
+		_c.SetDeviceBinding(*v)
+	}
+	return _c
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (_c *SharedLinkCreate) SetDeviceBoundAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetDeviceBoundAt(v)
+	return _c
+}
+
+// SetNillableDeviceBoundAt sets the "device_bound_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableDeviceBoundAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetDeviceBoundAt(*v)
+	}
+	return _c
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (_c *SharedLinkCreate) SetPassphraseHash(v string) *SharedLinkCreate {
+	_c.mutation.SetPassphraseHash(v)
+	return _c
+}
+
+// SetNillablePassphraseHash sets the "passphrase_hash" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillablePassphraseHash(v *string) *SharedLinkCreate {
+	if v != nil {
+		_c.SetPassphraseHash(*v)
+	}
+	return _c
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_c *SharedLinkCreate) SetVerificationCodeHash(v string) *SharedLinkCreate {
+	_c.mutation.SetVerificationCodeHash(v)
+	return _c
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableVerificationCodeHash(v *string) *SharedLinkCreate {
+	if v != nil {
+		_c.SetVerificationCodeHash(*v)
+	}
+	return _c
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (_c *SharedLinkCreate) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetVerificationCodeExpiresAt(v)
+	return _c
+}
+
+// SetNillableVerificationCodeExpiresAt sets the "verification_code_expires_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableVerificationCodeExpiresAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetVerificationCodeExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (_c *SharedLinkCreate) SetStepUpAttempts(v int32) *SharedLinkCreate {
+	_c.mutation.SetStepUpAttempts(v)
+	return _c
+}
+
+// SetNillableStepUpAttempts sets the "step_up_attempts" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableStepUpAttempts(v *int32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetStepUpAttempts(*v)
+	}
+	return _c
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (_c *SharedLinkCreate) SetPassphraseAttempts(v int32) *SharedLinkCreate {
+	_c.mutation.SetPassphraseAttempts(v)
+	return _c
+}
+
+// SetNillablePassphraseAttempts sets the "passphrase_attempts" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillablePassphraseAttempts(v *int32) *SharedLinkCreate {
+	if v != nil {
+		_c.SetPassphraseAttempts(*v)
+	}
+	return _c
+}
+
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (_c *SharedLinkCreate) SetStepUpChallengedAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetStepUpChallengedAt(v)
+	return _c
+}
+
+// SetNillableStepUpChallengedAt sets the "step_up_challenged_at" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableStepUpChallengedAt(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetStepUpChallengedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SharedLinkCreate) SetID(v string) *SharedLinkCreate {
 	_c.mutation.SetID(v)
@@ -274,6 +568,42 @@ func (_c *SharedLinkCreate) defaults() error {
 		v := sharedlink.DefaultRevoked
 		_c.mutation.SetRevoked(v)
 	}
+	if _, ok := _c.mutation.ExpiryNotified(); !ok {
+		v := sharedlink.DefaultExpiryNotified
+		_c.mutation.SetExpiryNotified(v)
+	}
+	if _, ok := _c.mutation.ExpiryNoticeAttempts(); !ok {
+		v := sharedlink.DefaultExpiryNoticeAttempts
+		_c.mutation.SetExpiryNoticeAttempts(v)
+	}
+	if _, ok := _c.mutation.PolicyMode(); !ok {
+		v := sharedlink.DefaultPolicyMode
+		_c.mutation.SetPolicyMode(v)
+	}
+	if _, ok := _c.mutation.PolicyDefault(); !ok {
+		v := sharedlink.DefaultPolicyDefault
+		_c.mutation.SetPolicyDefault(v)
+	}
+	if _, ok := _c.mutation.MaxViews(); !ok {
+		v := sharedlink.DefaultMaxViews
+		_c.mutation.SetMaxViews(v)
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		v := sharedlink.DefaultViewCount
+		_c.mutation.SetViewCount(v)
+	}
+	if _, ok := _c.mutation.BindDevice(); !ok {
+		v := sharedlink.DefaultBindDevice
+		_c.mutation.SetBindDevice(v)
+	}
+	if _, ok := _c.mutation.StepUpAttempts(); !ok {
+		v := sharedlink.DefaultStepUpAttempts
+		_c.mutation.SetStepUpAttempts(v)
+	}
+	if _, ok := _c.mutation.PassphraseAttempts(); !ok {
+		v := sharedlink.DefaultPassphraseAttempts
+		_c.mutation.SetPassphraseAttempts(v)
+	}
 	return nil
 }
 
@@ -319,6 +649,11 @@ func (_c *SharedLinkCreate) check() error {
 			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.recipient_email": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.SenderEmail(); ok {
+		if err := sharedlink.SenderEmailValidator(v); err != nil {
+			return &ValidationError{Name: "sender_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.sender_email": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.Message(); ok {
 		if err := sharedlink.MessageValidator(v); err != nil {
 			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "SharedLink.message": %w`, err)}
@@ -340,6 +675,73 @@ func (_c *SharedLinkCreate) check() error {
 	if _, ok := _c.mutation.Revoked(); !ok {
 		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "SharedLink.revoked"`)}
 	}
+	if _, ok := _c.mutation.ExpiryNotified(); !ok {
+		return &ValidationError{Name: "expiry_notified", err: errors.New(`ent: missing required field "SharedLink.expiry_notified"`)}
+	}
+	if _, ok := _c.mutation.ExpiryNoticeAttempts(); !ok {
+		return &ValidationError{Name: "expiry_notice_attempts", err: errors.New(`ent: missing required field "SharedLink.expiry_notice_attempts"`)}
+	}
+	if v, ok := _c.mutation.AuthorizedVia(); ok {
+		if err := sharedlink.AuthorizedViaValidator(v); err != nil {
+			return &ValidationError{Name: "authorized_via", err: fmt.Errorf(`ent: validator failed for field "SharedLink.authorized_via": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyMode(); !ok {
+		return &ValidationError{Name: "policy_mode", err: errors.New(`ent: missing required field "SharedLink.policy_mode"`)}
+	}
+	if v, ok := _c.mutation.PolicyMode(); ok {
+		if err := sharedlink.PolicyModeValidator(v); err != nil {
+			return &ValidationError{Name: "policy_mode", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyDefault(); !ok {
+		return &ValidationError{Name: "policy_default", err: errors.New(`ent: missing required field "SharedLink.policy_default"`)}
+	}
+	if v, ok := _c.mutation.PolicyDefault(); ok {
+		if err := sharedlink.PolicyDefaultValidator(v); err != nil {
+			return &ValidationError{Name: "policy_default", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_default": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxViews(); !ok {
+		return &ValidationError{Name: "max_views", err: errors.New(`ent: missing required field "SharedLink.max_views"`)}
+	}
+	if v, ok := _c.mutation.MaxViews(); ok {
+		if err := sharedlink.MaxViewsValidator(v); err != nil {
+			return &ValidationError{Name: "max_views", err: fmt.Errorf(`ent: validator failed for field "SharedLink.max_views": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		return &ValidationError{Name: "view_count", err: errors.New(`ent: missing required field "SharedLink.view_count"`)}
+	}
+	if v, ok := _c.mutation.ViewCount(); ok {
+		if err := sharedlink.ViewCountValidator(v); err != nil {
+			return &ValidationError{Name: "view_count", err: fmt.Errorf(`ent: validator failed for field "SharedLink.view_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BindDevice(); !ok {
+		return &ValidationError{Name: "bind_device", err: errors.New(`ent: missing required field "SharedLink.bind_device"`)}
+	}
+	if v, ok := _c.mutation.DeviceBinding(); ok {
+		if err := sharedlink.DeviceBindingValidator(v); err != nil {
+			return &ValidationError{Name: "device_binding", err: fmt.Errorf(`ent: validator failed for field "SharedLink.device_binding": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PassphraseHash(); ok {
+		if err := sharedlink.PassphraseHashValidator(v); err != nil {
+			return &ValidationError{Name: "passphrase_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.passphrase_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.VerificationCodeHash(); ok {
+		if err := sharedlink.VerificationCodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "verification_code_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.verification_code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StepUpAttempts(); !ok {
+		return &ValidationError{Name: "step_up_attempts", err: errors.New(`ent: missing required field "SharedLink.step_up_attempts"`)}
+	}
+	if _, ok := _c.mutation.PassphraseAttempts(); !ok {
+		return &ValidationError{Name: "passphrase_attempts", err: errors.New(`ent: missing required field "SharedLink.passphrase_attempts"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := sharedlink.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SharedLink.id": %w`, err)}
@@ -429,6 +831,10 @@ func (_c *SharedLinkCreate) createSpec() (*SharedLink, *sqlgraph.CreateSpec) {
 		_spec.SetField(sharedlink.FieldRecipientEmail, field.TypeString, value)
 		_node.RecipientEmail = value
 	}
+	if value, ok := _c.mutation.SenderEmail(); ok {
+		_spec.SetField(sharedlink.FieldSenderEmail, field.TypeString, value)
+		_node.SenderEmail = value
+	}
 	if value, ok := _c.mutation.Message(); ok {
 		_spec.SetField(sharedlink.FieldMessage, field.TypeString, value)
 		_node.Message = value
@@ -453,6 +859,86 @@ func (_c *SharedLinkCreate) createSpec() (*SharedLink, *sqlgraph.CreateSpec) {
 		_spec.SetField(sharedlink.FieldRevoked, field.TypeBool, value)
 		_node.Revoked = value
 	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ExpiryNotified(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNotified, field.TypeBool, value)
+		_node.ExpiryNotified = value
+	}
+	if value, ok := _c.mutation.ExpiryNoticeAttempts(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeAttempts, field.TypeInt32, value)
+		_node.ExpiryNoticeAttempts = value
+	}
+	if value, ok := _c.mutation.ExpiryNoticeNextAttemptAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeNextAttemptAt, field.TypeTime, value)
+		_node.ExpiryNoticeNextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.AuthorizedBy(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedBy, field.TypeUint32, value)
+		_node.AuthorizedBy = &value
+	}
+	if value, ok := _c.mutation.AuthorizedVia(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedVia, field.TypeString, value)
+		_node.AuthorizedVia = value
+	}
+	if value, ok := _c.mutation.AuthorizedAt(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedAt, field.TypeTime, value)
+		_node.AuthorizedAt = &value
+	}
+	if value, ok := _c.mutation.PolicyMode(); ok {
+		_spec.SetField(sharedlink.FieldPolicyMode, field.TypeEnum, value)
+		_node.PolicyMode = value
+	}
+	if value, ok := _c.mutation.PolicyDefault(); ok {
+		_spec.SetField(sharedlink.FieldPolicyDefault, field.TypeEnum, value)
+		_node.PolicyDefault = value
+	}
+	if value, ok := _c.mutation.MaxViews(); ok {
+		_spec.SetField(sharedlink.FieldMaxViews, field.TypeInt32, value)
+		_node.MaxViews = value
+	}
+	if value, ok := _c.mutation.ViewCount(); ok {
+		_spec.SetField(sharedlink.FieldViewCount, field.TypeInt32, value)
+		_node.ViewCount = value
+	}
+	if value, ok := _c.mutation.BindDevice(); ok {
+		_spec.SetField(sharedlink.FieldBindDevice, field.TypeBool, value)
+		_node.BindDevice = value
+	}
+	if value, ok := _c.mutation.DeviceBinding(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBinding, field.TypeString, value)
+		_node.DeviceBinding = &value
+	}
+	if value, ok := _c.mutation.DeviceBoundAt(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBoundAt, field.TypeTime, value)
+		_node.DeviceBoundAt = &value
+	}
+	if value, ok := _c.mutation.PassphraseHash(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseHash, field.TypeString, value)
+		_node.PassphraseHash = value
+	}
+	if value, ok := _c.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeHash, field.TypeString, value)
+		_node.VerificationCodeHash = &value
+	}
+	if value, ok := _c.mutation.VerificationCodeExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeExpiresAt, field.TypeTime, value)
+		_node.VerificationCodeExpiresAt = &value
+	}
+	if value, ok := _c.mutation.StepUpAttempts(); ok {
+		_spec.SetField(sharedlink.FieldStepUpAttempts, field.TypeInt32, value)
+		_node.StepUpAttempts = value
+	}
+	if value, ok := _c.mutation.PassphraseAttempts(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseAttempts, field.TypeInt32, value)
+		_node.PassphraseAttempts = value
+	}
+	if value, ok := _c.mutation.StepUpChallengedAt(); ok {
+		_spec.SetField(sharedlink.FieldStepUpChallengedAt, field.TypeTime, value)
+		_node.StepUpChallengedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -661,6 +1147,24 @@ func (u *SharedLinkUpsert) UpdateRecipientEmail() *SharedLinkUpsert {
 	return u
 }
 
+// SetSenderEmail sets the "sender_email" field.
+func (u *SharedLinkUpsert) SetSenderEmail(v string) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldSenderEmail, v)
+	return u
+}
+
+// UpdateSenderEmail sets the "sender_email" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateSenderEmail() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldSenderEmail)
+	return u
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (u *SharedLinkUpsert) ClearSenderEmail() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldSenderEmail)
+	return u
+}
+
 // SetMessage sets the "message" field.
 func (u *SharedLinkUpsert) SetMessage(v string) *SharedLinkUpsert {
 	u.Set(sharedlink.FieldMessage, v)
@@ -757,28 +1261,370 @@ func (u *SharedLinkUpsert) UpdateRevoked() *SharedLinkUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.SharedLink.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(sharedlink.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *SharedLinkUpsertOne) UpdateNewValues() *SharedLinkUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(sharedlink.FieldID)
-		}
-		if _, exists := u.create.mutation.CreateTime(); exists {
-			s.SetIgnore(sharedlink.FieldCreateTime)
-		}
-		if _, exists := u.create.mutation.TenantID(); exists {
-			s.SetIgnore(sharedlink.FieldTenantID)
+// SetExpiresAt sets the "expires_at" field.
+func (u *SharedLinkUpsert) SetExpiresAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateExpiresAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SharedLinkUpsert) ClearExpiresAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldExpiresAt)
+	return u
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (u *SharedLinkUpsert) SetExpiryNotified(v bool) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldExpiryNotified, v)
+	return u
+}
+
+// UpdateExpiryNotified sets the "expiry_notified" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateExpiryNotified() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldExpiryNotified)
+	return u
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsert) SetExpiryNoticeAttempts(v int32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldExpiryNoticeAttempts, v)
+	return u
+}
+
+// UpdateExpiryNoticeAttempts sets the "expiry_notice_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateExpiryNoticeAttempts() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldExpiryNoticeAttempts)
+	return u
+}
+
+// AddExpiryNoticeAttempts adds v to the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsert) AddExpiryNoticeAttempts(v int32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldExpiryNoticeAttempts, v)
+	return u
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsert) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldExpiryNoticeNextAttemptAt, v)
+	return u
+}
+
+// UpdateExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateExpiryNoticeNextAttemptAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldExpiryNoticeNextAttemptAt)
+	return u
+}
+
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsert) ClearExpiryNoticeNextAttemptAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldExpiryNoticeNextAttemptAt)
+	return u
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (u *SharedLinkUpsert) SetAuthorizedBy(v uint32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldAuthorizedBy, v)
+	return u
+}
+
+// UpdateAuthorizedBy sets the "authorized_by" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateAuthorizedBy() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldAuthorizedBy)
+	return u
+}
+
+// AddAuthorizedBy adds v to the "authorized_by" field.
+func (u *SharedLinkUpsert) AddAuthorizedBy(v uint32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldAuthorizedBy, v)
+	return u
+}
+
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (u *SharedLinkUpsert) ClearAuthorizedBy() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldAuthorizedBy)
+	return u
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (u *SharedLinkUpsert) SetAuthorizedVia(v string) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldAuthorizedVia, v)
+	return u
+}
+
+// UpdateAuthorizedVia sets the "authorized_via" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateAuthorizedVia() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldAuthorizedVia)
+	return u
+}
+
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (u *SharedLinkUpsert) ClearAuthorizedVia() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldAuthorizedVia)
+	return u
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (u *SharedLinkUpsert) SetAuthorizedAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldAuthorizedAt, v)
+	return u
+}
+
+// UpdateAuthorizedAt sets the "authorized_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateAuthorizedAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldAuthorizedAt)
+	return u
+}
+
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (u *SharedLinkUpsert) ClearAuthorizedAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldAuthorizedAt)
+	return u
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (u *SharedLinkUpsert) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldPolicyMode, v)
+	return u
+}
+
+// UpdatePolicyMode sets the "policy_mode" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdatePolicyMode() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldPolicyMode)
+	return u
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (u *SharedLinkUpsert) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldPolicyDefault, v)
+	return u
+}
+
+// UpdatePolicyDefault sets the "policy_default" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdatePolicyDefault() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldPolicyDefault)
+	return u
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *SharedLinkUpsert) SetMaxViews(v int32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldMaxViews, v)
+	return u
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateMaxViews() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldMaxViews)
+	return u
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *SharedLinkUpsert) AddMaxViews(v int32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldMaxViews, v)
+	return u
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *SharedLinkUpsert) SetViewCount(v int32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldViewCount, v)
+	return u
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateViewCount() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldViewCount)
+	return u
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *SharedLinkUpsert) AddViewCount(v int32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldViewCount, v)
+	return u
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (u *SharedLinkUpsert) SetBindDevice(v bool) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldBindDevice, v)
+	return u
+}
+
+// UpdateBindDevice sets the "bind_device" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateBindDevice() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldBindDevice)
+	return u
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (u *SharedLinkUpsert) SetDeviceBinding(v string) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldDeviceBinding, v)
+	return u
+}
+
+// UpdateDeviceBinding sets the "device_binding" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateDeviceBinding() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldDeviceBinding)
+	return u
+}
+
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (u *SharedLinkUpsert) ClearDeviceBinding() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldDeviceBinding)
+	return u
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (u *SharedLinkUpsert) SetDeviceBoundAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldDeviceBoundAt, v)
+	return u
+}
+
+// UpdateDeviceBoundAt sets the "device_bound_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateDeviceBoundAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldDeviceBoundAt)
+	return u
+}
+
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (u *SharedLinkUpsert) ClearDeviceBoundAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldDeviceBoundAt)
+	return u
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (u *SharedLinkUpsert) SetPassphraseHash(v string) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldPassphraseHash, v)
+	return u
+}
+
+// UpdatePassphraseHash sets the "passphrase_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdatePassphraseHash() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldPassphraseHash)
+	return u
+}
+
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (u *SharedLinkUpsert) ClearPassphraseHash() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldPassphraseHash)
+	return u
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *SharedLinkUpsert) SetVerificationCodeHash(v string) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldVerificationCodeHash, v)
+	return u
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateVerificationCodeHash() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldVerificationCodeHash)
+	return u
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *SharedLinkUpsert) ClearVerificationCodeHash() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldVerificationCodeHash)
+	return u
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (u *SharedLinkUpsert) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldVerificationCodeExpiresAt, v)
+	return u
+}
+
+// UpdateVerificationCodeExpiresAt sets the "verification_code_expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateVerificationCodeExpiresAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldVerificationCodeExpiresAt)
+	return u
+}
+
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (u *SharedLinkUpsert) ClearVerificationCodeExpiresAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldVerificationCodeExpiresAt)
+	return u
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (u *SharedLinkUpsert) SetStepUpAttempts(v int32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldStepUpAttempts, v)
+	return u
+}
+
+// UpdateStepUpAttempts sets the "step_up_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateStepUpAttempts() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldStepUpAttempts)
+	return u
+}
+
+// AddStepUpAttempts adds v to the "step_up_attempts" field.
+func (u *SharedLinkUpsert) AddStepUpAttempts(v int32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldStepUpAttempts, v)
+	return u
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (u *SharedLinkUpsert) SetPassphraseAttempts(v int32) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldPassphraseAttempts, v)
+	return u
+}
+
+// UpdatePassphraseAttempts sets the "passphrase_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdatePassphraseAttempts() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldPassphraseAttempts)
+	return u
+}
+
+// AddPassphraseAttempts adds v to the "passphrase_attempts" field.
+func (u *SharedLinkUpsert) AddPassphraseAttempts(v int32) *SharedLinkUpsert {
+	u.Add(sharedlink.FieldPassphraseAttempts, v)
+	return u
+}
+
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (u *SharedLinkUpsert) SetStepUpChallengedAt(v time.Time) *SharedLinkUpsert {
+	u.Set(sharedlink.FieldStepUpChallengedAt, v)
+	return u
+}
+
+// UpdateStepUpChallengedAt sets the "step_up_challenged_at" field to the value that was provided on create.
+func (u *SharedLinkUpsert) UpdateStepUpChallengedAt() *SharedLinkUpsert {
+	u.SetExcluded(sharedlink.FieldStepUpChallengedAt)
+	return u
+}
+
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (u *SharedLinkUpsert) ClearStepUpChallengedAt() *SharedLinkUpsert {
+	u.SetNull(sharedlink.FieldStepUpChallengedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SharedLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sharedlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SharedLinkUpsertOne) UpdateNewValues() *SharedLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sharedlink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(sharedlink.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(sharedlink.FieldTenantID)
 		}
 	}))
 	return u
@@ -993,6 +1839,27 @@ func (u *SharedLinkUpsertOne) UpdateRecipientEmail() *SharedLinkUpsertOne {
 	})
 }
 
+// SetSenderEmail sets the "sender_email" field.
+func (u *SharedLinkUpsertOne) SetSenderEmail(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetSenderEmail(v)
+	})
+}
+
+// UpdateSenderEmail sets the "sender_email" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateSenderEmail() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateSenderEmail()
+	})
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (u *SharedLinkUpsertOne) ClearSenderEmail() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearSenderEmail()
+	})
+}
+
 // SetMessage sets the "message" field.
 func (u *SharedLinkUpsertOne) SetMessage(v string) *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
@@ -1066,42 +1933,441 @@ func (u *SharedLinkUpsertOne) UpdateViewedAt() *SharedLinkUpsertOne {
 // ClearViewedAt clears the value of the "viewed_at" field.
 func (u *SharedLinkUpsertOne) ClearViewedAt() *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.ClearViewedAt()
+		s.ClearViewedAt()
+	})
+}
+
+// SetViewedIP sets the "viewed_ip" field.
+func (u *SharedLinkUpsertOne) SetViewedIP(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetViewedIP(v)
+	})
+}
+
+// UpdateViewedIP sets the "viewed_ip" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateViewedIP() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateViewedIP()
+	})
+}
+
+// ClearViewedIP clears the value of the "viewed_ip" field.
+func (u *SharedLinkUpsertOne) ClearViewedIP() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearViewedIP()
+	})
+}
+
+// SetRevoked sets the "revoked" field.
+func (u *SharedLinkUpsertOne) SetRevoked(v bool) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetRevoked(v)
+	})
+}
+
+// UpdateRevoked sets the "revoked" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateRevoked() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateRevoked()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SharedLinkUpsertOne) SetExpiresAt(v time.Time) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateExpiresAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SharedLinkUpsertOne) ClearExpiresAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (u *SharedLinkUpsertOne) SetExpiryNotified(v bool) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNotified(v)
+	})
+}
+
+// UpdateExpiryNotified sets the "expiry_notified" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateExpiryNotified() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNotified()
+	})
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsertOne) SetExpiryNoticeAttempts(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNoticeAttempts(v)
+	})
+}
+
+// AddExpiryNoticeAttempts adds v to the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsertOne) AddExpiryNoticeAttempts(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddExpiryNoticeAttempts(v)
+	})
+}
+
+// UpdateExpiryNoticeAttempts sets the "expiry_notice_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateExpiryNoticeAttempts() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNoticeAttempts()
+	})
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsertOne) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNoticeNextAttemptAt(v)
+	})
+}
+
+// UpdateExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateExpiryNoticeNextAttemptAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNoticeNextAttemptAt()
+	})
+}
+
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsertOne) ClearExpiryNoticeNextAttemptAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearExpiryNoticeNextAttemptAt()
+	})
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (u *SharedLinkUpsertOne) SetAuthorizedBy(v uint32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedBy(v)
+	})
+}
+
+// AddAuthorizedBy adds v to the "authorized_by" field.
+func (u *SharedLinkUpsertOne) AddAuthorizedBy(v uint32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddAuthorizedBy(v)
+	})
+}
+
+// UpdateAuthorizedBy sets the "authorized_by" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateAuthorizedBy() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedBy()
+	})
+}
+
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (u *SharedLinkUpsertOne) ClearAuthorizedBy() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedBy()
+	})
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (u *SharedLinkUpsertOne) SetAuthorizedVia(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedVia(v)
+	})
+}
+
+// UpdateAuthorizedVia sets the "authorized_via" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateAuthorizedVia() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedVia()
+	})
+}
+
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (u *SharedLinkUpsertOne) ClearAuthorizedVia() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedVia()
+	})
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (u *SharedLinkUpsertOne) SetAuthorizedAt(v time.Time) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedAt(v)
+	})
+}
+
+// UpdateAuthorizedAt sets the "authorized_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateAuthorizedAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedAt()
+	})
+}
+
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (u *SharedLinkUpsertOne) ClearAuthorizedAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedAt()
+	})
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (u *SharedLinkUpsertOne) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPolicyMode(v)
+	})
+}
+
+// UpdatePolicyMode sets the "policy_mode" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdatePolicyMode() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePolicyMode()
+	})
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (u *SharedLinkUpsertOne) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPolicyDefault(v)
+	})
+}
+
+// UpdatePolicyDefault sets the "policy_default" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdatePolicyDefault() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePolicyDefault()
+	})
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *SharedLinkUpsertOne) SetMaxViews(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetMaxViews(v)
+	})
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *SharedLinkUpsertOne) AddMaxViews(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddMaxViews(v)
+	})
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateMaxViews() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateMaxViews()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *SharedLinkUpsertOne) SetViewCount(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *SharedLinkUpsertOne) AddViewCount(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateViewCount() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (u *SharedLinkUpsertOne) SetBindDevice(v bool) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetBindDevice(v)
+	})
+}
+
+// UpdateBindDevice sets the "bind_device" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateBindDevice() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateBindDevice()
+	})
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (u *SharedLinkUpsertOne) SetDeviceBinding(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetDeviceBinding(v)
+	})
+}
+
+// UpdateDeviceBinding sets the "device_binding" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateDeviceBinding() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateDeviceBinding()
+	})
+}
+
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (u *SharedLinkUpsertOne) ClearDeviceBinding() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearDeviceBinding()
+	})
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (u *SharedLinkUpsertOne) SetDeviceBoundAt(v time.Time) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetDeviceBoundAt(v)
+	})
+}
+
+// UpdateDeviceBoundAt sets the "device_bound_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateDeviceBoundAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateDeviceBoundAt()
+	})
+}
+
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (u *SharedLinkUpsertOne) ClearDeviceBoundAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearDeviceBoundAt()
+	})
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (u *SharedLinkUpsertOne) SetPassphraseHash(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPassphraseHash(v)
+	})
+}
+
+// UpdatePassphraseHash sets the "passphrase_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdatePassphraseHash() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePassphraseHash()
+	})
+}
+
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (u *SharedLinkUpsertOne) ClearPassphraseHash() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearPassphraseHash()
+	})
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *SharedLinkUpsertOne) SetVerificationCodeHash(v string) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetVerificationCodeHash(v)
+	})
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateVerificationCodeHash() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateVerificationCodeHash()
+	})
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *SharedLinkUpsertOne) ClearVerificationCodeHash() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearVerificationCodeHash()
+	})
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (u *SharedLinkUpsertOne) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetVerificationCodeExpiresAt(v)
+	})
+}
+
+// UpdateVerificationCodeExpiresAt sets the "verification_code_expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateVerificationCodeExpiresAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateVerificationCodeExpiresAt()
+	})
+}
+
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (u *SharedLinkUpsertOne) ClearVerificationCodeExpiresAt() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearVerificationCodeExpiresAt()
+	})
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (u *SharedLinkUpsertOne) SetStepUpAttempts(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetStepUpAttempts(v)
+	})
+}
+
+// AddStepUpAttempts adds v to the "step_up_attempts" field.
+func (u *SharedLinkUpsertOne) AddStepUpAttempts(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddStepUpAttempts(v)
+	})
+}
+
+// UpdateStepUpAttempts sets the "step_up_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateStepUpAttempts() *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateStepUpAttempts()
+	})
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (u *SharedLinkUpsertOne) SetPassphraseAttempts(v int32) *SharedLinkUpsertOne {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPassphraseAttempts(v)
 	})
 }
 
-// SetViewedIP sets the "viewed_ip" field.
-func (u *SharedLinkUpsertOne) SetViewedIP(v string) *SharedLinkUpsertOne {
+// AddPassphraseAttempts adds v to the "passphrase_attempts" field.
+func (u *SharedLinkUpsertOne) AddPassphraseAttempts(v int32) *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.SetViewedIP(v)
+		s.AddPassphraseAttempts(v)
 	})
 }
 
-// UpdateViewedIP sets the "viewed_ip" field to the value that was provided on create.
-func (u *SharedLinkUpsertOne) UpdateViewedIP() *SharedLinkUpsertOne {
+// UpdatePassphraseAttempts sets the "passphrase_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdatePassphraseAttempts() *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.UpdateViewedIP()
+		s.UpdatePassphraseAttempts()
 	})
 }
 
-// ClearViewedIP clears the value of the "viewed_ip" field.
-func (u *SharedLinkUpsertOne) ClearViewedIP() *SharedLinkUpsertOne {
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (u *SharedLinkUpsertOne) SetStepUpChallengedAt(v time.Time) *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.ClearViewedIP()
+		s.SetStepUpChallengedAt(v)
 	})
 }
 
-// SetRevoked sets the "revoked" field.
-func (u *SharedLinkUpsertOne) SetRevoked(v bool) *SharedLinkUpsertOne {
+// UpdateStepUpChallengedAt sets the "step_up_challenged_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertOne) UpdateStepUpChallengedAt() *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.SetRevoked(v)
+		s.UpdateStepUpChallengedAt()
 	})
 }
 
-// UpdateRevoked sets the "revoked" field to the value that was provided on create.
-func (u *SharedLinkUpsertOne) UpdateRevoked() *SharedLinkUpsertOne {
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (u *SharedLinkUpsertOne) ClearStepUpChallengedAt() *SharedLinkUpsertOne {
 	return u.Update(func(s *SharedLinkUpsert) {
-		s.UpdateRevoked()
+		s.ClearStepUpChallengedAt()
 	})
 }
 
@@ -1508,6 +2774,27 @@ func (u *SharedLinkUpsertBulk) UpdateRecipientEmail() *SharedLinkUpsertBulk {
 	})
 }
 
+// SetSenderEmail sets the "sender_email" field.
+func (u *SharedLinkUpsertBulk) SetSenderEmail(v string) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetSenderEmail(v)
+	})
+}
+
+// UpdateSenderEmail sets the "sender_email" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateSenderEmail() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateSenderEmail()
+	})
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (u *SharedLinkUpsertBulk) ClearSenderEmail() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearSenderEmail()
+	})
+}
+
 // SetMessage sets the "message" field.
 func (u *SharedLinkUpsertBulk) SetMessage(v string) *SharedLinkUpsertBulk {
 	return u.Update(func(s *SharedLinkUpsert) {
@@ -1620,6 +2907,405 @@ func (u *SharedLinkUpsertBulk) UpdateRevoked() *SharedLinkUpsertBulk {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *SharedLinkUpsertBulk) SetExpiresAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateExpiresAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SharedLinkUpsertBulk) ClearExpiresAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (u *SharedLinkUpsertBulk) SetExpiryNotified(v bool) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNotified(v)
+	})
+}
+
+// UpdateExpiryNotified sets the "expiry_notified" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateExpiryNotified() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNotified()
+	})
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsertBulk) SetExpiryNoticeAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNoticeAttempts(v)
+	})
+}
+
+// AddExpiryNoticeAttempts adds v to the "expiry_notice_attempts" field.
+func (u *SharedLinkUpsertBulk) AddExpiryNoticeAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddExpiryNoticeAttempts(v)
+	})
+}
+
+// UpdateExpiryNoticeAttempts sets the "expiry_notice_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateExpiryNoticeAttempts() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNoticeAttempts()
+	})
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsertBulk) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetExpiryNoticeNextAttemptAt(v)
+	})
+}
+
+// UpdateExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateExpiryNoticeNextAttemptAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateExpiryNoticeNextAttemptAt()
+	})
+}
+
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (u *SharedLinkUpsertBulk) ClearExpiryNoticeNextAttemptAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearExpiryNoticeNextAttemptAt()
+	})
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (u *SharedLinkUpsertBulk) SetAuthorizedBy(v uint32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedBy(v)
+	})
+}
+
+// AddAuthorizedBy adds v to the "authorized_by" field.
+func (u *SharedLinkUpsertBulk) AddAuthorizedBy(v uint32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddAuthorizedBy(v)
+	})
+}
+
+// UpdateAuthorizedBy sets the "authorized_by" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateAuthorizedBy() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedBy()
+	})
+}
+
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (u *SharedLinkUpsertBulk) ClearAuthorizedBy() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedBy()
+	})
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (u *SharedLinkUpsertBulk) SetAuthorizedVia(v string) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedVia(v)
+	})
+}
+
+// UpdateAuthorizedVia sets the "authorized_via" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateAuthorizedVia() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedVia()
+	})
+}
+
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (u *SharedLinkUpsertBulk) ClearAuthorizedVia() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedVia()
+	})
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (u *SharedLinkUpsertBulk) SetAuthorizedAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetAuthorizedAt(v)
+	})
+}
+
+// UpdateAuthorizedAt sets the "authorized_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateAuthorizedAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateAuthorizedAt()
+	})
+}
+
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (u *SharedLinkUpsertBulk) ClearAuthorizedAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearAuthorizedAt()
+	})
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (u *SharedLinkUpsertBulk) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPolicyMode(v)
+	})
+}
+
+// UpdatePolicyMode sets the "policy_mode" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdatePolicyMode() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePolicyMode()
+	})
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (u *SharedLinkUpsertBulk) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPolicyDefault(v)
+	})
+}
+
+// UpdatePolicyDefault sets the "policy_default" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdatePolicyDefault() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePolicyDefault()
+	})
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *SharedLinkUpsertBulk) SetMaxViews(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetMaxViews(v)
+	})
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *SharedLinkUpsertBulk) AddMaxViews(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddMaxViews(v)
+	})
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateMaxViews() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateMaxViews()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *SharedLinkUpsertBulk) SetViewCount(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *SharedLinkUpsertBulk) AddViewCount(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateViewCount() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (u *SharedLinkUpsertBulk) SetBindDevice(v bool) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetBindDevice(v)
+	})
+}
+
+// UpdateBindDevice sets the "bind_device" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateBindDevice() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateBindDevice()
+	})
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (u *SharedLinkUpsertBulk) SetDeviceBinding(v string) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetDeviceBinding(v)
+	})
+}
+
+// UpdateDeviceBinding sets the "device_binding" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateDeviceBinding() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateDeviceBinding()
+	})
+}
+
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (u *SharedLinkUpsertBulk) ClearDeviceBinding() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearDeviceBinding()
+	})
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (u *SharedLinkUpsertBulk) SetDeviceBoundAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetDeviceBoundAt(v)
+	})
+}
+
+// UpdateDeviceBoundAt sets the "device_bound_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateDeviceBoundAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateDeviceBoundAt()
+	})
+}
+
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (u *SharedLinkUpsertBulk) ClearDeviceBoundAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearDeviceBoundAt()
+	})
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (u *SharedLinkUpsertBulk) SetPassphraseHash(v string) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPassphraseHash(v)
+	})
+}
+
+// UpdatePassphraseHash sets the "passphrase_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdatePassphraseHash() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePassphraseHash()
+	})
+}
+
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (u *SharedLinkUpsertBulk) ClearPassphraseHash() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearPassphraseHash()
+	})
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *SharedLinkUpsertBulk) SetVerificationCodeHash(v string) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetVerificationCodeHash(v)
+	})
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateVerificationCodeHash() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateVerificationCodeHash()
+	})
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *SharedLinkUpsertBulk) ClearVerificationCodeHash() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearVerificationCodeHash()
+	})
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (u *SharedLinkUpsertBulk) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetVerificationCodeExpiresAt(v)
+	})
+}
+
+// UpdateVerificationCodeExpiresAt sets the "verification_code_expires_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateVerificationCodeExpiresAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateVerificationCodeExpiresAt()
+	})
+}
+
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (u *SharedLinkUpsertBulk) ClearVerificationCodeExpiresAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearVerificationCodeExpiresAt()
+	})
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (u *SharedLinkUpsertBulk) SetStepUpAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetStepUpAttempts(v)
+	})
+}
+
+// AddStepUpAttempts adds v to the "step_up_attempts" field.
+func (u *SharedLinkUpsertBulk) AddStepUpAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddStepUpAttempts(v)
+	})
+}
+
+// UpdateStepUpAttempts sets the "step_up_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateStepUpAttempts() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateStepUpAttempts()
+	})
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (u *SharedLinkUpsertBulk) SetPassphraseAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetPassphraseAttempts(v)
+	})
+}
+
+// AddPassphraseAttempts adds v to the "passphrase_attempts" field.
+func (u *SharedLinkUpsertBulk) AddPassphraseAttempts(v int32) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.AddPassphraseAttempts(v)
+	})
+}
+
+// UpdatePassphraseAttempts sets the "passphrase_attempts" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdatePassphraseAttempts() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdatePassphraseAttempts()
+	})
+}
+
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (u *SharedLinkUpsertBulk) SetStepUpChallengedAt(v time.Time) *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.SetStepUpChallengedAt(v)
+	})
+}
+
+// UpdateStepUpChallengedAt sets the "step_up_challenged_at" field to the value that was provided on create.
+func (u *SharedLinkUpsertBulk) UpdateStepUpChallengedAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.UpdateStepUpChallengedAt()
+	})
+}
+
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (u *SharedLinkUpsertBulk) ClearStepUpChallengedAt() *SharedLinkUpsertBulk {
+	return u.Update(func(s *SharedLinkUpsert) {
+		s.ClearStepUpChallengedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *SharedLinkUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -190,6 +190,26 @@ func (_u *SharedLinkUpdate) SetNillableRecipientEmail(v *string) *SharedLinkUpda
 	return _u
 }
 
+// SetSenderEmail sets the "sender_email" field.
+func (_u *SharedLinkUpdate) SetSenderEmail(v string) *SharedLinkUpdate {
+	_u.mutation.SetSenderEmail(v)
+	return _u
+}
+
+// SetNillableSenderEmail sets the "sender_email" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableSenderEmail(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetSenderEmail(*v)
+	}
+	return _u
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (_u *SharedLinkUpdate) ClearSenderEmail() *SharedLinkUpdate {
+	_u.mutation.ClearSenderEmail()
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *SharedLinkUpdate) SetMessage(v string) *SharedLinkUpdate {
 	_u.mutation.SetMessage(v)
@@ -298,6 +318,394 @@ func (_u *SharedLinkUpdate) SetNillableRevoked(v *bool) *SharedLinkUpdate {
 	return _u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SharedLinkUpdate) SetExpiresAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableExpiresAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SharedLinkUpdate) ClearExpiresAt() *SharedLinkUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (_u *SharedLinkUpdate) SetExpiryNotified(v bool) *SharedLinkUpdate {
+	_u.mutation.SetExpiryNotified(v)
+	return _u
+}
+
+// SetNillableExpiryNotified sets the "expiry_notified" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableExpiryNotified(v *bool) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetExpiryNotified(*v)
+	}
+	return _u
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (_u *SharedLinkUpdate) SetExpiryNoticeAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.ResetExpiryNoticeAttempts()
+	_u.mutation.SetExpiryNoticeAttempts(v)
+	return _u
+}
+
+// SetNillableExpiryNoticeAttempts sets the "expiry_notice_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableExpiryNoticeAttempts(v *int32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetExpiryNoticeAttempts(*v)
+	}
+	return _u
+}
+
+// AddExpiryNoticeAttempts adds value to the "expiry_notice_attempts" field.
+func (_u *SharedLinkUpdate) AddExpiryNoticeAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.AddExpiryNoticeAttempts(v)
+	return _u
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (_u *SharedLinkUpdate) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetExpiryNoticeNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableExpiryNoticeNextAttemptAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetExpiryNoticeNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (_u *SharedLinkUpdate) ClearExpiryNoticeNextAttemptAt() *SharedLinkUpdate {
+	_u.mutation.ClearExpiryNoticeNextAttemptAt()
+	return _u
+}
+
+// SetAuthorizedBy sets the "authorized_by" field.
+func (_u *SharedLinkUpdate) SetAuthorizedBy(v uint32) *SharedLinkUpdate {
+	_u.mutation.ResetAuthorizedBy()
+	_u.mutation.SetAuthorizedBy(v)
+	return _u
+}
+
+// SetNillableAuthorizedBy sets the "authorized_by" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableAuthorizedBy(v *uint32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetAuthorizedBy(*v)
+	}
+	return _u
+}
+
+// AddAuthorizedBy adds value to the "authorized_by" field.
+func (_u *SharedLinkUpdate) AddAuthorizedBy(v int32) *SharedLinkUpdate {
+	_u.mutation.AddAuthorizedBy(v)
+	return _u
+}
+
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (_u *SharedLinkUpdate) ClearAuthorizedBy() *SharedLinkUpdate {
+	_u.mutation.ClearAuthorizedBy()
+	return _u
+}
+
+// SetAuthorizedVia sets the "authorized_via" field.
+func (_u *SharedLinkUpdate) SetAuthorizedVia(v string) *SharedLinkUpdate {
+	_u.mutation.SetAuthorizedVia(v)
+	return _u
+}
+
+// SetNillableAuthorizedVia sets the "authorized_via" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableAuthorizedVia(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetAuthorizedVia(*v)
+	}
+	return _u
+}
+
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (_u *SharedLinkUpdate) ClearAuthorizedVia() *SharedLinkUpdate {
+	_u.mutation.ClearAuthorizedVia()
+	return _u
+}
+
+// SetAuthorizedAt sets the "authorized_at" field.
+func (_u *SharedLinkUpdate) SetAuthorizedAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetAuthorizedAt(v)
+	return _u
+}
+
+// SetNillableAuthorizedAt sets the "authorized_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableAuthorizedAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetAuthorizedAt(*v)
+	}
+	return _u
+}
+
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (_u *SharedLinkUpdate) ClearAuthorizedAt() *SharedLinkUpdate {
+	_u.mutation.ClearAuthorizedAt()
+	return _u
+}
+
+// SetPolicyMode sets the "policy_mode" field.
+func (_u *SharedLinkUpdate) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkUpdate {
+	_u.mutation.SetPolicyMode(v)
+	return _u
+}
+
+// SetNillablePolicyMode sets the "policy_mode" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillablePolicyMode(v *sharedlink.PolicyMode) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetPolicyMode(*v)
+	}
+	return _u
+}
+
+// SetPolicyDefault sets the "policy_default" field.
+func (_u *SharedLinkUpdate) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkUpdate {
+	_u.mutation.SetPolicyDefault(v)
+	return _u
+}
+
+// SetNillablePolicyDefault sets the "policy_default" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillablePolicyDefault(v *sharedlink.PolicyDefault) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetPolicyDefault(*v)
+	}
+	return _u
+}
+
+// SetMaxViews sets the "max_views" field.
+func (_u *SharedLinkUpdate) SetMaxViews(v int32) *SharedLinkUpdate {
+	_u.mutation.ResetMaxViews()
+	_u.mutation.SetMaxViews(v)
+	return _u
+}
+
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableMaxViews(v *int32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetMaxViews(*v)
+	}
+	return _u
+}
+
+// AddMaxViews adds value to the "max_views" field.
+func (_u *SharedLinkUpdate) AddMaxViews(v int32) *SharedLinkUpdate {
+	_u.mutation.AddMaxViews(v)
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *SharedLinkUpdate) SetViewCount(v int32) *SharedLinkUpdate {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableViewCount(v *int32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *SharedLinkUpdate) AddViewCount(v int32) *SharedLinkUpdate {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetBindDevice sets the "bind_device" field.
+func (_u *SharedLinkUpdate) SetBindDevice(v bool) *SharedLinkUpdate {
+	_u.mutation.SetBindDevice(v)
+	return _u
+}
+
+// SetNillableBindDevice sets the "bind_device" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableBindDevice(v *bool) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetBindDevice(*v)
+	}
+	return _u
+}
+
+// SetDeviceBinding sets the "device_binding" field.
+func (_u *SharedLinkUpdate) SetDeviceBinding(v string) *SharedLinkUpdate {
+	_u.mutation.SetDeviceBinding(v)
+	return _u
+}
+
+// SetNillableDeviceBinding sets the "device_binding" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableDeviceBinding(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetDeviceBinding(*v)
+	}
+	return _u
+}
+
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (_u *SharedLinkUpdate) ClearDeviceBinding() *SharedLinkUpdate {
+	_u.mutation.ClearDeviceBinding()
+	return _u
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (_u *SharedLinkUpdate) SetDeviceBoundAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetDeviceBoundAt(v)
+	return _u
+}
+
+// SetNillableDeviceBoundAt sets the "device_bound_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableDeviceBoundAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetDeviceBoundAt(*v)
+	}
+	return _u
+}
+
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (_u *SharedLinkUpdate) ClearDeviceBoundAt() *SharedLinkUpdate {
+	_u.mutation.ClearDeviceBoundAt()
+	return _u
+}
+
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (_u *SharedLinkUpdate) SetPassphraseHash(v string) *SharedLinkUpdate {
+	_u.mutation.SetPassphraseHash(v)
+	return _u
+}
+
+// SetNillablePassphraseHash sets the "passphrase_hash" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillablePassphraseHash(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetPassphraseHash(*v)
+	}
+	return _u
+}
+
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (_u *SharedLinkUpdate) ClearPassphraseHash() *SharedLinkUpdate {
+	_u.mutation.ClearPassphraseHash()
+	return _u
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_u *SharedLinkUpdate) SetVerificationCodeHash(v string) *SharedLinkUpdate {
+	_u.mutation.SetVerificationCodeHash(v)
+	return _u
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableVerificationCodeHash(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetVerificationCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (_u *SharedLinkUpdate) ClearVerificationCodeHash() *SharedLinkUpdate {
+	_u.mutation.ClearVerificationCodeHash()
+	return _u
+}
+
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (_u *SharedLinkUpdate) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetVerificationCodeExpiresAt(v)
+	return _u
+}
+
+// SetNillableVerificationCodeExpiresAt sets the "verification_code_expires_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableVerificationCodeExpiresAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetVerificationCodeExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (_u *SharedLinkUpdate) ClearVerificationCodeExpiresAt() *SharedLinkUpdate {
+	_u.mutation.ClearVerificationCodeExpiresAt()
+	return _u
+}
+
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (_u *SharedLinkUpdate) SetStepUpAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.ResetStepUpAttempts()
+	_u.mutation.SetStepUpAttempts(v)
+	return _u
+}
+
+// SetNillableStepUpAttempts sets the "step_up_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableStepUpAttempts(v *int32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetStepUpAttempts(*v)
+	}
+	return _u
+}
+
+// AddStepUpAttempts adds value to the "step_up_attempts" field.
+func (_u *SharedLinkUpdate) AddStepUpAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.AddStepUpAttempts(v)
+	return _u
+}
+
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (_u *SharedLinkUpdate) SetPassphraseAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.ResetPassphraseAttempts()
+	_u.mutation.SetPassphraseAttempts(v)
+	return _u
+}
+
+// SetNillablePassphraseAttempts sets the "passphrase_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillablePassphraseAttempts(v *int32) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetPassphraseAttempts(*v)
+	}
+	return _u
+}
+
+// AddPassphraseAttempts adds value to the "passphrase_attempts" field.
+func (_u *SharedLinkUpdate) AddPassphraseAttempts(v int32) *SharedLinkUpdate {
+	_u.mutation.AddPassphraseAttempts(v)
+	return _u
+}
+
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (_u *SharedLinkUpdate) SetStepUpChallengedAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetStepUpChallengedAt(v)
+	return _u
+}
+
+// SetNillableStepUpChallengedAt sets the "step_up_challenged_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableStepUpChallengedAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetStepUpChallengedAt(*v)
+	}
+	return _u
+}
+
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (_u *SharedLinkUpdate) ClearStepUpChallengedAt() *SharedLinkUpdate {
+	_u.mutation.ClearStepUpChallengedAt()
+	return _u
+}
+
 // Mutation returns the SharedLinkMutation object of the builder.
 func (_u *SharedLinkUpdate) Mutation() *SharedLinkMutation {
 	return _u.mutation
@@ -357,6 +765,11 @@ func (_u *SharedLinkUpdate) check() error {
 			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.recipient_email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SenderEmail(); ok {
+		if err := sharedlink.SenderEmailValidator(v); err != nil {
+			return &ValidationError{Name: "sender_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.sender_email": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Message(); ok {
 		if err := sharedlink.MessageValidator(v); err != nil {
 			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "SharedLink.message": %w`, err)}
@@ -372,6 +785,46 @@ func (_u *SharedLinkUpdate) check() error {
 			return &ValidationError{Name: "viewed_ip", err: fmt.Errorf(`ent: validator failed for field "SharedLink.viewed_ip": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AuthorizedVia(); ok {
+		if err := sharedlink.AuthorizedViaValidator(v); err != nil {
+			return &ValidationError{Name: "authorized_via", err: fmt.Errorf(`ent: validator failed for field "SharedLink.authorized_via": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyMode(); ok {
+		if err := sharedlink.PolicyModeValidator(v); err != nil {
+			return &ValidationError{Name: "policy_mode", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyDefault(); ok {
+		if err := sharedlink.PolicyDefaultValidator(v); err != nil {
+			return &ValidationError{Name: "policy_default", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_default": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxViews(); ok {
+		if err := sharedlink.MaxViewsValidator(v); err != nil {
+			return &ValidationError{Name: "max_views", err: fmt.Errorf(`ent: validator failed for field "SharedLink.max_views": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ViewCount(); ok {
+		if err := sharedlink.ViewCountValidator(v); err != nil {
+			return &ValidationError{Name: "view_count", err: fmt.Errorf(`ent: validator failed for field "SharedLink.view_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeviceBinding(); ok {
+		if err := sharedlink.DeviceBindingValidator(v); err != nil {
+			return &ValidationError{Name: "device_binding", err: fmt.Errorf(`ent: validator failed for field "SharedLink.device_binding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PassphraseHash(); ok {
+		if err := sharedlink.PassphraseHashValidator(v); err != nil {
+			return &ValidationError{Name: "passphrase_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.passphrase_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationCodeHash(); ok {
+		if err := sharedlink.VerificationCodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "verification_code_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.verification_code_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -447,6 +900,12 @@ func (_u *SharedLinkUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.RecipientEmail(); ok {
 		_spec.SetField(sharedlink.FieldRecipientEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SenderEmail(); ok {
+		_spec.SetField(sharedlink.FieldSenderEmail, field.TypeString, value)
+	}
+	if _u.mutation.SenderEmailCleared() {
+		_spec.ClearField(sharedlink.FieldSenderEmail, field.TypeString)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(sharedlink.FieldMessage, field.TypeString, value)
 	}
@@ -471,303 +930,822 @@ func (_u *SharedLinkUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.ViewedIP(); ok {
 		_spec.SetField(sharedlink.FieldViewedIP, field.TypeString, value)
 	}
-	if _u.mutation.ViewedIPCleared() {
-		_spec.ClearField(sharedlink.FieldViewedIP, field.TypeString)
+	if _u.mutation.ViewedIPCleared() {
+		_spec.ClearField(sharedlink.FieldViewedIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Revoked(); ok {
+		_spec.SetField(sharedlink.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharedlink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryNotified(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNotified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiryNoticeAttempts(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedExpiryNoticeAttempts(); ok {
+		_spec.AddField(sharedlink.FieldExpiryNoticeAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ExpiryNoticeNextAttemptAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryNoticeNextAttemptAtCleared() {
+		_spec.ClearField(sharedlink.FieldExpiryNoticeNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AuthorizedBy(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorizedBy(); ok {
+		_spec.AddField(sharedlink.FieldAuthorizedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.AuthorizedByCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AuthorizedVia(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedVia, field.TypeString, value)
+	}
+	if _u.mutation.AuthorizedViaCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedVia, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorizedAt(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AuthorizedAtCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyMode(); ok {
+		_spec.SetField(sharedlink.FieldPolicyMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolicyDefault(); ok {
+		_spec.SetField(sharedlink.FieldPolicyDefault, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxViews(); ok {
+		_spec.SetField(sharedlink.FieldMaxViews, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxViews(); ok {
+		_spec.AddField(sharedlink.FieldMaxViews, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(sharedlink.FieldViewCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(sharedlink.FieldViewCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.BindDevice(); ok {
+		_spec.SetField(sharedlink.FieldBindDevice, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeviceBinding(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBinding, field.TypeString, value)
+	}
+	if _u.mutation.DeviceBindingCleared() {
+		_spec.ClearField(sharedlink.FieldDeviceBinding, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeviceBoundAt(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBoundAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeviceBoundAtCleared() {
+		_spec.ClearField(sharedlink.FieldDeviceBoundAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PassphraseHash(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseHash, field.TypeString, value)
+	}
+	if _u.mutation.PassphraseHashCleared() {
+		_spec.ClearField(sharedlink.FieldPassphraseHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCodeHashCleared() {
+		_spec.ClearField(sharedlink.FieldVerificationCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationCodeExpiresAtCleared() {
+		_spec.ClearField(sharedlink.FieldVerificationCodeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StepUpAttempts(); ok {
+		_spec.SetField(sharedlink.FieldStepUpAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedStepUpAttempts(); ok {
+		_spec.AddField(sharedlink.FieldStepUpAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.PassphraseAttempts(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPassphraseAttempts(); ok {
+		_spec.AddField(sharedlink.FieldPassphraseAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.StepUpChallengedAt(); ok {
+		_spec.SetField(sharedlink.FieldStepUpChallengedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StepUpChallengedAtCleared() {
+		_spec.ClearField(sharedlink.FieldStepUpChallengedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SharedLinkUpdateOne is the builder for updating a single SharedLink entity.
+type SharedLinkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SharedLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SharedLinkUpdateOne) SetCreateBy(v uint32) *SharedLinkUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableCreateBy(v *uint32) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SharedLinkUpdateOne) AddCreateBy(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SharedLinkUpdateOne) ClearCreateBy() *SharedLinkUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SharedLinkUpdateOne) SetUpdateTime(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableUpdateTime(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SharedLinkUpdateOne) ClearUpdateTime() *SharedLinkUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SharedLinkUpdateOne) SetDeleteTime(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableDeleteTime(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SharedLinkUpdateOne) ClearDeleteTime() *SharedLinkUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *SharedLinkUpdateOne) SetResourceType(v sharedlink.ResourceType) *SharedLinkUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableResourceType(v *sharedlink.ResourceType) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *SharedLinkUpdateOne) SetResourceID(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableResourceID(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetResourceName sets the "resource_name" field.
+func (_u *SharedLinkUpdateOne) SetResourceName(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetResourceName(v)
+	return _u
+}
+
+// SetNillableResourceName sets the "resource_name" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableResourceName(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetResourceName(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *SharedLinkUpdateOne) SetToken(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableToken(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetEncryptedContent sets the "encrypted_content" field.
+func (_u *SharedLinkUpdateOne) SetEncryptedContent(v []byte) *SharedLinkUpdateOne {
+	_u.mutation.SetEncryptedContent(v)
+	return _u
+}
+
+// ClearEncryptedContent clears the value of the "encrypted_content" field.
+func (_u *SharedLinkUpdateOne) ClearEncryptedContent() *SharedLinkUpdateOne {
+	_u.mutation.ClearEncryptedContent()
+	return _u
+}
+
+// SetEncryptionNonce sets the "encryption_nonce" field.
+func (_u *SharedLinkUpdateOne) SetEncryptionNonce(v []byte) *SharedLinkUpdateOne {
+	_u.mutation.SetEncryptionNonce(v)
+	return _u
+}
+
+// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
+func (_u *SharedLinkUpdateOne) ClearEncryptionNonce() *SharedLinkUpdateOne {
+	_u.mutation.ClearEncryptionNonce()
+	return _u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_u *SharedLinkUpdateOne) SetRecipientEmail(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetRecipientEmail(v)
+	return _u
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableRecipientEmail(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetRecipientEmail(*v)
+	}
+	return _u
+}
+
+// SetSenderEmail sets the "sender_email" field.
+func (_u *SharedLinkUpdateOne) SetSenderEmail(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetSenderEmail(v)
+	return _u
+}
+
+// SetNillableSenderEmail sets the "sender_email" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableSenderEmail(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetSenderEmail(*v)
+	}
+	return _u
+}
+
+// ClearSenderEmail clears the value of the "sender_email" field.
+func (_u *SharedLinkUpdateOne) ClearSenderEmail() *SharedLinkUpdateOne {
+	_u.mutation.ClearSenderEmail()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *SharedLinkUpdateOne) SetMessage(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableMessage(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *SharedLinkUpdateOne) ClearMessage() *SharedLinkUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_u *SharedLinkUpdateOne) SetTemplateID(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableTemplateID(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (_u *SharedLinkUpdateOne) ClearTemplateID() *SharedLinkUpdateOne {
+	_u.mutation.ClearTemplateID()
+	return _u
+}
+
+// SetViewed sets the "viewed" field.
+func (_u *SharedLinkUpdateOne) SetViewed(v bool) *SharedLinkUpdateOne {
+	_u.mutation.SetViewed(v)
+	return _u
+}
+
+// SetNillableViewed sets the "viewed" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableViewed(v *bool) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetViewed(*v)
+	}
+	return _u
+}
+
+// SetViewedAt sets the "viewed_at" field.
+func (_u *SharedLinkUpdateOne) SetViewedAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetViewedAt(v)
+	return _u
+}
+
+// SetNillableViewedAt sets the "viewed_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableViewedAt(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetViewedAt(*v)
+	}
+	return _u
+}
+
+// ClearViewedAt clears the value of the "viewed_at" field.
+func (_u *SharedLinkUpdateOne) ClearViewedAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearViewedAt()
+	return _u
+}
+
+// SetViewedIP sets the "viewed_ip" field.
+func (_u *SharedLinkUpdateOne) SetViewedIP(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetViewedIP(v)
+	return _u
+}
+
+// SetNillableViewedIP sets the "viewed_ip" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableViewedIP(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetViewedIP(*v)
+	}
+	return _u
+}
+
+// ClearViewedIP clears the value of the "viewed_ip" field.
+func (_u *SharedLinkUpdateOne) ClearViewedIP() *SharedLinkUpdateOne {
+	_u.mutation.ClearViewedIP()
+	return _u
+}
+
+// SetRevoked sets the "revoked" field.
+func (_u *SharedLinkUpdateOne) SetRevoked(v bool) *SharedLinkUpdateOne {
+	_u.mutation.SetRevoked(v)
+	return _u
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableRevoked(v *bool) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetRevoked(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SharedLinkUpdateOne) SetExpiresAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableExpiresAt(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SharedLinkUpdateOne) ClearExpiresAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiryNotified sets the "expiry_notified" field.
+func (_u *SharedLinkUpdateOne) SetExpiryNotified(v bool) *SharedLinkUpdateOne {
+	_u.mutation.SetExpiryNotified(v)
+	return _u
+}
+
+// SetNillableExpiryNotified sets the "expiry_notified" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableExpiryNotified(v *bool) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiryNotified(*v)
 	}
-	if value, ok := _u.mutation.Revoked(); ok {
-		_spec.SetField(sharedlink.FieldRevoked, field.TypeBool, value)
+	return _u
+}
+
+// SetExpiryNoticeAttempts sets the "expiry_notice_attempts" field.
+func (_u *SharedLinkUpdateOne) SetExpiryNoticeAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.ResetExpiryNoticeAttempts()
+	_u.mutation.SetExpiryNoticeAttempts(v)
+	return _u
+}
+
+// SetNillableExpiryNoticeAttempts sets the "expiry_notice_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableExpiryNoticeAttempts(v *int32) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiryNoticeAttempts(*v)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{sharedlink.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// AddExpiryNoticeAttempts adds value to the "expiry_notice_attempts" field.
+func (_u *SharedLinkUpdateOne) AddExpiryNoticeAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddExpiryNoticeAttempts(v)
+	return _u
+}
+
+// SetExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field.
+func (_u *SharedLinkUpdateOne) SetExpiryNoticeNextAttemptAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetExpiryNoticeNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableExpiryNoticeNextAttemptAt sets the "expiry_notice_next_attempt_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableExpiryNoticeNextAttemptAt(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiryNoticeNextAttemptAt(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// SharedLinkUpdateOne is the builder for updating a single SharedLink entity.
-type SharedLinkUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *SharedLinkMutation
-	modifiers []func(*sql.UpdateBuilder)
+// ClearExpiryNoticeNextAttemptAt clears the value of the "expiry_notice_next_attempt_at" field.
+func (_u *SharedLinkUpdateOne) ClearExpiryNoticeNextAttemptAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearExpiryNoticeNextAttemptAt()
+	return _u
 }
 
-// SetCreateBy sets the "create_by" field.
-func (_u *SharedLinkUpdateOne) SetCreateBy(v uint32) *SharedLinkUpdateOne {
-	_u.mutation.ResetCreateBy()
-	_u.mutation.SetCreateBy(v)
+// SetAuthorizedBy sets the "authorized_by" field.
+func (_u *SharedLinkUpdateOne) SetAuthorizedBy(v uint32) *SharedLinkUpdateOne {
+	_u.mutation.ResetAuthorizedBy()
+	_u.mutation.SetAuthorizedBy(v)
 	return _u
 }
 
-// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableCreateBy(v *uint32) *SharedLinkUpdateOne {
+// SetNillableAuthorizedBy sets the "authorized_by" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableAuthorizedBy(v *uint32) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetCreateBy(*v)
+		_u.SetAuthorizedBy(*v)
 	}
 	return _u
 }
 
-// AddCreateBy adds value to the "create_by" field.
-func (_u *SharedLinkUpdateOne) AddCreateBy(v int32) *SharedLinkUpdateOne {
-	_u.mutation.AddCreateBy(v)
+// AddAuthorizedBy adds value to the "authorized_by" field.
+func (_u *SharedLinkUpdateOne) AddAuthorizedBy(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddAuthorizedBy(v)
 	return _u
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (_u *SharedLinkUpdateOne) ClearCreateBy() *SharedLinkUpdateOne {
-	_u.mutation.ClearCreateBy()
+// ClearAuthorizedBy clears the value of the "authorized_by" field.
+func (_u *SharedLinkUpdateOne) ClearAuthorizedBy() *SharedLinkUpdateOne {
+	_u.mutation.ClearAuthorizedBy()
 	return _u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *SharedLinkUpdateOne) SetUpdateTime(v time.Time) *SharedLinkUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetAuthorizedVia sets the "authorized_via" field.
+func (_u *SharedLinkUpdateOne) SetAuthorizedVia(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetAuthorizedVia(v)
 	return _u
 }
 
-// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableUpdateTime(v *time.Time) *SharedLinkUpdateOne {
+// SetNillableAuthorizedVia sets the "authorized_via" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableAuthorizedVia(v *string) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetUpdateTime(*v)
+		_u.SetAuthorizedVia(*v)
 	}
 	return _u
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (_u *SharedLinkUpdateOne) ClearUpdateTime() *SharedLinkUpdateOne {
-	_u.mutation.ClearUpdateTime()
+// ClearAuthorizedVia clears the value of the "authorized_via" field.
+func (_u *SharedLinkUpdateOne) ClearAuthorizedVia() *SharedLinkUpdateOne {
+	_u.mutation.ClearAuthorizedVia()
 	return _u
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (_u *SharedLinkUpdateOne) SetDeleteTime(v time.Time) *SharedLinkUpdateOne {
-	_u.mutation.SetDeleteTime(v)
+// SetAuthorizedAt sets the "authorized_at" field.
+func (_u *SharedLinkUpdateOne) SetAuthorizedAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetAuthorizedAt(v)
 	return _u
 }
 
-// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableDeleteTime(v *time.Time) *SharedLinkUpdateOne {
+// SetNillableAuthorizedAt sets the "authorized_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableAuthorizedAt(v *time.Time) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetDeleteTime(*v)
+		_u.SetAuthorizedAt(*v)
 	}
 	return _u
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (_u *SharedLinkUpdateOne) ClearDeleteTime() *SharedLinkUpdateOne {
-	_u.mutation.ClearDeleteTime()
+// ClearAuthorizedAt clears the value of the "authorized_at" field.
+func (_u *SharedLinkUpdateOne) ClearAuthorizedAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearAuthorizedAt()
 	return _u
 }
 
-// SetResourceType sets the "resource_type" field.
-func (_u *SharedLinkUpdateOne) SetResourceType(v sharedlink.ResourceType) *SharedLinkUpdateOne {
-	_u.mutation.SetResourceType(v)
+// SetPolicyMode sets the "policy_mode" field.
+func (_u *SharedLinkUpdateOne) SetPolicyMode(v sharedlink.PolicyMode) *SharedLinkUpdateOne {
+	_u.mutation.SetPolicyMode(v)
 	return _u
 }
 
-// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableResourceType(v *sharedlink.ResourceType) *SharedLinkUpdateOne {
+// SetNillablePolicyMode sets the "policy_mode" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillablePolicyMode(v *sharedlink.PolicyMode) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetResourceType(*v)
+		_u.SetPolicyMode(*v)
 	}
 	return _u
 }
 
-// SetResourceID sets the "resource_id" field.
-func (_u *SharedLinkUpdateOne) SetResourceID(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetResourceID(v)
+// SetPolicyDefault sets the "policy_default" field.
+func (_u *SharedLinkUpdateOne) SetPolicyDefault(v sharedlink.PolicyDefault) *SharedLinkUpdateOne {
+	_u.mutation.SetPolicyDefault(v)
 	return _u
 }
 
-// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableResourceID(v *string) *SharedLinkUpdateOne {
+// SetNillablePolicyDefault sets the "policy_default" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillablePolicyDefault(v *sharedlink.PolicyDefault) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetResourceID(*v)
+		_u.SetPolicyDefault(*v)
 	}
 	return _u
 }
 
-// SetResourceName sets the "resource_name" field.
-func (_u *SharedLinkUpdateOne) SetResourceName(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetResourceName(v)
+// SetMaxViews sets the "max_views" field.
+func (_u *SharedLinkUpdateOne) SetMaxViews(v int32) *SharedLinkUpdateOne {
+	_u.mutation.ResetMaxViews()
+	_u.mutation.SetMaxViews(v)
 	return _u
 }
 
-// SetNillableResourceName sets the "resource_name" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableResourceName(v *string) *SharedLinkUpdateOne {
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableMaxViews(v *int32) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetResourceName(*v)
+		_u.SetMaxViews(*v)
 	}
 	return _u
 }
 
-// SetToken sets the "token" field.
-func (_u *SharedLinkUpdateOne) SetToken(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetToken(v)
+// AddMaxViews adds value to the "max_views" field.
+func (_u *SharedLinkUpdateOne) AddMaxViews(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddMaxViews(v)
 	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableToken(v *string) *SharedLinkUpdateOne {
+// SetViewCount sets the "view_count" field.
+func (_u *SharedLinkUpdateOne) SetViewCount(v int32) *SharedLinkUpdateOne {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableViewCount(v *int32) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetToken(*v)
+		_u.SetViewCount(*v)
 	}
 	return _u
 }
 
-// SetEncryptedContent sets the "encrypted_content" field.
-func (_u *SharedLinkUpdateOne) SetEncryptedContent(v []byte) *SharedLinkUpdateOne {
-	_u.mutation.SetEncryptedContent(v)
+// AddViewCount adds value to the "view_count" field.
+func (_u *SharedLinkUpdateOne) AddViewCount(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddViewCount(v)
 	return _u
 }
 
-// ClearEncryptedContent clears the value of the "encrypted_content" field.
-func (_u *SharedLinkUpdateOne) ClearEncryptedContent() *SharedLinkUpdateOne {
-	_u.mutation.ClearEncryptedContent()
+// SetBindDevice sets the "bind_device" field.
+func (_u *SharedLinkUpdateOne) SetBindDevice(v bool) *SharedLinkUpdateOne {
+	_u.mutation.SetBindDevice(v)
 	return _u
 }
 
-// SetEncryptionNonce sets the "encryption_nonce" field.
-func (_u *SharedLinkUpdateOne) SetEncryptionNonce(v []byte) *SharedLinkUpdateOne {
-	_u.mutation.SetEncryptionNonce(v)
+// SetNillableBindDevice sets the "bind_device" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableBindDevice(v *bool) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetBindDevice(*v)
+	}
 	return _u
 }
 
-// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
-func (_u *SharedLinkUpdateOne) ClearEncryptionNonce() *SharedLinkUpdateOne {
-	_u.mutation.ClearEncryptionNonce()
+// SetDeviceBinding sets the "device_binding" field.
+func (_u *SharedLinkUpdateOne) SetDeviceBinding(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetDeviceBinding(v)
 	return _u
 }
 
-// SetRecipientEmail sets the "recipient_email" field.
-func (_u *SharedLinkUpdateOne) SetRecipientEmail(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetRecipientEmail(v)
+// SetNillableDeviceBinding sets the "device_binding" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableDeviceBinding(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetDeviceBinding(*v)
+	}
 	return _u
 }
 
-// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableRecipientEmail(v *string) *SharedLinkUpdateOne {
+// ClearDeviceBinding clears the value of the "device_binding" field.
+func (_u *SharedLinkUpdateOne) ClearDeviceBinding() *SharedLinkUpdateOne {
+	_u.mutation.ClearDeviceBinding()
+	return _u
+}
+
+// SetDeviceBoundAt sets the "device_bound_at" field.
+func (_u *SharedLinkUpdateOne) SetDeviceBoundAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetDeviceBoundAt(v)
+	return _u
+}
+
+// SetNillableDeviceBoundAt sets the "device_bound_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableDeviceBoundAt(v *time.Time) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetRecipientEmail(*v)
+		_u.SetDeviceBoundAt(*v)
 	}
 	return _u
 }
 
-// SetMessage sets the "message" field.
-func (_u *SharedLinkUpdateOne) SetMessage(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetMessage(v)
+// ClearDeviceBoundAt clears the value of the "device_bound_at" field.
+func (_u *SharedLinkUpdateOne) ClearDeviceBoundAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearDeviceBoundAt()
 	return _u
 }
 
-// SetNillableMessage sets the "message" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableMessage(v *string) *SharedLinkUpdateOne {
+// SetPassphraseHash sets the "passphrase_hash" field.
+func (_u *SharedLinkUpdateOne) SetPassphraseHash(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetPassphraseHash(v)
+	return _u
+}
+
+// SetNillablePassphraseHash sets the "passphrase_hash" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillablePassphraseHash(v *string) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetMessage(*v)
+		_u.SetPassphraseHash(*v)
 	}
 	return _u
 }
 
-// ClearMessage clears the value of the "message" field.
-func (_u *SharedLinkUpdateOne) ClearMessage() *SharedLinkUpdateOne {
-	_u.mutation.ClearMessage()
+// ClearPassphraseHash clears the value of the "passphrase_hash" field.
+func (_u *SharedLinkUpdateOne) ClearPassphraseHash() *SharedLinkUpdateOne {
+	_u.mutation.ClearPassphraseHash()
 	return _u
 }
 
-// SetTemplateID sets the "template_id" field.
-func (_u *SharedLinkUpdateOne) SetTemplateID(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetTemplateID(v)
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_u *SharedLinkUpdateOne) SetVerificationCodeHash(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetVerificationCodeHash(v)
 	return _u
 }
 
-// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableTemplateID(v *string) *SharedLinkUpdateOne {
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableVerificationCodeHash(v *string) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetTemplateID(*v)
+		_u.SetVerificationCodeHash(*v)
 	}
 	return _u
 }
 
-// ClearTemplateID clears the value of the "template_id" field.
-func (_u *SharedLinkUpdateOne) ClearTemplateID() *SharedLinkUpdateOne {
-	_u.mutation.ClearTemplateID()
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (_u *SharedLinkUpdateOne) ClearVerificationCodeHash() *SharedLinkUpdateOne {
+	_u.mutation.ClearVerificationCodeHash()
 	return _u
 }
 
-// SetViewed sets the "viewed" field.
-func (_u *SharedLinkUpdateOne) SetViewed(v bool) *SharedLinkUpdateOne {
-	_u.mutation.SetViewed(v)
+// SetVerificationCodeExpiresAt sets the "verification_code_expires_at" field.
+func (_u *SharedLinkUpdateOne) SetVerificationCodeExpiresAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetVerificationCodeExpiresAt(v)
 	return _u
 }
 
-// SetNillableViewed sets the "viewed" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableViewed(v *bool) *SharedLinkUpdateOne {
+// SetNillableVerificationCodeExpiresAt sets the "verification_code_expires_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableVerificationCodeExpiresAt(v *time.Time) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetViewed(*v)
+		_u.SetVerificationCodeExpiresAt(*v)
 	}
 	return _u
 }
 
-// SetViewedAt sets the "viewed_at" field.
-func (_u *SharedLinkUpdateOne) SetViewedAt(v time.Time) *SharedLinkUpdateOne {
-	_u.mutation.SetViewedAt(v)
+// ClearVerificationCodeExpiresAt clears the value of the "verification_code_expires_at" field.
+func (_u *SharedLinkUpdateOne) ClearVerificationCodeExpiresAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearVerificationCodeExpiresAt()
 	return _u
 }
 
-// SetNillableViewedAt sets the "viewed_at" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableViewedAt(v *time.Time) *SharedLinkUpdateOne {
+// SetStepUpAttempts sets the "step_up_attempts" field.
+func (_u *SharedLinkUpdateOne) SetStepUpAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.ResetStepUpAttempts()
+	_u.mutation.SetStepUpAttempts(v)
+	return _u
+}
+
+// SetNillableStepUpAttempts sets the "step_up_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableStepUpAttempts(v *int32) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetViewedAt(*v)
+		_u.SetStepUpAttempts(*v)
 	}
 	return _u
 }
 
-// ClearViewedAt clears the value of the "viewed_at" field.
-func (_u *SharedLinkUpdateOne) ClearViewedAt() *SharedLinkUpdateOne {
-	_u.mutation.ClearViewedAt()
+// AddStepUpAttempts adds value to the "step_up_attempts" field.
+func (_u *SharedLinkUpdateOne) AddStepUpAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddStepUpAttempts(v)
 	return _u
 }
 
-// SetViewedIP sets the "viewed_ip" field.
-func (_u *SharedLinkUpdateOne) SetViewedIP(v string) *SharedLinkUpdateOne {
-	_u.mutation.SetViewedIP(v)
+// SetPassphraseAttempts sets the "passphrase_attempts" field.
+func (_u *SharedLinkUpdateOne) SetPassphraseAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.ResetPassphraseAttempts()
+	_u.mutation.SetPassphraseAttempts(v)
 	return _u
 }
 
-// SetNillableViewedIP sets the "viewed_ip" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableViewedIP(v *string) *SharedLinkUpdateOne {
+// SetNillablePassphraseAttempts sets the "passphrase_attempts" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillablePassphraseAttempts(v *int32) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetViewedIP(*v)
+		_u.SetPassphraseAttempts(*v)
 	}
 	return _u
 }
 
-// ClearViewedIP clears the value of the "viewed_ip" field.
-func (_u *SharedLinkUpdateOne) ClearViewedIP() *SharedLinkUpdateOne {
-	_u.mutation.ClearViewedIP()
+// AddPassphraseAttempts adds value to the "passphrase_attempts" field.
+func (_u *SharedLinkUpdateOne) AddPassphraseAttempts(v int32) *SharedLinkUpdateOne {
+	_u.mutation.AddPassphraseAttempts(v)
 	return _u
 }
 
-// SetRevoked sets the "revoked" field.
-func (_u *SharedLinkUpdateOne) SetRevoked(v bool) *SharedLinkUpdateOne {
-	_u.mutation.SetRevoked(v)
+// SetStepUpChallengedAt sets the "step_up_challenged_at" field.
+func (_u *SharedLinkUpdateOne) SetStepUpChallengedAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetStepUpChallengedAt(v)
 	return _u
 }
 
-// SetNillableRevoked sets the "revoked" field if the given value is not nil.
-func (_u *SharedLinkUpdateOne) SetNillableRevoked(v *bool) *SharedLinkUpdateOne {
+// SetNillableStepUpChallengedAt sets the "step_up_challenged_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableStepUpChallengedAt(v *time.Time) *SharedLinkUpdateOne {
 	if v != nil {
-		_u.SetRevoked(*v)
+		_u.SetStepUpChallengedAt(*v)
 	}
 	return _u
 }
 
+// ClearStepUpChallengedAt clears the value of the "step_up_challenged_at" field.
+func (_u *SharedLinkUpdateOne) ClearStepUpChallengedAt() *SharedLinkUpdateOne {
+	_u.mutation.ClearStepUpChallengedAt()
+	return _u
+}
+
 // Mutation returns the SharedLinkMutation object of the builder.
 func (_u *SharedLinkUpdateOne) Mutation() *SharedLinkMutation {
 	return _u.mutation
@@ -840,6 +1818,11 @@ func (_u *SharedLinkUpdateOne) check() error {
 			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.recipient_email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SenderEmail(); ok {
+		if err := sharedlink.SenderEmailValidator(v); err != nil {
+			return &ValidationError{Name: "sender_email", err: fmt.Errorf(`ent: validator failed for field "SharedLink.sender_email": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Message(); ok {
 		if err := sharedlink.MessageValidator(v); err != nil {
 			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "SharedLink.message": %w`, err)}
@@ -855,6 +1838,46 @@ func (_u *SharedLinkUpdateOne) check() error {
 			return &ValidationError{Name: "viewed_ip", err: fmt.Errorf(`ent: validator failed for field "SharedLink.viewed_ip": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AuthorizedVia(); ok {
+		if err := sharedlink.AuthorizedViaValidator(v); err != nil {
+			return &ValidationError{Name: "authorized_via", err: fmt.Errorf(`ent: validator failed for field "SharedLink.authorized_via": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyMode(); ok {
+		if err := sharedlink.PolicyModeValidator(v); err != nil {
+			return &ValidationError{Name: "policy_mode", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyDefault(); ok {
+		if err := sharedlink.PolicyDefaultValidator(v); err != nil {
+			return &ValidationError{Name: "policy_default", err: fmt.Errorf(`ent: validator failed for field "SharedLink.policy_default": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxViews(); ok {
+		if err := sharedlink.MaxViewsValidator(v); err != nil {
+			return &ValidationError{Name: "max_views", err: fmt.Errorf(`ent: validator failed for field "SharedLink.max_views": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ViewCount(); ok {
+		if err := sharedlink.ViewCountValidator(v); err != nil {
+			return &ValidationError{Name: "view_count", err: fmt.Errorf(`ent: validator failed for field "SharedLink.view_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeviceBinding(); ok {
+		if err := sharedlink.DeviceBindingValidator(v); err != nil {
+			return &ValidationError{Name: "device_binding", err: fmt.Errorf(`ent: validator failed for field "SharedLink.device_binding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PassphraseHash(); ok {
+		if err := sharedlink.PassphraseHashValidator(v); err != nil {
+			return &ValidationError{Name: "passphrase_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.passphrase_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationCodeHash(); ok {
+		if err := sharedlink.VerificationCodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "verification_code_hash", err: fmt.Errorf(`ent: validator failed for field "SharedLink.verification_code_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -947,6 +1970,12 @@ func (_u *SharedLinkUpdateOne) sqlSave(ctx context.Context) (_node *SharedLink,
 	if value, ok := _u.mutation.RecipientEmail(); ok {
 		_spec.SetField(sharedlink.FieldRecipientEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SenderEmail(); ok {
+		_spec.SetField(sharedlink.FieldSenderEmail, field.TypeString, value)
+	}
+	if _u.mutation.SenderEmailCleared() {
+		_spec.ClearField(sharedlink.FieldSenderEmail, field.TypeString)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(sharedlink.FieldMessage, field.TypeString, value)
 	}
@@ -977,6 +2006,117 @@ func (_u *SharedLinkUpdateOne) sqlSave(ctx context.Context) (_node *SharedLink,
 	if value, ok := _u.mutation.Revoked(); ok {
 		_spec.SetField(sharedlink.FieldRevoked, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharedlink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryNotified(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNotified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiryNoticeAttempts(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedExpiryNoticeAttempts(); ok {
+		_spec.AddField(sharedlink.FieldExpiryNoticeAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ExpiryNoticeNextAttemptAt(); ok {
+		_spec.SetField(sharedlink.FieldExpiryNoticeNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryNoticeNextAttemptAtCleared() {
+		_spec.ClearField(sharedlink.FieldExpiryNoticeNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AuthorizedBy(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorizedBy(); ok {
+		_spec.AddField(sharedlink.FieldAuthorizedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.AuthorizedByCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AuthorizedVia(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedVia, field.TypeString, value)
+	}
+	if _u.mutation.AuthorizedViaCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedVia, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorizedAt(); ok {
+		_spec.SetField(sharedlink.FieldAuthorizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AuthorizedAtCleared() {
+		_spec.ClearField(sharedlink.FieldAuthorizedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyMode(); ok {
+		_spec.SetField(sharedlink.FieldPolicyMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolicyDefault(); ok {
+		_spec.SetField(sharedlink.FieldPolicyDefault, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxViews(); ok {
+		_spec.SetField(sharedlink.FieldMaxViews, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxViews(); ok {
+		_spec.AddField(sharedlink.FieldMaxViews, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(sharedlink.FieldViewCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(sharedlink.FieldViewCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.BindDevice(); ok {
+		_spec.SetField(sharedlink.FieldBindDevice, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeviceBinding(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBinding, field.TypeString, value)
+	}
+	if _u.mutation.DeviceBindingCleared() {
+		_spec.ClearField(sharedlink.FieldDeviceBinding, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeviceBoundAt(); ok {
+		_spec.SetField(sharedlink.FieldDeviceBoundAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeviceBoundAtCleared() {
+		_spec.ClearField(sharedlink.FieldDeviceBoundAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PassphraseHash(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseHash, field.TypeString, value)
+	}
+	if _u.mutation.PassphraseHashCleared() {
+		_spec.ClearField(sharedlink.FieldPassphraseHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCodeHashCleared() {
+		_spec.ClearField(sharedlink.FieldVerificationCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeExpiresAt(); ok {
+		_spec.SetField(sharedlink.FieldVerificationCodeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationCodeExpiresAtCleared() {
+		_spec.ClearField(sharedlink.FieldVerificationCodeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StepUpAttempts(); ok {
+		_spec.SetField(sharedlink.FieldStepUpAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedStepUpAttempts(); ok {
+		_spec.AddField(sharedlink.FieldStepUpAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.PassphraseAttempts(); ok {
+		_spec.SetField(sharedlink.FieldPassphraseAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPassphraseAttempts(); ok {
+		_spec.AddField(sharedlink.FieldPassphraseAttempts, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.StepUpChallengedAt(); ok {
+		_spec.SetField(sharedlink.FieldStepUpChallengedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StepUpChallengedAtCleared() {
+		_spec.ClearField(sharedlink.FieldStepUpChallengedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &SharedLink{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
+)
+
+// SharedLinkPolicySet is the model entity for the SharedLinkPolicySet schema.
+type SharedLinkPolicySet struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to shared_link.id
+	ShareLinkID string `json:"share_link_id,omitempty"`
+	// FK to policy_set.id
+	PolicySetID  string `json:"policy_set_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SharedLinkPolicySet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharedlinkpolicyset.FieldCreateBy, sharedlinkpolicyset.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case sharedlinkpolicyset.FieldID, sharedlinkpolicyset.FieldShareLinkID, sharedlinkpolicyset.FieldPolicySetID:
+			values[i] = new(sql.NullString)
+		case sharedlinkpolicyset.FieldCreateTime, sharedlinkpolicyset.FieldUpdateTime, sharedlinkpolicyset.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SharedLinkPolicySet fields.
+func (_m *SharedLinkPolicySet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharedlinkpolicyset.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case sharedlinkpolicyset.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case sharedlinkpolicyset.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case sharedlinkpolicyset.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case sharedlinkpolicyset.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case sharedlinkpolicyset.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case sharedlinkpolicyset.FieldShareLinkID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_link_id", values[i])
+			} else if value.Valid {
+				_m.ShareLinkID = value.String
+			}
+		case sharedlinkpolicyset.FieldPolicySetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_set_id", values[i])
+			} else if value.Valid {
+				_m.PolicySetID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SharedLinkPolicySet.
+// This includes values selected through modifiers, order, etc.
+func (_m *SharedLinkPolicySet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SharedLinkPolicySet.
+// Note that you need to call SharedLinkPolicySet.Unwrap() before calling this method if this SharedLinkPolicySet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SharedLinkPolicySet) Update() *SharedLinkPolicySetUpdateOne {
+	return NewSharedLinkPolicySetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SharedLinkPolicySet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SharedLinkPolicySet) Unwrap() *SharedLinkPolicySet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SharedLinkPolicySet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SharedLinkPolicySet) String() string {
+	var builder strings.Builder
+	builder.WriteString("SharedLinkPolicySet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("share_link_id=")
+	builder.WriteString(_m.ShareLinkID)
+	builder.WriteString(", ")
+	builder.WriteString("policy_set_id=")
+	builder.WriteString(_m.PolicySetID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SharedLinkPolicySets is a parsable slice of SharedLinkPolicySet.
+type SharedLinkPolicySets []*SharedLinkPolicySet
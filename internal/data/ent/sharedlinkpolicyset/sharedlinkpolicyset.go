@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedlinkpolicyset
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sharedlinkpolicyset type in the database.
+	Label = "shared_link_policy_set"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldShareLinkID holds the string denoting the share_link_id field in the database.
+	FieldShareLinkID = "share_link_id"
+	// FieldPolicySetID holds the string denoting the policy_set_id field in the database.
+	FieldPolicySetID = "policy_set_id"
+	// Table holds the table name of the sharedlinkpolicyset in the database.
+	Table = "sharing_shared_link_policy_sets"
+)
+
+// Columns holds all SQL columns for sharedlinkpolicyset fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldShareLinkID,
+	FieldPolicySetID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ShareLinkIDValidator is a validator for the "share_link_id" field. It is called by the builders before save.
+	ShareLinkIDValidator func(string) error
+	// PolicySetIDValidator is a validator for the "policy_set_id" field. It is called by the builders before save.
+	PolicySetIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SharedLinkPolicySet queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByShareLinkID orders the results by the share_link_id field.
+func ByShareLinkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareLinkID, opts...).ToFunc()
+}
+
+// ByPolicySetID orders the results by the policy_set_id field.
+func ByPolicySetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicySetID, opts...).ToFunc()
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharedlinkpolicyset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ShareLinkID applies equality check predicate on the "share_link_id" field. It's identical to ShareLinkIDEQ.
+func ShareLinkID(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// PolicySetID applies equality check predicate on the "policy_set_id" field. It's identical to PolicySetIDEQ.
+func PolicySetID(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldPolicySetID, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotNull(FieldTenantID))
+}
+
+// ShareLinkIDEQ applies the EQ predicate on the "share_link_id" field.
+func ShareLinkIDEQ(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDNEQ applies the NEQ predicate on the "share_link_id" field.
+func ShareLinkIDNEQ(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDIn applies the In predicate on the "share_link_id" field.
+func ShareLinkIDIn(vs ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldShareLinkID, vs...))
+}
+
+// ShareLinkIDNotIn applies the NotIn predicate on the "share_link_id" field.
+func ShareLinkIDNotIn(vs ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldShareLinkID, vs...))
+}
+
+// ShareLinkIDGT applies the GT predicate on the "share_link_id" field.
+func ShareLinkIDGT(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldShareLinkID, v))
+}
+
+// ShareLinkIDGTE applies the GTE predicate on the "share_link_id" field.
+func ShareLinkIDGTE(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldShareLinkID, v))
+}
+
+// ShareLinkIDLT applies the LT predicate on the "share_link_id" field.
+func ShareLinkIDLT(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldShareLinkID, v))
+}
+
+// ShareLinkIDLTE applies the LTE predicate on the "share_link_id" field.
+func ShareLinkIDLTE(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldShareLinkID, v))
+}
+
+// ShareLinkIDContains applies the Contains predicate on the "share_link_id" field.
+func ShareLinkIDContains(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldContains(FieldShareLinkID, v))
+}
+
+// ShareLinkIDHasPrefix applies the HasPrefix predicate on the "share_link_id" field.
+func ShareLinkIDHasPrefix(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldHasPrefix(FieldShareLinkID, v))
+}
+
+// ShareLinkIDHasSuffix applies the HasSuffix predicate on the "share_link_id" field.
+func ShareLinkIDHasSuffix(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldHasSuffix(FieldShareLinkID, v))
+}
+
+// ShareLinkIDEqualFold applies the EqualFold predicate on the "share_link_id" field.
+func ShareLinkIDEqualFold(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEqualFold(FieldShareLinkID, v))
+}
+
+// ShareLinkIDContainsFold applies the ContainsFold predicate on the "share_link_id" field.
+func ShareLinkIDContainsFold(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldContainsFold(FieldShareLinkID, v))
+}
+
+// PolicySetIDEQ applies the EQ predicate on the "policy_set_id" field.
+func PolicySetIDEQ(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEQ(FieldPolicySetID, v))
+}
+
+// PolicySetIDNEQ applies the NEQ predicate on the "policy_set_id" field.
+func PolicySetIDNEQ(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNEQ(FieldPolicySetID, v))
+}
+
+// PolicySetIDIn applies the In predicate on the "policy_set_id" field.
+func PolicySetIDIn(vs ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldIn(FieldPolicySetID, vs...))
+}
+
+// PolicySetIDNotIn applies the NotIn predicate on the "policy_set_id" field.
+func PolicySetIDNotIn(vs ...string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldNotIn(FieldPolicySetID, vs...))
+}
+
+// PolicySetIDGT applies the GT predicate on the "policy_set_id" field.
+func PolicySetIDGT(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGT(FieldPolicySetID, v))
+}
+
+// PolicySetIDGTE applies the GTE predicate on the "policy_set_id" field.
+func PolicySetIDGTE(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldGTE(FieldPolicySetID, v))
+}
+
+// PolicySetIDLT applies the LT predicate on the "policy_set_id" field.
+func PolicySetIDLT(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLT(FieldPolicySetID, v))
+}
+
+// PolicySetIDLTE applies the LTE predicate on the "policy_set_id" field.
+func PolicySetIDLTE(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldLTE(FieldPolicySetID, v))
+}
+
+// PolicySetIDContains applies the Contains predicate on the "policy_set_id" field.
+func PolicySetIDContains(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldContains(FieldPolicySetID, v))
+}
+
+// PolicySetIDHasPrefix applies the HasPrefix predicate on the "policy_set_id" field.
+func PolicySetIDHasPrefix(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldHasPrefix(FieldPolicySetID, v))
+}
+
+// PolicySetIDHasSuffix applies the HasSuffix predicate on the "policy_set_id" field.
+func PolicySetIDHasSuffix(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldHasSuffix(FieldPolicySetID, v))
+}
+
+// PolicySetIDEqualFold applies the EqualFold predicate on the "policy_set_id" field.
+func PolicySetIDEqualFold(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldEqualFold(FieldPolicySetID, v))
+}
+
+// PolicySetIDContainsFold applies the ContainsFold predicate on the "policy_set_id" field.
+func PolicySetIDContainsFold(v string) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.FieldContainsFold(FieldPolicySetID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SharedLinkPolicySet) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SharedLinkPolicySet) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SharedLinkPolicySet) predicate.SharedLinkPolicySet {
+	return predicate.SharedLinkPolicySet(sql.NotPredicates(p))
+}
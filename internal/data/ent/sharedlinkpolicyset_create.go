@@ -0,0 +1,873 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
+)
+
+// SharedLinkPolicySetCreate is the builder for creating a SharedLinkPolicySet entity.
+type SharedLinkPolicySetCreate struct {
+	config
+	mutation *SharedLinkPolicySetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *SharedLinkPolicySetCreate) SetCreateBy(v uint32) *SharedLinkPolicySetCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *SharedLinkPolicySetCreate) SetNillableCreateBy(v *uint32) *SharedLinkPolicySetCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SharedLinkPolicySetCreate) SetCreateTime(v time.Time) *SharedLinkPolicySetCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SharedLinkPolicySetCreate) SetNillableCreateTime(v *time.Time) *SharedLinkPolicySetCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SharedLinkPolicySetCreate) SetUpdateTime(v time.Time) *SharedLinkPolicySetCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SharedLinkPolicySetCreate) SetNillableUpdateTime(v *time.Time) *SharedLinkPolicySetCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *SharedLinkPolicySetCreate) SetDeleteTime(v time.Time) *SharedLinkPolicySetCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *SharedLinkPolicySetCreate) SetNillableDeleteTime(v *time.Time) *SharedLinkPolicySetCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SharedLinkPolicySetCreate) SetTenantID(v uint32) *SharedLinkPolicySetCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SharedLinkPolicySetCreate) SetNillableTenantID(v *uint32) *SharedLinkPolicySetCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_c *SharedLinkPolicySetCreate) SetShareLinkID(v string) *SharedLinkPolicySetCreate {
+	_c.mutation.SetShareLinkID(v)
+	return _c
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (_c *SharedLinkPolicySetCreate) SetPolicySetID(v string) *SharedLinkPolicySetCreate {
+	_c.mutation.SetPolicySetID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SharedLinkPolicySetCreate) SetID(v string) *SharedLinkPolicySetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SharedLinkPolicySetMutation object of the builder.
+func (_c *SharedLinkPolicySetCreate) Mutation() *SharedLinkPolicySetMutation {
+	return _c.mutation
+}
+
+// Save creates the SharedLinkPolicySet in the database.
+func (_c *SharedLinkPolicySetCreate) Save(ctx context.Context) (*SharedLinkPolicySet, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SharedLinkPolicySetCreate) SaveX(ctx context.Context) *SharedLinkPolicySet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SharedLinkPolicySetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SharedLinkPolicySetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SharedLinkPolicySetCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := sharedlinkpolicyset.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SharedLinkPolicySetCreate) check() error {
+	if _, ok := _c.mutation.ShareLinkID(); !ok {
+		return &ValidationError{Name: "share_link_id", err: errors.New(`ent: missing required field "SharedLinkPolicySet.share_link_id"`)}
+	}
+	if v, ok := _c.mutation.ShareLinkID(); ok {
+		if err := sharedlinkpolicyset.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.share_link_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicySetID(); !ok {
+		return &ValidationError{Name: "policy_set_id", err: errors.New(`ent: missing required field "SharedLinkPolicySet.policy_set_id"`)}
+	}
+	if v, ok := _c.mutation.PolicySetID(); ok {
+		if err := sharedlinkpolicyset.PolicySetIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_set_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.policy_set_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := sharedlinkpolicyset.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SharedLinkPolicySetCreate) sqlSave(ctx context.Context) (*SharedLinkPolicySet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SharedLinkPolicySet.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SharedLinkPolicySetCreate) createSpec() (*SharedLinkPolicySet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SharedLinkPolicySet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharedlinkpolicyset.Table, sqlgraph.NewFieldSpec(sharedlinkpolicyset.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ShareLinkID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldShareLinkID, field.TypeString, value)
+		_node.ShareLinkID = value
+	}
+	if value, ok := _c.mutation.PolicySetID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldPolicySetID, field.TypeString, value)
+		_node.PolicySetID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SharedLinkPolicySet.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SharedLinkPolicySetUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SharedLinkPolicySetCreate) OnConflict(opts ...sql.ConflictOption) *SharedLinkPolicySetUpsertOne {
+	_c.conflict = opts
+	return &SharedLinkPolicySetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SharedLinkPolicySetCreate) OnConflictColumns(columns ...string) *SharedLinkPolicySetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SharedLinkPolicySetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SharedLinkPolicySetUpsertOne is the builder for "upsert"-ing
+	//  one SharedLinkPolicySet node.
+	SharedLinkPolicySetUpsertOne struct {
+		create *SharedLinkPolicySetCreate
+	}
+
+	// SharedLinkPolicySetUpsert is the "OnConflict" setter.
+	SharedLinkPolicySetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *SharedLinkPolicySetUpsert) SetCreateBy(v uint32) *SharedLinkPolicySetUpsert {
+	u.Set(sharedlinkpolicyset.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsert) UpdateCreateBy() *SharedLinkPolicySetUpsert {
+	u.SetExcluded(sharedlinkpolicyset.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SharedLinkPolicySetUpsert) AddCreateBy(v uint32) *SharedLinkPolicySetUpsert {
+	u.Add(sharedlinkpolicyset.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SharedLinkPolicySetUpsert) ClearCreateBy() *SharedLinkPolicySetUpsert {
+	u.SetNull(sharedlinkpolicyset.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SharedLinkPolicySetUpsert) SetUpdateTime(v time.Time) *SharedLinkPolicySetUpsert {
+	u.Set(sharedlinkpolicyset.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsert) UpdateUpdateTime() *SharedLinkPolicySetUpsert {
+	u.SetExcluded(sharedlinkpolicyset.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SharedLinkPolicySetUpsert) ClearUpdateTime() *SharedLinkPolicySetUpsert {
+	u.SetNull(sharedlinkpolicyset.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SharedLinkPolicySetUpsert) SetDeleteTime(v time.Time) *SharedLinkPolicySetUpsert {
+	u.Set(sharedlinkpolicyset.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsert) UpdateDeleteTime() *SharedLinkPolicySetUpsert {
+	u.SetExcluded(sharedlinkpolicyset.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SharedLinkPolicySetUpsert) ClearDeleteTime() *SharedLinkPolicySetUpsert {
+	u.SetNull(sharedlinkpolicyset.FieldDeleteTime)
+	return u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *SharedLinkPolicySetUpsert) SetShareLinkID(v string) *SharedLinkPolicySetUpsert {
+	u.Set(sharedlinkpolicyset.FieldShareLinkID, v)
+	return u
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsert) UpdateShareLinkID() *SharedLinkPolicySetUpsert {
+	u.SetExcluded(sharedlinkpolicyset.FieldShareLinkID)
+	return u
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (u *SharedLinkPolicySetUpsert) SetPolicySetID(v string) *SharedLinkPolicySetUpsert {
+	u.Set(sharedlinkpolicyset.FieldPolicySetID, v)
+	return u
+}
+
+// UpdatePolicySetID sets the "policy_set_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsert) UpdatePolicySetID() *SharedLinkPolicySetUpsert {
+	u.SetExcluded(sharedlinkpolicyset.FieldPolicySetID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sharedlinkpolicyset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SharedLinkPolicySetUpsertOne) UpdateNewValues() *SharedLinkPolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sharedlinkpolicyset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(sharedlinkpolicyset.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(sharedlinkpolicyset.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SharedLinkPolicySetUpsertOne) Ignore() *SharedLinkPolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SharedLinkPolicySetUpsertOne) DoNothing() *SharedLinkPolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SharedLinkPolicySetCreate.OnConflict
+// documentation for more info.
+func (u *SharedLinkPolicySetUpsertOne) Update(set func(*SharedLinkPolicySetUpsert)) *SharedLinkPolicySetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SharedLinkPolicySetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SharedLinkPolicySetUpsertOne) SetCreateBy(v uint32) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SharedLinkPolicySetUpsertOne) AddCreateBy(v uint32) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertOne) UpdateCreateBy() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SharedLinkPolicySetUpsertOne) ClearCreateBy() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SharedLinkPolicySetUpsertOne) SetUpdateTime(v time.Time) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertOne) UpdateUpdateTime() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SharedLinkPolicySetUpsertOne) ClearUpdateTime() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SharedLinkPolicySetUpsertOne) SetDeleteTime(v time.Time) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertOne) UpdateDeleteTime() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SharedLinkPolicySetUpsertOne) ClearDeleteTime() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *SharedLinkPolicySetUpsertOne) SetShareLinkID(v string) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetShareLinkID(v)
+	})
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertOne) UpdateShareLinkID() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateShareLinkID()
+	})
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (u *SharedLinkPolicySetUpsertOne) SetPolicySetID(v string) *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetPolicySetID(v)
+	})
+}
+
+// UpdatePolicySetID sets the "policy_set_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertOne) UpdatePolicySetID() *SharedLinkPolicySetUpsertOne {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdatePolicySetID()
+	})
+}
+
+// Exec executes the query.
+func (u *SharedLinkPolicySetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SharedLinkPolicySetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SharedLinkPolicySetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SharedLinkPolicySetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SharedLinkPolicySetUpsertOne.ID is not supported by MySQL driver. Use SharedLinkPolicySetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SharedLinkPolicySetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SharedLinkPolicySetCreateBulk is the builder for creating many SharedLinkPolicySet entities in bulk.
+type SharedLinkPolicySetCreateBulk struct {
+	config
+	err      error
+	builders []*SharedLinkPolicySetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SharedLinkPolicySet entities in the database.
+func (_c *SharedLinkPolicySetCreateBulk) Save(ctx context.Context) ([]*SharedLinkPolicySet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SharedLinkPolicySet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SharedLinkPolicySetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SharedLinkPolicySetCreateBulk) SaveX(ctx context.Context) []*SharedLinkPolicySet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SharedLinkPolicySetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SharedLinkPolicySetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SharedLinkPolicySet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SharedLinkPolicySetUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SharedLinkPolicySetCreateBulk) OnConflict(opts ...sql.ConflictOption) *SharedLinkPolicySetUpsertBulk {
+	_c.conflict = opts
+	return &SharedLinkPolicySetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SharedLinkPolicySetCreateBulk) OnConflictColumns(columns ...string) *SharedLinkPolicySetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SharedLinkPolicySetUpsertBulk{
+		create: _c,
+	}
+}
+
+// SharedLinkPolicySetUpsertBulk is the builder for "upsert"-ing
+// a bulk of SharedLinkPolicySet nodes.
+type SharedLinkPolicySetUpsertBulk struct {
+	create *SharedLinkPolicySetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sharedlinkpolicyset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SharedLinkPolicySetUpsertBulk) UpdateNewValues() *SharedLinkPolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sharedlinkpolicyset.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(sharedlinkpolicyset.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(sharedlinkpolicyset.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SharedLinkPolicySet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SharedLinkPolicySetUpsertBulk) Ignore() *SharedLinkPolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SharedLinkPolicySetUpsertBulk) DoNothing() *SharedLinkPolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SharedLinkPolicySetCreateBulk.OnConflict
+// documentation for more info.
+func (u *SharedLinkPolicySetUpsertBulk) Update(set func(*SharedLinkPolicySetUpsert)) *SharedLinkPolicySetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SharedLinkPolicySetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SharedLinkPolicySetUpsertBulk) SetCreateBy(v uint32) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SharedLinkPolicySetUpsertBulk) AddCreateBy(v uint32) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertBulk) UpdateCreateBy() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SharedLinkPolicySetUpsertBulk) ClearCreateBy() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SharedLinkPolicySetUpsertBulk) SetUpdateTime(v time.Time) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertBulk) UpdateUpdateTime() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SharedLinkPolicySetUpsertBulk) ClearUpdateTime() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SharedLinkPolicySetUpsertBulk) SetDeleteTime(v time.Time) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertBulk) UpdateDeleteTime() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SharedLinkPolicySetUpsertBulk) ClearDeleteTime() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (u *SharedLinkPolicySetUpsertBulk) SetShareLinkID(v string) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetShareLinkID(v)
+	})
+}
+
+// UpdateShareLinkID sets the "share_link_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertBulk) UpdateShareLinkID() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdateShareLinkID()
+	})
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (u *SharedLinkPolicySetUpsertBulk) SetPolicySetID(v string) *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.SetPolicySetID(v)
+	})
+}
+
+// UpdatePolicySetID sets the "policy_set_id" field to the value that was provided on create.
+func (u *SharedLinkPolicySetUpsertBulk) UpdatePolicySetID() *SharedLinkPolicySetUpsertBulk {
+	return u.Update(func(s *SharedLinkPolicySetUpsert) {
+		s.UpdatePolicySetID()
+	})
+}
+
+// Exec executes the query.
+func (u *SharedLinkPolicySetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SharedLinkPolicySetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SharedLinkPolicySetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SharedLinkPolicySetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
+)
+
+// SharedLinkPolicySetDelete is the builder for deleting a SharedLinkPolicySet entity.
+type SharedLinkPolicySetDelete struct {
+	config
+	hooks    []Hook
+	mutation *SharedLinkPolicySetMutation
+}
+
+// Where appends a list predicates to the SharedLinkPolicySetDelete builder.
+func (_d *SharedLinkPolicySetDelete) Where(ps ...predicate.SharedLinkPolicySet) *SharedLinkPolicySetDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SharedLinkPolicySetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SharedLinkPolicySetDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SharedLinkPolicySetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sharedlinkpolicyset.Table, sqlgraph.NewFieldSpec(sharedlinkpolicyset.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SharedLinkPolicySetDeleteOne is the builder for deleting a single SharedLinkPolicySet entity.
+type SharedLinkPolicySetDeleteOne struct {
+	_d *SharedLinkPolicySetDelete
+}
+
+// Where appends a list predicates to the SharedLinkPolicySetDelete builder.
+func (_d *SharedLinkPolicySetDeleteOne) Where(ps ...predicate.SharedLinkPolicySet) *SharedLinkPolicySetDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SharedLinkPolicySetDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharedlinkpolicyset.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SharedLinkPolicySetDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
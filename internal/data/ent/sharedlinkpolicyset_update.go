@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
+)
+
+// SharedLinkPolicySetUpdate is the builder for updating SharedLinkPolicySet entities.
+type SharedLinkPolicySetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SharedLinkPolicySetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SharedLinkPolicySetUpdate builder.
+func (_u *SharedLinkPolicySetUpdate) Where(ps ...predicate.SharedLinkPolicySet) *SharedLinkPolicySetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SharedLinkPolicySetUpdate) SetCreateBy(v uint32) *SharedLinkPolicySetUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdate) SetNillableCreateBy(v *uint32) *SharedLinkPolicySetUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SharedLinkPolicySetUpdate) AddCreateBy(v int32) *SharedLinkPolicySetUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SharedLinkPolicySetUpdate) ClearCreateBy() *SharedLinkPolicySetUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SharedLinkPolicySetUpdate) SetUpdateTime(v time.Time) *SharedLinkPolicySetUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdate) SetNillableUpdateTime(v *time.Time) *SharedLinkPolicySetUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SharedLinkPolicySetUpdate) ClearUpdateTime() *SharedLinkPolicySetUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SharedLinkPolicySetUpdate) SetDeleteTime(v time.Time) *SharedLinkPolicySetUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdate) SetNillableDeleteTime(v *time.Time) *SharedLinkPolicySetUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SharedLinkPolicySetUpdate) ClearDeleteTime() *SharedLinkPolicySetUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_u *SharedLinkPolicySetUpdate) SetShareLinkID(v string) *SharedLinkPolicySetUpdate {
+	_u.mutation.SetShareLinkID(v)
+	return _u
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdate) SetNillableShareLinkID(v *string) *SharedLinkPolicySetUpdate {
+	if v != nil {
+		_u.SetShareLinkID(*v)
+	}
+	return _u
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (_u *SharedLinkPolicySetUpdate) SetPolicySetID(v string) *SharedLinkPolicySetUpdate {
+	_u.mutation.SetPolicySetID(v)
+	return _u
+}
+
+// SetNillablePolicySetID sets the "policy_set_id" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdate) SetNillablePolicySetID(v *string) *SharedLinkPolicySetUpdate {
+	if v != nil {
+		_u.SetPolicySetID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SharedLinkPolicySetMutation object of the builder.
+func (_u *SharedLinkPolicySetUpdate) Mutation() *SharedLinkPolicySetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SharedLinkPolicySetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SharedLinkPolicySetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SharedLinkPolicySetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SharedLinkPolicySetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SharedLinkPolicySetUpdate) check() error {
+	if v, ok := _u.mutation.ShareLinkID(); ok {
+		if err := sharedlinkpolicyset.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.share_link_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicySetID(); ok {
+		if err := sharedlinkpolicyset.PolicySetIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_set_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.policy_set_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SharedLinkPolicySetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SharedLinkPolicySetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SharedLinkPolicySetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharedlinkpolicyset.Table, sharedlinkpolicyset.Columns, sqlgraph.NewFieldSpec(sharedlinkpolicyset.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ShareLinkID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldShareLinkID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicySetID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldPolicySetID, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedlinkpolicyset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SharedLinkPolicySetUpdateOne is the builder for updating a single SharedLinkPolicySet entity.
+type SharedLinkPolicySetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SharedLinkPolicySetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SharedLinkPolicySetUpdateOne) SetCreateBy(v uint32) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdateOne) SetNillableCreateBy(v *uint32) *SharedLinkPolicySetUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SharedLinkPolicySetUpdateOne) AddCreateBy(v int32) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SharedLinkPolicySetUpdateOne) ClearCreateBy() *SharedLinkPolicySetUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SharedLinkPolicySetUpdateOne) SetUpdateTime(v time.Time) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdateOne) SetNillableUpdateTime(v *time.Time) *SharedLinkPolicySetUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SharedLinkPolicySetUpdateOne) ClearUpdateTime() *SharedLinkPolicySetUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SharedLinkPolicySetUpdateOne) SetDeleteTime(v time.Time) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdateOne) SetNillableDeleteTime(v *time.Time) *SharedLinkPolicySetUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SharedLinkPolicySetUpdateOne) ClearDeleteTime() *SharedLinkPolicySetUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (_u *SharedLinkPolicySetUpdateOne) SetShareLinkID(v string) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.SetShareLinkID(v)
+	return _u
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdateOne) SetNillableShareLinkID(v *string) *SharedLinkPolicySetUpdateOne {
+	if v != nil {
+		_u.SetShareLinkID(*v)
+	}
+	return _u
+}
+
+// SetPolicySetID sets the "policy_set_id" field.
+func (_u *SharedLinkPolicySetUpdateOne) SetPolicySetID(v string) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.SetPolicySetID(v)
+	return _u
+}
+
+// SetNillablePolicySetID sets the "policy_set_id" field if the given value is not nil.
+func (_u *SharedLinkPolicySetUpdateOne) SetNillablePolicySetID(v *string) *SharedLinkPolicySetUpdateOne {
+	if v != nil {
+		_u.SetPolicySetID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SharedLinkPolicySetMutation object of the builder.
+func (_u *SharedLinkPolicySetUpdateOne) Mutation() *SharedLinkPolicySetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SharedLinkPolicySetUpdate builder.
+func (_u *SharedLinkPolicySetUpdateOne) Where(ps ...predicate.SharedLinkPolicySet) *SharedLinkPolicySetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SharedLinkPolicySetUpdateOne) Select(field string, fields ...string) *SharedLinkPolicySetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SharedLinkPolicySet entity.
+func (_u *SharedLinkPolicySetUpdateOne) Save(ctx context.Context) (*SharedLinkPolicySet, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SharedLinkPolicySetUpdateOne) SaveX(ctx context.Context) *SharedLinkPolicySet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SharedLinkPolicySetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SharedLinkPolicySetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SharedLinkPolicySetUpdateOne) check() error {
+	if v, ok := _u.mutation.ShareLinkID(); ok {
+		if err := sharedlinkpolicyset.ShareLinkIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_link_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.share_link_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicySetID(); ok {
+		if err := sharedlinkpolicyset.PolicySetIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_set_id", err: fmt.Errorf(`ent: validator failed for field "SharedLinkPolicySet.policy_set_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SharedLinkPolicySetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SharedLinkPolicySetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SharedLinkPolicySetUpdateOne) sqlSave(ctx context.Context) (_node *SharedLinkPolicySet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharedlinkpolicyset.Table, sharedlinkpolicyset.Columns, sqlgraph.NewFieldSpec(sharedlinkpolicyset.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SharedLinkPolicySet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedlinkpolicyset.FieldID)
+		for _, f := range fields {
+			if !sharedlinkpolicyset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharedlinkpolicyset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(sharedlinkpolicyset.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ShareLinkID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldShareLinkID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicySetID(); ok {
+		_spec.SetField(sharedlinkpolicyset.FieldPolicySetID, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SharedLinkPolicySet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedlinkpolicyset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
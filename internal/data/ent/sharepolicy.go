@@ -34,10 +34,14 @@ type SharePolicy struct {
 	Type sharepolicy.Type `json:"type,omitempty"`
 	// Restriction method
 	Method sharepolicy.Method `json:"method,omitempty"`
-	// Restriction value (IP, CIDR range, MAC, region code, time range, device ID)
+	// Restriction value (IP, CIDR range, MAC, region code, time range, device rule, CEL expression, certificate rule)
 	Value string `json:"value,omitempty"`
 	// Explanation for this restriction
-	Reason       string `json:"reason,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// Evaluation order, lower priorities first
+	Priority int32 `json:"priority,omitempty"`
+	// Whether the policy uses a deprecated method and should be replaced
+	Deprecated   bool `json:"deprecated,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -46,7 +50,9 @@ func (*SharePolicy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case sharepolicy.FieldCreateBy, sharepolicy.FieldTenantID:
+		case sharepolicy.FieldDeprecated:
+			values[i] = new(sql.NullBool)
+		case sharepolicy.FieldCreateBy, sharepolicy.FieldTenantID, sharepolicy.FieldPriority:
 			values[i] = new(sql.NullInt64)
 		case sharepolicy.FieldID, sharepolicy.FieldShareLinkID, sharepolicy.FieldType, sharepolicy.FieldMethod, sharepolicy.FieldValue, sharepolicy.FieldReason:
 			values[i] = new(sql.NullString)
@@ -138,6 +144,18 @@ func (_m *SharePolicy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Reason = value.String
 			}
+		case sharepolicy.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int32(value.Int64)
+			}
+		case sharepolicy.FieldDeprecated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated", values[i])
+			} else if value.Valid {
+				_m.Deprecated = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -213,6 +231,12 @@ func (_m *SharePolicy) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("reason=")
 	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("deprecated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Deprecated))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,6 +34,10 @@ const (
 	FieldValue = "value"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldDeprecated holds the string denoting the deprecated field in the database.
+	FieldDeprecated = "deprecated"
 	// Table holds the table name of the sharepolicy in the database.
 	Table = "sharing_share_policies"
 )
@@ -51,6 +55,8 @@ var Columns = []string{
 	FieldMethod,
 	FieldValue,
 	FieldReason,
+	FieldPriority,
+	FieldDeprecated,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,7 +75,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
 var (
-	Hooks  [1]ent.Hook
+	Hooks  [2]ent.Hook
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
@@ -79,6 +85,10 @@ var (
 	ValueValidator func(string) error
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int32
+	// DefaultDeprecated holds the default value on creation for the "deprecated" field.
+	DefaultDeprecated bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -111,12 +121,15 @@ type Method string
 
 // Method values.
 const (
-	MethodIP      Method = "IP"
-	MethodMAC     Method = "MAC"
-	MethodREGION  Method = "REGION"
-	MethodTIME    Method = "TIME"
-	MethodDEVICE  Method = "DEVICE"
-	MethodNETWORK Method = "NETWORK"
+	MethodIP          Method = "IP"
+	MethodMAC         Method = "MAC"
+	MethodREGION      Method = "REGION"
+	MethodTIME        Method = "TIME"
+	MethodDEVICE      Method = "DEVICE"
+	MethodNETWORK     Method = "NETWORK"
+	MethodEXPRESSION  Method = "EXPRESSION"
+	MethodCERTIFICATE Method = "CERTIFICATE"
+	MethodREPUTATION  Method = "REPUTATION"
 )
 
 func (m Method) String() string {
@@ -126,7 +139,7 @@ func (m Method) String() string {
 // MethodValidator is a validator for the "method" field enum values. It is called by the builders before save.
 func MethodValidator(m Method) error {
 	switch m {
-	case MethodIP, MethodMAC, MethodREGION, MethodTIME, MethodDEVICE, MethodNETWORK:
+	case MethodIP, MethodMAC, MethodREGION, MethodTIME, MethodDEVICE, MethodNETWORK, MethodEXPRESSION, MethodCERTIFICATE, MethodREPUTATION:
 		return nil
 	default:
 		return fmt.Errorf("sharepolicy: invalid enum value for method field: %q", m)
@@ -190,3 +203,13 @@ func ByValue(opts ...sql.OrderTermOption) OrderOption {
 func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
 }
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByDeprecated orders the results by the deprecated field.
+func ByDeprecated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecated, opts...).ToFunc()
+}
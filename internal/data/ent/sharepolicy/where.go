@@ -104,6 +104,16 @@ func Reason(v string) predicate.SharePolicy {
 	return predicate.SharePolicy(sql.FieldEQ(FieldReason, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// Deprecated applies equality check predicate on the "deprecated" field. It's identical to DeprecatedEQ.
+func Deprecated(v bool) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldEQ(FieldDeprecated, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.SharePolicy {
 	return predicate.SharePolicy(sql.FieldEQ(FieldCreateBy, v))
@@ -599,6 +609,56 @@ func ReasonContainsFold(v string) predicate.SharePolicy {
 	return predicate.SharePolicy(sql.FieldContainsFold(FieldReason, v))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int32) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldLTE(FieldPriority, v))
+}
+
+// DeprecatedEQ applies the EQ predicate on the "deprecated" field.
+func DeprecatedEQ(v bool) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecatedNEQ applies the NEQ predicate on the "deprecated" field.
+func DeprecatedNEQ(v bool) predicate.SharePolicy {
+	return predicate.SharePolicy(sql.FieldNEQ(FieldDeprecated, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SharePolicy) predicate.SharePolicy {
 	return predicate.SharePolicy(sql.AndPredicates(predicates...))
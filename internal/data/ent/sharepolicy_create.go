@@ -131,6 +131,34 @@ func (_c *SharePolicyCreate) SetNillableReason(v *string) *SharePolicyCreate {
 	return _c
 }
 
+// SetPriority sets the "priority" field.
+func (_c *SharePolicyCreate) SetPriority(v int32) *SharePolicyCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *SharePolicyCreate) SetNillablePriority(v *int32) *SharePolicyCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_c *SharePolicyCreate) SetDeprecated(v bool) *SharePolicyCreate {
+	_c.mutation.SetDeprecated(v)
+	return _c
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_c *SharePolicyCreate) SetNillableDeprecated(v *bool) *SharePolicyCreate {
+	if v != nil {
+		_c.SetDeprecated(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SharePolicyCreate) SetID(v string) *SharePolicyCreate {
 	_c.mutation.SetID(v)
@@ -178,6 +206,14 @@ func (_c *SharePolicyCreate) defaults() error {
 		v := sharepolicy.DefaultTenantID
 		_c.mutation.SetTenantID(v)
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := sharepolicy.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Deprecated(); !ok {
+		v := sharepolicy.DefaultDeprecated
+		_c.mutation.SetDeprecated(v)
+	}
 	return nil
 }
 
@@ -220,6 +256,12 @@ func (_c *SharePolicyCreate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "SharePolicy.reason": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "SharePolicy.priority"`)}
+	}
+	if _, ok := _c.mutation.Deprecated(); !ok {
+		return &ValidationError{Name: "deprecated", err: errors.New(`ent: missing required field "SharePolicy.deprecated"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := sharepolicy.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SharePolicy.id": %w`, err)}
@@ -301,6 +343,14 @@ func (_c *SharePolicyCreate) createSpec() (*SharePolicy, *sqlgraph.CreateSpec) {
 		_spec.SetField(sharepolicy.FieldReason, field.TypeString, value)
 		_node.Reason = value
 	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(sharepolicy.FieldPriority, field.TypeInt32, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Deprecated(); ok {
+		_spec.SetField(sharepolicy.FieldDeprecated, field.TypeBool, value)
+		_node.Deprecated = value
+	}
 	return _node, _spec
 }
 
@@ -479,6 +529,36 @@ func (u *SharePolicyUpsert) ClearReason() *SharePolicyUpsert {
 	return u
 }
 
+// SetPriority sets the "priority" field.
+func (u *SharePolicyUpsert) SetPriority(v int32) *SharePolicyUpsert {
+	u.Set(sharepolicy.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *SharePolicyUpsert) UpdatePriority() *SharePolicyUpsert {
+	u.SetExcluded(sharepolicy.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *SharePolicyUpsert) AddPriority(v int32) *SharePolicyUpsert {
+	u.Add(sharepolicy.FieldPriority, v)
+	return u
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (u *SharePolicyUpsert) SetDeprecated(v bool) *SharePolicyUpsert {
+	u.Set(sharepolicy.FieldDeprecated, v)
+	return u
+}
+
+// UpdateDeprecated sets the "deprecated" field to the value that was provided on create.
+func (u *SharePolicyUpsert) UpdateDeprecated() *SharePolicyUpsert {
+	u.SetExcluded(sharepolicy.FieldDeprecated)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -680,6 +760,41 @@ func (u *SharePolicyUpsertOne) ClearReason() *SharePolicyUpsertOne {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *SharePolicyUpsertOne) SetPriority(v int32) *SharePolicyUpsertOne {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *SharePolicyUpsertOne) AddPriority(v int32) *SharePolicyUpsertOne {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *SharePolicyUpsertOne) UpdatePriority() *SharePolicyUpsertOne {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (u *SharePolicyUpsertOne) SetDeprecated(v bool) *SharePolicyUpsertOne {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.SetDeprecated(v)
+	})
+}
+
+// UpdateDeprecated sets the "deprecated" field to the value that was provided on create.
+func (u *SharePolicyUpsertOne) UpdateDeprecated() *SharePolicyUpsertOne {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.UpdateDeprecated()
+	})
+}
+
 // Exec executes the query.
 func (u *SharePolicyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1048,6 +1163,41 @@ func (u *SharePolicyUpsertBulk) ClearReason() *SharePolicyUpsertBulk {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *SharePolicyUpsertBulk) SetPriority(v int32) *SharePolicyUpsertBulk {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *SharePolicyUpsertBulk) AddPriority(v int32) *SharePolicyUpsertBulk {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *SharePolicyUpsertBulk) UpdatePriority() *SharePolicyUpsertBulk {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (u *SharePolicyUpsertBulk) SetDeprecated(v bool) *SharePolicyUpsertBulk {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.SetDeprecated(v)
+	})
+}
+
+// UpdateDeprecated sets the "deprecated" field to the value that was provided on create.
+func (u *SharePolicyUpsertBulk) UpdateDeprecated() *SharePolicyUpsertBulk {
+	return u.Update(func(s *SharePolicyUpsert) {
+		s.UpdateDeprecated()
+	})
+}
+
 // Exec executes the query.
 func (u *SharePolicyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -172,6 +172,41 @@ func (_u *SharePolicyUpdate) ClearReason() *SharePolicyUpdate {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *SharePolicyUpdate) SetPriority(v int32) *SharePolicyUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *SharePolicyUpdate) SetNillablePriority(v *int32) *SharePolicyUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *SharePolicyUpdate) AddPriority(v int32) *SharePolicyUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_u *SharePolicyUpdate) SetDeprecated(v bool) *SharePolicyUpdate {
+	_u.mutation.SetDeprecated(v)
+	return _u
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_u *SharePolicyUpdate) SetNillableDeprecated(v *bool) *SharePolicyUpdate {
+	if v != nil {
+		_u.SetDeprecated(*v)
+	}
+	return _u
+}
+
 // Mutation returns the SharePolicyMutation object of the builder.
 func (_u *SharePolicyUpdate) Mutation() *SharePolicyMutation {
 	return _u.mutation
@@ -297,6 +332,15 @@ func (_u *SharePolicyUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ReasonCleared() {
 		_spec.ClearField(sharepolicy.FieldReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(sharepolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(sharepolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Deprecated(); ok {
+		_spec.SetField(sharepolicy.FieldDeprecated, field.TypeBool, value)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -462,6 +506,41 @@ func (_u *SharePolicyUpdateOne) ClearReason() *SharePolicyUpdateOne {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *SharePolicyUpdateOne) SetPriority(v int32) *SharePolicyUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *SharePolicyUpdateOne) SetNillablePriority(v *int32) *SharePolicyUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *SharePolicyUpdateOne) AddPriority(v int32) *SharePolicyUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_u *SharePolicyUpdateOne) SetDeprecated(v bool) *SharePolicyUpdateOne {
+	_u.mutation.SetDeprecated(v)
+	return _u
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_u *SharePolicyUpdateOne) SetNillableDeprecated(v *bool) *SharePolicyUpdateOne {
+	if v != nil {
+		_u.SetDeprecated(*v)
+	}
+	return _u
+}
+
 // Mutation returns the SharePolicyMutation object of the builder.
 func (_u *SharePolicyUpdateOne) Mutation() *SharePolicyMutation {
 	return _u.mutation
@@ -617,6 +696,15 @@ func (_u *SharePolicyUpdateOne) sqlSave(ctx context.Context) (_node *SharePolicy
 	if _u.mutation.ReasonCleared() {
 		_spec.ClearField(sharepolicy.FieldReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(sharepolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(sharepolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Deprecated(); ok {
+		_spec.SetField(sharepolicy.FieldDeprecated, field.TypeBool, value)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &SharePolicy{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -14,10 +14,24 @@ type Tx struct {
 	config
 	// EmailTemplate is the client for interacting with the EmailTemplate builders.
 	EmailTemplate *EmailTemplateClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PolicySet is the client for interacting with the PolicySet builders.
+	PolicySet *PolicySetClient
+	// RiskSettings is the client for interacting with the RiskSettings builders.
+	RiskSettings *RiskSettingsClient
+	// ShareAccessEvent is the client for interacting with the ShareAccessEvent builders.
+	ShareAccessEvent *ShareAccessEventClient
 	// SharePolicy is the client for interacting with the SharePolicy builders.
 	SharePolicy *SharePolicyClient
 	// SharedLink is the client for interacting with the SharedLink builders.
 	SharedLink *SharedLinkClient
+	// SharedLinkPolicySet is the client for interacting with the SharedLinkPolicySet builders.
+	SharedLinkPolicySet *SharedLinkPolicySetClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -150,8 +164,15 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.EmailTemplate = NewEmailTemplateClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.PolicySet = NewPolicySetClient(tx.config)
+	tx.RiskSettings = NewRiskSettingsClient(tx.config)
+	tx.ShareAccessEvent = NewShareAccessEventClient(tx.config)
 	tx.SharePolicy = NewSharePolicyClient(tx.config)
 	tx.SharedLink = NewSharedLinkClient(tx.config)
+	tx.SharedLinkPolicySet = NewSharedLinkPolicySetClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
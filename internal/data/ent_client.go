@@ -16,9 +16,12 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	entBootstrap "github.com/tx7do/kratos-bootstrap/database/ent"
 
+	"github.com/go-tangra/go-tangra-common/viewer"
+
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/migrate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
 	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
 
 	_ "github.com/go-tangra/go-tangra-sharing/internal/data/ent/runtime"
@@ -51,6 +54,7 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 			if err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(true)); err != nil {
 				l.Fatalf("failed creating schema resources: %v", err)
 			}
+			flagDeprecatedPolicies(client, l)
 		}
 
 		// Seed default email template
@@ -66,6 +70,28 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 	}, nil
 }
 
+// flagDeprecatedPolicies marks the policies of deprecated methods, so that
+// their owners can find and replace them. MAC policies never match since
+// client MAC addresses are not visible over HTTP.
+func flagDeprecatedPolicies(client *ent.Client, l *log.Helper) {
+	ctx := viewer.NewSystemViewerContext(context.Background())
+
+	n, err := client.SharePolicy.Update().
+		Where(
+			sharepolicy.MethodEQ(sharepolicy.MethodMAC),
+			sharepolicy.DeprecatedEQ(false),
+		).
+		SetDeprecated(true).
+		Save(ctx)
+	if err != nil {
+		l.Warnf("Failed to flag deprecated MAC policies: %v", err)
+		return
+	}
+	if n > 0 {
+		l.Warnf("Flagged %d MAC share policies as deprecated, replace them with CERTIFICATE policies", n)
+	}
+}
+
 const defaultTemplateID = "00000000-0000-0000-0000-000000000001"
 const defaultTemplateName = "Default Sharing Template"
 
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/notificationpreference"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// NotificationPreferenceRepo handles database operations for sender notification preferences
+type NotificationPreferenceRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewNotificationPreferenceRepo creates a new NotificationPreferenceRepo
+func NewNotificationPreferenceRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *NotificationPreferenceRepo {
+	return &NotificationPreferenceRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/notification_preference"),
+		entClient: entClient,
+	}
+}
+
+// GetByUser retrieves the preferences of a user, returning nil if none were saved
+func (r *NotificationPreferenceRepo) GetByUser(ctx context.Context, tenantID, userID uint32) (*ent.NotificationPreference, error) {
+	entity, err := r.entClient.Client().NotificationPreference.Query().
+		Where(
+			notificationpreference.TenantIDEQ(tenantID),
+			notificationpreference.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get notification preference failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("get notification preference failed")
+	}
+	return entity, nil
+}
+
+// Upsert creates or updates the preferences of a user; nil values are left unchanged
+func (r *NotificationPreferenceRepo) Upsert(ctx context.Context, tenantID, userID uint32, notifyViewed, notifyDenied, notifyExpired *bool) (*ent.NotificationPreference, error) {
+	existing, err := r.GetByUser(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		builder := r.entClient.Client().NotificationPreference.Create().
+			SetID(uuid.New().String()).
+			SetTenantID(tenantID).
+			SetUserID(userID).
+			SetCreateTime(time.Now())
+
+		if notifyViewed != nil {
+			builder.SetNotifyViewed(*notifyViewed)
+		}
+		if notifyDenied != nil {
+			builder.SetNotifyDenied(*notifyDenied)
+		}
+		if notifyExpired != nil {
+			builder.SetNotifyExpired(*notifyExpired)
+		}
+
+		entity, err := builder.Save(ctx)
+		if err != nil {
+			r.log.Errorf("create notification preference failed: %s", err.Error())
+			return nil, sharingV1.ErrorInternalServerError("create notification preference failed")
+		}
+		return entity, nil
+	}
+
+	builder := r.entClient.Client().NotificationPreference.UpdateOneID(existing.ID).
+		SetUpdateTime(time.Now())
+
+	if notifyViewed != nil {
+		builder.SetNotifyViewed(*notifyViewed)
+	}
+	if notifyDenied != nil {
+		builder.SetNotifyDenied(*notifyDenied)
+	}
+	if notifyExpired != nil {
+		builder.SetNotifyExpired(*notifyExpired)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update notification preference failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("update notification preference failed")
+	}
+	return entity, nil
+}
+
+// ToProto converts an ent.NotificationPreference to sharingV1.NotificationPreferences.
+// A nil entity yields the defaults (all notifications enabled).
+func (r *NotificationPreferenceRepo) ToProto(entity *ent.NotificationPreference) *sharingV1.NotificationPreferences {
+	if entity == nil {
+		return &sharingV1.NotificationPreferences{
+			NotifyViewed:  true,
+			NotifyDenied:  true,
+			NotifyExpired: true,
+		}
+	}
+
+	return &sharingV1.NotificationPreferences{
+		NotifyViewed:  entity.NotifyViewed,
+		NotifyDenied:  entity.NotifyDenied,
+		NotifyExpired: entity.NotifyExpired,
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 
+	"github.com/go-tangra/go-tangra-sharing/internal/metrics"
+
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
 
@@ -111,7 +113,9 @@ func (c *PaperlessClient) GetDocument(ctx context.Context, tenantID uint32, docu
 
 	ctx = forwardMetadata(ctx, tenantID)
 
+	start := time.Now()
 	resp, err := c.DocumentService.GetDocument(ctx, &paperlessV1.GetDocumentRequest{Id: documentID})
+	metrics.ObserveUpstream(metrics.UpstreamPaperless, "GetDocument", start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get document from paperless: %w", err)
 	}
@@ -126,7 +130,9 @@ func (c *PaperlessClient) DownloadDocument(ctx context.Context, tenantID uint32,
 
 	ctx = forwardMetadata(ctx, tenantID)
 
+	start := time.Now()
 	resp, err := c.DocumentService.DownloadDocument(ctx, &paperlessV1.DownloadDocumentRequest{Id: documentID})
+	metrics.ObserveUpstream(metrics.UpstreamPaperless, "DownloadDocument", start, err)
 	if err != nil {
 		return nil, "", "", fmt.Errorf("failed to download document from paperless: %w", err)
 	}
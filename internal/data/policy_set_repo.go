@@ -0,0 +1,372 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// PolicySetRepo handles database operations for policy sets and the shares referencing them
+type PolicySetRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewPolicySetRepo creates a new PolicySetRepo
+func NewPolicySetRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PolicySetRepo {
+	return &PolicySetRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/policy_set"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new policy set
+func (r *PolicySetRepo) Create(ctx context.Context, tenantID uint32, name, description string, rules []schema.PolicyRule, createdBy *uint32) (*ent.PolicySet, error) {
+	builder := r.entClient.Client().PolicySet.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetRules(rules).
+		SetCreateTime(time.Now())
+
+	if description != "" {
+		builder.SetDescription(description)
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, sharingV1.ErrorPolicySetAlreadyExists("policy set with this name already exists")
+		}
+		r.log.Errorf("create policy set failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create policy set failed")
+	}
+
+	return entity, nil
+}
+
+// GetByID retrieves a policy set by ID
+func (r *PolicySetRepo) GetByID(ctx context.Context, id string) (*ent.PolicySet, error) {
+	entity, err := r.entClient.Client().PolicySet.Query().
+		Where(policyset.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get policy set failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("get policy set failed")
+	}
+	return entity, nil
+}
+
+// GetByIDs retrieves the policy sets with the given IDs that exist, in the order of ids
+func (r *PolicySetRepo) GetByIDs(ctx context.Context, ids []string) ([]*ent.PolicySet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().PolicySet.Query().
+		Where(policyset.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get policy sets failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("get policy sets failed")
+	}
+
+	byID := make(map[string]*ent.PolicySet, len(entities))
+	for _, e := range entities {
+		byID[e.ID] = e
+	}
+	sets := make([]*ent.PolicySet, 0, len(entities))
+	for _, id := range ids {
+		if e, ok := byID[id]; ok {
+			sets = append(sets, e)
+		}
+	}
+	return sets, nil
+}
+
+// ListByTenant lists policy sets for a tenant
+func (r *PolicySetRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.PolicySet, int, error) {
+	query := r.entClient.Client().PolicySet.Query().
+		Where(policyset.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count policy sets failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("count policy sets failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(policyset.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list policy sets failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("list policy sets failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update updates a policy set. Nil fields and empty rules are left unchanged.
+func (r *PolicySetRepo) Update(ctx context.Context, id string, name, description *string, rules []schema.PolicyRule, updatedBy *uint32) (*ent.PolicySet, error) {
+	builder := r.entClient.Client().PolicySet.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if len(rules) > 0 {
+		builder.SetRules(rules)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, sharingV1.ErrorPolicySetNotFound("policy set not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, sharingV1.ErrorPolicySetAlreadyExists("policy set with this name already exists")
+		}
+		r.log.Errorf("update policy set failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("update policy set failed")
+	}
+
+	return entity, nil
+}
+
+// Delete deletes a policy set and its share references. Sets referenced by
+// active shares are not deleted, since that would lift their restrictions.
+func (r *PolicySetRepo) Delete(ctx context.Context, id string, now time.Time) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("delete policy set failed")
+	}
+
+	// Lock the set so no share can reference it between the check and the delete
+	ids, err := tx.PolicySet.Query().
+		Where(policyset.IDEQ(id)).
+		Select(policyset.FieldID).
+		Modify(lockRows(true)).
+		Strings(ctx)
+	if err == nil && len(ids) == 0 {
+		_ = tx.Rollback()
+		return sharingV1.ErrorPolicySetNotFound("policy set not found")
+	}
+
+	var shareIDs []string
+	if err == nil {
+		shareIDs, err = tx.SharedLinkPolicySet.Query().
+			Where(sharedlinkpolicyset.PolicySetIDEQ(id)).
+			Select(sharedlinkpolicyset.FieldShareLinkID).
+			Strings(ctx)
+	}
+
+	var active int
+	if err == nil && len(shareIDs) > 0 {
+		active, err = tx.SharedLink.Query().
+			Where(
+				sharedlink.IDIn(shareIDs...),
+				sharedlink.ViewedEQ(false),
+				sharedlink.RevokedEQ(false),
+				sharedlink.Or(
+					sharedlink.ExpiresAtIsNil(),
+					sharedlink.ExpiresAtGT(now),
+				),
+			).
+			Count(ctx)
+	}
+	if err == nil && active > 0 {
+		_ = tx.Rollback()
+		return sharingV1.ErrorPolicySetInUse("policy set is used by %d active share(s)", active)
+	}
+
+	if err == nil {
+		_, err = tx.SharedLinkPolicySet.Delete().
+			Where(sharedlinkpolicyset.PolicySetIDEQ(id)).
+			Exec(ctx)
+	}
+	if err == nil {
+		err = tx.PolicySet.DeleteOneID(id).Exec(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return sharingV1.ErrorPolicySetNotFound("policy set not found")
+		}
+		r.log.Errorf("delete policy set failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("delete policy set failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit policy set deletion failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("delete policy set failed")
+	}
+	return nil
+}
+
+// SetShareLinkSets replaces the policy sets referenced by a share link
+func (r *PolicySetRepo) SetShareLinkSets(ctx context.Context, tenantID uint32, shareLinkID string, policySetIDs []string, createdBy *uint32) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("set share policy sets failed")
+	}
+
+	_, err = tx.SharedLinkPolicySet.Delete().
+		Where(sharedlinkpolicyset.ShareLinkIDEQ(shareLinkID)).
+		Exec(ctx)
+	if err == nil {
+		err = createShareLinkSets(ctx, tx, tenantID, shareLinkID, policySetIDs, createdBy)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("set share policy sets failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("set share policy sets failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit share policy sets failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("set share policy sets failed")
+	}
+	return nil
+}
+
+// createShareLinkSets references the policy sets from a share link within tx
+func createShareLinkSets(ctx context.Context, tx *ent.Tx, tenantID uint32, shareLinkID string, policySetIDs []string, createdBy *uint32) error {
+	if len(policySetIDs) == 0 {
+		return nil
+	}
+	// Hold the sets until commit so a concurrent Delete waits for the references
+	if _, err := tx.PolicySet.Query().
+		Where(policyset.IDIn(policySetIDs...)).
+		Select(policyset.FieldID).
+		Modify(lockRows(false)).
+		Strings(ctx); err != nil {
+		return err
+	}
+
+	for _, setID := range policySetIDs {
+		builder := tx.SharedLinkPolicySet.Create().
+			SetID(uuid.New().String()).
+			SetTenantID(tenantID).
+			SetShareLinkID(shareLinkID).
+			SetPolicySetID(setID).
+			SetCreateTime(time.Now())
+		if createdBy != nil {
+			builder.SetCreateBy(*createdBy)
+		}
+		if _, err := builder.Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockRows locks the selected rows until the transaction ends, exclusively
+// when forUpdate is set. SQLite has no row locks and serializes writers instead.
+func lockRows(forUpdate bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		switch {
+		case s.Dialect() == dialect.SQLite:
+		case forUpdate:
+			s.ForUpdate()
+		default:
+			s.ForShare()
+		}
+	}
+}
+
+// ListSetIDsByShareLinkID lists the IDs of the policy sets referenced by a share link
+func (r *PolicySetRepo) ListSetIDsByShareLinkID(ctx context.Context, shareLinkID string) ([]string, error) {
+	ids, err := r.entClient.Client().SharedLinkPolicySet.Query().
+		Where(sharedlinkpolicyset.ShareLinkIDEQ(shareLinkID)).
+		Order(ent.Asc(sharedlinkpolicyset.FieldCreateTime)).
+		Select(sharedlinkpolicyset.FieldPolicySetID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list share policy sets failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list share policy sets failed")
+	}
+	return ids, nil
+}
+
+// ListShareLinkIDs lists the IDs of the share links referencing a policy set
+func (r *PolicySetRepo) ListShareLinkIDs(ctx context.Context, policySetID string) ([]string, error) {
+	ids, err := r.entClient.Client().SharedLinkPolicySet.Query().
+		Where(sharedlinkpolicyset.PolicySetIDEQ(policySetID)).
+		Select(sharedlinkpolicyset.FieldShareLinkID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list policy set shares failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list policy set shares failed")
+	}
+	return ids, nil
+}
+
+// ToProto converts an ent.PolicySet to sharingV1.PolicySet
+func (r *PolicySetRepo) ToProto(entity *ent.PolicySet) *sharingV1.PolicySet {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &sharingV1.PolicySet{
+		Id:          entity.ID,
+		TenantId:    derefUint32(entity.TenantID),
+		Name:        entity.Name,
+		Description: entity.Description,
+		CreatedBy:   entity.CreateBy,
+		UpdatedBy:   entity.UpdateBy,
+	}
+
+	for _, rule := range entity.Rules {
+		proto.Rules = append(proto.Rules, &sharingV1.PolicySetRule{
+			Type:     sharingV1.SharePolicyType(sharingV1.SharePolicyType_value["SHARE_POLICY_TYPE_"+rule.Type]),
+			Method:   sharingV1.SharePolicyMethod(sharingV1.SharePolicyMethod_value["SHARE_POLICY_METHOD_"+rule.Method]),
+			Value:    rule.Value,
+			Reason:   rule.Reason,
+			Priority: rule.Priority,
+		})
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
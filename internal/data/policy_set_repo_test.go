@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func newPolicySetFixture(t *testing.T) (*PolicySetRepo, *SharedLinkRepo, context.Context) {
+	t.Helper()
+
+	entClient := newTestClient(t)
+	l := log.NewHelper(log.DefaultLogger)
+
+	return &PolicySetRepo{entClient: entClient, log: l},
+		&SharedLinkRepo{entClient: entClient, log: l},
+		authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+}
+
+func TestPolicySetShareLinks(t *testing.T) {
+	sets, links, ctx := newPolicySetFixture(t)
+
+	office, err := sets.Create(ctx, tenantA, "Office", "", []schema.PolicyRule{{Type: "WHITELIST", Method: "NETWORK", Value: "10.0.0.0/8"}}, nil)
+	if err != nil {
+		t.Fatalf("create set: %v", err)
+	}
+	hours, err := sets.Create(ctx, tenantA, "Business hours", "", []schema.PolicyRule{{Type: "WHITELIST", Method: "TIME", Value: "Mon-Fri 09:00-17:00"}}, nil)
+	if err != nil {
+		t.Fatalf("create set: %v", err)
+	}
+	if _, err := sets.Create(ctx, tenantA, "Office", "", []schema.PolicyRule{{Type: "BLACKLIST", Method: "IP", Value: "10.0.0.1"}}, nil); !sharingV1.IsPolicySetAlreadyExists(err) {
+		t.Fatalf("expected PolicySetAlreadyExists for a duplicate name, got %v", err)
+	}
+
+	share, err := links.Create(ctx, &SharedLinkInput{
+		TenantID:         tenantA,
+		ResourceType:     "SECRET",
+		ResourceID:       "secret-1",
+		ResourceName:     "Secret",
+		Token:            "tgs_share",
+		EncryptedContent: []byte("ciphertext"),
+		Nonce:            []byte("nonce"),
+		RecipientEmail:   "bob@example.com",
+	})
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+
+	if err := sets.SetShareLinkSets(ctx, tenantA, share.ID, []string{hours.ID, office.ID}, nil); err != nil {
+		t.Fatalf("set share policy sets: %v", err)
+	}
+	if err := sets.SetShareLinkSets(ctx, tenantA, share.ID, []string{office.ID}, nil); err != nil {
+		t.Fatalf("replace share policy sets: %v", err)
+	}
+	ids, err := sets.ListSetIDsByShareLinkID(ctx, share.ID)
+	if err != nil || len(ids) != 1 || ids[0] != office.ID {
+		t.Fatalf("ListSetIDsByShareLinkID = %v, %v; want [%s]", ids, err, office.ID)
+	}
+
+	// Sets used by active shares cannot be deleted
+	if err := sets.Delete(ctx, office.ID, time.Now()); !sharingV1.IsPolicySetInUse(err) {
+		t.Fatalf("expected PolicySetInUse, got %v", err)
+	}
+	if err := links.Revoke(ctx, share.ID); err != nil {
+		t.Fatalf("revoke share: %v", err)
+	}
+	if err := sets.Delete(ctx, office.ID, time.Now()); err != nil {
+		t.Fatalf("delete set of a revoked share: %v", err)
+	}
+	if ids, _ := sets.ListSetIDsByShareLinkID(ctx, share.ID); len(ids) != 0 {
+		t.Fatalf("references of the deleted set remain: %v", ids)
+	}
+	if err := sets.Delete(ctx, office.ID, time.Now()); !sharingV1.IsPolicySetNotFound(err) {
+		t.Fatalf("expected PolicySetNotFound, got %v", err)
+	}
+}
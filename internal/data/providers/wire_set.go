@@ -21,4 +21,10 @@ var ProviderSet = wire.NewSet(
 	data.NewSharedLinkRepo,
 	data.NewEmailTemplateRepo,
 	data.NewSharePolicyRepo,
+	data.NewPolicySetRepo,
+	data.NewShareAccessEventRepo,
+	data.NewNotificationPreferenceRepo,
+	data.NewRiskSettingsRepo,
+	data.NewWebhookRepo,
+	data.NewWebhookDeliveryRepo,
 )
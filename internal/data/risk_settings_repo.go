@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/risksettings"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	// DefaultRiskStepUpThreshold is the step-up threshold of tenants without saved settings
+	DefaultRiskStepUpThreshold = 30
+
+	// DefaultRiskDenyThreshold is the deny threshold of tenants without saved settings
+	DefaultRiskDenyThreshold = 70
+)
+
+// RiskSettingsUpdate holds the risk settings to change; nil values are left unchanged
+type RiskSettingsUpdate struct {
+	Enabled         *bool
+	StepUpThreshold *int32
+	DenyThreshold   *int32
+	BusinessHours   *string
+}
+
+// RiskSettingsRepo handles database operations for tenant risk settings
+type RiskSettingsRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewRiskSettingsRepo creates a new RiskSettingsRepo
+func NewRiskSettingsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *RiskSettingsRepo {
+	return &RiskSettingsRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/risk_settings"),
+		entClient: entClient,
+	}
+}
+
+// GetByTenant retrieves the risk settings of a tenant, returning nil if none were saved
+func (r *RiskSettingsRepo) GetByTenant(ctx context.Context, tenantID uint32) (*ent.RiskSettings, error) {
+	entity, err := r.entClient.Client().RiskSettings.Query().
+		Where(risksettings.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get risk settings failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("get risk settings failed")
+	}
+	return entity, nil
+}
+
+// Upsert creates or updates the risk settings of a tenant
+func (r *RiskSettingsRepo) Upsert(ctx context.Context, tenantID uint32, update *RiskSettingsUpdate) (*ent.RiskSettings, error) {
+	existing, err := r.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		builder := r.entClient.Client().RiskSettings.Create().
+			SetID(uuid.New().String()).
+			SetTenantID(tenantID).
+			SetCreateTime(time.Now())
+
+		if update.Enabled != nil {
+			builder.SetEnabled(*update.Enabled)
+		}
+		if update.StepUpThreshold != nil {
+			builder.SetStepUpThreshold(*update.StepUpThreshold)
+		}
+		if update.DenyThreshold != nil {
+			builder.SetDenyThreshold(*update.DenyThreshold)
+		}
+		if update.BusinessHours != nil {
+			builder.SetBusinessHours(*update.BusinessHours)
+		}
+
+		entity, err := builder.Save(ctx)
+		if err != nil {
+			r.log.Errorf("create risk settings failed: %s", err.Error())
+			return nil, sharingV1.ErrorInternalServerError("create risk settings failed")
+		}
+		return entity, nil
+	}
+
+	builder := r.entClient.Client().RiskSettings.UpdateOneID(existing.ID).
+		SetUpdateTime(time.Now())
+
+	if update.Enabled != nil {
+		builder.SetEnabled(*update.Enabled)
+	}
+	if update.StepUpThreshold != nil {
+		builder.SetStepUpThreshold(*update.StepUpThreshold)
+	}
+	if update.DenyThreshold != nil {
+		builder.SetDenyThreshold(*update.DenyThreshold)
+	}
+	if update.BusinessHours != nil {
+		builder.SetBusinessHours(*update.BusinessHours)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update risk settings failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("update risk settings failed")
+	}
+	return entity, nil
+}
+
+// ToProto converts an ent.RiskSettings to sharingV1.RiskSettings.
+// A nil entity yields the defaults (disabled).
+func (r *RiskSettingsRepo) ToProto(entity *ent.RiskSettings) *sharingV1.RiskSettings {
+	if entity == nil {
+		return &sharingV1.RiskSettings{
+			StepUpThreshold: DefaultRiskStepUpThreshold,
+			DenyThreshold:   DefaultRiskDenyThreshold,
+		}
+	}
+
+	proto := &sharingV1.RiskSettings{
+		Enabled:         entity.Enabled,
+		StepUpThreshold: uint32(entity.StepUpThreshold),
+		DenyThreshold:   uint32(entity.DenyThreshold),
+		BusinessHours:   entity.BusinessHours,
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
@@ -0,0 +1,328 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// ShareAccessEventInput holds the attributes of a share access attempt to record
+type ShareAccessEventInput struct {
+	TenantID    uint32
+	ShareLinkID string
+	TokenPrefix string
+	Outcome     string
+	PolicyID    string
+	Reason      string
+	ClientIP    string
+	UserAgent   string
+	Country     string
+	// ReputationList is the reputation list the client IP matched, if any
+	ReputationList string
+	// RiskScore is the risk score of the attempt, when risk-based access is enabled
+	RiskScore *int32
+}
+
+// ShareAccessEventFilter holds optional filters for listing share access events
+type ShareAccessEventFilter struct {
+	ShareLinkID *string
+	Outcome     *string
+	ClientIP    *string
+	StartTime   *time.Time
+	EndTime     *time.Time
+}
+
+// ShareAccessEventRepo handles database operations for share access events
+type ShareAccessEventRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewShareAccessEventRepo creates a new ShareAccessEventRepo
+func NewShareAccessEventRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ShareAccessEventRepo {
+	return &ShareAccessEventRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/share_access_event"),
+		entClient: entClient,
+	}
+}
+
+// Create records a share access attempt
+func (r *ShareAccessEventRepo) Create(ctx context.Context, in *ShareAccessEventInput) (*ent.ShareAccessEvent, error) {
+	id := uuid.New().String()
+
+	builder := r.entClient.Client().ShareAccessEvent.Create().
+		SetID(id).
+		SetTenantID(in.TenantID).
+		SetOutcome(shareaccessevent.Outcome(in.Outcome)).
+		SetCreateTime(time.Now())
+
+	if in.ShareLinkID != "" {
+		builder.SetShareLinkID(in.ShareLinkID)
+	}
+	if in.TokenPrefix != "" {
+		builder.SetTokenPrefix(in.TokenPrefix)
+	}
+	if in.PolicyID != "" {
+		builder.SetPolicyID(in.PolicyID)
+	}
+	if in.Reason != "" {
+		builder.SetReason(truncate(in.Reason, 1024))
+	}
+	if in.ClientIP != "" {
+		builder.SetClientIP(truncate(in.ClientIP, 45))
+	}
+	if in.UserAgent != "" {
+		builder.SetUserAgent(truncate(in.UserAgent, 1024))
+	}
+	if in.Country != "" {
+		builder.SetCountry(in.Country)
+	}
+	if in.ReputationList != "" {
+		builder.SetReputationList(in.ReputationList)
+	}
+	if in.RiskScore != nil {
+		builder.SetRiskScore(*in.RiskScore)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create share access event failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create share access event failed")
+	}
+
+	return entity, nil
+}
+
+// ListByTenant lists share access events for a tenant with filtering and pagination
+func (r *ShareAccessEventRepo) ListByTenant(ctx context.Context, tenantID uint32, filter *ShareAccessEventFilter, page, pageSize uint32) ([]*ent.ShareAccessEvent, int, error) {
+	query := r.entClient.Client().ShareAccessEvent.Query().
+		Where(shareaccessevent.TenantIDEQ(tenantID))
+
+	if filter != nil {
+		if filter.ShareLinkID != nil && *filter.ShareLinkID != "" {
+			query = query.Where(shareaccessevent.ShareLinkIDEQ(*filter.ShareLinkID))
+		}
+		if filter.Outcome != nil && *filter.Outcome != "" {
+			query = query.Where(shareaccessevent.OutcomeEQ(shareaccessevent.Outcome(*filter.Outcome)))
+		}
+		if filter.ClientIP != nil && *filter.ClientIP != "" {
+			query = query.Where(shareaccessevent.ClientIPEQ(*filter.ClientIP))
+		}
+		if filter.StartTime != nil {
+			query = query.Where(shareaccessevent.CreateTimeGTE(*filter.StartTime))
+		}
+		if filter.EndTime != nil {
+			query = query.Where(shareaccessevent.CreateTimeLT(*filter.EndTime))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count share access events failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("count share access events failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(shareaccessevent.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list share access events failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("list share access events failed")
+	}
+
+	return entities, total, nil
+}
+
+// CountSince counts events of an outcome for a share link recorded at or after since
+func (r *ShareAccessEventRepo) CountSince(ctx context.Context, shareLinkID, outcome string, since time.Time) (int, error) {
+	count, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(
+			shareaccessevent.ShareLinkIDEQ(shareLinkID),
+			shareaccessevent.OutcomeEQ(shareaccessevent.Outcome(outcome)),
+			shareaccessevent.CreateTimeGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count share access events failed: %s", err.Error())
+		return 0, sharingV1.ErrorInternalServerError("count share access events failed")
+	}
+	return count, nil
+}
+
+// failedAccessOutcomes are the outcomes of attempts that look like guessing or probing
+var failedAccessOutcomes = []shareaccessevent.Outcome{
+	shareaccessevent.OutcomeNOT_FOUND,
+	shareaccessevent.OutcomePOLICY_DENIED,
+	shareaccessevent.OutcomeDEVICE_MISMATCH,
+	shareaccessevent.OutcomeSTEP_UP_FAILED,
+	shareaccessevent.OutcomeRISK_DENIED,
+}
+
+// CountFailuresSince counts the failed attempts to open a share link recorded at or after since
+func (r *ShareAccessEventRepo) CountFailuresSince(ctx context.Context, shareLinkID string, since time.Time) (int, error) {
+	count, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(
+			shareaccessevent.ShareLinkIDEQ(shareLinkID),
+			shareaccessevent.OutcomeIn(failedAccessOutcomes...),
+			shareaccessevent.CreateTimeGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count share access failures failed: %s", err.Error())
+		return 0, sharingV1.ErrorInternalServerError("count share access failures failed")
+	}
+	return count, nil
+}
+
+// CountFailuresByClientIPSince counts the failed attempts from a client IP,
+// across tenants, recorded at or after since
+func (r *ShareAccessEventRepo) CountFailuresByClientIPSince(ctx context.Context, clientIP string, since time.Time) (int, error) {
+	count, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(
+			shareaccessevent.ClientIPEQ(clientIP),
+			shareaccessevent.OutcomeIn(failedAccessOutcomes...),
+			shareaccessevent.CreateTimeGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count client access failures failed: %s", err.Error())
+		return 0, sharingV1.ErrorInternalServerError("count client access failures failed")
+	}
+	return count, nil
+}
+
+// GrantedCountriesSince lists the distinct countries shares of a tenant were
+// opened from at or after since
+func (r *ShareAccessEventRepo) GrantedCountriesSince(ctx context.Context, tenantID uint32, since time.Time) ([]string, error) {
+	countries, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(
+			shareaccessevent.TenantIDEQ(tenantID),
+			shareaccessevent.OutcomeEQ(shareaccessevent.OutcomeGRANTED),
+			shareaccessevent.CountryNEQ(""),
+			shareaccessevent.CreateTimeGTE(since),
+		).
+		Unique(true).
+		Select(shareaccessevent.FieldCountry).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list granted countries failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list granted countries failed")
+	}
+	return countries, nil
+}
+
+// CountByShareLinkID counts all recorded attempts to open a share link
+func (r *ShareAccessEventRepo) CountByShareLinkID(ctx context.Context, shareLinkID string) (int, error) {
+	count, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(shareaccessevent.ShareLinkIDEQ(shareLinkID)).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count share access events failed: %s", err.Error())
+		return 0, sharingV1.ErrorInternalServerError("count share access events failed")
+	}
+	return count, nil
+}
+
+// CountByOutcome counts events of an outcome for a tenant recorded in [start, end)
+func (r *ShareAccessEventRepo) CountByOutcome(ctx context.Context, tenantID uint32, outcome string, start, end time.Time) (int, error) {
+	count, err := r.entClient.Client().ShareAccessEvent.Query().
+		Where(
+			shareaccessevent.TenantIDEQ(tenantID),
+			shareaccessevent.OutcomeEQ(shareaccessevent.Outcome(outcome)),
+			shareaccessevent.CreateTimeGTE(start),
+			shareaccessevent.CreateTimeLT(end),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count share access events failed: %s", err.Error())
+		return 0, sharingV1.ErrorInternalServerError("count share access events failed")
+	}
+	return count, nil
+}
+
+// ToProto converts an ent.ShareAccessEvent to sharingV1.ShareAccessEvent
+func (r *ShareAccessEventRepo) ToProto(entity *ent.ShareAccessEvent) *sharingV1.ShareAccessEvent {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &sharingV1.ShareAccessEvent{
+		Id:             entity.ID,
+		TenantId:       derefUint32(entity.TenantID),
+		ShareLinkId:    entity.ShareLinkID,
+		TokenPrefix:    entity.TokenPrefix,
+		PolicyId:       entity.PolicyID,
+		Reason:         entity.Reason,
+		ClientIp:       entity.ClientIP,
+		UserAgent:      entity.UserAgent,
+		Country:        entity.Country,
+		ReputationList: entity.ReputationList,
+	}
+	if entity.RiskScore != nil {
+		score := uint32(*entity.RiskScore)
+		proto.RiskScore = &score
+	}
+
+	switch entity.Outcome {
+	case shareaccessevent.OutcomeGRANTED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_GRANTED
+	case shareaccessevent.OutcomePOLICY_DENIED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_POLICY_DENIED
+	case shareaccessevent.OutcomeALREADY_VIEWED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ALREADY_VIEWED
+	case shareaccessevent.OutcomeREVOKED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_REVOKED
+	case shareaccessevent.OutcomeNOT_FOUND:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_NOT_FOUND
+	case shareaccessevent.OutcomeERROR:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ERROR
+	case shareaccessevent.OutcomeEXPIRED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_EXPIRED
+	case shareaccessevent.OutcomeDEVICE_MISMATCH:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH
+	case shareaccessevent.OutcomeSTEP_UP_REQUIRED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED
+	case shareaccessevent.OutcomeSTEP_UP_FAILED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_FAILED
+	case shareaccessevent.OutcomeRISK_DENIED:
+		proto.Outcome = sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_RISK_DENIED
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
+
+// truncate shortens s to at most n bytes of valid UTF-8 without NUL bytes.
+// Client-controlled values are stored with it, and databases reject invalid
+// text: a cut multi-byte character would drop the whole insert.
+func truncate(s string, n int) string {
+	s = strings.ReplaceAll(strings.ToValidUTF8(s, "\uFFFD"), "\x00", "")
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func newAccessEventFixture(t *testing.T) (*ShareAccessEventRepo, context.Context, context.Context) {
+	t.Helper()
+
+	return &ShareAccessEventRepo{entClient: newTestClient(t), log: log.NewHelper(log.DefaultLogger)},
+		authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil),
+		authz.NewViewerContext(context.Background(), tenantB, 20, nil, nil)
+}
+
+func TestAccessEventRecording(t *testing.T) {
+	events, ctxA, _ := newAccessEventFixture(t)
+
+	score := int32(45)
+	entity, err := events.Create(ctxA, &ShareAccessEventInput{
+		TenantID:       tenantA,
+		ShareLinkID:    "share-1",
+		TokenPrefix:    "tgs_abcd",
+		Outcome:        "POLICY_DENIED",
+		PolicyID:       "policy-1",
+		Reason:         strings.Repeat("r", 2000),
+		ClientIP:       "203.0.113.7",
+		UserAgent:      "curl/8.0",
+		Country:        "DE",
+		ReputationList: "tor-exits",
+		RiskScore:      &score,
+	})
+	if err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+
+	p := events.ToProto(entity)
+	if p.Outcome != sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_POLICY_DENIED {
+		t.Errorf("Outcome = %v", p.Outcome)
+	}
+	if p.TenantId != tenantA || p.ShareLinkId != "share-1" || p.TokenPrefix != "tgs_abcd" || p.PolicyId != "policy-1" {
+		t.Errorf("identifiers not recorded: %+v", p)
+	}
+	if p.ClientIp != "203.0.113.7" || p.UserAgent != "curl/8.0" || p.Country != "DE" || p.ReputationList != "tor-exits" {
+		t.Errorf("client details not recorded: %+v", p)
+	}
+	if len(p.Reason) != 1024 {
+		t.Errorf("reason was not truncated to 1024 bytes: %d", len(p.Reason))
+	}
+	if p.RiskScore == nil || *p.RiskScore != 45 {
+		t.Errorf("RiskScore = %v, want 45", p.RiskScore)
+	}
+	if p.CreateTime == nil {
+		t.Error("CreateTime not set")
+	}
+
+	// Attempts on unknown tokens carry no share link
+	entity, err = events.Create(ctxA, &ShareAccessEventInput{TenantID: tenantA, TokenPrefix: "tgs_zzzz", Outcome: "NOT_FOUND"})
+	if err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+	if p := events.ToProto(entity); p.ShareLinkId != "" || p.RiskScore != nil || p.Outcome != sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_NOT_FOUND {
+		t.Errorf("unexpected event %+v", p)
+	}
+}
+
+func TestAccessEventListing(t *testing.T) {
+	events, ctxA, ctxB := newAccessEventFixture(t)
+
+	for _, in := range []*ShareAccessEventInput{
+		{TenantID: tenantA, ShareLinkID: "share-1", Outcome: "GRANTED", ClientIP: "10.0.0.1"},
+		{TenantID: tenantA, ShareLinkID: "share-1", Outcome: "POLICY_DENIED", ClientIP: "10.0.0.2"},
+		{TenantID: tenantA, ShareLinkID: "share-2", Outcome: "POLICY_DENIED", ClientIP: "10.0.0.2"},
+		{TenantID: tenantA, ShareLinkID: "share-2", Outcome: "ALREADY_VIEWED", ClientIP: "10.0.0.1"},
+	} {
+		if _, err := events.Create(ctxA, in); err != nil {
+			t.Fatalf("create event: %v", err)
+		}
+	}
+	if _, err := events.Create(ctxB, &ShareAccessEventInput{TenantID: tenantB, ShareLinkID: "share-b", Outcome: "GRANTED"}); err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+
+	str := func(s string) *string { return &s }
+	for _, tc := range []struct {
+		name   string
+		filter *ShareAccessEventFilter
+		want   int
+	}{
+		{"all", nil, 4},
+		{"by share", &ShareAccessEventFilter{ShareLinkID: str("share-1")}, 2},
+		{"by outcome", &ShareAccessEventFilter{Outcome: str("POLICY_DENIED")}, 2},
+		{"by client ip", &ShareAccessEventFilter{ClientIP: str("10.0.0.1")}, 2},
+		{"combined", &ShareAccessEventFilter{ShareLinkID: str("share-2"), Outcome: str("POLICY_DENIED")}, 1},
+		{"future", &ShareAccessEventFilter{StartTime: timePtr(time.Now().Add(time.Hour))}, 0},
+		{"past", &ShareAccessEventFilter{EndTime: timePtr(time.Now().Add(-time.Hour))}, 0},
+	} {
+		list, total, err := events.ListByTenant(ctxA, tenantA, tc.filter, 0, 0)
+		if err != nil {
+			t.Fatalf("%s: ListByTenant: %v", tc.name, err)
+		}
+		if len(list) != tc.want || total != tc.want {
+			t.Errorf("%s: got %d events (total %d), want %d", tc.name, len(list), total, tc.want)
+		}
+	}
+
+	// Newest first, paginated, with the total of all matches
+	page, total, err := events.ListByTenant(ctxA, tenantA, nil, 1, 3)
+	if err != nil {
+		t.Fatalf("ListByTenant: %v", err)
+	}
+	if len(page) != 3 || total != 4 {
+		t.Fatalf("first page: %d events, total %d", len(page), total)
+	}
+	if page[0].Outcome != "ALREADY_VIEWED" {
+		t.Errorf("first event is %s, want the newest (ALREADY_VIEWED)", page[0].Outcome)
+	}
+	page, _, err = events.ListByTenant(ctxA, tenantA, nil, 2, 3)
+	if err != nil || len(page) != 1 || page[0].Outcome != "GRANTED" {
+		t.Fatalf("second page: %v, %v; want the oldest event", page, err)
+	}
+
+	// Another tenant's events stay invisible, even when asked for explicitly
+	if list, total, err := events.ListByTenant(ctxB, tenantA, nil, 0, 0); err != nil || len(list) != 0 || total != 0 {
+		t.Errorf("tenant B listed %d tenant A events (total %d): %v", len(list), total, err)
+	}
+
+	if n, err := events.CountFailuresSince(ctxA, "share-1", time.Now().Add(-time.Minute)); err != nil || n != 1 {
+		t.Errorf("CountFailuresSince = %d, %v; want 1", n, err)
+	}
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func TestTruncateKeepsValidUTF8(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{"short", "curl/8.0", 1024, "curl/8.0"},
+		{"ascii", "abcdef", 3, "abc"},
+		{"multi-byte rune at the boundary", strings.Repeat("a", 1023) + "é", 1024, strings.Repeat("a", 1023)},
+		{"rune ending at the boundary", strings.Repeat("a", 1022) + "é", 1024, strings.Repeat("a", 1022) + "é"},
+		{"four-byte rune", "ab😀", 5, "ab"},
+		{"invalid UTF-8", "ab\xffc", 1024, "ab�c"},
+		{"NUL bytes", "a\x00b", 1024, "ab"},
+	} {
+		got := truncate(tc.in, tc.n)
+		if got != tc.want {
+			t.Errorf("%s: truncate() = %q, want %q", tc.name, got, tc.want)
+		}
+		if !utf8.ValidString(got) || len(got) > tc.n {
+			t.Errorf("%s: truncate() = %q is not valid UTF-8 of at most %d bytes", tc.name, got, tc.n)
+		}
+	}
+}
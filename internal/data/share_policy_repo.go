@@ -32,39 +32,43 @@ func NewSharePolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*en
 }
 
 // Create creates a new share policy
-func (r *SharePolicyRepo) Create(ctx context.Context, tenantID uint32, shareLinkID, policyType, method, value, reason string, createdBy *uint32) (*ent.SharePolicy, error) {
-	id := uuid.New().String()
+func (r *SharePolicyRepo) Create(ctx context.Context, tenantID uint32, shareLinkID, policyType, method, value, reason string, priority int32, createdBy *uint32) (*ent.SharePolicy, error) {
+	in := SharePolicyInput{Type: policyType, Method: method, Value: value, Reason: reason, Priority: priority}
+	entity, err := newSharePolicyCreate(r.entClient.Client().SharePolicy, tenantID, shareLinkID, in, createdBy).Save(ctx)
+	if err != nil {
+		r.log.Errorf("create share policy failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create share policy failed")
+	}
 
-	builder := r.entClient.Client().SharePolicy.Create().
-		SetID(id).
+	return entity, nil
+}
+
+// newSharePolicyCreate builds the creation of a policy of a share link
+func newSharePolicyCreate(client *ent.SharePolicyClient, tenantID uint32, shareLinkID string, in SharePolicyInput, createdBy *uint32) *ent.SharePolicyCreate {
+	builder := client.Create().
+		SetID(uuid.New().String()).
 		SetTenantID(tenantID).
 		SetShareLinkID(shareLinkID).
-		SetType(sharepolicy.Type(policyType)).
-		SetMethod(sharepolicy.Method(method)).
-		SetValue(value).
+		SetType(sharepolicy.Type(in.Type)).
+		SetMethod(sharepolicy.Method(in.Method)).
+		SetValue(in.Value).
+		SetPriority(in.Priority).
 		SetCreateTime(time.Now())
 
-	if reason != "" {
-		builder.SetReason(reason)
+	if in.Reason != "" {
+		builder.SetReason(in.Reason)
 	}
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
 	}
-
-	entity, err := builder.Save(ctx)
-	if err != nil {
-		r.log.Errorf("create share policy failed: %s", err.Error())
-		return nil, sharingV1.ErrorInternalServerError("create share policy failed")
-	}
-
-	return entity, nil
+	return builder
 }
 
-// ListByShareLinkID lists policies for a share link
+// ListByShareLinkID lists policies for a share link in evaluation order
 func (r *SharePolicyRepo) ListByShareLinkID(ctx context.Context, shareLinkID string) ([]*ent.SharePolicy, error) {
 	entities, err := r.entClient.Client().SharePolicy.Query().
 		Where(sharepolicy.ShareLinkIDEQ(shareLinkID)).
-		Order(ent.Asc(sharepolicy.FieldCreateTime)).
+		Order(ent.Asc(sharepolicy.FieldPriority), ent.Asc(sharepolicy.FieldCreateTime), ent.Asc(sharepolicy.FieldID)).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("list share policies failed: %s", err.Error())
@@ -73,16 +77,21 @@ func (r *SharePolicyRepo) ListByShareLinkID(ctx context.Context, shareLinkID str
 	return entities, nil
 }
 
-// Delete deletes a share policy by ID
-func (r *SharePolicyRepo) Delete(ctx context.Context, id string) error {
-	err := r.entClient.Client().SharePolicy.DeleteOneID(id).Exec(ctx)
+// Delete deletes a policy of a share link by ID
+func (r *SharePolicyRepo) Delete(ctx context.Context, shareLinkID, id string) error {
+	n, err := r.entClient.Client().SharePolicy.Delete().
+		Where(
+			sharepolicy.IDEQ(id),
+			sharepolicy.ShareLinkIDEQ(shareLinkID),
+		).
+		Exec(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return sharingV1.ErrorNotFound("share policy not found")
-		}
 		r.log.Errorf("delete share policy failed: %s", err.Error())
 		return sharingV1.ErrorInternalServerError("delete share policy failed")
 	}
+	if n == 0 {
+		return sharingV1.ErrorNotFound("share policy not found")
+	}
 	return nil
 }
 
@@ -97,6 +106,8 @@ func (r *SharePolicyRepo) ToProto(entity *ent.SharePolicy) *sharingV1.SharePolic
 		ShareLinkId: entity.ShareLinkID,
 		Value:       entity.Value,
 		Reason:      entity.Reason,
+		Priority:    entity.Priority,
+		Deprecated:  entity.Deprecated,
 	}
 
 	switch entity.Type {
@@ -119,6 +130,10 @@ func (r *SharePolicyRepo) ToProto(entity *ent.SharePolicy) *sharingV1.SharePolic
 		proto.Method = sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE
 	case sharepolicy.MethodNETWORK:
 		proto.Method = sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK
+	case sharepolicy.MethodEXPRESSION:
+		proto.Method = sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION
+	case sharepolicy.MethodCERTIFICATE:
+		proto.Method = sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE
 	}
 
 	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
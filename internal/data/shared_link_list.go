@@ -0,0 +1,215 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// Share statuses; every share has exactly one
+const (
+	SharedLinkStatusActive  = "ACTIVE"
+	SharedLinkStatusViewed  = "VIEWED"
+	SharedLinkStatusRevoked = "REVOKED"
+	SharedLinkStatusExpired = "EXPIRED"
+)
+
+// Sortable shared link fields
+const (
+	SharedLinkSortCreateTime     = sharedlink.FieldCreateTime
+	SharedLinkSortResourceName   = sharedlink.FieldResourceName
+	SharedLinkSortRecipientEmail = sharedlink.FieldRecipientEmail
+)
+
+// SharedLinkFilter holds optional filters for listing shared links
+type SharedLinkFilter struct {
+	ResourceType   *string
+	RecipientEmail *string
+	Status         *string
+	CreatedBy      *uint32
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	ViewedAfter    *time.Time
+	ViewedBefore   *time.Time
+	Search         *string
+}
+
+// predicates returns the predicates for the filter; now decides which shares are expired
+func (f *SharedLinkFilter) predicates(now time.Time) []predicate.SharedLink {
+	var ps []predicate.SharedLink
+
+	if f.ResourceType != nil && *f.ResourceType != "" {
+		ps = append(ps, sharedlink.ResourceTypeEQ(sharedlink.ResourceType(*f.ResourceType)))
+	}
+	if f.RecipientEmail != nil && *f.RecipientEmail != "" {
+		ps = append(ps, sharedlink.RecipientEmailEQ(*f.RecipientEmail))
+	}
+	if f.Status != nil && *f.Status != "" {
+		ps = append(ps, sharedLinkStatusPredicates(*f.Status, now)...)
+	}
+	if f.CreatedBy != nil {
+		ps = append(ps, sharedlink.CreateByEQ(*f.CreatedBy))
+	}
+	if f.CreatedAfter != nil {
+		ps = append(ps, sharedlink.CreateTimeGTE(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		ps = append(ps, sharedlink.CreateTimeLT(*f.CreatedBefore))
+	}
+	if f.ViewedAfter != nil {
+		ps = append(ps, sharedlink.ViewedAtGTE(*f.ViewedAfter))
+	}
+	if f.ViewedBefore != nil {
+		ps = append(ps, sharedlink.ViewedAtLT(*f.ViewedBefore))
+	}
+	if f.Search != nil && *f.Search != "" {
+		ps = append(ps, sharedlink.Or(
+			sharedlink.ResourceNameContainsFold(*f.Search),
+			sharedlink.MessageContainsFold(*f.Search),
+			sharedlink.RecipientEmailContainsFold(*f.Search),
+		))
+	}
+
+	return ps
+}
+
+// sharedLinkStatusPredicates returns the predicates selecting shares in a status.
+// Revoked wins over viewed, which wins over expired.
+func sharedLinkStatusPredicates(status string, now time.Time) []predicate.SharedLink {
+	switch status {
+	case SharedLinkStatusRevoked:
+		return []predicate.SharedLink{sharedlink.RevokedEQ(true)}
+	case SharedLinkStatusViewed:
+		return []predicate.SharedLink{sharedlink.RevokedEQ(false), sharedlink.ViewedEQ(true)}
+	case SharedLinkStatusExpired:
+		return []predicate.SharedLink{
+			sharedlink.RevokedEQ(false),
+			sharedlink.ViewedEQ(false),
+			sharedlink.ExpiresAtNotNil(),
+			sharedlink.ExpiresAtLTE(now),
+		}
+	default:
+		return []predicate.SharedLink{
+			sharedlink.RevokedEQ(false),
+			sharedlink.ViewedEQ(false),
+			sharedlink.Or(sharedlink.ExpiresAtIsNil(), sharedlink.ExpiresAtGT(now)),
+		}
+	}
+}
+
+// SharedLinkSort selects the order of listed shared links; ties are broken by ID
+type SharedLinkSort struct {
+	Field string
+	Desc  bool
+}
+
+// order returns the ent ordering for the sort
+func (s SharedLinkSort) order() []sharedlink.OrderOption {
+	dir := ent.Asc
+	if s.Desc {
+		dir = ent.Desc
+	}
+	return []sharedlink.OrderOption{dir(s.Field), dir(sharedlink.FieldID)}
+}
+
+// cursor returns the cursor positioned at entity
+func (s SharedLinkSort) cursor(entity *ent.SharedLink) *SharedLinkCursor {
+	c := &SharedLinkCursor{Field: s.Field, Desc: s.Desc, ID: entity.ID}
+	switch s.Field {
+	case SharedLinkSortResourceName:
+		c.Value = entity.ResourceName
+	case SharedLinkSortRecipientEmail:
+		c.Value = entity.RecipientEmail
+	default:
+		if entity.CreateTime != nil {
+			c.Value = entity.CreateTime.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return c
+}
+
+// after returns the predicate selecting shared links that come after the cursor
+func (s SharedLinkSort) after(c *SharedLinkCursor) (predicate.SharedLink, error) {
+	if c.Field != s.Field || c.Desc != s.Desc {
+		return nil, sharingV1.ErrorBadRequest("pageToken does not match the requested sort")
+	}
+
+	switch s.Field {
+	case SharedLinkSortCreateTime:
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, sharingV1.ErrorBadRequest("invalid pageToken")
+		}
+		if s.Desc {
+			return sharedlink.Or(
+				sharedlink.CreateTimeLT(t),
+				sharedlink.And(sharedlink.CreateTimeEQ(t), sharedlink.IDLT(c.ID)),
+			), nil
+		}
+		return sharedlink.Or(
+			sharedlink.CreateTimeGT(t),
+			sharedlink.And(sharedlink.CreateTimeEQ(t), sharedlink.IDGT(c.ID)),
+		), nil
+
+	case SharedLinkSortResourceName:
+		if s.Desc {
+			return sharedlink.Or(
+				sharedlink.ResourceNameLT(c.Value),
+				sharedlink.And(sharedlink.ResourceNameEQ(c.Value), sharedlink.IDLT(c.ID)),
+			), nil
+		}
+		return sharedlink.Or(
+			sharedlink.ResourceNameGT(c.Value),
+			sharedlink.And(sharedlink.ResourceNameEQ(c.Value), sharedlink.IDGT(c.ID)),
+		), nil
+
+	case SharedLinkSortRecipientEmail:
+		if s.Desc {
+			return sharedlink.Or(
+				sharedlink.RecipientEmailLT(c.Value),
+				sharedlink.And(sharedlink.RecipientEmailEQ(c.Value), sharedlink.IDLT(c.ID)),
+			), nil
+		}
+		return sharedlink.Or(
+			sharedlink.RecipientEmailGT(c.Value),
+			sharedlink.And(sharedlink.RecipientEmailEQ(c.Value), sharedlink.IDGT(c.ID)),
+		), nil
+
+	default:
+		return nil, sharingV1.ErrorBadRequest("invalid pageToken")
+	}
+}
+
+// SharedLinkCursor marks the position of the last shared link of a page
+type SharedLinkCursor struct {
+	Field string `json:"f"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    string `json:"i"`
+}
+
+// Encode returns the cursor as an opaque page token
+func (c *SharedLinkCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeSharedLinkCursor parses a page token produced by SharedLinkCursor.Encode
+func DecodeSharedLinkCursor(token string) (*SharedLinkCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, sharingV1.ErrorBadRequest("invalid pageToken")
+	}
+
+	var c SharedLinkCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, sharingV1.ErrorBadRequest("invalid pageToken")
+	}
+	return &c, nil
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestSharedLinkCursorPagination(t *testing.T) {
+	entClient := newTestClient(t)
+	links := &SharedLinkRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+
+	// Shares 1-3 and 4-5 share their creation time, so pages must break ties by ID
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, offset := range []time.Duration{0, 0, 0, time.Hour, time.Hour, 2 * time.Hour} {
+		createTestShare(t, ctx, entClient.Client(), testShare{
+			ID:         fmt.Sprintf("share-%d", i+1),
+			Recipient:  fmt.Sprintf("r%d@example.com", i%2),
+			CreateTime: base.Add(offset),
+		})
+	}
+
+	for _, tc := range []struct {
+		sort SharedLinkSort
+		want []string
+	}{
+		{
+			SharedLinkSort{Field: SharedLinkSortCreateTime},
+			[]string{"share-1", "share-2", "share-3", "share-4", "share-5", "share-6"},
+		},
+		{
+			SharedLinkSort{Field: SharedLinkSortCreateTime, Desc: true},
+			[]string{"share-6", "share-5", "share-4", "share-3", "share-2", "share-1"},
+		},
+		{
+			SharedLinkSort{Field: SharedLinkSortRecipientEmail},
+			[]string{"share-1", "share-3", "share-5", "share-2", "share-4", "share-6"},
+		},
+		{
+			SharedLinkSort{Field: SharedLinkSortRecipientEmail, Desc: true},
+			[]string{"share-6", "share-4", "share-2", "share-5", "share-3", "share-1"},
+		},
+	} {
+		var got []string
+		var cursor *SharedLinkCursor
+		for pages := 0; ; pages++ {
+			if pages > 6 {
+				t.Fatalf("%+v: pagination does not terminate", tc.sort)
+			}
+			list, total, next, err := links.ListByTenant(ctx, tenantA, nil, tc.sort, cursor, 0, 2)
+			if err != nil {
+				t.Fatalf("%+v: ListByTenant: %v", tc.sort, err)
+			}
+			if total != 6 {
+				t.Errorf("%+v: total = %d, want 6", tc.sort, total)
+			}
+			for _, e := range list {
+				got = append(got, e.ID)
+			}
+			if next == nil {
+				break
+			}
+
+			// Cursors travel as opaque page tokens
+			if cursor, err = DecodeSharedLinkCursor(next.Encode()); err != nil {
+				t.Fatalf("%+v: decode page token: %v", tc.sort, err)
+			}
+			if !reflect.DeepEqual(cursor, next) {
+				t.Fatalf("%+v: page token round trip = %+v, want %+v", tc.sort, cursor, next)
+			}
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%+v: pages = %v, want %v", tc.sort, got, tc.want)
+		}
+	}
+
+	// The last page has no next cursor
+	if _, _, next, err := links.ListByTenant(ctx, tenantA, nil, SharedLinkSort{Field: SharedLinkSortCreateTime}, nil, 0, 6); err != nil || next != nil {
+		t.Errorf("single page: next = %+v, %v; want nil", next, err)
+	}
+}
+
+func TestSharedLinkCursorInvalid(t *testing.T) {
+	for _, token := range []string{
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		base64.RawURLEncoding.EncodeToString([]byte(`{"f":"create_time","v":"2026-10-01T12:00:00Z"}`)),
+	} {
+		if _, err := DecodeSharedLinkCursor(token); !sharingV1.IsBadRequest(err) {
+			t.Errorf("DecodeSharedLinkCursor(%q) error = %v, want BadRequest", token, err)
+		}
+	}
+
+	links := &SharedLinkRepo{entClient: newTestClient(t), log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+	byTime := SharedLinkSort{Field: SharedLinkSortCreateTime}
+
+	for name, cursor := range map[string]*SharedLinkCursor{
+		"other field":     {Field: SharedLinkSortResourceName, Value: "x", ID: "share-1"},
+		"other direction": {Field: SharedLinkSortCreateTime, Desc: true, Value: "2026-10-01T12:00:00Z", ID: "share-1"},
+		"bad time":        {Field: SharedLinkSortCreateTime, Value: "yesterday", ID: "share-1"},
+	} {
+		if _, _, _, err := links.ListByTenant(ctx, tenantA, nil, byTime, cursor, 0, 2); !sharingV1.IsBadRequest(err) {
+			t.Errorf("%s: ListByTenant error = %v, want BadRequest", name, err)
+		}
+	}
+}
@@ -13,10 +13,60 @@ import (
 
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
 )
 
+// UpstreamAuthorization records the upstream decision that allowed a user to share a resource
+type UpstreamAuthorization struct {
+	// UserID is the user the upstream service authorized
+	UserID uint32
+	// Via names the upstream service and permission, e.g. "warden:share"
+	Via string
+	// At is when the upstream service authorized the read
+	At time.Time
+}
+
+// SharedLinkInput holds the attributes of a shared link to create
+type SharedLinkInput struct {
+	TenantID         uint32
+	ResourceType     string
+	ResourceID       string
+	ResourceName     string
+	Token            string
+	EncryptedContent []byte
+	Nonce            []byte
+	RecipientEmail   string
+	SenderEmail      string
+	Message          string
+	TemplateID       string
+	ExpiresAt        *time.Time
+	CreatedBy        *uint32
+	// Authorization records the upstream decision that allowed the share, if any
+	Authorization *UpstreamAuthorization
+	PolicyMode    string
+	PolicyDefault string
+	// MaxViews is the number of views allowed, 0 for the single view default
+	MaxViews   int32
+	BindDevice bool
+	// PassphraseHash is the hash of the passphrase required to open the share, if any
+	PassphraseHash string
+
+	// Policies and PolicySetIDs are created with the link, in the same transaction
+	Policies     []SharePolicyInput
+	PolicySetIDs []string
+}
+
+// SharePolicyInput holds the attributes of a share policy to create
+type SharePolicyInput struct {
+	Type     string
+	Method   string
+	Value    string
+	Reason   string
+	Priority int32
+}
+
 // SharedLinkRepo handles database operations for shared links
 type SharedLinkRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
@@ -31,45 +81,96 @@ func NewSharedLinkRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent
 	}
 }
 
-// Create creates a new shared link
-func (r *SharedLinkRepo) Create(ctx context.Context, tenantID uint32, resourceType, resourceID, resourceName, token string, encryptedContent, nonce []byte, recipientEmail, message, templateID string, createdBy *uint32) (*ent.SharedLink, error) {
+// Create creates a new shared link together with its policies and policy set
+// references. Either all of them are created or none.
+func (r *SharedLinkRepo) Create(ctx context.Context, in *SharedLinkInput) (*ent.SharedLink, error) {
 	id := uuid.New().String()
 
-	builder := r.entClient.Client().SharedLink.Create().
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create shared link failed")
+	}
+
+	builder := tx.SharedLink.Create().
 		SetID(id).
-		SetTenantID(tenantID).
-		SetResourceType(sharedlink.ResourceType(resourceType)).
-		SetResourceID(resourceID).
-		SetResourceName(resourceName).
-		SetToken(token).
-		SetEncryptedContent(encryptedContent).
-		SetEncryptionNonce(nonce).
-		SetRecipientEmail(recipientEmail).
+		SetTenantID(in.TenantID).
+		SetResourceType(sharedlink.ResourceType(in.ResourceType)).
+		SetResourceID(in.ResourceID).
+		SetResourceName(in.ResourceName).
+		SetToken(in.Token).
+		SetEncryptedContent(in.EncryptedContent).
+		SetEncryptionNonce(in.Nonce).
+		SetRecipientEmail(in.RecipientEmail).
 		SetViewed(false).
 		SetRevoked(false).
+		SetBindDevice(in.BindDevice).
 		SetCreateTime(time.Now())
 
-	if message != "" {
-		builder.SetMessage(message)
+	if in.MaxViews > 0 {
+		builder.SetMaxViews(in.MaxViews)
 	}
-	if templateID != "" {
-		builder.SetTemplateID(templateID)
+	if in.PolicyMode != "" {
+		builder.SetPolicyMode(sharedlink.PolicyMode(in.PolicyMode))
 	}
-	if createdBy != nil {
-		builder.SetCreateBy(*createdBy)
+	if in.PolicyDefault != "" {
+		builder.SetPolicyDefault(sharedlink.PolicyDefault(in.PolicyDefault))
+	}
+	if in.SenderEmail != "" {
+		builder.SetSenderEmail(in.SenderEmail)
+	}
+	if in.Message != "" {
+		builder.SetMessage(in.Message)
+	}
+	if in.TemplateID != "" {
+		builder.SetTemplateID(in.TemplateID)
+	}
+	if in.PassphraseHash != "" {
+		builder.SetPassphraseHash(in.PassphraseHash)
+	}
+	if in.ExpiresAt != nil {
+		builder.SetExpiresAt(*in.ExpiresAt)
+	}
+	if in.CreatedBy != nil {
+		builder.SetCreateBy(*in.CreatedBy)
+	}
+	if in.Authorization != nil {
+		builder.
+			SetAuthorizedBy(in.Authorization.UserID).
+			SetAuthorizedVia(in.Authorization.Via).
+			SetAuthorizedAt(in.Authorization.At)
 	}
 
 	entity, err := builder.Save(ctx)
+	for _, p := range in.Policies {
+		if err != nil {
+			break
+		}
+		_, err = newSharePolicyCreate(tx.SharePolicy, in.TenantID, id, p, in.CreatedBy).Save(ctx)
+	}
+	if err == nil {
+		err = createShareLinkSets(ctx, tx, in.TenantID, id, in.PolicySetIDs, in.CreatedBy)
+	}
 	if err != nil {
+		_ = tx.Rollback()
 		r.log.Errorf("create shared link failed: %s", err.Error())
 		return nil, sharingV1.ErrorInternalServerError("create shared link failed")
 	}
 
-	return entity, nil
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit shared link failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create shared link failed")
+	}
+	return entity.Unwrap(), nil
 }
 
-// GetByToken retrieves a shared link by token
+// GetByToken retrieves a shared link by token.
+// Tokens failing the offline checksum check are treated as not found without querying the database.
 func (r *SharedLinkRepo) GetByToken(ctx context.Context, token string) (*ent.SharedLink, error) {
+	if !crypto.ValidateToken(token) {
+		return nil, nil
+	}
+
 	entity, err := r.entClient.Client().SharedLink.Query().
 		Where(sharedlink.TokenEQ(token)).
 		Only(ctx)
@@ -98,17 +199,17 @@ func (r *SharedLinkRepo) GetByID(ctx context.Context, id string) (*ent.SharedLin
 	return entity, nil
 }
 
-// ListByTenant lists shared links for a tenant with pagination
-func (r *SharedLinkRepo) ListByTenant(ctx context.Context, tenantID uint32, resourceType *string, recipientEmail *string, page, pageSize uint32) ([]*ent.SharedLink, int, error) {
-	query := r.entClient.Client().SharedLink.Query().
-		Where(sharedlink.TenantIDEQ(tenantID))
-
-	if resourceType != nil && *resourceType != "" {
-		query = query.Where(sharedlink.ResourceTypeEQ(sharedlink.ResourceType(*resourceType)))
+// ListByIDs lists the shared links with the given IDs, newest first, optionally
+// restricted to those created by createdBy
+func (r *SharedLinkRepo) ListByIDs(ctx context.Context, ids []string, createdBy *uint32, page, pageSize uint32) ([]*ent.SharedLink, int, error) {
+	if len(ids) == 0 {
+		return nil, 0, nil
 	}
 
-	if recipientEmail != nil && *recipientEmail != "" {
-		query = query.Where(sharedlink.RecipientEmailEQ(*recipientEmail))
+	query := r.entClient.Client().SharedLink.Query().
+		Where(sharedlink.IDIn(ids...))
+	if createdBy != nil {
+		query = query.Where(sharedlink.CreateByEQ(*createdBy))
 	}
 
 	total, err := query.Clone().Count(ctx)
@@ -118,8 +219,7 @@ func (r *SharedLinkRepo) ListByTenant(ctx context.Context, tenantID uint32, reso
 	}
 
 	if page > 0 && pageSize > 0 {
-		offset := int((page - 1) * pageSize)
-		query = query.Offset(offset).Limit(int(pageSize))
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
 	}
 
 	entities, err := query.
@@ -133,27 +233,205 @@ func (r *SharedLinkRepo) ListByTenant(ctx context.Context, tenantID uint32, reso
 	return entities, total, nil
 }
 
-// MarkViewed marks a shared link as viewed and clears the encrypted content
-func (r *SharedLinkRepo) MarkViewed(ctx context.Context, id string, viewerIP string) error {
-	now := time.Now()
-	builder := r.entClient.Client().SharedLink.UpdateOneID(id).
-		SetViewed(true).
-		SetViewedAt(now).
-		ClearEncryptedContent().
-		ClearEncryptionNonce()
+// ListByTenant lists shared links for a tenant with filtering and sorting.
+// When cursor is set, keyset pagination continues after it and page is ignored;
+// otherwise page selects an offset page. It returns the shares, the total number
+// of shares matching the filter and, when more results exist, the cursor for the next page.
+func (r *SharedLinkRepo) ListByTenant(ctx context.Context, tenantID uint32, filter *SharedLinkFilter, sort SharedLinkSort, cursor *SharedLinkCursor, page, pageSize uint32) ([]*ent.SharedLink, int, *SharedLinkCursor, error) {
+	query := r.entClient.Client().SharedLink.Query().
+		Where(sharedlink.TenantIDEQ(tenantID))
+
+	if filter != nil {
+		query = query.Where(filter.predicates(time.Now())...)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count shared links failed: %s", err.Error())
+		return nil, 0, nil, sharingV1.ErrorInternalServerError("count shared links failed")
+	}
+
+	if cursor != nil {
+		p, err := sort.after(cursor)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		query = query.Where(p)
+	} else if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize))
+	}
+
+	// Fetch one extra row to find out whether there is a next page
+	if pageSize > 0 {
+		query = query.Limit(int(pageSize) + 1)
+	}
+
+	entities, err := query.
+		Order(sort.order()...).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list shared links failed: %s", err.Error())
+		return nil, 0, nil, sharingV1.ErrorInternalServerError("list shared links failed")
+	}
+
+	var next *SharedLinkCursor
+	if pageSize > 0 && len(entities) > int(pageSize) {
+		entities = entities[:pageSize]
+		next = sort.cursor(entities[len(entities)-1])
+	}
+
+	return entities, total, next, nil
+}
 
+// RecordView counts a view of a shared link. The first view sets viewed_at,
+// the last allowed view marks the link as viewed and clears the encrypted
+// content. A non-empty deviceBinding binds the link to the device of the view.
+//
+// The update only applies while the view count is the one entity was read
+// with, so concurrent views cannot exceed the limit: the losing view gets
+// ErrorShareAlreadyViewed.
+func (r *SharedLinkRepo) RecordView(ctx context.Context, entity *ent.SharedLink, viewerIP, deviceBinding string) error {
+	now := time.Now()
+	builder := r.entClient.Client().SharedLink.Update().
+		Where(
+			sharedlink.IDEQ(entity.ID),
+			sharedlink.ViewedEQ(false),
+			sharedlink.ViewCountEQ(entity.ViewCount),
+		).
+		AddViewCount(1)
+
+	if entity.ViewCount == 0 {
+		builder.SetViewedAt(now)
+	}
+	if entity.ViewCount+1 >= entity.MaxViews {
+		builder.
+			SetViewed(true).
+			ClearEncryptedContent().
+			ClearEncryptionNonce()
+	}
 	if viewerIP != "" {
 		builder.SetViewedIP(viewerIP)
 	}
+	if deviceBinding != "" {
+		builder.
+			SetDeviceBinding(deviceBinding).
+			SetDeviceBoundAt(now)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("record shared link view failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("record shared link view failed")
+	}
+	if n == 0 {
+		return sharingV1.ErrorShareAlreadyViewed("this share has already been viewed")
+	}
+	return nil
+}
+
+// ListExpiredUnnotified returns unviewed, unrevoked shares that expired before now
+// and whose sender has not yet been notified, leaving out shares whose next
+// notification attempt is not due and those that ran out of attempts
+func (r *SharedLinkRepo) ListExpiredUnnotified(ctx context.Context, now time.Time, maxAttempts int32, limit int) ([]*ent.SharedLink, error) {
+	entities, err := r.entClient.Client().SharedLink.Query().
+		Where(
+			sharedlink.ExpiresAtNotNil(),
+			sharedlink.ExpiresAtLTE(now),
+			sharedlink.ViewedEQ(false),
+			sharedlink.RevokedEQ(false),
+			sharedlink.ExpiryNotifiedEQ(false),
+			sharedlink.ExpiryNoticeAttemptsLT(maxAttempts),
+			sharedlink.Or(
+				sharedlink.ExpiryNoticeNextAttemptAtIsNil(),
+				sharedlink.ExpiryNoticeNextAttemptAtLTE(now),
+			),
+		).
+		Order(ent.Asc(sharedlink.FieldExpiresAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expired shared links failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list expired shared links failed")
+	}
+	return entities, nil
+}
+
+// MarkExpired clears the encrypted content of an expired share
+func (r *SharedLinkRepo) MarkExpired(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		ClearEncryptedContent().
+		ClearEncryptionNonce().
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark shared link expired failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("mark shared link expired failed")
+	}
+	return nil
+}
 
+// RecordExpiryNoticeAttempt counts an attempt to notify the sender that a share
+// expired and schedules the next one at nextAttemptAt, or none when it is nil
+func (r *SharedLinkRepo) RecordExpiryNoticeAttempt(ctx context.Context, id string, nextAttemptAt *time.Time) error {
+	builder := r.entClient.Client().SharedLink.UpdateOneID(id).
+		AddExpiryNoticeAttempts(1)
+	if nextAttemptAt != nil {
+		builder.SetExpiryNoticeNextAttemptAt(*nextAttemptAt)
+	} else {
+		builder.ClearExpiryNoticeNextAttemptAt()
+	}
 	_, err := builder.Save(ctx)
 	if err != nil {
-		r.log.Errorf("mark shared link viewed failed: %s", err.Error())
-		return sharingV1.ErrorInternalServerError("mark shared link viewed failed")
+		r.log.Errorf("record expiry notice attempt failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("record expiry notice attempt failed")
+	}
+	return nil
+}
+
+// MarkExpiryNotified flags the sender of an expired share as notified, which
+// takes the share out of the expiry sweep
+func (r *SharedLinkRepo) MarkExpiryNotified(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		SetExpiryNotified(true).
+		ClearExpiryNoticeNextAttemptAt().
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark shared link expiry notified failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("mark shared link expiry notified failed")
 	}
 	return nil
 }
 
+// CountActiveByTenant counts unviewed, unrevoked and unexpired shares grouped by tenant
+func (r *SharedLinkRepo) CountActiveByTenant(ctx context.Context, now time.Time) (map[uint32]int, error) {
+	var rows []struct {
+		TenantID *uint32 `json:"tenant_id"`
+		Count    int     `json:"count"`
+	}
+
+	err := r.entClient.Client().SharedLink.Query().
+		Where(
+			sharedlink.ViewedEQ(false),
+			sharedlink.RevokedEQ(false),
+			sharedlink.Or(
+				sharedlink.ExpiresAtIsNil(),
+				sharedlink.ExpiresAtGT(now),
+			),
+		).
+		GroupBy(sharedlink.FieldTenantID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("count active shared links failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("count active shared links failed")
+	}
+
+	counts := make(map[uint32]int, len(rows))
+	for _, row := range rows {
+		counts[derefUint32(row.TenantID)] += row.Count
+	}
+	return counts, nil
+}
+
 // Revoke revokes a shared link and clears the encrypted content
 func (r *SharedLinkRepo) Revoke(ctx context.Context, id string) error {
 	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
@@ -171,6 +449,123 @@ func (r *SharedLinkRepo) Revoke(ctx context.Context, id string) error {
 	return nil
 }
 
+// SetPolicyMode changes how the policies of a shared link are combined
+func (r *SharedLinkRepo) SetPolicyMode(ctx context.Context, id, policyMode, policyDefault string) (*ent.SharedLink, error) {
+	entity, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		SetPolicyMode(sharedlink.PolicyMode(policyMode)).
+		SetPolicyDefault(sharedlink.PolicyDefault(policyDefault)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, sharingV1.ErrorShareNotFound("share not found")
+		}
+		r.log.Errorf("set shared link policy mode failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("set share policy mode failed")
+	}
+	return entity, nil
+}
+
+// ResetDeviceBinding forgets the device a shared link is bound to
+func (r *SharedLinkRepo) ResetDeviceBinding(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		ClearDeviceBinding().
+		ClearDeviceBoundAt().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return sharingV1.ErrorShareNotFound("share not found")
+		}
+		r.log.Errorf("reset shared link device binding failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("reset share device binding failed")
+	}
+	return nil
+}
+
+// SetVerificationCode stores a new email verification code for a share,
+// replacing any pending one and resetting the step-up attempts
+func (r *SharedLinkRepo) SetVerificationCode(ctx context.Context, id, codeHash string, expiresAt time.Time) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		SetVerificationCodeHash(codeHash).
+		SetVerificationCodeExpiresAt(expiresAt).
+		SetStepUpAttempts(0).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return sharingV1.ErrorShareNotFound("share not found")
+		}
+		r.log.Errorf("set shared link verification code failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("set share verification code failed")
+	}
+	return nil
+}
+
+// UseStepUpAttempt counts a step-up answer before it is checked. It reports
+// false once maxAttempts answers were given, also when answers race each other.
+func (r *SharedLinkRepo) UseStepUpAttempt(ctx context.Context, id string, maxAttempts int32) (bool, error) {
+	n, err := r.entClient.Client().SharedLink.Update().
+		Where(
+			sharedlink.IDEQ(id),
+			sharedlink.StepUpAttemptsLT(maxAttempts),
+		).
+		AddStepUpAttempts(1).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("use shared link step-up attempt failed: %s", err.Error())
+		return false, sharingV1.ErrorInternalServerError("use share step-up attempt failed")
+	}
+	return n > 0, nil
+}
+
+// UsePassphraseAttempt counts a passphrase answer before it is checked. It
+// reports false once maxAttempts answers were given: new verification codes do
+// not grant new ones, only passing a step-up challenge does.
+func (r *SharedLinkRepo) UsePassphraseAttempt(ctx context.Context, id string, maxAttempts int32) (bool, error) {
+	n, err := r.entClient.Client().SharedLink.Update().
+		Where(
+			sharedlink.IDEQ(id),
+			sharedlink.PassphraseAttemptsLT(maxAttempts),
+		).
+		AddPassphraseAttempts(1).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("use shared link passphrase attempt failed: %s", err.Error())
+		return false, sharingV1.ErrorInternalServerError("use share passphrase attempt failed")
+	}
+	return n > 0, nil
+}
+
+// MarkStepUpChallenged records that an access attempt was asked to step up
+func (r *SharedLinkRepo) MarkStepUpChallenged(ctx context.Context, id string, at time.Time) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		SetStepUpChallengedAt(at).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return sharingV1.ErrorShareNotFound("share not found")
+		}
+		r.log.Errorf("mark shared link step-up challenge failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("mark share step-up challenge failed")
+	}
+	return nil
+}
+
+// ClearStepUp discards the pending challenge, verification code and step-up
+// attempts once a step-up challenge was passed
+func (r *SharedLinkRepo) ClearStepUp(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().SharedLink.UpdateOneID(id).
+		ClearStepUpChallengedAt().
+		ClearVerificationCodeHash().
+		ClearVerificationCodeExpiresAt().
+		SetStepUpAttempts(0).
+		SetPassphraseAttempts(0).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("clear shared link step-up failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("clear share step-up failed")
+	}
+	return nil
+}
+
 // ToProto converts an ent.SharedLink to sharingV1.SharedLink
 func (r *SharedLinkRepo) ToProto(entity *ent.SharedLink) *sharingV1.SharedLink {
 	if entity == nil {
@@ -184,9 +579,18 @@ func (r *SharedLinkRepo) ToProto(entity *ent.SharedLink) *sharingV1.SharedLink {
 		ResourceName:   entity.ResourceName,
 		Token:          entity.Token,
 		RecipientEmail: entity.RecipientEmail,
+		SenderEmail:    entity.SenderEmail,
 		Message:        entity.Message,
 		Viewed:         entity.Viewed,
 		Revoked:        entity.Revoked,
+		AuthorizedBy:   entity.AuthorizedBy,
+		AuthorizedVia:  entity.AuthorizedVia,
+		PolicyMode:     sharingV1.SharePolicyMode(sharingV1.SharePolicyMode_value["SHARE_POLICY_MODE_"+string(entity.PolicyMode)]),
+		PolicyDefault:  sharingV1.SharePolicyDefault(sharingV1.SharePolicyDefault_value["SHARE_POLICY_DEFAULT_"+string(entity.PolicyDefault)]),
+		MaxViews:       uint32(entity.MaxViews),
+		ViewCount:      uint32(entity.ViewCount),
+		BindDevice:     entity.BindDevice,
+		HasPassphrase:  entity.PassphraseHash != "",
 	}
 
 	switch entity.ResourceType {
@@ -208,6 +612,18 @@ func (r *SharedLinkRepo) ToProto(entity *ent.SharedLink) *sharingV1.SharedLink {
 		proto.ViewedAt = timestamppb.New(*entity.ViewedAt)
 	}
 
+	if entity.ExpiresAt != nil && !entity.ExpiresAt.IsZero() {
+		proto.ExpiresAt = timestamppb.New(*entity.ExpiresAt)
+	}
+
+	if entity.AuthorizedAt != nil && !entity.AuthorizedAt.IsZero() {
+		proto.AuthorizedAt = timestamppb.New(*entity.AuthorizedAt)
+	}
+
+	if entity.DeviceBoundAt != nil && !entity.DeviceBoundAt.IsZero() {
+		proto.DeviceBoundAt = timestamppb.New(*entity.DeviceBoundAt)
+	}
+
 	return proto
 }
 
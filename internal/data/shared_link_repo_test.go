@@ -0,0 +1,199 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// hasContent reports whether a shared link still holds encrypted content
+func hasContent(entity *ent.SharedLink) bool {
+	return entity.EncryptedContent != nil && len(*entity.EncryptedContent) > 0
+}
+
+func TestRecordView(t *testing.T) {
+	links := &SharedLinkRepo{entClient: newTestClient(t), log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+
+	share, err := links.Create(ctx, &SharedLinkInput{
+		TenantID:         tenantA,
+		ResourceType:     "SECRET",
+		ResourceID:       "secret-1",
+		ResourceName:     "Secret",
+		Token:            "tgs_share",
+		EncryptedContent: []byte("ciphertext"),
+		Nonce:            []byte("nonce"),
+		RecipientEmail:   "bob@example.com",
+		MaxViews:         2,
+		BindDevice:       true,
+	})
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+
+	// The first view binds the share and keeps the content for the second one
+	if err := links.RecordView(ctx, share, "10.0.0.1", "binding"); err != nil {
+		t.Fatalf("first view: %v", err)
+	}
+	first, err := links.GetByID(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if first.Viewed || first.ViewCount != 1 || !hasContent(first) {
+		t.Fatalf("after first view: viewed=%v count=%d content=%v", first.Viewed, first.ViewCount, hasContent(first))
+	}
+	if first.DeviceBinding == nil || *first.DeviceBinding != "binding" || first.DeviceBoundAt == nil {
+		t.Fatal("first view did not bind the share")
+	}
+
+	// A view racing with the first one was read with the old count and loses
+	if err := links.RecordView(ctx, share, "10.0.0.2", ""); !sharingV1.IsShareAlreadyViewed(err) {
+		t.Fatalf("expected ShareAlreadyViewed for a stale view, got %v", err)
+	}
+
+	// The last allowed view consumes the share
+	if err := links.RecordView(ctx, first, "10.0.0.1", ""); err != nil {
+		t.Fatalf("second view: %v", err)
+	}
+	last, err := links.GetByID(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !last.Viewed || last.ViewCount != 2 || hasContent(last) {
+		t.Fatalf("after last view: viewed=%v count=%d content=%v", last.Viewed, last.ViewCount, hasContent(last))
+	}
+
+	if err := links.ResetDeviceBinding(ctx, share.ID); err != nil {
+		t.Fatalf("reset device binding: %v", err)
+	}
+	if reset, _ := links.GetByID(ctx, share.ID); reset.DeviceBinding != nil || reset.DeviceBoundAt != nil {
+		t.Fatal("device binding was not reset")
+	}
+}
+
+func TestStepUpAttempts(t *testing.T) {
+	links := &SharedLinkRepo{entClient: newTestClient(t), log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+
+	share, err := links.Create(ctx, &SharedLinkInput{
+		TenantID:         tenantA,
+		ResourceType:     "SECRET",
+		ResourceID:       "secret-1",
+		ResourceName:     "Secret",
+		Token:            "tgs_share",
+		EncryptedContent: []byte("ciphertext"),
+		Nonce:            []byte("nonce"),
+		RecipientEmail:   "bob@example.com",
+	})
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+	if err := links.SetVerificationCode(ctx, share.ID, "hash", time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("set verification code: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if ok, err := links.UseStepUpAttempt(ctx, share.ID, 3); err != nil || !ok {
+			t.Fatalf("attempt %d: ok=%v err=%v", i+1, ok, err)
+		}
+	}
+	if ok, err := links.UseStepUpAttempt(ctx, share.ID, 3); err != nil || ok {
+		t.Fatalf("attempt beyond the limit: ok=%v err=%v", ok, err)
+	}
+
+	// A new code grants new attempts, passing the challenge clears the code
+	if err := links.SetVerificationCode(ctx, share.ID, "hash2", time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("set verification code: %v", err)
+	}
+	if ok, _ := links.UseStepUpAttempt(ctx, share.ID, 3); !ok {
+		t.Fatal("new code did not reset the attempts")
+	}
+
+	// Passphrase answers are limited until a challenge is passed, whatever the codes
+	for i := 0; i < 3; i++ {
+		if ok, err := links.UsePassphraseAttempt(ctx, share.ID, 3); err != nil || !ok {
+			t.Fatalf("passphrase attempt %d: ok=%v err=%v", i+1, ok, err)
+		}
+	}
+	if err := links.SetVerificationCode(ctx, share.ID, "hash3", time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("set verification code: %v", err)
+	}
+	if ok, err := links.UsePassphraseAttempt(ctx, share.ID, 3); err != nil || ok {
+		t.Fatalf("passphrase attempt after a new code: ok=%v err=%v", ok, err)
+	}
+	if err := links.MarkStepUpChallenged(ctx, share.ID, time.Now()); err != nil {
+		t.Fatalf("mark step-up challenge: %v", err)
+	}
+	if err := links.ClearStepUp(ctx, share.ID); err != nil {
+		t.Fatalf("clear step-up: %v", err)
+	}
+	cleared, err := links.GetByID(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if cleared.VerificationCodeHash != nil || cleared.VerificationCodeExpiresAt != nil || cleared.StepUpAttempts != 0 ||
+		cleared.PassphraseAttempts != 0 || cleared.StepUpChallengedAt != nil {
+		t.Fatal("step-up state was not cleared")
+	}
+}
+
+func TestCreateWithPoliciesIsAtomic(t *testing.T) {
+	entClient := newTestClient(t)
+	links := &SharedLinkRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	sets := &PolicySetRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	policies := &SharePolicyRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+
+	office, err := sets.Create(ctx, tenantA, "Office", "", []schema.PolicyRule{{Type: "WHITELIST", Method: "NETWORK", Value: "10.0.0.0/8"}}, nil)
+	if err != nil {
+		t.Fatalf("create policy set: %v", err)
+	}
+	input := func(token string, p ...SharePolicyInput) *SharedLinkInput {
+		return &SharedLinkInput{
+			TenantID:         tenantA,
+			ResourceType:     "SECRET",
+			ResourceID:       "secret-1",
+			ResourceName:     "Secret",
+			Token:            token,
+			EncryptedContent: []byte("ciphertext"),
+			Nonce:            []byte("nonce"),
+			RecipientEmail:   "bob@example.com",
+			Policies:         p,
+			PolicySetIDs:     []string{office.ID},
+		}
+	}
+
+	share, err := links.Create(ctx, input("tgs_ok", SharePolicyInput{Type: "BLACKLIST", Method: "IP", Value: "10.0.0.66", Priority: 1}))
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+	if list, err := policies.ListByShareLinkID(ctx, share.ID); err != nil || len(list) != 1 || list[0].Value != "10.0.0.66" {
+		t.Fatalf("policies = %v, %v; want the IP blacklist", list, err)
+	}
+	if ids, err := sets.ListSetIDsByShareLinkID(ctx, share.ID); err != nil || len(ids) != 1 || ids[0] != office.ID {
+		t.Fatalf("policy sets = %v, %v; want [%s]", ids, err, office.ID)
+	}
+
+	// A policy that cannot be stored leaves neither the share nor its set references behind
+	_, err = links.Create(ctx, input("tgs_bad", SharePolicyInput{Type: "BLACKLIST", Method: "IP", Value: "10.0.0.66"}, SharePolicyInput{Type: "BLACKLIST", Method: "BOGUS", Value: "x"}))
+	if !sharingV1.IsInternalServerError(err) {
+		t.Fatalf("expected InternalServerError for an invalid policy, got %v", err)
+	}
+	if n, _ := entClient.Client().SharedLink.Query().Count(ctx); n != 1 {
+		t.Errorf("%d shares stored, want only the first", n)
+	}
+	if n, _ := entClient.Client().SharePolicy.Query().Count(ctx); n != 1 {
+		t.Errorf("%d policies stored, want only the first share's", n)
+	}
+	if ids, _ := sets.ListShareLinkIDs(ctx, office.ID); len(ids) != 1 {
+		t.Errorf("policy set referenced by %d shares, want 1", len(ids))
+	}
+}
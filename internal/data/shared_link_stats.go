@@ -0,0 +1,221 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// SharedLinkKeyCount is a share count grouped by a string key
+type SharedLinkKeyCount struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// SharedLinkSharerCount is a share count grouped by creator
+type SharedLinkSharerCount struct {
+	UserID      *uint32 `json:"user_id"`
+	SenderEmail string  `json:"sender_email"`
+	Count       int     `json:"count"`
+}
+
+// SharedLinkStats holds aggregated statistics over the shares of a tenant
+type SharedLinkStats struct {
+	Active  int
+	Viewed  int
+	Revoked int
+	Expired int
+
+	ByResourceType      []SharedLinkKeyCount
+	PerDay              []SharedLinkKeyCount
+	MedianSecondsToView float64
+	TopRecipients       []SharedLinkKeyCount
+	TopDomains          []SharedLinkKeyCount
+	TopSharers          []SharedLinkSharerCount
+}
+
+// Total returns the number of shares across all statuses
+func (s *SharedLinkStats) Total() int {
+	return s.Active + s.Viewed + s.Revoked + s.Expired
+}
+
+// Stats computes statistics over the shares of a tenant created in [start, end).
+// All figures are computed with aggregate queries; now decides which shares are expired.
+func (r *SharedLinkRepo) Stats(ctx context.Context, tenantID uint32, start, end, now time.Time, topLimit int) (*SharedLinkStats, error) {
+	query := func(ps ...predicate.SharedLink) *ent.SharedLinkQuery {
+		return r.entClient.Client().SharedLink.Query().
+			Where(
+				sharedlink.TenantIDEQ(tenantID),
+				sharedlink.CreateTimeGTE(start),
+				sharedlink.CreateTimeLT(end),
+			).
+			Where(ps...)
+	}
+
+	stats := &SharedLinkStats{}
+	var err error
+
+	counts := []struct {
+		dst    *int
+		status string
+	}{
+		{&stats.Active, SharedLinkStatusActive},
+		{&stats.Viewed, SharedLinkStatusViewed},
+		{&stats.Revoked, SharedLinkStatusRevoked},
+		{&stats.Expired, SharedLinkStatusExpired},
+	}
+	for _, c := range counts {
+		if *c.dst, err = query(sharedLinkStatusPredicates(c.status, now)...).Count(ctx); err != nil {
+			return nil, r.statsError(err)
+		}
+	}
+
+	var byType []struct {
+		ResourceType string `json:"resource_type"`
+		Count        int    `json:"count"`
+	}
+	if err = query().
+		GroupBy(sharedlink.FieldResourceType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byType); err != nil {
+		return nil, r.statsError(err)
+	}
+	for _, row := range byType {
+		stats.ByResourceType = append(stats.ByResourceType, SharedLinkKeyCount{Key: row.ResourceType, Count: row.Count})
+	}
+
+	if err = query().
+		Modify(func(s *sql.Selector) {
+			day := fmt.Sprintf("DATE(%s)", s.C(sharedlink.FieldCreateTime))
+			s.Select(sql.As(day, "key"), sql.As(sql.Count("*"), "count")).
+				GroupBy(day).
+				OrderBy(day)
+		}).
+		Scan(ctx, &stats.PerDay); err != nil {
+		return nil, r.statsError(err)
+	}
+	// Drivers return dates either as "YYYY-MM-DD" or as a full timestamp
+	for i := range stats.PerDay {
+		if len(stats.PerDay[i].Key) > len("2006-01-02") {
+			stats.PerDay[i].Key = stats.PerDay[i].Key[:len("2006-01-02")]
+		}
+	}
+
+	if stats.MedianSecondsToView, err = r.medianSecondsToView(ctx, query(sharedlink.ViewedAtNotNil())); err != nil {
+		return nil, r.statsError(err)
+	}
+
+	if err = query().
+		Limit(topLimit).
+		Modify(func(s *sql.Selector) {
+			col := s.C(sharedlink.FieldRecipientEmail)
+			s.Select(sql.As(col, "key"), sql.As(sql.Count("*"), "count")).
+				GroupBy(col).
+				OrderBy(sql.Desc(sql.Count("*")), col)
+		}).
+		Scan(ctx, &stats.TopRecipients); err != nil {
+		return nil, r.statsError(err)
+	}
+
+	if err = query().
+		Limit(topLimit).
+		Modify(func(s *sql.Selector) {
+			col := s.C(sharedlink.FieldRecipientEmail)
+			domain := emailDomain(s.Dialect(), col)
+			s.Select(sql.As(domain, "key"), sql.As(sql.Count("*"), "count")).
+				GroupBy(domain).
+				OrderBy(sql.Desc(sql.Count("*")), domain)
+		}).
+		Scan(ctx, &stats.TopDomains); err != nil {
+		return nil, r.statsError(err)
+	}
+
+	if err = query().
+		Limit(topLimit).
+		Modify(func(s *sql.Selector) {
+			col := s.C(sharedlink.FieldCreateBy)
+			s.Select(
+				sql.As(col, "user_id"),
+				sql.As(sql.Max(s.C(sharedlink.FieldSenderEmail)), "sender_email"),
+				sql.As(sql.Count("*"), "count"),
+			).
+				GroupBy(col).
+				OrderBy(sql.Desc(sql.Count("*")), col)
+		}).
+		Scan(ctx, &stats.TopSharers); err != nil {
+		return nil, r.statsError(err)
+	}
+
+	return stats, nil
+}
+
+// medianSecondsToView returns the median time between creation and first view of the
+// shares matched by query, ordering on the database and fetching only the middle rows
+func (r *SharedLinkRepo) medianSecondsToView(ctx context.Context, query *ent.SharedLinkQuery) (float64, error) {
+	n, err := query.Clone().Count(ctx)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	limit := 1
+	if n%2 == 0 {
+		limit = 2
+	}
+
+	var rows []struct {
+		Seconds float64 `json:"seconds"`
+	}
+	err = query.
+		Offset((n-1)/2).
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			duration := secondsBetween(s.Dialect(), s.C(sharedlink.FieldCreateTime), s.C(sharedlink.FieldViewedAt))
+			s.Select(sql.As(duration, "seconds")).OrderBy(duration)
+		}).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+
+	var sum float64
+	for _, row := range rows {
+		sum += row.Seconds
+	}
+	return sum / float64(len(rows)), nil
+}
+
+// secondsBetween returns a SQL expression for the number of seconds from one timestamp column to another
+func secondsBetween(d, from, to string) string {
+	switch d {
+	case dialect.Postgres:
+		return fmt.Sprintf("EXTRACT(EPOCH FROM (%s - %s))", to, from)
+	case dialect.MySQL:
+		return fmt.Sprintf("TIMESTAMPDIFF(SECOND, %s, %s)", from, to)
+	default:
+		return fmt.Sprintf("((JULIANDAY(%s) - JULIANDAY(%s)) * 86400)", to, from)
+	}
+}
+
+// emailDomain returns a SQL expression for the lowercased domain of an email column
+func emailDomain(d, col string) string {
+	switch d {
+	case dialect.Postgres, dialect.MySQL:
+		return fmt.Sprintf("LOWER(SUBSTRING(%s FROM POSITION('@' IN %s) + 1))", col, col)
+	default:
+		return fmt.Sprintf("LOWER(SUBSTR(%s, INSTR(%s, '@') + 1))", col, col)
+	}
+}
+
+func (r *SharedLinkRepo) statsError(err error) error {
+	r.log.Errorf("compute shared link stats failed: %s", err.Error())
+	return sharingV1.ErrorInternalServerError("compute shared link stats failed")
+}
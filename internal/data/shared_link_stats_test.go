@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+)
+
+func TestSharedLinkStats(t *testing.T) {
+	entClient := newTestClient(t)
+	links := &SharedLinkRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	ctxA := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+	ctxB := authz.NewViewerContext(context.Background(), tenantB, 20, nil, nil)
+	client := entClient.Client()
+
+	day1 := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 10, 2, 14, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	at := func(t time.Time) *time.Time { return &t }
+
+	for _, s := range []testShare{
+		// Viewed one and three minutes after creation
+		{Recipient: "a@x.com", CreateBy: 10, SenderEmail: "alice@corp.com", CreateTime: day1, ViewedAt: at(day1.Add(time.Minute))},
+		{Recipient: "b@Y.com", CreateBy: 10, SenderEmail: "alice@corp.com", CreateTime: day1, ViewedAt: at(day1.Add(3 * time.Minute))},
+		{Recipient: "a@x.com", CreateBy: 10, SenderEmail: "alice@corp.com", CreateTime: day2, Revoked: true, ResourceType: "DOCUMENT"},
+		{Recipient: "c@y.com", CreateBy: 11, SenderEmail: "bob@corp.com", CreateTime: day2, ExpiresAt: at(now.Add(-time.Hour))},
+		{Recipient: "d@z.com", CreateBy: 11, SenderEmail: "bob@corp.com", CreateTime: day2, ExpiresAt: at(now.Add(time.Hour))},
+		// Outside of the range
+		{Recipient: "a@x.com", CreateBy: 10, CreateTime: day1.Add(-48 * time.Hour)},
+	} {
+		createTestShare(t, ctxA, client, s)
+	}
+	createTestShare(t, ctxB, client, testShare{Recipient: "a@x.com", CreateBy: 20, CreateTime: day1})
+
+	stats, err := links.Stats(ctxA, tenantA, day1.Add(-time.Hour), day2.Add(time.Hour), now, 2)
+	if err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+
+	if stats.Active != 1 || stats.Viewed != 2 || stats.Revoked != 1 || stats.Expired != 1 || stats.Total() != 5 {
+		t.Errorf("status counts = active %d, viewed %d, revoked %d, expired %d",
+			stats.Active, stats.Viewed, stats.Revoked, stats.Expired)
+	}
+	if want := []SharedLinkKeyCount{{"DOCUMENT", 1}, {"SECRET", 4}}; !sameKeyCounts(stats.ByResourceType, want) {
+		t.Errorf("ByResourceType = %v, want %v", stats.ByResourceType, want)
+	}
+	if want := []SharedLinkKeyCount{{"2026-10-01", 2}, {"2026-10-02", 3}}; !reflect.DeepEqual(stats.PerDay, want) {
+		t.Errorf("PerDay = %v, want %v", stats.PerDay, want)
+	}
+	if stats.MedianSecondsToView < 119.9 || stats.MedianSecondsToView > 120.1 {
+		t.Errorf("MedianSecondsToView = %v, want 120", stats.MedianSecondsToView)
+	}
+
+	// Top lists are ordered by count, then key, and cut at the limit
+	if want := []SharedLinkKeyCount{{"a@x.com", 2}, {"b@Y.com", 1}}; !reflect.DeepEqual(stats.TopRecipients, want) {
+		t.Errorf("TopRecipients = %v, want %v", stats.TopRecipients, want)
+	}
+	if want := []SharedLinkKeyCount{{"x.com", 2}, {"y.com", 2}}; !reflect.DeepEqual(stats.TopDomains, want) {
+		t.Errorf("TopDomains = %v, want %v", stats.TopDomains, want)
+	}
+	if len(stats.TopSharers) != 2 ||
+		stats.TopSharers[0].UserID == nil || *stats.TopSharers[0].UserID != 10 || stats.TopSharers[0].Count != 3 || stats.TopSharers[0].SenderEmail != "alice@corp.com" ||
+		stats.TopSharers[1].UserID == nil || *stats.TopSharers[1].UserID != 11 || stats.TopSharers[1].Count != 2 {
+		t.Errorf("TopSharers = %+v", stats.TopSharers)
+	}
+
+	// A range without shares yields zeros
+	empty, err := links.Stats(ctxA, tenantA, now, now.Add(time.Hour), now, 10)
+	if err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+	if empty.Total() != 0 || empty.MedianSecondsToView != 0 || len(empty.PerDay) != 0 || len(empty.TopSharers) != 0 {
+		t.Errorf("empty range stats = %+v", empty)
+	}
+}
+
+func TestSharedLinkStatsTimeToFirstView(t *testing.T) {
+	entClient := newTestClient(t)
+	links := &SharedLinkRepo{entClient: entClient, log: log.NewHelper(log.DefaultLogger)}
+	ctx := authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil)
+	client := entClient.Client()
+
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	share := createTestShare(t, ctx, client, testShare{Recipient: "a@x.com", CreateBy: 10, CreateTime: created})
+	share, err := client.SharedLink.UpdateOne(share).SetMaxViews(2).Save(ctx)
+	if err != nil {
+		t.Fatalf("set max views: %v", err)
+	}
+
+	// The share is first viewed a minute after its creation, then again now
+	if err := links.RecordView(ctx, share, "", ""); err != nil {
+		t.Fatalf("first view: %v", err)
+	}
+	firstView := created.Add(time.Minute)
+	share, err = client.SharedLink.UpdateOneID(share.ID).SetViewedAt(firstView).Save(ctx)
+	if err != nil {
+		t.Fatalf("set first view time: %v", err)
+	}
+	if err := links.RecordView(ctx, share, "", ""); err != nil {
+		t.Fatalf("second view: %v", err)
+	}
+
+	viewed, err := links.GetByID(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !viewed.Viewed || viewed.ViewedAt == nil || !viewed.ViewedAt.Equal(firstView) {
+		t.Fatalf("after last view: viewed=%v viewed_at=%v, want %v", viewed.Viewed, viewed.ViewedAt, firstView)
+	}
+
+	stats, err := links.Stats(ctx, tenantA, created.Add(-time.Hour), created.Add(time.Hour), time.Now(), 10)
+	if err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+	if stats.MedianSecondsToView < 59.9 || stats.MedianSecondsToView > 60.1 {
+		t.Errorf("MedianSecondsToView = %v, want 60", stats.MedianSecondsToView)
+	}
+}
+
+// sameKeyCounts compares key counts regardless of their order
+func sameKeyCounts(got, want []SharedLinkKeyCount) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	counts := make(map[string]int, len(got))
+	for _, c := range got {
+		counts[c.Key] = c.Count
+	}
+	for _, c := range want {
+		if n, ok := counts[c.Key]; !ok || n != c.Count {
+			return false
+		}
+	}
+	return true
+}
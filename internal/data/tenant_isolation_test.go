@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	tenantA uint32 = 1
+	tenantB uint32 = 2
+)
+
+type isolationFixture struct {
+	links     *SharedLinkRepo
+	policies  *SharePolicyRepo
+	templates *EmailTemplateRepo
+
+	ctxA, ctxB context.Context
+
+	shareB    *ent.SharedLink
+	policyB   *ent.SharePolicy
+	templateB *ent.EmailTemplate
+}
+
+func newIsolationFixture(t *testing.T) *isolationFixture {
+	t.Helper()
+
+	entClient := newTestClient(t)
+	l := log.NewHelper(log.DefaultLogger)
+
+	f := &isolationFixture{
+		links:     &SharedLinkRepo{entClient: entClient, log: l},
+		policies:  &SharePolicyRepo{entClient: entClient, log: l},
+		templates: &EmailTemplateRepo{entClient: entClient, log: l},
+		ctxA:      authz.NewViewerContext(context.Background(), tenantA, 10, nil, nil),
+		ctxB:      authz.NewViewerContext(context.Background(), tenantB, 20, nil, nil),
+	}
+
+	var err error
+	f.shareB, err = f.links.Create(f.ctxB, &SharedLinkInput{
+		TenantID:         tenantB,
+		ResourceType:     "SECRET",
+		ResourceID:       "secret-1",
+		ResourceName:     "Tenant B secret",
+		Token:            "tgs_tenantb",
+		EncryptedContent: []byte("ciphertext"),
+		Nonce:            []byte("nonce"),
+		RecipientEmail:   "bob@example.com",
+	})
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+	f.policyB, err = f.policies.Create(f.ctxB, tenantB, f.shareB.ID, "WHITELIST", "IP", "10.0.0.1", "", 0, nil)
+	if err != nil {
+		t.Fatalf("create policy: %v", err)
+	}
+	f.templateB, err = f.templates.Create(f.ctxB, tenantB, "Tenant B template", "SHARE", "Subject", "<p>Body</p>", "", false, nil)
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+
+	return f
+}
+
+func TestTenantCannotReadOtherTenantShares(t *testing.T) {
+	f := newIsolationFixture(t)
+
+	share, err := f.links.GetByID(f.ctxA, f.shareB.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if share != nil {
+		t.Fatalf("tenant A read tenant B share %s", share.ID)
+	}
+
+	// Asking for another tenant explicitly must not bypass the viewer's scope
+	shares, total, _, err := f.links.ListByTenant(f.ctxA, tenantB, nil, SharedLinkSort{Field: SharedLinkSortCreateTime}, nil, 0, 0)
+	if err != nil {
+		t.Fatalf("ListByTenant: %v", err)
+	}
+	if len(shares) != 0 || total != 0 {
+		t.Fatalf("tenant A listed %d tenant B shares (total %d)", len(shares), total)
+	}
+
+	share, err = f.links.GetByID(f.ctxB, f.shareB.ID)
+	if err != nil || share == nil {
+		t.Fatalf("tenant B cannot read its own share: %v", err)
+	}
+}
+
+func TestTenantCannotRevokeOtherTenantShares(t *testing.T) {
+	f := newIsolationFixture(t)
+
+	err := f.links.Revoke(f.ctxA, f.shareB.ID)
+	if !sharingV1.IsShareNotFound(err) {
+		t.Fatalf("expected ShareNotFound revoking another tenant's share, got %v", err)
+	}
+
+	share, err := f.links.GetByID(f.ctxB, f.shareB.ID)
+	if err != nil || share == nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if share.Revoked || share.EncryptedContent == nil {
+		t.Fatal("tenant B share was revoked by tenant A")
+	}
+
+	if err := f.links.Revoke(f.ctxB, f.shareB.ID); err != nil {
+		t.Fatalf("tenant B cannot revoke its own share: %v", err)
+	}
+}
+
+func TestTenantCannotAccessOtherTenantPolicies(t *testing.T) {
+	f := newIsolationFixture(t)
+
+	policies, err := f.policies.ListByShareLinkID(f.ctxA, f.shareB.ID)
+	if err != nil {
+		t.Fatalf("ListByShareLinkID: %v", err)
+	}
+	if len(policies) != 0 {
+		t.Fatalf("tenant A listed %d tenant B policies", len(policies))
+	}
+
+	if err := f.policies.Delete(f.ctxA, f.shareB.ID, f.policyB.ID); !sharingV1.IsNotFound(err) {
+		t.Fatalf("expected NotFound deleting another tenant's policy, got %v", err)
+	}
+
+	policies, err = f.policies.ListByShareLinkID(f.ctxB, f.shareB.ID)
+	if err != nil || len(policies) != 1 {
+		t.Fatalf("tenant B policy was deleted by tenant A: %d policies, %v", len(policies), err)
+	}
+}
+
+func TestTenantCannotAccessOtherTenantTemplates(t *testing.T) {
+	f := newIsolationFixture(t)
+
+	tmpl, err := f.templates.GetByID(f.ctxA, f.templateB.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if tmpl != nil {
+		t.Fatalf("tenant A read tenant B template %s", tmpl.ID)
+	}
+
+	if err := f.templates.Delete(f.ctxA, f.templateB.ID); !sharingV1.IsTemplateNotFound(err) {
+		t.Fatalf("expected TemplateNotFound deleting another tenant's template, got %v", err)
+	}
+
+	tmpl, err = f.templates.GetByID(f.ctxB, f.templateB.ID)
+	if err != nil || tmpl == nil {
+		t.Fatalf("tenant B template was deleted by tenant A: %v", err)
+	}
+}
+
+func TestTenantCreatesAreForcedIntoViewerTenant(t *testing.T) {
+	f := newIsolationFixture(t)
+
+	share, err := f.links.Create(f.ctxA, &SharedLinkInput{
+		TenantID:         tenantB,
+		ResourceType:     "SECRET",
+		ResourceID:       "secret-2",
+		ResourceName:     "Smuggled",
+		Token:            "tgs_smuggled",
+		EncryptedContent: []byte("ciphertext"),
+		Nonce:            []byte("nonce"),
+		RecipientEmail:   "eve@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got := derefUint32(share.TenantID); got != tenantA {
+		t.Fatalf("share created by tenant A landed in tenant %d", got)
+	}
+}
@@ -12,10 +12,15 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	grpcMD "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/metrics"
 
 	wardenV1 "github.com/go-tangra/go-tangra-warden/gen/go/warden/service/v1"
 )
@@ -104,11 +109,21 @@ func NewWardenClient(ctx *bootstrap.Context) (*WardenClient, func(), error) {
 	return client, cleanup, nil
 }
 
+// UpstreamSharePermission is the permission Warden and Paperless must grant the
+// caller on a secret or document before it may be shared
+const UpstreamSharePermission = "share"
+
+// requiredPermissionKey is the metadata key naming the permission upstream
+// services must authorize for the forwarded user
+const requiredPermissionKey = "x-md-global-required-permission"
+
 // forwardMetadata builds outgoing gRPC metadata by forwarding relevant headers
-// from the incoming context (tenant ID, user ID, username, roles).
+// from the incoming context (tenant ID, user ID, username, roles) and asks the
+// upstream service to authorize the share permission for that user.
 func forwardMetadata(ctx context.Context, tenantID uint32) context.Context {
 	outMD := grpcMD.New(map[string]string{
 		"x-md-global-tenant-id": fmt.Sprintf("%d", tenantID),
+		requiredPermissionKey:   UpstreamSharePermission,
 	})
 
 	// Forward user identity from incoming context so upstream permission checks pass
@@ -120,9 +135,29 @@ func forwardMetadata(ctx context.Context, tenantID uint32) context.Context {
 		}
 	}
 
+	// The caller's viewer is authoritative for the user upstream authorizes
+	if userID := authz.CallerID(ctx); userID != 0 {
+		outMD.Set("x-md-global-user-id", fmt.Sprintf("%d", userID))
+	}
+
 	return grpcMD.NewOutgoingContext(ctx, outMD)
 }
 
+// IsUpstreamAccessDenied reports whether an upstream service refused to
+// authorize the forwarded user for the request
+func IsUpstreamAccessDenied(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetSecret retrieves secret metadata from Warden
 func (c *WardenClient) GetSecret(ctx context.Context, tenantID uint32, secretID string) (*wardenV1.Secret, error) {
 	if c == nil || c.conn == nil {
@@ -131,7 +166,9 @@ func (c *WardenClient) GetSecret(ctx context.Context, tenantID uint32, secretID
 
 	ctx = forwardMetadata(ctx, tenantID)
 
+	start := time.Now()
 	resp, err := c.SecretService.GetSecret(ctx, &wardenV1.GetSecretRequest{Id: secretID})
+	metrics.ObserveUpstream(metrics.UpstreamWarden, "GetSecret", start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret from warden: %w", err)
 	}
@@ -146,7 +183,9 @@ func (c *WardenClient) GetSecretPassword(ctx context.Context, tenantID uint32, s
 
 	ctx = forwardMetadata(ctx, tenantID)
 
+	start := time.Now()
 	resp, err := c.SecretService.GetSecretPassword(ctx, &wardenV1.GetSecretPasswordRequest{Id: secretID})
+	metrics.ObserveUpstream(metrics.UpstreamWarden, "GetSecretPassword", start, err)
 	if err != nil {
 		return "", fmt.Errorf("failed to get secret password from warden: %w", err)
 	}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	grpcMD "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+)
+
+func TestForwardMetadataRequestsShareAuthorizationForCaller(t *testing.T) {
+	in := grpcMD.New(map[string]string{
+		"x-md-global-user-id": "99",
+		"x-md-global-roles":   "sharing.operator",
+	})
+	ctx := grpcMD.NewIncomingContext(context.Background(), in)
+	ctx = authz.NewViewerContext(ctx, tenantA, 10, nil, nil)
+
+	out, ok := grpcMD.FromOutgoingContext(forwardMetadata(ctx, tenantA))
+	if !ok {
+		t.Fatal("no outgoing metadata")
+	}
+
+	want := map[string]string{
+		"x-md-global-tenant-id": "1",
+		"x-md-global-user-id":   "10",
+		"x-md-global-roles":     "sharing.operator",
+		requiredPermissionKey:   UpstreamSharePermission,
+	}
+	for key, v := range want {
+		if got := out.Get(key); len(got) != 1 || got[0] != v {
+			t.Errorf("%s = %v, want %q", key, got, v)
+		}
+	}
+}
+
+func TestIsUpstreamAccessDenied(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.PermissionDenied, "no share permission"), true},
+		{fmt.Errorf("failed to get secret from warden: %w", status.Error(codes.Unauthenticated, "")), true},
+		{fmt.Errorf("failed to get secret from warden: %w", status.Error(codes.Unavailable, "")), false},
+		{fmt.Errorf("warden client not available"), false},
+	} {
+		if got := IsUpstreamAccessDenied(tc.err); got != tc.want {
+			t.Errorf("IsUpstreamAccessDenied(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhookdelivery"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// WebhookDeliveryRepo handles database operations for webhook deliveries
+type WebhookDeliveryRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewWebhookDeliveryRepo creates a new WebhookDeliveryRepo
+func NewWebhookDeliveryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/webhook_delivery"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a pending delivery. nextAttemptAt is when the retry worker may pick it up,
+// leaving the caller time to make the first attempt itself.
+func (r *WebhookDeliveryRepo) Create(ctx context.Context, tenantID uint32, webhookID, eventID, eventType string, payload []byte, nextAttemptAt time.Time) (*ent.WebhookDelivery, error) {
+	now := time.Now()
+
+	entity, err := r.entClient.Client().WebhookDelivery.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetWebhookID(webhookID).
+		SetEventID(eventID).
+		SetEventType(eventType).
+		SetPayload(string(payload)).
+		SetStatus(webhookdelivery.StatusPENDING).
+		SetNextAttemptAt(nextAttemptAt).
+		SetCreateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("create webhook delivery failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create webhook delivery failed")
+	}
+
+	return entity, nil
+}
+
+// ListDue returns pending deliveries whose next attempt is due
+func (r *WebhookDeliveryRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.WebhookDelivery, error) {
+	entities, err := r.entClient.Client().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPENDING),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due webhook deliveries failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list due webhook deliveries failed")
+	}
+	return entities, nil
+}
+
+// MarkSucceeded records a successful attempt
+func (r *WebhookDeliveryRepo) MarkSucceeded(ctx context.Context, id string, attempts, responseStatus int32) error {
+	_, err := r.entClient.Client().WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusSUCCEEDED).
+		SetAttempts(attempts).
+		SetResponseStatus(responseStatus).
+		ClearLastError().
+		ClearNextAttemptAt().
+		SetDeliveredAt(time.Now()).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark webhook delivery succeeded failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("update webhook delivery failed")
+	}
+	return nil
+}
+
+// MarkAttemptFailed records a failed attempt. A nil nextAttemptAt marks the delivery as failed for good.
+func (r *WebhookDeliveryRepo) MarkAttemptFailed(ctx context.Context, id string, attempts, responseStatus int32, lastError string, nextAttemptAt *time.Time) error {
+	builder := r.entClient.Client().WebhookDelivery.UpdateOneID(id).
+		SetAttempts(attempts).
+		SetLastError(truncate(lastError, 1024)).
+		SetUpdateTime(time.Now())
+
+	if responseStatus > 0 {
+		builder.SetResponseStatus(responseStatus)
+	}
+	if nextAttemptAt != nil {
+		builder.SetNextAttemptAt(*nextAttemptAt)
+	} else {
+		builder.SetStatus(webhookdelivery.StatusFAILED).
+			ClearNextAttemptAt()
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		r.log.Errorf("mark webhook delivery failed failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("update webhook delivery failed")
+	}
+	return nil
+}
+
+// ListByTenant lists webhook deliveries for a tenant with filtering and pagination
+func (r *WebhookDeliveryRepo) ListByTenant(ctx context.Context, tenantID uint32, webhookID, status, eventType *string, page, pageSize uint32) ([]*ent.WebhookDelivery, int, error) {
+	query := r.entClient.Client().WebhookDelivery.Query().
+		Where(webhookdelivery.TenantIDEQ(tenantID))
+
+	if webhookID != nil && *webhookID != "" {
+		query = query.Where(webhookdelivery.WebhookIDEQ(*webhookID))
+	}
+	if status != nil && *status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(*status)))
+	}
+	if eventType != nil && *eventType != "" {
+		query = query.Where(webhookdelivery.EventTypeEQ(*eventType))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook deliveries failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("count webhook deliveries failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook deliveries failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("list webhook deliveries failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.WebhookDelivery to sharingV1.WebhookDelivery
+func (r *WebhookDeliveryRepo) ToProto(entity *ent.WebhookDelivery) *sharingV1.WebhookDelivery {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &sharingV1.WebhookDelivery{
+		Id:             entity.ID,
+		WebhookId:      entity.WebhookID,
+		EventId:        entity.EventID,
+		EventType:      entity.EventType,
+		Attempts:       entity.Attempts,
+		ResponseStatus: entity.ResponseStatus,
+		LastError:      entity.LastError,
+	}
+
+	switch entity.Status {
+	case webhookdelivery.StatusPENDING:
+		proto.Status = sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhookdelivery.StatusSUCCEEDED:
+		proto.Status = sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhookdelivery.StatusFAILED:
+		proto.Status = sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	}
+
+	if entity.NextAttemptAt != nil && !entity.NextAttemptAt.IsZero() {
+		proto.NextAttemptAt = timestamppb.New(*entity.NextAttemptAt)
+	}
+	if entity.DeliveredAt != nil && !entity.DeliveredAt.IsZero() {
+		proto.DeliveredAt = timestamppb.New(*entity.DeliveredAt)
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,202 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/webhook"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// WebhookRepo handles database operations for webhooks
+type WebhookRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewWebhookRepo creates a new WebhookRepo
+func NewWebhookRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WebhookRepo {
+	return &WebhookRepo{
+		log:       ctx.NewLoggerHelper("sharing/repo/webhook"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new webhook
+func (r *WebhookRepo) Create(ctx context.Context, tenantID uint32, name, url, secret string, events []string, enabled bool, createdBy *uint32) (*ent.Webhook, error) {
+	id := uuid.New().String()
+
+	builder := r.entClient.Client().Webhook.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetURL(url).
+		SetSecret(secret).
+		SetEvents(events).
+		SetEnabled(enabled).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, sharingV1.ErrorBadRequest("webhook with this name already exists")
+		}
+		r.log.Errorf("create webhook failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("create webhook failed")
+	}
+
+	return entity, nil
+}
+
+// GetByID retrieves a webhook by ID
+func (r *WebhookRepo) GetByID(ctx context.Context, id string) (*ent.Webhook, error) {
+	entity, err := r.entClient.Client().Webhook.Query().
+		Where(webhook.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("get webhook failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists webhooks for a tenant with pagination
+func (r *WebhookRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.Webhook, int, error) {
+	query := r.entClient.Client().Webhook.Query().
+		Where(webhook.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhooks failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("count webhooks failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(webhook.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhooks failed: %s", err.Error())
+		return nil, 0, sharingV1.ErrorInternalServerError("list webhooks failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListEnabledByTenant lists the enabled webhooks of a tenant
+func (r *WebhookRepo) ListEnabledByTenant(ctx context.Context, tenantID uint32) ([]*ent.Webhook, error) {
+	entities, err := r.entClient.Client().Webhook.Query().
+		Where(
+			webhook.TenantIDEQ(tenantID),
+			webhook.EnabledEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list enabled webhooks failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("list enabled webhooks failed")
+	}
+	return entities, nil
+}
+
+// Update updates a webhook; nil values are left unchanged
+func (r *WebhookRepo) Update(ctx context.Context, id string, name, url, secret *string, events []string, updateEvents bool, enabled *bool, updatedBy *uint32) (*ent.Webhook, error) {
+	builder := r.entClient.Client().Webhook.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if url != nil {
+		builder.SetURL(*url)
+	}
+	if secret != nil {
+		builder.SetSecret(*secret)
+	}
+	if updateEvents {
+		builder.SetEvents(events)
+	}
+	if enabled != nil {
+		builder.SetEnabled(*enabled)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, sharingV1.ErrorNotFound("webhook not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, sharingV1.ErrorBadRequest("webhook with this name already exists")
+		}
+		r.log.Errorf("update webhook failed: %s", err.Error())
+		return nil, sharingV1.ErrorInternalServerError("update webhook failed")
+	}
+
+	return entity, nil
+}
+
+// Delete deletes a webhook
+func (r *WebhookRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Webhook.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return sharingV1.ErrorNotFound("webhook not found")
+		}
+		r.log.Errorf("delete webhook failed: %s", err.Error())
+		return sharingV1.ErrorInternalServerError("delete webhook failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.Webhook to sharingV1.Webhook (the secret is never included)
+func (r *WebhookRepo) ToProto(entity *ent.Webhook) *sharingV1.Webhook {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &sharingV1.Webhook{
+		Id:       entity.ID,
+		TenantId: derefUint32(entity.TenantID),
+		Name:     entity.Name,
+		Url:      entity.URL,
+		Events:   entity.Events,
+		Enabled:  entity.Enabled,
+	}
+
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.UpdateBy != nil {
+		proto.UpdatedBy = entity.UpdateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,187 @@
+package geoip
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/oschwald/geoip2-golang"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+var (
+	// ErrUnavailable is returned when no GeoIP database is loaded
+	ErrUnavailable = errors.New("geoip database not loaded")
+
+	// ErrNotFound is returned when the database has no country for an address
+	ErrNotFound = errors.New("address not found in geoip database")
+)
+
+// Location is the geographic location of an IP address
+type Location struct {
+	// Country is the ISO 3166-1 alpha-2 country code, upper case
+	Country string
+	// Subdivisions are ISO 3166-2 subdivision codes without the country prefix (e.g. "CA"), most general first
+	Subdivisions []string
+	// City is the English city name, empty for country databases
+	City string
+}
+
+// String formats the location as CC[-SUB]/City, the format of REGION policy values
+func (l *Location) String() string {
+	s := l.Country
+	if len(l.Subdivisions) > 0 {
+		s += "-" + strings.Join(l.Subdivisions, ",")
+	}
+	if l.City != "" {
+		s += "/" + l.City
+	}
+	return s
+}
+
+// Resolver resolves IP addresses to locations using a local MaxMind
+// GeoLite2/GeoIP2 Country or City database. The database file is reopened
+// by Reload when it changes on disk.
+type Resolver struct {
+	log      *log.Helper
+	path     string
+	failOpen bool
+
+	mu      sync.RWMutex
+	reader  *geoip2.Reader
+	modTime time.Time
+}
+
+// NewResolver creates a Resolver for the database at SHARING_GEOIP_DB_PATH.
+// SHARING_GEOIP_FAIL_OPEN decides whether REGION policies let requests
+// through when an address cannot be resolved (default: fail closed).
+func NewResolver(ctx *bootstrap.Context) (*Resolver, func(), error) {
+	l := ctx.NewLoggerHelper("sharing/geoip")
+
+	failOpen := false
+	if v := os.Getenv("SHARING_GEOIP_FAIL_OPEN"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			l.Warnf("Invalid SHARING_GEOIP_FAIL_OPEN %q, failing closed", v)
+		}
+		failOpen = b
+	}
+
+	r := &Resolver{
+		log:      l,
+		path:     os.Getenv("SHARING_GEOIP_DB_PATH"),
+		failOpen: failOpen,
+	}
+
+	if r.path == "" {
+		l.Warn("SHARING_GEOIP_DB_PATH not set, REGION policies cannot resolve client locations")
+	} else if _, err := r.Reload(); err != nil {
+		l.Warnf("Failed to load GeoIP database, REGION policies cannot resolve client locations: %v", err)
+	}
+
+	return r, r.close, nil
+}
+
+// FailOpen reports whether REGION policies should let requests through when lookups fail
+func (r *Resolver) FailOpen() bool {
+	if r == nil {
+		return false
+	}
+	return r.failOpen
+}
+
+// Lookup resolves an IP address to its location
+func (r *Resolver) Lookup(ip string) (*Location, error) {
+	addr := net.ParseIP(strings.TrimSpace(ip))
+	if addr == nil {
+		return nil, fmt.Errorf("invalid ip address %q", ip)
+	}
+	if r == nil {
+		return nil, ErrUnavailable
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.reader == nil {
+		return nil, ErrUnavailable
+	}
+
+	record, err := r.reader.City(addr)
+	if err != nil {
+		return nil, fmt.Errorf("geoip lookup failed: %w", err)
+	}
+	if record.Country.IsoCode == "" {
+		return nil, ErrNotFound
+	}
+
+	loc := &Location{
+		Country: strings.ToUpper(record.Country.IsoCode),
+		City:    record.City.Names["en"],
+	}
+	for _, sub := range record.Subdivisions {
+		if sub.IsoCode != "" {
+			loc.Subdivisions = append(loc.Subdivisions, strings.ToUpper(sub.IsoCode))
+		}
+	}
+	return loc, nil
+}
+
+// Reload reopens the database when the file changed since it was last loaded.
+// It reports whether a new database was loaded.
+func (r *Resolver) Reload() (bool, error) {
+	if r == nil || r.path == "" {
+		return false, nil
+	}
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false, fmt.Errorf("stat geoip database: %w", err)
+	}
+
+	r.mu.RLock()
+	unchanged := r.reader != nil && info.ModTime().Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	reader, err := geoip2.Open(r.path)
+	if err != nil {
+		return false, fmt.Errorf("open geoip database: %w", err)
+	}
+
+	r.mu.Lock()
+	old := r.reader
+	r.reader = reader
+	r.modTime = info.ModTime()
+	r.mu.Unlock()
+
+	if old != nil {
+		_ = old.Close()
+	}
+
+	meta := reader.Metadata()
+	r.log.Infof("Loaded GeoIP database %s (%s, built %s)", r.path, meta.DatabaseType,
+		time.Unix(int64(meta.BuildEpoch), 0).UTC().Format(time.DateOnly))
+	return true, nil
+}
+
+// close releases the loaded database
+func (r *Resolver) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.reader != nil {
+		if err := r.reader.Close(); err != nil {
+			r.log.Errorf("Failed to close GeoIP database: %v", err)
+		}
+		r.reader = nil
+	}
+}
@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "sharing"
+
+// Upstream services called by the sharing module
+const (
+	UpstreamWarden    = "warden"
+	UpstreamPaperless = "paperless"
+)
+
+// Registry holds all sharing metrics together with the Go runtime and process collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	SharesCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "shares_created_total",
+		Help:      "Number of shares created.",
+	}, []string{"tenant_id", "resource_type"})
+
+	ShareViews = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "share_views_total",
+		Help:      "Number of shares successfully viewed.",
+	}, []string{"tenant_id", "resource_type"})
+
+	PolicyDenials = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "policy_denials_total",
+		Help:      "Number of share views denied by an access policy.",
+	}, []string{"tenant_id", "method"})
+
+	EmailsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "emails_sent_total",
+		Help:      "Number of emails sent, by kind and result.",
+	}, []string{"kind", "result"})
+
+	EmailSendDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "email_send_duration_seconds",
+		Help:      "Time spent sending emails over SMTP.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"kind"})
+
+	UpstreamRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upstream_request_duration_seconds",
+		Help:      "Latency of calls to upstream services.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation"})
+
+	UpstreamErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_errors_total",
+		Help:      "Number of failed calls to upstream services.",
+	}, []string{"service", "operation"})
+
+	EncryptionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "encryption_errors_total",
+		Help:      "Number of token generation, encryption and decryption failures.",
+	}, []string{"operation"})
+
+	TimeToFirstView = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "share_time_to_first_view_seconds",
+		Help:      "Time between creating a share and its first successful view.",
+		// 1m .. ~7d
+		Buckets: prometheus.ExponentialBuckets(60, 4, 9),
+	}, []string{"resource_type"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		SharesCreated,
+		ShareViews,
+		PolicyDenials,
+		EmailsSent,
+		EmailSendDuration,
+		UpstreamRequestDuration,
+		UpstreamErrors,
+		EncryptionErrors,
+		TimeToFirstView,
+	)
+}
+
+// Handler returns the HTTP handler serving the metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// TenantLabel formats a tenant ID as a label value
+func TenantLabel(tenantID uint32) string {
+	return strconv.FormatUint(uint64(tenantID), 10)
+}
+
+// ResultLabel returns "success" or "failure" depending on err
+func ResultLabel(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// ObserveUpstream records the latency and outcome of an upstream call started at start
+func ObserveUpstream(service, operation string, start time.Time, err error) {
+	UpstreamRequestDuration.WithLabelValues(service, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		UpstreamErrors.WithLabelValues(service, operation).Inc()
+	}
+}
+
+// ActiveSharesFunc counts active (unviewed, unrevoked, unexpired) shares per tenant
+type ActiveSharesFunc func(ctx context.Context) (map[uint32]int, error)
+
+// activeSharesCollector reports the active share gauge by querying the database on scrape
+type activeSharesCollector struct {
+	desc  *prometheus.Desc
+	count ActiveSharesFunc
+}
+
+// Describe implements prometheus.Collector
+func (c *activeSharesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector
+func (c *activeSharesCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	for tenantID, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), TenantLabel(tenantID))
+	}
+}
+
+// RegisterActiveShares registers the active shares gauge backed by count.
+// Registering more than once keeps the first registration.
+func RegisterActiveShares(count ActiveSharesFunc) error {
+	err := Registry.Register(&activeSharesCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "active_shares"),
+			"Number of shares that are neither viewed, revoked nor expired.",
+			[]string{"tenant_id"}, nil,
+		),
+		count: count,
+	})
+	var are prometheus.AlreadyRegisteredError
+	if errors.As(err, &are) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,299 @@
+package reputation
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/oschwald/geoip2-golang"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/iptrie"
+)
+
+// listNamePattern restricts reputation list names to lower-case identifiers
+var listNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ValidListName reports whether name can name a reputation list
+func ValidListName(name string) bool {
+	return listNamePattern.MatchString(name)
+}
+
+// source is a feed file contributing to a reputation list
+type source struct {
+	list string
+	path string
+
+	modTime  time.Time
+	prefixes []netip.Prefix
+	asns     []uint
+}
+
+// index is an immutable snapshot of all loaded feeds
+type index struct {
+	prefixes iptrie.Trie[string]
+	asns     map[uint][]string
+}
+
+// Feeds matches IP addresses against reputation lists, such as Tor exit
+// nodes, VPN or hosting ranges and custom deny lists, loaded from local feed
+// files. Feed files are re-read by Reload when they change on disk.
+//
+// A feed file lists one entry per line: an IP address, a CIDR prefix or an
+// autonomous system number ("AS64496"). Text after the entry and lines
+// starting with "#" are ignored. AS entries match through the MaxMind ASN
+// database at SHARING_REPUTATION_ASN_DB_PATH.
+type Feeds struct {
+	log     *log.Helper
+	sources []*source
+	asnPath string
+
+	mu         sync.RWMutex
+	index      *index
+	asnReader  *geoip2.Reader
+	asnModTime time.Time
+}
+
+// NewFeeds creates the reputation feeds configured by SHARING_REPUTATION_FEEDS,
+// a comma-separated list of name=path entries (e.g.
+// "tor=/feeds/tor-exits.txt,vpn=/feeds/vpn.txt,vpn=/feeds/hosting-asns.txt").
+// Several files may contribute to the same list.
+func NewFeeds(ctx *bootstrap.Context) (*Feeds, func(), error) {
+	l := ctx.NewLoggerHelper("sharing/reputation")
+
+	f := &Feeds{
+		log:     l,
+		asnPath: os.Getenv("SHARING_REPUTATION_ASN_DB_PATH"),
+		index:   &index{},
+	}
+	for _, entry := range strings.Split(os.Getenv("SHARING_REPUTATION_FEEDS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, path, ok := strings.Cut(entry, "=")
+		name, path = strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(path)
+		if !ok || !ValidListName(name) || path == "" {
+			l.Warnf("Ignoring invalid reputation feed %q, expected name=path", entry)
+			continue
+		}
+		f.sources = append(f.sources, &source{list: name, path: path})
+	}
+
+	if len(f.sources) == 0 {
+		l.Info("SHARING_REPUTATION_FEEDS not set, REPUTATION policies match no client")
+	} else if _, err := f.Reload(); err != nil {
+		l.Warnf("Failed to load reputation feeds: %v", err)
+	}
+
+	return f, f.close, nil
+}
+
+// HasList reports whether a configured feed contributes to the reputation list name
+func (f *Feeds) HasList(name string) bool {
+	if f == nil {
+		return false
+	}
+	for _, s := range f.sources {
+		if s.list == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Lists returns the names of the reputation lists ip is on, in the order the
+// feeds are configured
+func (f *Feeds) Lists(ip string) []string {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil || f == nil {
+		return nil
+	}
+	addr = addr.WithZone("").Unmap()
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	found := f.index.prefixes.Lookup(addr)
+	if len(f.index.asns) > 0 && f.asnReader != nil {
+		if record, err := f.asnReader.ASN(net.IP(addr.AsSlice())); err == nil {
+			found = append(found, f.index.asns[record.AutonomousSystemNumber]...)
+		}
+	}
+
+	var lists []string
+	for _, s := range f.sources {
+		if slices.Contains(found, s.list) && !slices.Contains(lists, s.list) {
+			lists = append(lists, s.list)
+		}
+	}
+	return lists
+}
+
+// Reload re-reads the feed files and the ASN database that changed since they
+// were last loaded. A feed that cannot be read keeps its previous entries.
+// It reports whether anything was reloaded.
+func (f *Feeds) Reload() (bool, error) {
+	if f == nil {
+		return false, nil
+	}
+
+	var errs []error
+	changed := false
+	for _, s := range f.sources {
+		reloaded, err := s.reload(f.log)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("feed %s (%s): %w", s.list, s.path, err))
+		}
+		changed = changed || reloaded
+	}
+
+	if changed {
+		idx := &index{asns: make(map[uint][]string)}
+		for _, s := range f.sources {
+			for _, p := range s.prefixes {
+				idx.prefixes.Insert(p, s.list)
+			}
+			for _, asn := range s.asns {
+				idx.asns[asn] = append(idx.asns[asn], s.list)
+			}
+		}
+		f.mu.Lock()
+		f.index = idx
+		f.mu.Unlock()
+		f.log.Infof("Loaded %d reputation prefixes and %d autonomous systems from %d feed(s)", idx.prefixes.Len(), len(idx.asns), len(f.sources))
+	}
+
+	asnReloaded, err := f.reloadASN()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return changed || asnReloaded, errors.Join(errs...)
+}
+
+// reload re-reads the feed file when it changed since it was last loaded
+func (s *source) reload(l *log.Helper) (bool, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false, err
+	}
+	if !s.modTime.IsZero() && info.ModTime().Equal(s.modTime) {
+		return false, nil
+	}
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	var prefixes []netip.Prefix
+	var asns []uint
+	invalid := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		prefix, asn, ok := parseEntry(fields[0])
+		switch {
+		case !ok:
+			invalid++
+		case asn != 0:
+			asns = append(asns, asn)
+		default:
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, err
+	}
+	if invalid > 0 {
+		l.Warnf("Skipped %d invalid entries in reputation feed %s", invalid, s.path)
+	}
+
+	s.prefixes, s.asns, s.modTime = prefixes, asns, info.ModTime()
+	return true, nil
+}
+
+// parseEntry parses a feed entry: an IP address, a CIDR prefix or an AS number
+func parseEntry(entry string) (netip.Prefix, uint, bool) {
+	if len(entry) > 2 && strings.EqualFold(entry[:2], "AS") {
+		asn, err := strconv.ParseUint(entry[2:], 10, 32)
+		if err != nil || asn == 0 {
+			return netip.Prefix{}, 0, false
+		}
+		return netip.Prefix{}, uint(asn), true
+	}
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		return prefix, 0, err == nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil || addr.Zone() != "" {
+		return netip.Prefix{}, 0, false
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), 0, true
+}
+
+// reloadASN reopens the ASN database when the file changed since it was last loaded
+func (f *Feeds) reloadASN() (bool, error) {
+	if f.asnPath == "" {
+		return false, nil
+	}
+
+	info, err := os.Stat(f.asnPath)
+	if err != nil {
+		return false, fmt.Errorf("stat ASN database: %w", err)
+	}
+
+	f.mu.RLock()
+	unchanged := f.asnReader != nil && info.ModTime().Equal(f.asnModTime)
+	f.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	reader, err := geoip2.Open(f.asnPath)
+	if err != nil {
+		return false, fmt.Errorf("open ASN database: %w", err)
+	}
+
+	f.mu.Lock()
+	old := f.asnReader
+	f.asnReader = reader
+	f.asnModTime = info.ModTime()
+	f.mu.Unlock()
+
+	if old != nil {
+		_ = old.Close()
+	}
+	f.log.Infof("Loaded ASN database %s", f.asnPath)
+	return true, nil
+}
+
+// close releases the loaded ASN database
+func (f *Feeds) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.asnReader != nil {
+		if err := f.asnReader.Close(); err != nil {
+			f.log.Errorf("Failed to close ASN database: %v", err)
+		}
+		f.asnReader = nil
+	}
+}
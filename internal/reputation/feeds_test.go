@@ -0,0 +1,111 @@
+package reputation
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func writeFeed(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFeeds(t *testing.T) {
+	dir := t.TempDir()
+	torPath := filepath.Join(dir, "tor.txt")
+	vpnPath := filepath.Join(dir, "vpn.txt")
+	start := time.Now().Add(-time.Hour)
+
+	writeFeed(t, torPath, "# Tor exit nodes\n198.51.100.7\n2001:db8::7 # exit\n", start)
+	writeFeed(t, vpnPath, "198.51.100.0/24 hosting\nAS64496\nnot-an-entry\n", start)
+
+	f := &Feeds{
+		log:   log.NewHelper(log.DefaultLogger),
+		index: &index{},
+		sources: []*source{
+			{list: "tor", path: torPath},
+			{list: "vpn", path: vpnPath},
+			{list: "custom", path: filepath.Join(dir, "missing.txt")},
+		},
+	}
+
+	reloaded, err := f.Reload()
+	if !reloaded || err == nil {
+		t.Fatalf("Reload() = %v, %v; want a reload and an error for the missing feed", reloaded, err)
+	}
+
+	for _, tc := range []struct {
+		ip   string
+		want []string
+	}{
+		{"198.51.100.7", []string{"tor", "vpn"}},
+		{"::ffff:198.51.100.8", []string{"vpn"}},
+		{"2001:db8::7", []string{"tor"}},
+		{"203.0.113.1", nil},
+		{"garbage", nil},
+	} {
+		if got := f.Lists(tc.ip); !slices.Equal(got, tc.want) {
+			t.Errorf("Lists(%s) = %v, want %v", tc.ip, got, tc.want)
+		}
+	}
+
+	// Unchanged files are not re-read, changed files replace their entries
+	if reloaded, _ := f.Reload(); reloaded {
+		t.Error("Reload() reloaded unchanged feeds")
+	}
+	writeFeed(t, torPath, "203.0.113.1\n", start.Add(time.Minute))
+	if reloaded, _ := f.Reload(); !reloaded {
+		t.Fatal("Reload() did not pick up a changed feed")
+	}
+	if got := f.Lists("198.51.100.7"); !slices.Equal(got, []string{"vpn"}) {
+		t.Errorf("after reload Lists(198.51.100.7) = %v, want [vpn]", got)
+	}
+	if got := f.Lists("203.0.113.1"); !slices.Equal(got, []string{"tor"}) {
+		t.Errorf("after reload Lists(203.0.113.1) = %v, want [tor]", got)
+	}
+}
+
+func TestParseEntry(t *testing.T) {
+	for _, tc := range []struct {
+		entry string
+		ok    bool
+		asn   uint
+	}{
+		{"10.0.0.0/8", true, 0},
+		{"10.0.0.1", true, 0},
+		{"2001:db8::/32", true, 0},
+		{"AS64496", true, 64496},
+		{"as13335", true, 13335},
+		{"AS0", false, 0},
+		{"ASX", false, 0},
+		{"10.0.0.0/33", false, 0},
+		{"fe80::1%eth0", false, 0},
+	} {
+		_, asn, ok := parseEntry(tc.entry)
+		if ok != tc.ok || asn != tc.asn {
+			t.Errorf("parseEntry(%q) = %d, %v; want %d, %v", tc.entry, asn, ok, tc.asn, tc.ok)
+		}
+	}
+}
+
+func TestFeedsHasList(t *testing.T) {
+	f := &Feeds{sources: []*source{{list: "tor"}, {list: "vpn"}, {list: "vpn"}}}
+	for name, want := range map[string]bool{"tor": true, "vpn": true, "vnp": false, "": false} {
+		if got := f.HasList(name); got != want {
+			t.Errorf("HasList(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if (*Feeds)(nil).HasList("tor") {
+		t.Error("nil feeds provide no list")
+	}
+}
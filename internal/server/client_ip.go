@@ -0,0 +1,196 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Forwarding headers a trusted proxy can report the client address in
+const (
+	headerXForwardedFor = "x-forwarded-for"
+	headerForwarded     = "forwarded"
+	headerXRealIP       = "x-real-ip"
+)
+
+// clientIPResolver determines the IP address of the client of a public request.
+// Forwarding headers are only believed when they were added by a trusted proxy,
+// and only the one header the proxies are configured to set: proxies pass the
+// other headers through from the client unchanged. The hops of X-Forwarded-For,
+// or of the RFC 7239 Forwarded header, are walked right-to-left starting at the
+// socket peer, and the first address that is not a trusted proxy is the client.
+// When the walk cannot reach such an address the client is unknown rather than
+// one of the proxies.
+type clientIPResolver struct {
+	trusted []netip.Prefix
+	header  string
+}
+
+// newClientIPResolverFromEnv creates a resolver trusting the proxies listed in
+// SHARING_TRUSTED_PROXIES, a comma-separated list of IP addresses or CIDR
+// prefixes (e.g. "10.0.0.0/8,192.168.1.10"), to report the client address in
+// the header named by SHARING_TRUSTED_PROXY_HEADER: x-forwarded-for (the
+// default), forwarded or x-real-ip. Without trusted proxies no forwarding
+// header is believed and the socket remote address is used.
+func newClientIPResolverFromEnv(l *log.Helper) *clientIPResolver {
+	r := &clientIPResolver{header: headerXForwardedFor}
+	if v := strings.ToLower(strings.TrimSpace(os.Getenv("SHARING_TRUSTED_PROXY_HEADER"))); v != "" {
+		switch v {
+		case headerXForwardedFor, headerForwarded, headerXRealIP:
+			r.header = v
+		default:
+			l.Errorf("Invalid SHARING_TRUSTED_PROXY_HEADER %q, forwarding headers are not trusted", v)
+			return r
+		}
+	}
+
+	for _, entry := range strings.Split(os.Getenv("SHARING_TRUSTED_PROXIES"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, err := parseTrustedProxy(entry)
+		if err != nil {
+			l.Warnf("Ignoring invalid trusted proxy %q: %v", entry, err)
+			continue
+		}
+		r.trusted = append(r.trusted, prefix)
+	}
+	if len(r.trusted) > 0 {
+		l.Infof("Trusting the %s header from %d proxy range(s)", r.header, len(r.trusted))
+	}
+	return r
+}
+
+// parseTrustedProxy parses an IP address or CIDR prefix
+func parseTrustedProxy(entry string) (netip.Prefix, error) {
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// isTrusted reports whether addr belongs to a trusted proxy
+func (r *clientIPResolver) isTrusted(addr netip.Addr) bool {
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the client IP of the request, or an empty string when it
+// cannot be determined
+func (r *clientIPResolver) ClientIP(req *http.Request) string {
+	peer, ok := parseHop(req.RemoteAddr)
+	if !ok {
+		return ""
+	}
+	if !r.isTrusted(peer) {
+		return peer.String()
+	}
+
+	var hops []string
+	switch r.header {
+	case headerForwarded:
+		hops = forwardedFor(req.Header.Values("Forwarded"))
+	case headerXRealIP:
+		// Proxies setting X-Real-IP report a single hop
+		if v := req.Header.Values("X-Real-IP"); len(v) > 0 {
+			hops = v[len(v)-1:]
+		}
+	default:
+		for _, value := range req.Header.Values("X-Forwarded-For") {
+			hops = append(hops, strings.Split(value, ",")...)
+		}
+	}
+	if len(hops) == 0 {
+		// Nothing was forwarded: the trusted host is the client itself
+		return peer.String()
+	}
+
+	// Walk right-to-left past the trusted proxies. A hop that is not an
+	// address (e.g. "unknown") hides the client, and so does a chain made of
+	// trusted proxies only: answering with a proxy would apply IP policies,
+	// the risk score and the proof-of-work difficulty to every client behind it.
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseHop(hops[i])
+		if !ok {
+			return ""
+		}
+		if !r.isTrusted(addr) {
+			return addr.String()
+		}
+	}
+	return ""
+}
+
+// parseHop parses a hop of a forwarding header or a socket address: an IP
+// address optionally with a port, IPv6 addresses optionally in brackets
+func parseHop(value string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.WithZone("").Unmap(), true
+}
+
+// forwardedFor returns the "for" node of every element of RFC 7239 Forwarded
+// header values, in order. Elements without one yield an empty hop.
+func forwardedFor(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for _, element := range splitQuoted(value, ',') {
+			node := ""
+			for _, pair := range splitQuoted(element, ';') {
+				key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if ok && strings.EqualFold(strings.TrimSpace(key), "for") {
+					node = strings.Trim(strings.TrimSpace(val), `"`)
+				}
+			}
+			hops = append(hops, node)
+		}
+	}
+	return hops
+}
+
+// splitQuoted splits s at sep outside of double-quoted strings
+func splitQuoted(s string, sep byte) []string {
+	var parts []string
+	quoted, start := false, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quoted {
+				i++
+			}
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
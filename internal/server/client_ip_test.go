@@ -0,0 +1,141 @@
+package server
+
+import (
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestClientIP(t *testing.T) {
+	var trusted []netip.Prefix
+	for _, entry := range []string{"10.0.0.0/8", "2001:db8::1"} {
+		prefix, err := parseTrustedProxy(entry)
+		if err != nil {
+			t.Fatalf("parseTrustedProxy(%q): %v", entry, err)
+		}
+		trusted = append(trusted, prefix)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		header  string
+		remote  string
+		headers map[string][]string
+		want    string
+	}{
+		{
+			name:    "untrusted peer cannot spoof",
+			remote:  "203.0.113.9:5000",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4"}, "X-Real-Ip": {"1.2.3.4"}},
+			want:    "203.0.113.9",
+		},
+		{
+			name:   "trusted peer without headers",
+			remote: "10.0.0.2:5000",
+			want:   "10.0.0.2",
+		},
+		{
+			name:    "rightmost untrusted hop wins",
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4, 198.51.100.7", "10.0.0.3"}},
+			want:    "198.51.100.7",
+		},
+		{
+			name:    "all hops trusted",
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"X-Forwarded-For": {"10.1.1.1, 10.0.0.3"}},
+			want:    "",
+		},
+		{
+			name:    "invalid hop hides the client",
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.7, garbage, 10.0.0.3"}},
+			want:    "",
+		},
+		{
+			name:   "client-sent Forwarded is ignored behind an X-Forwarded-For proxy",
+			remote: "10.0.0.2:5000",
+			headers: map[string][]string{
+				"Forwarded":       {"for=1.2.3.4"},
+				"X-Real-Ip":       {"1.2.3.4"},
+				"X-Forwarded-For": {"198.51.100.7"},
+			},
+			want: "198.51.100.7",
+		},
+		{
+			name:    "client-sent Forwarded unknown is ignored",
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"Forwarded": {"for=unknown"}, "X-Forwarded-For": {"198.51.100.7"}},
+			want:    "198.51.100.7",
+		},
+		{
+			name:   "Forwarded header",
+			header: headerForwarded,
+			remote: "[2001:db8::1]:443",
+			headers: map[string][]string{
+				"Forwarded":       {`for="[2001:db8:cafe::17]:4711";proto=https, for=10.0.0.3;by=10.0.0.2`},
+				"X-Forwarded-For": {"1.2.3.4"},
+			},
+			want: "2001:db8:cafe::17",
+		},
+		{
+			name:    "Forwarded unknown node",
+			header:  headerForwarded,
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"Forwarded": {"for=unknown, for=10.0.0.3"}},
+			want:    "",
+		},
+		{
+			name:    "Forwarded element without a for node",
+			header:  headerForwarded,
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"Forwarded": {"for=198.51.100.7, proto=https"}},
+			want:    "",
+		},
+		{
+			name:    "empty X-Real-IP from trusted proxy",
+			header:  headerXRealIP,
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"X-Real-Ip": {""}},
+			want:    "",
+		},
+		{
+			name:    "X-Real-IP from trusted proxy",
+			header:  headerXRealIP,
+			remote:  "10.0.0.2:5000",
+			headers: map[string][]string{"X-Real-Ip": {"198.51.100.7"}, "X-Forwarded-For": {"1.2.3.4"}},
+			want:    "198.51.100.7",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &clientIPResolver{trusted: trusted, header: tc.header}
+			if r.header == "" {
+				r.header = headerXForwardedFor
+			}
+			req := &http.Request{RemoteAddr: tc.remote, Header: http.Header(tc.headers)}
+			if got := r.ClientIP(req); got != tc.want {
+				t.Errorf("ClientIP = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClientIPResolverFromEnv(t *testing.T) {
+	l := log.NewHelper(log.DefaultLogger)
+	t.Setenv("SHARING_TRUSTED_PROXIES", "10.0.0.0/8")
+
+	t.Setenv("SHARING_TRUSTED_PROXY_HEADER", "")
+	if r := newClientIPResolverFromEnv(l); r.header != headerXForwardedFor || len(r.trusted) != 1 {
+		t.Fatalf("default resolver = %+v, want x-forwarded-for from one range", r)
+	}
+	t.Setenv("SHARING_TRUSTED_PROXY_HEADER", "Forwarded")
+	if r := newClientIPResolverFromEnv(l); r.header != headerForwarded {
+		t.Fatalf("header = %q, want forwarded", r.header)
+	}
+	t.Setenv("SHARING_TRUSTED_PROXY_HEADER", "true-client-ip")
+	if r := newClientIPResolverFromEnv(l); len(r.trusted) != 0 {
+		t.Fatalf("unknown header trusts %v, want no proxy", r.trusted)
+	}
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// corsPolicy answers the cross-origin requests of the public share endpoints.
+// Any origin may read them, but only the origins listed in
+// SHARING_ALLOWED_ORIGINS, a comma-separated list such as
+// "https://share.example.com", are echoed back with credentials allowed.
+// Browsers only store and send the device binding cookie for those.
+type corsPolicy struct {
+	origins map[string]bool
+}
+
+// newCORSPolicyFromEnv creates the CORS policy of the public share endpoints
+func newCORSPolicyFromEnv(l *log.Helper) *corsPolicy {
+	p := &corsPolicy{origins: make(map[string]bool)}
+	for _, origin := range strings.Split(os.Getenv("SHARING_ALLOWED_ORIGINS"), ",") {
+		origin = strings.TrimRight(strings.TrimSpace(origin), "/")
+		if origin == "" {
+			continue
+		}
+		if origin == "*" {
+			l.Warnf("Ignoring wildcard in SHARING_ALLOWED_ORIGINS: credentialed origins must be listed")
+			continue
+		}
+		p.origins[origin] = true
+	}
+	if len(p.origins) == 0 {
+		l.Infof("No credentialed CORS origin configured: device-bound shares only work on the API's own origin")
+	}
+	return p
+}
+
+// setHeaders sets the CORS headers of a public share response
+func (p *corsPolicy) setHeaders(ctx kratosHttp.Context) {
+	h := ctx.Response().Header()
+	h.Add("Vary", "Origin")
+	if origin := ctx.Request().Header.Get("Origin"); p.origins[origin] {
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Set("Access-Control-Allow-Credentials", "true")
+	} else {
+		h.Set("Access-Control-Allow-Origin", "*")
+	}
+	h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	h.Set("Access-Control-Allow-Headers", strings.Join([]string{
+		"Content-Type", passphraseHeader, verificationCodeHeader, powChallengeHeader, powSolutionHeader,
+	}, ", "))
+}
+
+// preflightHandler answers CORS preflight requests
+func (p *corsPolicy) preflightHandler() kratosHttp.HandlerFunc {
+	return func(ctx kratosHttp.Context) error {
+		p.setHeaders(ctx)
+		ctx.Response().WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+func TestCORSPolicy(t *testing.T) {
+	cors := &corsPolicy{origins: map[string]bool{"https://share.example.com": true}}
+	srv := kratosHttp.NewServer()
+	srv.Route("/").Handle("OPTIONS", "/api/v1/shared/{token}", cors.preflightHandler())
+
+	for _, tc := range []struct {
+		name        string
+		origin      string
+		wantOrigin  string
+		credentials bool
+	}{
+		{"allowed origin is echoed with credentials", "https://share.example.com", "https://share.example.com", true},
+		{"other origin gets the wildcard", "https://evil.example", "*", false},
+		{"same-origin request", "", "*", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/api/v1/shared/tgs_x", nil)
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusNoContent {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tc.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tc.wantOrigin)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Credentials") == "true"; got != tc.credentials {
+				t.Errorf("Access-Control-Allow-Credentials = %v, want %v", got, tc.credentials)
+			}
+			if got := rec.Header().Get("Vary"); got != "Origin" {
+				t.Errorf("Vary = %q, want Origin", got)
+			}
+		})
+	}
+}
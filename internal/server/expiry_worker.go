@@ -0,0 +1,24 @@
+package server
+
+import (
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/service"
+)
+
+// ExpiryWorker periodically expires unviewed shares and notifies their senders
+type ExpiryWorker struct {
+	*periodicWorker
+}
+
+// NewExpiryWorker creates a new ExpiryWorker
+func NewExpiryWorker(ctx *bootstrap.Context, shareSvc *service.ShareService) *ExpiryWorker {
+	l := ctx.NewLoggerHelper("sharing/worker/expiry")
+	interval := workerIntervalFromEnv(l, "SHARING_EXPIRY_SWEEP_INTERVAL", time.Minute)
+
+	return &ExpiryWorker{
+		periodicWorker: newPeriodicWorker(l, "Share expiry", interval, shareSvc.ExpireShares),
+	}
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+)
+
+// GeoIPWorker periodically reloads the GeoIP database when its file is replaced
+type GeoIPWorker struct {
+	*periodicWorker
+}
+
+// NewGeoIPWorker creates a new GeoIPWorker
+func NewGeoIPWorker(ctx *bootstrap.Context, resolver *geoip.Resolver) *GeoIPWorker {
+	l := ctx.NewLoggerHelper("sharing/worker/geoip")
+	interval := workerIntervalFromEnv(l, "SHARING_GEOIP_RELOAD_INTERVAL", time.Minute)
+
+	reload := func(context.Context) (int, error) {
+		reloaded, err := resolver.Reload()
+		if reloaded {
+			return 1, err
+		}
+		return 0, err
+	}
+
+	return &GeoIPWorker{
+		periodicWorker: newPeriodicWorker(l, "GeoIP reload", interval, reload),
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"slices"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
@@ -9,12 +10,14 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
-	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-common/grpcx"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
 	"github.com/go-tangra/go-tangra-sharing/internal/cert"
 	"github.com/go-tangra/go-tangra-sharing/internal/service"
 
@@ -22,11 +25,35 @@ import (
 	"github.com/go-tangra/go-tangra-common/middleware/mtls"
 )
 
-// systemViewerMiddleware injects system viewer context for all requests
-func systemViewerMiddleware() middleware.Middleware {
+// publicOperations are served without a caller identity
+var publicOperations = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+// tenantViewerMiddleware injects a viewer for the calling user so that ent
+// privacy rules scope every query and mutation to the caller's tenant and
+// services can check the permissions granted by the caller's roles.
+// Requests without a tenant are rejected unless the caller is a platform
+// administrator: missing metadata must never widen access to every tenant.
+func tenantViewerMiddleware(authorizer *authz.Authorizer) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			ctx = viewer.NewSystemViewerContext(ctx)
+			if tr, ok := transport.FromServerContext(ctx); ok && slices.Contains(publicOperations, tr.Operation()) {
+				return handler(ctx, req)
+			}
+
+			var userID uint32
+			if id := grpcx.GetUserIDAsUint32(ctx); id != nil {
+				userID = *id
+			}
+			roles := authz.ParseRoles(grpcx.GetMetadataValue(ctx, "x-md-global-roles"))
+			tenantID := grpcx.GetTenantIDFromContext(ctx)
+			if tenantID == 0 && !slices.Contains(roles, authz.PlatformAdminRole) {
+				return nil, sharingV1.ErrorUnauthorized("tenant is required")
+			}
+
+			ctx = authorizer.NewViewerContext(ctx, tenantID, userID, roles)
 			return handler(ctx, req)
 		}
 	}
@@ -36,9 +63,12 @@ func systemViewerMiddleware() middleware.Middleware {
 func NewGRPCServer(
 	ctx *bootstrap.Context,
 	certManager *cert.CertManager,
+	authorizer *authz.Authorizer,
 	shareSvc *service.ShareService,
 	templateSvc *service.TemplateService,
+	policySetSvc *service.PolicySetService,
 	backupSvc *service.BackupService,
+	webhookSvc *service.WebhookService,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	l := ctx.NewLoggerHelper("sharing/grpc")
@@ -73,17 +103,14 @@ func NewGRPCServer(
 	// Add middleware
 	var ms []middleware.Middleware
 	ms = append(ms, recovery.Recovery())
-	ms = append(ms, systemViewerMiddleware())
 	ms = append(ms, tracing.Server())
 	ms = append(ms, metadata.Server())
+	ms = append(ms, tenantViewerMiddleware(authorizer))
 	ms = append(ms, logging.Server(ctx.GetLogger()))
 
 	ms = append(ms, mtls.MTLSMiddleware(
 		ctx.GetLogger(),
-		mtls.WithPublicEndpoints(
-			"/grpc.health.v1.Health/Check",
-			"/grpc.health.v1.Health/Watch",
-		),
+		mtls.WithPublicEndpoints(publicOperations...),
 	))
 
 	ms = append(ms, audit.Server(
@@ -106,7 +133,9 @@ func NewGRPCServer(
 	// Register services
 	sharingV1.RegisterRedactedSharingShareServiceServer(srv, shareSvc, nil)
 	sharingV1.RegisterRedactedSharingTemplateServiceServer(srv, templateSvc, nil)
+	sharingV1.RegisterRedactedSharingPolicySetServiceServer(srv, policySetSvc, nil)
 	sharingV1.RegisterRedactedBackupServiceServer(srv, backupSvc, nil)
+	sharingV1.RegisterRedactedSharingWebhookServiceServer(srv, webhookSvc, nil)
 
 	return srv
 }
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/metadata"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+)
+
+// testTransport is a server transport reporting a fixed operation
+type testTransport struct {
+	transport.Transporter
+	operation string
+}
+
+func (t testTransport) Operation() string { return t.operation }
+
+func newTestViewerMiddlewareCall(t *testing.T, operation, roles string) (bool, context.Context, error) {
+	t.Helper()
+
+	menus, err := os.ReadFile("../../cmd/server/assets/menus.yaml")
+	if err != nil {
+		t.Fatalf("read menus.yaml: %v", err)
+	}
+	authorizer, err := authz.NewAuthorizer(menus)
+	if err != nil {
+		t.Fatalf("NewAuthorizer: %v", err)
+	}
+
+	ctx := transport.NewServerContext(context.Background(), testTransport{operation: operation})
+	if roles != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-md-global-roles", roles))
+	}
+
+	var called bool
+	var handlerCtx context.Context
+	_, err = tenantViewerMiddleware(authorizer)(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		called, handlerCtx = true, ctx
+		return nil, nil
+	})(ctx, nil)
+	return called, handlerCtx, err
+}
+
+func TestTenantViewerMiddlewareRejectsMissingTenant(t *testing.T) {
+	const op = "/sharing.service.v1.SharingShareService/ListShares"
+
+	// Neither missing metadata nor a tenant role grants access across tenants
+	for _, roles := range []string{"", "tenant:manager", "sharing.operator"} {
+		called, _, err := newTestViewerMiddlewareCall(t, op, roles)
+		if called || !sharingV1.IsUnauthorized(err) {
+			t.Errorf("roles %q without tenant: called = %v, err = %v; want Unauthorized", roles, called, err)
+		}
+	}
+}
+
+func TestTenantViewerMiddlewareAllowsPlatformAdmin(t *testing.T) {
+	called, ctx, err := newTestViewerMiddlewareCall(t, "/sharing.service.v1.SharingShareService/ListShares", authz.PlatformAdminRole)
+	if err != nil || !called {
+		t.Fatalf("platform admin: called = %v, err = %v", called, err)
+	}
+	if err := authz.Require(ctx, authz.PermShareViewAll); err != nil {
+		t.Errorf("platform admin lacks permissions: %v", err)
+	}
+}
+
+func TestTenantViewerMiddlewareServesHealthChecks(t *testing.T) {
+	called, _, err := newTestViewerMiddlewareCall(t, "/grpc.health.v1.Health/Check", "")
+	if err != nil || !called {
+		t.Fatalf("health check: called = %v, err = %v", called, err)
+	}
+}
@@ -1,13 +1,19 @@
 package server
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"io"
 	"io/fs"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	grpcMD "google.golang.org/grpc/metadata"
@@ -15,6 +21,7 @@ import (
 	"github.com/go-tangra/go-tangra-common/viewer"
 	"github.com/go-tangra/go-tangra-sharing/cmd/server/assets"
 	"github.com/go-tangra/go-tangra-sharing/internal/service"
+	"github.com/go-tangra/go-tangra-sharing/pkg/clientcert"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
 )
@@ -38,13 +45,13 @@ func NewHTTPServer(
 	// Register routes
 	route := srv.Route("/")
 
-	// CORS preflight
-	route.Handle("OPTIONS", "/api/v1/shared/{token}", corsHandler())
-	route.Handle("OPTIONS", "/api/v1/shared/{token}/download", corsHandler())
-
 	// Public endpoints (no auth)
-	route.GET("/api/v1/shared/{token}", handleViewShared(shareSvc))
-	route.GET("/api/v1/shared/{token}/download", handleDownloadShared(shareSvc))
+	registerPublicShareRoutes(route, shareSvc, newClientIPResolverFromEnv(l), newCORSPolicyFromEnv(l))
+
+	// Leaked token reports revoke shares, so only signed reports are accepted
+	if verifier := newLeakReportVerifierFromEnv(l); verifier != nil {
+		route.POST("/api/v1/shared/leaks", handleReportLeakedToken(shareSvc, verifier))
+	}
 
 	// Health check
 	route.GET("/health", func(ctx kratosHttp.Context) error {
@@ -65,39 +72,35 @@ func NewHTTPServer(
 	return srv
 }
 
+// registerPublicShareRoutes registers the endpoints recipients open shares with
+func registerPublicShareRoutes(route *kratosHttp.Router, shareSvc *service.ShareService, ips *clientIPResolver, cors *corsPolicy) {
+	route.Handle("OPTIONS", "/api/v1/shared/{token}", cors.preflightHandler())
+	route.Handle("OPTIONS", "/api/v1/shared/{token}/download", cors.preflightHandler())
+	route.Handle("OPTIONS", "/api/v1/shared/{token}/verification-code", cors.preflightHandler())
+	route.Handle("OPTIONS", "/api/v1/shared/{token}/challenge", cors.preflightHandler())
+	route.GET("/api/v1/shared/{token}", handleViewShared(shareSvc, ips, cors))
+	route.GET("/api/v1/shared/{token}/download", handleDownloadShared(shareSvc, ips, cors))
+	route.POST("/api/v1/shared/{token}/verification-code", handleSendVerificationCode(shareSvc, ips, cors))
+	route.GET("/api/v1/shared/{token}/challenge", handleGetChallenge(shareSvc, ips, cors))
+}
+
 // handleViewShared returns the shared content as JSON
-func handleViewShared(shareSvc *service.ShareService) kratosHttp.HandlerFunc {
+func handleViewShared(shareSvc *service.ShareService, ips *clientIPResolver, cors *corsPolicy) kratosHttp.HandlerFunc {
 	return func(ctx kratosHttp.Context) error {
-		setCORSHeaders(ctx)
+		cors.setHeaders(ctx)
 
 		token := ctx.Vars().Get("token")
 		if token == "" {
 			return ctx.JSON(http.StatusBadRequest, errorResponse("token is required"))
 		}
 
-		// Extract viewer IP
-		viewerIP := ctx.Header().Get("X-Real-IP")
-		if viewerIP == "" {
-			viewerIP = ctx.Header().Get("X-Forwarded-For")
-			if viewerIP != "" {
-				// Take first IP if multiple
-				if idx := strings.Index(viewerIP, ","); idx > 0 {
-					viewerIP = viewerIP[:idx]
-				}
-			}
-		}
-
-		// Inject client IP into gRPC metadata and system viewer for ENT privacy
-		grpcCtx := grpcMD.NewIncomingContext(ctx, grpcMD.Pairs("x-client-ip", viewerIP))
-		grpcCtx = viewer.NewSystemViewerContext(grpcCtx)
+		grpcCtx := publicRequestContext(ctx, ips)
 
-		resp, err := shareSvc.ViewSharedContent(grpcCtx, &sharingV1.ViewSharedContentRequest{
-			Token: token,
-		})
+		resp, err := shareSvc.ViewSharedContent(grpcCtx, viewSharedRequest(ctx, token))
 		if err != nil {
-			code, msg := mapShareError(err)
-			return ctx.JSON(code, errorResponse(msg))
+			return writeShareError(ctx, err)
 		}
+		setDeviceCookie(ctx, token, resp.DeviceToken)
 
 		result := map[string]interface{}{
 			"resourceType": resp.ResourceType.String(),
@@ -114,37 +117,22 @@ func handleViewShared(shareSvc *service.ShareService) kratosHttp.HandlerFunc {
 }
 
 // handleDownloadShared returns file content with proper headers for document shares
-func handleDownloadShared(shareSvc *service.ShareService) kratosHttp.HandlerFunc {
+func handleDownloadShared(shareSvc *service.ShareService, ips *clientIPResolver, cors *corsPolicy) kratosHttp.HandlerFunc {
 	return func(ctx kratosHttp.Context) error {
-		setCORSHeaders(ctx)
+		cors.setHeaders(ctx)
 
 		token := ctx.Vars().Get("token")
 		if token == "" {
 			return ctx.JSON(http.StatusBadRequest, errorResponse("token is required"))
 		}
 
-		// Extract viewer IP
-		viewerIP := ctx.Header().Get("X-Real-IP")
-		if viewerIP == "" {
-			viewerIP = ctx.Header().Get("X-Forwarded-For")
-			if viewerIP != "" {
-				if idx := strings.Index(viewerIP, ","); idx > 0 {
-					viewerIP = viewerIP[:idx]
-				}
-			}
-		}
-
-		// Inject client IP into gRPC metadata and system viewer for ENT privacy
-		grpcCtx := grpcMD.NewIncomingContext(ctx, grpcMD.Pairs("x-client-ip", viewerIP))
-		grpcCtx = viewer.NewSystemViewerContext(grpcCtx)
+		grpcCtx := publicRequestContext(ctx, ips)
 
-		resp, err := shareSvc.ViewSharedContent(grpcCtx, &sharingV1.ViewSharedContentRequest{
-			Token: token,
-		})
+		resp, err := shareSvc.ViewSharedContent(grpcCtx, viewSharedRequest(ctx, token))
 		if err != nil {
-			code, msg := mapShareError(err)
-			return ctx.JSON(code, errorResponse(msg))
+			return writeShareError(ctx, err)
 		}
+		setDeviceCookie(ctx, token, resp.DeviceToken)
 
 		if resp.ResourceType == sharingV1.ResourceType_RESOURCE_TYPE_DOCUMENT && len(resp.FileContent) > 0 {
 			mimeType := resp.MimeType
@@ -173,19 +161,241 @@ func handleDownloadShared(shareSvc *service.ShareService) kratosHttp.HandlerFunc
 	}
 }
 
-func setCORSHeaders(ctx kratosHttp.Context) {
-	w := ctx.Response()
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+// handleSendVerificationCode emails a step-up verification code to the recipient of a share
+func handleSendVerificationCode(shareSvc *service.ShareService, ips *clientIPResolver, cors *corsPolicy) kratosHttp.HandlerFunc {
+	return func(ctx kratosHttp.Context) error {
+		cors.setHeaders(ctx)
+
+		token := ctx.Vars().Get("token")
+		if token == "" {
+			return ctx.JSON(http.StatusBadRequest, errorResponse("token is required"))
+		}
+
+		challenge, solution := proofOfWorkFromHeaders(ctx)
+		resp, err := shareSvc.SendShareVerificationCode(publicRequestContext(ctx, ips), &sharingV1.SendShareVerificationCodeRequest{
+			Token:        token,
+			PowChallenge: challenge,
+			PowSolution:  solution,
+		})
+		if err != nil {
+			return writeShareError(ctx, err)
+		}
+
+		return ctx.JSON(http.StatusAccepted, map[string]interface{}{
+			"expiresAt": resp.ExpiresAt.AsTime().Format(time.RFC3339),
+		})
+	}
+}
+
+// handleGetChallenge issues a proof-of-work challenge for a share token
+func handleGetChallenge(shareSvc *service.ShareService, ips *clientIPResolver, cors *corsPolicy) kratosHttp.HandlerFunc {
+	return func(ctx kratosHttp.Context) error {
+		cors.setHeaders(ctx)
+
+		token := ctx.Vars().Get("token")
+		if token == "" {
+			return ctx.JSON(http.StatusBadRequest, errorResponse("token is required"))
+		}
+
+		resp, err := shareSvc.GetShareChallenge(publicRequestContext(ctx, ips), &sharingV1.GetShareChallengeRequest{
+			Token: token,
+		})
+		if err != nil {
+			return writeShareError(ctx, err)
+		}
+
+		result := map[string]interface{}{
+			"required":  resp.Required,
+			"algorithm": resp.Algorithm,
+		}
+		if resp.Required {
+			result["challenge"] = resp.Challenge
+			result["difficulty"] = resp.Difficulty
+			result["expiresAt"] = resp.ExpiresAt.AsTime().Format(time.RFC3339)
+		}
+		ctx.Response().Header().Set("Cache-Control", "no-store")
+		return ctx.JSON(http.StatusOK, result)
+	}
+}
+
+// Headers carrying the answer to a step-up challenge and the proof of work
+const (
+	passphraseHeader       = "X-Share-Passphrase"
+	verificationCodeHeader = "X-Share-Verification-Code"
+	powChallengeHeader     = "X-Share-Pow-Challenge"
+	powSolutionHeader      = "X-Share-Pow-Solution"
+)
+
+// proofOfWorkFromHeaders returns the proof-of-work challenge and solution sent with a request, if any
+func proofOfWorkFromHeaders(ctx kratosHttp.Context) (challenge, solution *string) {
+	if v := strings.TrimSpace(ctx.Header().Get(powChallengeHeader)); v != "" {
+		challenge = &v
+	}
+	if v := strings.TrimSpace(ctx.Header().Get(powSolutionHeader)); v != "" {
+		solution = &v
+	}
+	return challenge, solution
+}
+
+// viewSharedRequest builds the request to view a share from a public request
+func viewSharedRequest(ctx kratosHttp.Context, token string) *sharingV1.ViewSharedContentRequest {
+	req := &sharingV1.ViewSharedContentRequest{
+		Token:       token,
+		DeviceToken: deviceTokenFromCookie(ctx),
+	}
+	if v := ctx.Header().Get(passphraseHeader); v != "" {
+		req.Passphrase = &v
+	}
+	if v := strings.TrimSpace(ctx.Header().Get(verificationCodeHeader)); v != "" {
+		req.VerificationCode = &v
+	}
+	req.PowChallenge, req.PowSolution = proofOfWorkFromHeaders(ctx)
+	return req
+}
+
+// maxLeakReportBodySize caps the size of a leaked token report payload
+const maxLeakReportBodySize = 1 << 20
+
+// leakReport is a single entry of a secret scanning partner report
+type leakReport struct {
+	Token  string `json:"token"`
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Source string `json:"source"`
+}
+
+// leakReportResult is the per-token response expected by secret scanning partners
+type leakReportResult struct {
+	TokenRaw  string `json:"token_raw"`
+	TokenType string `json:"token_type"`
+	Label     string `json:"label"`
+	Revoked   bool   `json:"revoked"`
 }
 
-func corsHandler() kratosHttp.HandlerFunc {
+// handleReportLeakedToken accepts leaked token reports from secret scanners and revokes matching shares.
+// The payload may be a single report object or an array of reports, and must be signed by a configured scanner.
+func handleReportLeakedToken(shareSvc *service.ShareService, verifier *leakReportVerifier) kratosHttp.HandlerFunc {
 	return func(ctx kratosHttp.Context) error {
-		setCORSHeaders(ctx)
-		ctx.Response().WriteHeader(http.StatusNoContent)
+		body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxLeakReportBodySize))
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, errorResponse("failed to read request body"))
+		}
+		if !verifier.Verify(ctx.Request().Header, body) {
+			return ctx.JSON(http.StatusUnauthorized, errorResponse("invalid report signature"))
+		}
+
+		var reports []leakReport
+		trimmed := bytes.TrimSpace(body)
+		if len(trimmed) > 0 && trimmed[0] == '{' {
+			var single leakReport
+			if err := json.Unmarshal(trimmed, &single); err != nil {
+				return ctx.JSON(http.StatusBadRequest, errorResponse("invalid report payload"))
+			}
+			reports = append(reports, single)
+		} else if err := json.Unmarshal(trimmed, &reports); err != nil {
+			return ctx.JSON(http.StatusBadRequest, errorResponse("invalid report payload"))
+		}
+
+		// Leak reports are not tenant scoped, the token itself identifies the share
+		grpcCtx := viewer.NewSystemViewerContext(ctx)
+
+		results := make([]leakReportResult, 0, len(reports))
+		for _, report := range reports {
+			result := leakReportResult{
+				TokenRaw:  report.Token,
+				TokenType: "tangra_share_token",
+				Label:     "false_positive",
+			}
+
+			resp, err := shareSvc.ReportLeakedToken(grpcCtx, &sharingV1.ReportLeakedTokenRequest{
+				Token:  report.Token,
+				Source: report.Source,
+				Url:    report.URL,
+			})
+			if err == nil && resp.Matched {
+				result.Label = "true_positive"
+				result.Revoked = resp.Revoked
+			}
+
+			results = append(results, result)
+		}
+
+		return ctx.JSON(http.StatusOK, results)
+	}
+}
+
+// deviceCookieName is the cookie carrying the device binding token of a share
+const deviceCookieName = "tangra_share_device"
+
+// deviceCookieMaxAge keeps the binding for as long as browsers allow
+const deviceCookieMaxAge = 400 * 24 * time.Hour
+
+// deviceTokenFromCookie returns the device binding token sent with a request, if any
+func deviceTokenFromCookie(ctx kratosHttp.Context) *string {
+	cookie, err := ctx.Request().Cookie(deviceCookieName)
+	if err != nil || cookie.Value == "" {
 		return nil
 	}
+	return &cookie.Value
+}
+
+// setDeviceCookie hands the device binding token issued by the first view of a
+// device-bound share to the browser. The cookie is scoped to the share's
+// endpoints and is not readable by scripts. The viewer calls the endpoints
+// cross-origin, so the cookie must be sent with third-party requests.
+func setDeviceCookie(ctx kratosHttp.Context, token, deviceToken string) {
+	if deviceToken == "" {
+		return
+	}
+	http.SetCookie(ctx.Response(), &http.Cookie{
+		Name:     deviceCookieName,
+		Value:    deviceToken,
+		Path:     "/api/v1/shared/" + token,
+		MaxAge:   int(deviceCookieMaxAge.Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+// publicRequestContext threads the client IP and User-Agent of a public request
+// into gRPC metadata, the way gateways forward them to the gRPC services, and
+// injects the system viewer for ENT privacy. The client certificate of mTLS
+// requests is carried as a context value, which remote callers cannot forge.
+func publicRequestContext(ctx kratosHttp.Context, ips *clientIPResolver) context.Context {
+	var grpcCtx context.Context = grpcMD.NewIncomingContext(ctx, grpcMD.Pairs(
+		"x-client-ip", ips.ClientIP(ctx.Request()),
+		"x-client-user-agent", ctx.Header().Get("User-Agent"),
+	))
+	if info := clientcert.FromConnectionState(ctx.Request().TLS); info != nil {
+		grpcCtx = clientcert.NewContext(grpcCtx, info)
+	}
+	return viewer.NewSystemViewerContext(grpcCtx)
+}
+
+// writeShareError responds with the error of a public share request. Step-up
+// challenges tell the client which methods it can answer with, proof-of-work
+// challenges the difficulty to fetch a challenge for.
+func writeShareError(ctx kratosHttp.Context, err error) error {
+	if sharingV1.IsShareStepUpRequired(err) {
+		e := errors.FromError(err)
+		return ctx.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error":         e.GetMessage(),
+			"stepUp":        true,
+			"stepUpMethods": strings.Split(e.GetMetadata()["methods"], ","),
+		})
+	}
+	if sharingV1.IsShareChallengeRequired(err) {
+		e := errors.FromError(err)
+		difficulty, _ := strconv.Atoi(e.GetMetadata()["difficulty"])
+		return ctx.JSON(http.StatusPreconditionRequired, map[string]interface{}{
+			"error":             e.GetMessage(),
+			"challengeRequired": true,
+			"difficulty":        difficulty,
+		})
+	}
+	code, msg := mapShareError(err)
+	return ctx.JSON(code, errorResponse(msg))
 }
 
 func mapShareError(err error) (int, string) {
@@ -197,6 +407,10 @@ func mapShareError(err error) (int, string) {
 		return http.StatusConflict, "this share has already been viewed"
 	case strings.Contains(errMsg, "revoked"):
 		return http.StatusGone, "this share has been revoked"
+	case strings.Contains(errMsg, "expired"):
+		return http.StatusGone, "this share has expired"
+	case strings.Contains(errMsg, "bound to another device"):
+		return http.StatusForbidden, "this share is bound to another device"
 	case strings.Contains(errMsg, "access denied") || strings.Contains(errMsg, "blacklist") || strings.Contains(errMsg, "whitelist"):
 		return http.StatusForbidden, errMsg
 	default:
@@ -207,6 +421,3 @@ func mapShareError(err error) (int, string) {
 func errorResponse(msg string) map[string]string {
 	return map[string]string{"error": msg}
 }
-
-// Ensure json is used (silence import)
-var _ = json.Marshal
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/webhook"
+)
+
+const (
+	// leakReportSignatureTolerance bounds the age of a signed leak report
+	leakReportSignatureTolerance = 5 * time.Minute
+
+	// githubSignatureHeader carries the ECDSA signature GitHub secret scanning
+	// partner reports are sent with
+	githubSignatureHeader = "Github-Public-Key-Signature"
+)
+
+// leakReportVerifier authenticates leaked token reports, so that only the
+// configured secret scanners can revoke shares. Reports are accepted when
+// signed with the shared secret of SHARING_LEAK_REPORT_SECRET, in the format
+// of outgoing webhooks, or with the ECDSA keys of a secret scanning provider
+// listed in the PEM file SHARING_LEAK_REPORT_PUBLIC_KEYS (e.g. the keys
+// GitHub publishes for its secret scanning partner program).
+type leakReportVerifier struct {
+	secret     string
+	publicKeys []*ecdsa.PublicKey
+}
+
+// newLeakReportVerifierFromEnv creates the verifier of leaked token reports.
+// It returns nil when no secret or key is configured: the endpoint is then
+// disabled rather than left open.
+func newLeakReportVerifierFromEnv(l *log.Helper) *leakReportVerifier {
+	v := &leakReportVerifier{secret: os.Getenv("SHARING_LEAK_REPORT_SECRET")}
+
+	if path := os.Getenv("SHARING_LEAK_REPORT_PUBLIC_KEYS"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			l.Errorf("Failed to read leak report public keys %s: %v", path, err)
+		} else if v.publicKeys, err = parseECDSAPublicKeys(data); err != nil {
+			l.Errorf("Failed to parse leak report public keys %s: %v", path, err)
+		}
+	}
+
+	if v.secret == "" && len(v.publicKeys) == 0 {
+		l.Infof("Leaked token reports disabled: set SHARING_LEAK_REPORT_SECRET or SHARING_LEAK_REPORT_PUBLIC_KEYS to enable them")
+		return nil
+	}
+	return v
+}
+
+// parseECDSAPublicKeys parses the PEM encoded ECDSA public keys of data
+func parseECDSAPublicKeys(data []byte) ([]*ecdsa.PublicKey, error) {
+	var keys []*ecdsa.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := pub.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported public key type %T", pub)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no public key found")
+	}
+	return keys, nil
+}
+
+// Verify reports whether the report body was signed by a configured scanner
+func (v *leakReportVerifier) Verify(header http.Header, body []byte) bool {
+	if v.secret != "" {
+		signature := header.Get(webhook.HeaderSignature)
+		timestamp, err := strconv.ParseInt(header.Get(webhook.HeaderTimestamp), 10, 64)
+		if signature != "" && err == nil && webhook.Verify(v.secret, signature, timestamp, body, leakReportSignatureTolerance) {
+			return true
+		}
+	}
+
+	if len(v.publicKeys) > 0 {
+		sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(header.Get(githubSignatureHeader)))
+		if err != nil || len(sig) == 0 {
+			return false
+		}
+		digest := sha256.Sum256(body)
+		for _, key := range v.publicKeys {
+			if ecdsa.VerifyASN1(key, digest[:], sig) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/webhook"
+)
+
+func TestLeakReportVerifierSecret(t *testing.T) {
+	v := &leakReportVerifier{secret: "scanner-secret"}
+	body := []byte(`[{"token":"tgs_x","type":"tangra_share_token"}]`)
+	now := time.Now().Unix()
+
+	signed := func(secret string, timestamp int64, body []byte) http.Header {
+		h := http.Header{}
+		h.Set(webhook.HeaderSignature, webhook.Sign(secret, timestamp, body))
+		h.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		return h
+	}
+
+	for _, tc := range []struct {
+		name   string
+		header http.Header
+		body   []byte
+		want   bool
+	}{
+		{"signed", signed("scanner-secret", now, body), body, true},
+		{"unsigned", http.Header{}, body, false},
+		{"other secret", signed("guess", now, body), body, false},
+		{"tampered body", signed("scanner-secret", now, body), []byte(`[{"token":"tgs_y"}]`), false},
+		{"stale", signed("scanner-secret", now-3600, body), body, false},
+	} {
+		if got := v.Verify(tc.header, tc.body); got != tc.want {
+			t.Errorf("%s: Verify() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLeakReportVerifierPublicKeys(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := parseECDSAPublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &leakReportVerifier{publicKeys: keys}
+
+	body := []byte(`[{"token":"tgs_x","type":"tangra_share_token","url":"https://example.com"}]`)
+	digest := sha256.Sum256(body)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := http.Header{}
+	header.Set(githubSignatureHeader, base64.StdEncoding.EncodeToString(sig))
+
+	if !v.Verify(header, body) {
+		t.Error("Verify() rejected a report signed with a configured key")
+	}
+	if v.Verify(header, append(body, ' ')) {
+		t.Error("Verify() accepted a tampered report")
+	}
+	if v.Verify(http.Header{}, body) {
+		t.Error("Verify() accepted an unsigned report")
+	}
+
+	if _, err := parseECDSAPublicKeys([]byte("not a key")); err == nil {
+		t.Error("parseECDSAPublicKeys() accepted a file without keys")
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"os"
+
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/metrics"
+	"github.com/go-tangra/go-tangra-sharing/internal/service"
+)
+
+// MetricsServer is the optional internal listener serving Prometheus metrics.
+// Metrics reveal share volumes per tenant, so they are kept off the public
+// listener and should only be reachable from the monitoring network.
+type MetricsServer struct {
+	srv *kratosHttp.Server
+}
+
+// NewMetricsServer creates the metrics listener. It is enabled by
+// SHARING_METRICS_ADDR, e.g. "127.0.0.1:9602" or an address on the
+// monitoring network.
+func NewMetricsServer(ctx *bootstrap.Context, shareSvc *service.ShareService) *MetricsServer {
+	l := ctx.NewLoggerHelper("sharing/metrics")
+
+	addr := os.Getenv("SHARING_METRICS_ADDR")
+	if addr == "" {
+		l.Infof("Metrics listener disabled: set SHARING_METRICS_ADDR to enable it")
+		return &MetricsServer{}
+	}
+
+	if err := metrics.RegisterActiveShares(shareSvc.CountActiveShares); err != nil {
+		l.Warnf("Failed to register active shares metric: %v", err)
+	}
+
+	srv := kratosHttp.NewServer(
+		kratosHttp.Address(addr),
+	)
+	srv.Handle("/metrics", metrics.Handler())
+
+	l.Infof("Metrics server listening on %s", addr)
+	return &MetricsServer{srv: srv}
+}
+
+// Start serves the metrics listener when it is enabled
+func (s *MetricsServer) Start(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Start(ctx)
+}
+
+// Stop shuts the metrics listener down
+func (s *MetricsServer) Stop(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Stop(ctx)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/service"
+)
+
+// MTLSServer is the optional mutual TLS listener for the public share
+// endpoints. Recipients presenting a client certificate here can open shares
+// restricted by CERTIFICATE policies; clients without one are served as on
+// the plain HTTP listener.
+type MTLSServer struct {
+	srv *kratosHttp.Server
+}
+
+// NewMTLSServer creates the mTLS listener. It is enabled by SHARING_MTLS_ADDR
+// and configured by:
+//   - SHARING_MTLS_CERT_PATH, SHARING_MTLS_KEY_PATH: server certificate and key
+//   - SHARING_MTLS_CLIENT_CA_PATH: PEM bundle of the CAs issuing client
+//     certificates. Without it client certificates are requested but not
+//     verified, so only sha256 certificate rules can match.
+func NewMTLSServer(ctx *bootstrap.Context, shareSvc *service.ShareService) *MTLSServer {
+	l := ctx.NewLoggerHelper("sharing/mtls")
+
+	addr := os.Getenv("SHARING_MTLS_ADDR")
+	if addr == "" {
+		return &MTLSServer{}
+	}
+
+	tlsConfig, err := mtlsConfigFromEnv()
+	if err != nil {
+		l.Errorf("mTLS listener disabled: %v", err)
+		return &MTLSServer{}
+	}
+
+	srv := kratosHttp.NewServer(
+		kratosHttp.Address(addr),
+		kratosHttp.TLSConfig(tlsConfig),
+	)
+	registerPublicShareRoutes(srv.Route("/"), shareSvc, newClientIPResolverFromEnv(l), newCORSPolicyFromEnv(l))
+
+	l.Infof("mTLS server listening on %s", addr)
+	return &MTLSServer{srv: srv}
+}
+
+// mtlsConfigFromEnv builds the TLS configuration of the mTLS listener
+func mtlsConfigFromEnv() (*tls.Config, error) {
+	certPath, keyPath := os.Getenv("SHARING_MTLS_CERT_PATH"), os.Getenv("SHARING_MTLS_KEY_PATH")
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("SHARING_MTLS_CERT_PATH and SHARING_MTLS_KEY_PATH are required")
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequestClientCert,
+	}
+
+	if caPath := os.Getenv("SHARING_MTLS_CLIENT_CA_PATH"); caPath != "" {
+		pem, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA bundle %s", caPath)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return cfg, nil
+}
+
+// Start serves the mTLS listener when it is enabled
+func (s *MTLSServer) Start(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Start(ctx)
+}
+
+// Stop shuts the mTLS listener down
+func (s *MTLSServer) Stop(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Stop(ctx)
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/service"
+)
+
+// NotificationWorker sends queued sender notifications and drains the queue on shutdown.
+// It implements transport.Server so it runs alongside the gRPC and HTTP servers.
+type NotificationWorker struct {
+	log      *log.Helper
+	shareSvc *service.ShareService
+}
+
+// NewNotificationWorker creates a new NotificationWorker
+func NewNotificationWorker(ctx *bootstrap.Context, shareSvc *service.ShareService) *NotificationWorker {
+	return &NotificationWorker{
+		log:      ctx.NewLoggerHelper("sharing/worker/notification"),
+		shareSvc: shareSvc,
+	}
+}
+
+// Start sends notifications until Stop is called and the queue is drained
+func (w *NotificationWorker) Start(context.Context) error {
+	w.log.Info("Sender notification worker started")
+	w.shareSvc.RunNotifications()
+	return nil
+}
+
+// Stop stops accepting notifications and waits for the queued ones to be sent
+func (w *NotificationWorker) Stop(ctx context.Context) error {
+	if err := w.shareSvc.StopNotifications(ctx); err != nil {
+		w.log.Warnf("Sender notifications left unsent on shutdown: %v", err)
+	}
+	return nil
+}
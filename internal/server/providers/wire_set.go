@@ -9,12 +9,23 @@ import (
 	"github.com/google/wire"
 
 	"github.com/go-tangra/go-tangra-sharing/internal/cert"
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
 	"github.com/go-tangra/go-tangra-sharing/internal/server"
 )
 
 // ProviderSet is the Wire provider set for server layer
 var ProviderSet = wire.NewSet(
 	cert.NewCertManager,
+	geoip.NewResolver,
+	reputation.NewFeeds,
 	server.NewGRPCServer,
 	server.NewHTTPServer,
+	server.NewMTLSServer,
+	server.NewMetricsServer,
+	server.NewExpiryWorker,
+	server.NewNotificationWorker,
+	server.NewWebhookWorker,
+	server.NewGeoIPWorker,
+	server.NewReputationWorker,
 )
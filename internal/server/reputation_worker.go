@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
+)
+
+// ReputationWorker periodically reloads the reputation feed files that changed
+type ReputationWorker struct {
+	*periodicWorker
+}
+
+// NewReputationWorker creates a new ReputationWorker
+func NewReputationWorker(ctx *bootstrap.Context, feeds *reputation.Feeds) *ReputationWorker {
+	l := ctx.NewLoggerHelper("sharing/worker/reputation")
+	interval := workerIntervalFromEnv(l, "SHARING_REPUTATION_RELOAD_INTERVAL", time.Minute)
+
+	reload := func(context.Context) (int, error) {
+		reloaded, err := feeds.Reload()
+		if reloaded {
+			return 1, err
+		}
+		return 0, err
+	}
+
+	return &ReputationWorker{
+		periodicWorker: newPeriodicWorker(l, "Reputation feed reload", interval, reload),
+	}
+}
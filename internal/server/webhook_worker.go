@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/service"
+)
+
+// WebhookWorker delivers published webhook events, draining them on shutdown,
+// and periodically retries deliveries whose backoff has elapsed
+type WebhookWorker struct {
+	*periodicWorker
+	dispatcher *service.WebhookDispatcher
+}
+
+// NewWebhookWorker creates a new WebhookWorker
+func NewWebhookWorker(ctx *bootstrap.Context, dispatcher *service.WebhookDispatcher) *WebhookWorker {
+	l := ctx.NewLoggerHelper("sharing/worker/webhook")
+	interval := workerIntervalFromEnv(l, "SHARING_WEBHOOK_RETRY_INTERVAL", 15*time.Second)
+
+	return &WebhookWorker{
+		periodicWorker: newPeriodicWorker(l, "Webhook retry", interval, dispatcher.RetryDue),
+		dispatcher:     dispatcher,
+	}
+}
+
+// Start delivers published events and runs the retry loop until Stop is called
+func (w *WebhookWorker) Start(ctx context.Context) error {
+	go w.dispatcher.Run()
+	return w.periodicWorker.Start(ctx)
+}
+
+// Stop waits for the published events to be delivered, then stops the retry loop
+func (w *WebhookWorker) Stop(ctx context.Context) error {
+	if err := w.dispatcher.Stop(ctx); err != nil {
+		w.log.Warnf("Webhook events left undelivered on shutdown: %v", err)
+	}
+	return w.periodicWorker.Stop(ctx)
+}
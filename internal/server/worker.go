@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// periodicWorker runs a sweep function on a fixed interval.
+// It implements transport.Server so it runs alongside the gRPC and HTTP servers.
+type periodicWorker struct {
+	log      *log.Helper
+	name     string
+	interval time.Duration
+	sweep    func(ctx context.Context) (int, error)
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func newPeriodicWorker(l *log.Helper, name string, interval time.Duration, sweep func(ctx context.Context) (int, error)) *periodicWorker {
+	return &periodicWorker{
+		log:      l,
+		name:     name,
+		interval: interval,
+		sweep:    sweep,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the sweep loop until Stop is called
+func (w *periodicWorker) Start(ctx context.Context) error {
+	defer close(w.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-w.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	w.log.Infof("%s worker started (interval %s)", w.name, w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			n, err := w.sweep(ctx)
+			if err != nil {
+				w.log.Errorf("%s sweep failed: %v", w.name, err)
+				continue
+			}
+			if n > 0 {
+				w.log.Infof("%s sweep processed %d item(s)", w.name, n)
+			}
+		}
+	}
+}
+
+// Stop stops the sweep loop and waits for it to exit
+func (w *periodicWorker) Stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.stop) })
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// workerIntervalFromEnv reads a sweep interval from the environment, falling back to def
+func workerIntervalFromEnv(l *log.Helper, key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		l.Warnf("Invalid %s %q, using %s", key, v, def)
+		return def
+	}
+	return d
+}
@@ -15,10 +15,13 @@ import (
 	"github.com/go-tangra/go-tangra-common/grpcx"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/policyset"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlinkpolicyset"
 )
 
 const (
@@ -50,9 +53,19 @@ type backupData struct {
 }
 
 type backupEntities struct {
-	EmailTemplates []json.RawMessage `json:"emailTemplates,omitempty"`
-	SharedLinks    []json.RawMessage `json:"sharedLinks,omitempty"`
-	SharePolicies  []json.RawMessage `json:"sharePolicies,omitempty"`
+	EmailTemplates       []json.RawMessage `json:"emailTemplates,omitempty"`
+	SharedLinks          []json.RawMessage `json:"sharedLinks,omitempty"`
+	SharePolicies        []json.RawMessage `json:"sharePolicies,omitempty"`
+	PolicySets           []json.RawMessage `json:"policySets,omitempty"`
+	SharedLinkPolicySets []json.RawMessage `json:"sharedLinkPolicySets,omitempty"`
+}
+
+// backupSharedLink is a shared link as stored in backups. The passphrase hash
+// is sensitive and left out of the JSON of ent.SharedLink, but restored shares
+// need it to keep asking for their step-up passphrase.
+type backupSharedLink struct {
+	*ent.SharedLink
+	PassphraseHash string `json:"passphrase_hash,omitempty"`
 }
 
 func marshalEntities[T any](entities []*T) ([]json.RawMessage, error) {
@@ -68,6 +81,10 @@ func marshalEntities[T any](entities []*T) ([]json.RawMessage, error) {
 }
 
 func (s *BackupService) ExportBackup(ctx context.Context, req *sharingV1.ExportBackupRequest) (*sharingV1.ExportBackupResponse, error) {
+	if err := authz.Require(ctx, authz.PermBackupManage); err != nil {
+		return nil, err
+	}
+
 	tenantID := grpcx.GetTenantIDFromContext(ctx)
 	full := false
 
@@ -95,6 +112,14 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *sharingV1.ExportB
 	if err != nil {
 		return nil, fmt.Errorf("export share policies: %w", err)
 	}
+	policySets, err := s.exportPolicySets(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export policy sets: %w", err)
+	}
+	linkPolicySets, err := s.exportSharedLinkPolicySets(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export shared link policy sets: %w", err)
+	}
 
 	backup := backupData{
 		Module:     backupModule,
@@ -103,9 +128,11 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *sharingV1.ExportB
 		TenantID:   tenantID,
 		FullBackup: full,
 		Data: backupEntities{
-			EmailTemplates: templates,
-			SharedLinks:    links,
-			SharePolicies:  policies,
+			EmailTemplates:       templates,
+			SharedLinks:          links,
+			SharePolicies:        policies,
+			PolicySets:           policySets,
+			SharedLinkPolicySets: linkPolicySets,
 		},
 	}
 
@@ -115,9 +142,11 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *sharingV1.ExportB
 	}
 
 	entityCounts := map[string]int64{
-		"emailTemplates": int64(len(templates)),
-		"sharedLinks":    int64(len(links)),
-		"sharePolicies":  int64(len(policies)),
+		"emailTemplates":       int64(len(templates)),
+		"sharedLinks":          int64(len(links)),
+		"sharePolicies":        int64(len(policies)),
+		"policySets":           int64(len(policySets)),
+		"sharedLinkPolicySets": int64(len(linkPolicySets)),
 	}
 
 	s.log.Infof("exported backup: module=%s tenant=%d full=%v entities=%v", backupModule, tenantID, full, entityCounts)
@@ -133,6 +162,10 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *sharingV1.ExportB
 }
 
 func (s *BackupService) ImportBackup(ctx context.Context, req *sharingV1.ImportBackupRequest) (*sharingV1.ImportBackupResponse, error) {
+	if err := authz.Require(ctx, authz.PermBackupManage); err != nil {
+		return nil, err
+	}
+
 	tenantID := grpcx.GetTenantIDFromContext(ctx)
 	isPlatformAdmin := grpcx.IsPlatformAdmin(ctx)
 	mode := req.GetMode()
@@ -171,6 +204,8 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *sharingV1.ImportB
 		{"emailTemplates", backup.Data.EmailTemplates, s.importEmailTemplates},
 		{"sharedLinks", backup.Data.SharedLinks, s.importSharedLinks},
 		{"sharePolicies", backup.Data.SharePolicies, s.importSharePolicies},
+		{"policySets", backup.Data.PolicySets, s.importPolicySets},
+		{"sharedLinkPolicySets", backup.Data.SharedLinkPolicySets, s.importSharedLinkPolicySets},
 	}
 
 	for _, imp := range importFuncs {
@@ -216,7 +251,11 @@ func (s *BackupService) exportSharedLinks(ctx context.Context, client *ent.Clien
 	if err != nil {
 		return nil, err
 	}
-	return marshalEntities(entities)
+	links := make([]*backupSharedLink, 0, len(entities))
+	for _, e := range entities {
+		links = append(links, &backupSharedLink{SharedLink: e, PassphraseHash: e.PassphraseHash})
+	}
+	return marshalEntities(links)
 }
 
 func (s *BackupService) exportSharePolicies(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
@@ -231,6 +270,30 @@ func (s *BackupService) exportSharePolicies(ctx context.Context, client *ent.Cli
 	return marshalEntities(entities)
 }
 
+func (s *BackupService) exportPolicySets(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.PolicySet.Query()
+	if !full {
+		query = query.Where(policyset.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportSharedLinkPolicySets(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.SharedLinkPolicySet.Query()
+	if !full {
+		query = query.Where(sharedlinkpolicyset.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
 // --- Import helpers ---
 
 func (s *BackupService) importEmailTemplates(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode sharingV1.RestoreMode) (*sharingV1.EntityImportResult, []string) {
@@ -250,6 +313,12 @@ func (s *BackupService) importEmailTemplates(ctx context.Context, client *ent.Cl
 			tid = *e.TenantID
 		}
 
+		// Backups taken before template kinds existed carry no kind
+		kind := e.Kind
+		if kind == "" {
+			kind = emailtemplate.KindSHARE
+		}
+
 		existing, _ := client.EmailTemplate.Get(ctx, e.ID)
 		if existing != nil {
 			if mode == sharingV1.RestoreMode_RESTORE_MODE_SKIP {
@@ -258,8 +327,10 @@ func (s *BackupService) importEmailTemplates(ctx context.Context, client *ent.Cl
 			}
 			_, err := client.EmailTemplate.UpdateOneID(e.ID).
 				SetName(e.Name).
+				SetKind(kind).
 				SetSubject(e.Subject).
 				SetHTMLBody(e.HTMLBody).
+				SetTextBody(e.TextBody).
 				SetIsDefault(e.IsDefault).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
@@ -274,8 +345,10 @@ func (s *BackupService) importEmailTemplates(ctx context.Context, client *ent.Cl
 				SetID(e.ID).
 				SetNillableTenantID(&tid).
 				SetName(e.Name).
+				SetKind(kind).
 				SetSubject(e.Subject).
 				SetHTMLBody(e.HTMLBody).
+				SetTextBody(e.TextBody).
 				SetIsDefault(e.IsDefault).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
@@ -297,7 +370,7 @@ func (s *BackupService) importSharedLinks(ctx context.Context, client *ent.Clien
 	var warnings []string
 
 	for _, raw := range items {
-		var e ent.SharedLink
+		e := backupSharedLink{SharedLink: &ent.SharedLink{}}
 		if err := json.Unmarshal(raw, &e); err != nil {
 			warnings = append(warnings, fmt.Sprintf("sharedLinks: unmarshal error: %v", err))
 			result.Failed++
@@ -321,13 +394,32 @@ func (s *BackupService) importSharedLinks(ctx context.Context, client *ent.Clien
 				SetResourceName(e.ResourceName).
 				SetToken(e.Token).
 				SetRecipientEmail(e.RecipientEmail).
+				SetSenderEmail(e.SenderEmail).
 				SetMessage(e.Message).
 				SetNillableTemplateID(e.TemplateID).
 				SetViewed(e.Viewed).
 				SetNillableViewedAt(e.ViewedAt).
 				SetViewedIP(e.ViewedIP).
 				SetRevoked(e.Revoked).
-				SetNillableCreateBy(e.CreateBy)
+				SetNillableExpiresAt(e.ExpiresAt).
+				SetExpiryNotified(e.ExpiryNotified).
+				SetNillableCreateBy(e.CreateBy).
+				SetViewCount(e.ViewCount).
+				SetBindDevice(e.BindDevice)
+			// Backups taken before policy modes existed keep the defaults
+			if e.PolicyMode != "" {
+				builder.SetPolicyMode(e.PolicyMode)
+			}
+			if e.PolicyDefault != "" {
+				builder.SetPolicyDefault(e.PolicyDefault)
+			}
+			if e.MaxViews > 0 {
+				builder.SetMaxViews(e.MaxViews)
+			}
+			// Backups taken before passphrases were exported keep the current one
+			if e.PassphraseHash != "" {
+				builder.SetPassphraseHash(e.PassphraseHash)
+			}
 			if e.EncryptedContent != nil {
 				builder.SetEncryptedContent(*e.EncryptedContent)
 			} else {
@@ -354,14 +446,32 @@ func (s *BackupService) importSharedLinks(ctx context.Context, client *ent.Clien
 				SetResourceName(e.ResourceName).
 				SetToken(e.Token).
 				SetRecipientEmail(e.RecipientEmail).
+				SetSenderEmail(e.SenderEmail).
 				SetMessage(e.Message).
 				SetNillableTemplateID(e.TemplateID).
 				SetViewed(e.Viewed).
 				SetNillableViewedAt(e.ViewedAt).
 				SetViewedIP(e.ViewedIP).
 				SetRevoked(e.Revoked).
+				SetNillableExpiresAt(e.ExpiresAt).
+				SetExpiryNotified(e.ExpiryNotified).
 				SetNillableCreateBy(e.CreateBy).
-				SetNillableCreateTime(e.CreateTime)
+				SetNillableCreateTime(e.CreateTime).
+				SetViewCount(e.ViewCount).
+				SetBindDevice(e.BindDevice)
+			if e.PolicyMode != "" {
+				createBuilder.SetPolicyMode(e.PolicyMode)
+			}
+			if e.PolicyDefault != "" {
+				createBuilder.SetPolicyDefault(e.PolicyDefault)
+			}
+			// Device bindings are not exported; restored shares bind again on their next view
+			if e.MaxViews > 0 {
+				createBuilder.SetMaxViews(e.MaxViews)
+			}
+			if e.PassphraseHash != "" {
+				createBuilder.SetPassphraseHash(e.PassphraseHash)
+			}
 			if e.EncryptedContent != nil {
 				createBuilder.SetEncryptedContent(*e.EncryptedContent)
 			}
@@ -410,6 +520,8 @@ func (s *BackupService) importSharePolicies(ctx context.Context, client *ent.Cli
 				SetMethod(e.Method).
 				SetValue(e.Value).
 				SetReason(e.Reason).
+				SetPriority(e.Priority).
+				SetDeprecated(e.Deprecated).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -427,6 +539,8 @@ func (s *BackupService) importSharePolicies(ctx context.Context, client *ent.Cli
 				SetMethod(e.Method).
 				SetValue(e.Value).
 				SetReason(e.Reason).
+				SetPriority(e.Priority).
+				SetDeprecated(e.Deprecated).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -441,3 +555,106 @@ func (s *BackupService) importSharePolicies(ctx context.Context, client *ent.Cli
 
 	return result, warnings
 }
+
+func (s *BackupService) importPolicySets(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode sharingV1.RestoreMode) (*sharingV1.EntityImportResult, []string) {
+	result := &sharingV1.EntityImportResult{EntityType: "policySets", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.PolicySet
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("policySets: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.PolicySet.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == sharingV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.PolicySet.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetRules(e.Rules).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableUpdateTime(e.UpdateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("policySets: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.PolicySet.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetRules(e.Rules).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				SetNillableUpdateTime(e.UpdateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("policySets: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importSharedLinkPolicySets(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode sharingV1.RestoreMode) (*sharingV1.EntityImportResult, []string) {
+	result := &sharingV1.EntityImportResult{EntityType: "sharedLinkPolicySets", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.SharedLinkPolicySet
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("sharedLinkPolicySets: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		// References never change, so existing rows are always skipped
+		existing, _ := client.SharedLinkPolicySet.Get(ctx, e.ID)
+		if existing != nil {
+			result.Skipped++
+			continue
+		}
+
+		_, err := client.SharedLinkPolicySet.Create().
+			SetID(e.ID).
+			SetNillableTenantID(&tid).
+			SetShareLinkID(e.ShareLinkID).
+			SetPolicySetID(e.PolicySetID).
+			SetNillableCreateBy(e.CreateBy).
+			SetNillableCreateTime(e.CreateTime).
+			Save(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("sharedLinkPolicySets: create %s: %v", e.ID, err))
+			result.Failed++
+			continue
+		}
+		result.Created++
+	}
+
+	return result, warnings
+}
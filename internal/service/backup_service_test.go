@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestBackupKeepsSharePassphrases(t *testing.T) {
+	s, client := newTestShareService(t)
+	backup := NewBackupService(newTestContext(), nil)
+	ctx := tenantContext(tenantA, 0)
+	share, _ := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{PassphraseHash: "pbkdf2-sha256$1$c2FsdA$a2V5"})
+
+	items, err := backup.exportSharedLinks(ctx, client, tenantA, false)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("exportSharedLinks = %d item(s), %v; want the share", len(items), err)
+	}
+	if err := client.SharedLink.DeleteOneID(share.ID).Exec(ctx); err != nil {
+		t.Fatalf("delete share: %v", err)
+	}
+
+	result, warnings := backup.importSharedLinks(ctx, client, items, tenantA, false, sharingV1.RestoreMode_RESTORE_MODE_SKIP)
+	if result.Created != 1 || len(warnings) != 0 {
+		t.Fatalf("importSharedLinks = %+v, %v; want the share created", result, warnings)
+	}
+	restored, err := client.SharedLink.Get(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("get restored share: %v", err)
+	}
+	if restored.PassphraseHash != share.PassphraseHash {
+		t.Errorf("restored passphrase hash = %q, want %q", restored.PassphraseHash, share.PassphraseHash)
+	}
+}
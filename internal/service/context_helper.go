@@ -1,6 +1,10 @@
 package service
 
-import "github.com/go-tangra/go-tangra-common/grpcx"
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+)
 
 var (
 	getMetadataValue      = grpcx.GetMetadataValue
@@ -9,3 +13,8 @@ var (
 	getUsernameFromContext = grpcx.GetUsernameFromContext
 	getClientIPFromContext = grpcx.GetClientIPFromContext
 )
+
+// getUserAgentFromContext returns the client User-Agent forwarded by the public HTTP endpoint
+func getUserAgentFromContext(ctx context.Context) string {
+	return getMetadataValue(ctx, "x-client-user-agent")
+}
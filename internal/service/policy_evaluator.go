@@ -1,149 +1,461 @@
 package service
 
 import (
+	"cmp"
 	"fmt"
-	"net"
+	"net/netip"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
 	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+	"github.com/go-tangra/go-tangra-sharing/pkg/clientcert"
+	"github.com/go-tangra/go-tangra-sharing/pkg/device"
+	"github.com/go-tangra/go-tangra-sharing/pkg/expression"
+	"github.com/go-tangra/go-tangra-sharing/pkg/timewindow"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
 )
 
+// PolicyRequest holds the attributes of an access attempt that policies are matched against
+type PolicyRequest struct {
+	ClientIP string
+
+	// Now is the time of the request that TIME policies are matched against
+	Now time.Time
+
+	// UserAgent is the raw client User-Agent header
+	UserAgent string
+	// Device is parsed from the client User-Agent
+	Device device.Info
+
+	// Location is the GeoIP location of ClientIP, nil when it could not be resolved
+	Location *geoip.Location
+	// GeoFailOpen lets requests through REGION policies when Location is nil
+	GeoFailOpen bool
+
+	// Certificate is the client certificate presented on the mTLS listener, nil without one
+	Certificate *clientcert.Info
+
+	// ReputationLists are the reputation lists ClientIP is on
+	ReputationLists []string
+
+	// AttemptCount is the number of earlier attempts to open the share
+	AttemptCount int64
+	// RecipientEmail is the address the share was sent to
+	RecipientEmail string
+}
+
+// PolicyMode controls how the policies of a share are combined into a decision.
+// The zero value combines them ALL_MUST_PASS and allows by default.
+type PolicyMode struct {
+	Combine sharedlink.PolicyMode
+	Default sharedlink.PolicyDefault
+}
+
+// policyModeOf returns the policy mode of a share
+func policyModeOf(entity *ent.SharedLink) PolicyMode {
+	return PolicyMode{Combine: entity.PolicyMode, Default: entity.PolicyDefault}
+}
+
+// PolicyTrace explains the evaluation of a single policy
+type PolicyTrace struct {
+	Policy  *ent.SharePolicy
+	Matched bool
+	// Decisive is set on the policies that decided the outcome
+	Decisive bool
+	// Explanation says why the policy did or did not match
+	Explanation string
+}
+
+// PolicyDecision is the outcome of evaluating the policies of a share
+type PolicyDecision struct {
+	// Err is nil when access is allowed, a ShareAccessDenied error otherwise
+	Err error
+	// DeniedBy is the policy that denied access, nil when access was allowed
+	// or denied because no whitelist entry matched
+	DeniedBy *ent.SharePolicy
+	// DeniedMethod is the method whose policies denied access
+	DeniedMethod sharepolicy.Method
+	// DefaultApplied is set when no policy decided and the default decision was taken
+	DefaultApplied bool
+	// Trace holds one entry per policy, in the order the policies were given
+	Trace []PolicyTrace
+}
+
 // EvaluatePolicies checks whether the client is allowed to access the share
 // based on the configured policies. Returns nil if access is allowed,
-// or an error if denied.
-func EvaluatePolicies(policies []*ent.SharePolicy, clientIP string) error {
-	if len(policies) == 0 {
-		return nil
+// or an error if denied together with the policy that denied it (nil when
+// access was denied because no whitelist entry matched or by default) and
+// the method whose policies denied access.
+func EvaluatePolicies(policies []*ent.SharePolicy, mode PolicyMode, req *PolicyRequest) (*ent.SharePolicy, sharepolicy.Method, error) {
+	d := ExplainPolicies(policies, mode, req)
+	return d.DeniedBy, d.DeniedMethod, d.Err
+}
+
+// ExplainPolicies evaluates every policy against the request and returns the
+// decision together with a trace of which policies matched and why.
+// Policies are considered in ascending priority order, policies of equal
+// priority in the order they are given, so the decision and the reported
+// denial do not depend on anything but the policies and the request.
+func ExplainPolicies(policies []*ent.SharePolicy, mode PolicyMode, req *PolicyRequest) *PolicyDecision {
+	d := &PolicyDecision{Trace: make([]PolicyTrace, len(policies))}
+	for i, p := range policies {
+		matched, explanation := matchesPolicy(p, req)
+		d.Trace[i] = PolicyTrace{Policy: p, Matched: matched, Explanation: explanation}
+	}
+
+	order := make([]int, len(policies))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(policies[a].Priority, policies[b].Priority)
+	})
+
+	var decided bool
+	switch mode.Combine {
+	case sharedlink.PolicyModeANY_WHITELIST:
+		decided = d.anyWhitelist(order)
+	case sharedlink.PolicyModeFIRST_MATCH:
+		decided = d.firstMatch(order)
+	default:
+		decided = d.allMustPass(order)
 	}
 
-	// Group policies by method
-	byMethod := make(map[sharepolicy.Method][]*ent.SharePolicy)
-	for _, p := range policies {
-		byMethod[p.Method] = append(byMethod[p.Method], p)
+	if !decided {
+		d.DefaultApplied = true
+		if mode.Default == sharedlink.PolicyDefaultDENY {
+			d.Err = sharingV1.ErrorShareAccessDenied("access denied: no policy allows access")
+		}
+	}
+	return d
+}
+
+// allMustPass checks methods in the order they first appear; within a method
+// any matching blacklist entry denies, and when whitelist entries exist at
+// least one of them must match. Access is decided when it was denied or a
+// whitelist entry allowed it.
+func (d *PolicyDecision) allMustPass(order []int) bool {
+	var methods []sharepolicy.Method
+	byMethod := make(map[sharepolicy.Method][]int)
+	for _, i := range order {
+		p := d.Trace[i].Policy
+		if _, ok := byMethod[p.Method]; !ok {
+			methods = append(methods, p.Method)
+		}
+		byMethod[p.Method] = append(byMethod[p.Method], i)
 	}
 
-	for method, methodPolicies := range byMethod {
+	allowed := false
+	for _, method := range methods {
 		// Separate whitelist and blacklist
-		var whitelists, blacklists []*ent.SharePolicy
-		for _, p := range methodPolicies {
-			switch p.Type {
+		var whitelists, blacklists []int
+		for _, i := range byMethod[method] {
+			switch d.Trace[i].Policy.Type {
 			case sharepolicy.TypeWHITELIST:
-				whitelists = append(whitelists, p)
+				whitelists = append(whitelists, i)
 			case sharepolicy.TypeBLACKLIST:
-				blacklists = append(blacklists, p)
+				blacklists = append(blacklists, i)
 			}
 		}
 
 		// Check blacklist: if any match, deny
-		for _, p := range blacklists {
-			if matchesPolicy(p, clientIP) {
-				reason := p.Reason
-				if reason == "" {
-					reason = fmt.Sprintf("blocked by %s blacklist policy", method)
-				}
-				return sharingV1.ErrorShareAccessDenied(reason)
+		for _, i := range blacklists {
+			if d.Trace[i].Matched {
+				d.deny(i)
+				return true
 			}
 		}
 
 		// Check whitelist: if whitelists exist, at least one must match
 		if len(whitelists) > 0 {
-			matched := false
-			for _, p := range whitelists {
-				if matchesPolicy(p, clientIP) {
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				return sharingV1.ErrorShareAccessDenied("access denied: not in %s whitelist", method)
+			if !slices.ContainsFunc(whitelists, func(i int) bool { return d.Trace[i].Matched }) {
+				d.denyUnlisted(whitelists, fmt.Sprintf("%s whitelist", method))
+				d.DeniedMethod = method
+				return true
 			}
+			allowed = true
 		}
 	}
+	return allowed
+}
+
+// anyWhitelist denies on any matching blacklist entry and otherwise allows
+// when any whitelist entry, of whichever method, matches
+func (d *PolicyDecision) anyWhitelist(order []int) bool {
+	var whitelists []int
+	for _, i := range order {
+		t := &d.Trace[i]
+		if t.Policy.Type == sharepolicy.TypeBLACKLIST && t.Matched {
+			d.deny(i)
+			return true
+		}
+		if t.Policy.Type == sharepolicy.TypeWHITELIST {
+			whitelists = append(whitelists, i)
+		}
+	}
+
+	for _, i := range whitelists {
+		if d.Trace[i].Matched {
+			d.Trace[i].Decisive = true
+			return true
+		}
+	}
+	if len(whitelists) > 0 {
+		d.denyUnlisted(whitelists, "any whitelist")
+		return true
+	}
+	return false
+}
+
+// firstMatch lets the first matching policy decide: whitelist entries allow,
+// blacklist entries deny
+func (d *PolicyDecision) firstMatch(order []int) bool {
+	for _, i := range order {
+		if !d.Trace[i].Matched {
+			continue
+		}
+		if d.Trace[i].Policy.Type == sharepolicy.TypeBLACKLIST {
+			d.deny(i)
+		} else {
+			d.Trace[i].Decisive = true
+		}
+		return true
+	}
+	return false
+}
+
+// deny records a denial by the blacklist policy at index i
+func (d *PolicyDecision) deny(i int) {
+	p := d.Trace[i].Policy
+	reason := p.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("blocked by %s blacklist policy", p.Method)
+	}
+	d.Trace[i].Decisive = true
+	d.DeniedBy, d.DeniedMethod = p, p.Method
+	d.Err = sharingV1.ErrorShareAccessDenied("%s", reason)
+}
 
-	return nil
+// denyUnlisted records a denial because none of the whitelist policies matched
+func (d *PolicyDecision) denyUnlisted(whitelists []int, what string) {
+	for _, i := range whitelists {
+		d.Trace[i].Decisive = true
+	}
+	d.Err = sharingV1.ErrorShareAccessDenied("access denied: not in %s", what)
 }
 
 // matchesPolicy checks if a single policy matches the current request context
-func matchesPolicy(p *ent.SharePolicy, clientIP string) bool {
+// and explains why
+func matchesPolicy(p *ent.SharePolicy, req *PolicyRequest) (bool, string) {
+	switch p.Method {
+	case sharepolicy.MethodIP, sharepolicy.MethodNETWORK, sharepolicy.MethodREGION, sharepolicy.MethodREPUTATION:
+		// A client whose address is unknown could be anyone: policies on the
+		// address fail closed, so blacklists match and whitelists do not
+		if _, ok := parseClientIP(req.ClientIP); !ok {
+			return p.Type == sharepolicy.TypeBLACKLIST, fmt.Sprintf("client IP %q is unknown, %s policies fail closed", req.ClientIP, p.Method)
+		}
+	}
+
 	switch p.Method {
 	case sharepolicy.MethodIP:
-		return matchIP(p.Value, clientIP)
+		return matchIP(p.Value, req.ClientIP)
 	case sharepolicy.MethodNETWORK:
-		return matchNetwork(p.Value, clientIP)
+		return matchNetwork(p.Value, req.ClientIP)
 	case sharepolicy.MethodREGION:
-		// Region matching requires a geo-lookup service.
-		// For now, compare directly — can be enhanced with MaxMind GeoIP later.
-		return matchRegion(p.Value, clientIP)
+		if req.Location == nil {
+			// Failing open lets the request through: whitelists match, blacklists do not
+			if req.GeoFailOpen {
+				return p.Type == sharepolicy.TypeWHITELIST, "client location is unknown, REGION policies fail open"
+			}
+			return p.Type == sharepolicy.TypeBLACKLIST, "client location is unknown, REGION policies fail closed"
+		}
+		return matchRegion(p.Value, req.Location)
 	case sharepolicy.MethodTIME:
-		return matchTimeWindow(p.Value)
+		return matchTimeWindow(p.Value, req.Now)
 	case sharepolicy.MethodMAC:
-		// MAC matching depends on request headers (X-Client-MAC) — limited applicability
-		return false
+		return false, "MAC policies are deprecated and never match: client MAC addresses are not visible to the server"
+	case sharepolicy.MethodCERTIFICATE:
+		return matchCertificate(p.Value, req.Certificate)
+	case sharepolicy.MethodREPUTATION:
+		return matchReputation(p.Value, req.ClientIP, req.ReputationLists)
 	case sharepolicy.MethodDEVICE:
-		// Device matching depends on request headers (User-Agent) — limited applicability
-		return false
+		return matchDevice(p.Value, req.Device)
+	case sharepolicy.MethodEXPRESSION:
+		matched, explanation, err := matchExpression(p.Value, req)
+		if err != nil {
+			// Expressions that cannot be evaluated fail closed
+			return p.Type == sharepolicy.TypeBLACKLIST, explanation
+		}
+		return matched, explanation
 	default:
-		return false
+		return false, fmt.Sprintf("unknown policy method %s", p.Method)
 	}
 }
 
-// matchIP checks if the client IP matches the policy value (exact match)
-func matchIP(policyValue, clientIP string) bool {
-	return strings.TrimSpace(policyValue) == strings.TrimSpace(clientIP)
+// matchIP checks if the client IP is the policy address. Addresses are compared
+// parsed, so differently written forms of the same address match.
+func matchIP(policyValue, clientIP string) (bool, string) {
+	want, err := netip.ParseAddr(strings.TrimSpace(policyValue))
+	if err != nil {
+		return false, fmt.Sprintf("invalid IP address %q", policyValue)
+	}
+	ip, ok := parseClientIP(clientIP)
+	if !ok {
+		return false, fmt.Sprintf("client IP %q is not a valid address", clientIP)
+	}
+	if want.Unmap() != ip {
+		return false, fmt.Sprintf("client IP %s is not %s", ip, want)
+	}
+	return true, fmt.Sprintf("client IP %s is %s", ip, want)
 }
 
 // matchNetwork checks if the client IP falls within the CIDR range
-func matchNetwork(cidrStr, clientIP string) bool {
-	_, cidr, err := net.ParseCIDR(strings.TrimSpace(cidrStr))
+func matchNetwork(cidrStr, clientIP string) (bool, string) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidrStr))
 	if err != nil {
-		return false
+		return false, fmt.Sprintf("invalid CIDR prefix %q", cidrStr)
 	}
-	ip := net.ParseIP(strings.TrimSpace(clientIP))
-	if ip == nil {
-		return false
+	prefix = prefix.Masked()
+	ip, ok := parseClientIP(clientIP)
+	if !ok {
+		return false, fmt.Sprintf("client IP %q is not a valid address", clientIP)
 	}
-	return cidr.Contains(ip)
+	if !prefix.Contains(ip) {
+		return false, fmt.Sprintf("client IP %s is outside %s", ip, prefix)
+	}
+	return true, fmt.Sprintf("client IP %s is within %s", ip, prefix)
 }
 
-// matchRegion is a placeholder for geo-based matching.
-// In a full implementation, this would use a GeoIP database to resolve
-// the client IP to a country/region code and compare.
-func matchRegion(_ string, _ string) bool {
-	// TODO: integrate GeoIP lookup (e.g. MaxMind GeoLite2)
-	return false
+// parseClientIP parses the client IP, unmapping IPv4-mapped IPv6 addresses
+func parseClientIP(clientIP string) (netip.Addr, bool) {
+	ip, err := netip.ParseAddr(strings.TrimSpace(clientIP))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.WithZone("").Unmap(), true
+}
+
+// matchRegion checks if the client location matches the region.
+// Expected format: "CC", "CC-SUB" or either followed by "/City", where CC is
+// an ISO 3166-1 alpha-2 country code and SUB an ISO 3166-2 subdivision code
+// (e.g. "US", "US-CA", "US-CA/San Francisco", "DE/Berlin"). Case-insensitive.
+func matchRegion(region string, loc *geoip.Location) (bool, string) {
+	value := strings.TrimSpace(region)
+	region, city, hasCity := strings.Cut(value, "/")
+	country, subdivision, hasSubdivision := strings.Cut(strings.TrimSpace(region), "-")
+
+	if !strings.EqualFold(strings.TrimSpace(country), loc.Country) {
+		return false, fmt.Sprintf("client location %s is outside country %s", loc, strings.TrimSpace(country))
+	}
+	if hasSubdivision && !slices.ContainsFunc(loc.Subdivisions, func(s string) bool {
+		return strings.EqualFold(s, strings.TrimSpace(subdivision))
+	}) {
+		return false, fmt.Sprintf("client location %s is outside subdivision %s", loc, strings.TrimSpace(subdivision))
+	}
+	if hasCity && !strings.EqualFold(strings.TrimSpace(city), loc.City) {
+		return false, fmt.Sprintf("client location %s is outside city %s", loc, strings.TrimSpace(city))
+	}
+	return true, fmt.Sprintf("client location %s is within %s", loc, value)
 }
 
-// matchTimeWindow checks if the current time falls within the specified window.
-// Expected format: "HH:MM-HH:MM" (24-hour, UTC).
-func matchTimeWindow(timeRange string) bool {
-	parts := strings.SplitN(timeRange, "-", 2)
-	if len(parts) != 2 {
-		return false
+// matchDevice checks if the client device satisfies the device rule.
+// Expected format: "key=value[,value...]" conditions separated by ";", where key is
+// os, browser or class and a leading "!" negates the values (e.g. "os=windows,macos;class=!bot").
+func matchDevice(value string, info device.Info) (bool, string) {
+	rule, err := device.ParseRule(value)
+	if err != nil {
+		return false, fmt.Sprintf("invalid device rule: %v", err)
 	}
+	desc := fmt.Sprintf("os=%s browser=%s class=%s", info.OS, info.Browser, info.Class)
+	if !rule.Matches(info) {
+		return false, fmt.Sprintf("client device %s does not satisfy %q", desc, value)
+	}
+	return true, fmt.Sprintf("client device %s satisfies %q", desc, value)
+}
 
-	now := time.Now().UTC()
-	startTime, err := time.Parse("15:04", strings.TrimSpace(parts[0]))
+// matchExpression evaluates a CEL expression against the request.
+// See expression.Program for the variables and functions available.
+func matchExpression(value string, req *PolicyRequest) (bool, string, error) {
+	prg, err := expression.CompileCached(value)
 	if err != nil {
-		return false
+		return false, fmt.Sprintf("invalid expression: %v", err), err
+	}
+	country := ""
+	if req.Location != nil {
+		country = req.Location.Country
 	}
-	endTime, err := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	matched, err := prg.Eval(expression.Context{
+		IP:             req.ClientIP,
+		Country:        country,
+		Time:           req.Now,
+		UserAgent:      req.UserAgent,
+		AttemptCount:   req.AttemptCount,
+		RecipientEmail: req.RecipientEmail,
+	})
 	if err != nil {
-		return false
+		return false, fmt.Sprintf("expression could not be evaluated: %v", err), err
 	}
+	if !matched {
+		return false, fmt.Sprintf("expression %q is false", value), nil
+	}
+	return true, fmt.Sprintf("expression %q is true", value), nil
+}
 
-	// Normalize to today's date
-	currentMinutes := now.Hour()*60 + now.Minute()
-	startMinutes := startTime.Hour()*60 + startTime.Minute()
-	endMinutes := endTime.Hour()*60 + endTime.Minute()
+// matchCertificate checks if the client certificate satisfies the certificate rule.
+// See clientcert.Rule for the format, e.g. "sha256:<fingerprint>" or "issuer:CN=Acme Device CA".
+func matchCertificate(value string, info *clientcert.Info) (bool, string) {
+	rule, err := clientcert.ParseRule(value)
+	if err != nil {
+		return false, fmt.Sprintf("invalid certificate rule: %v", err)
+	}
+	if info == nil {
+		return false, "client presented no certificate"
+	}
+	desc := fmt.Sprintf("client certificate %q (sha256 %s)", info.Subject, info.Fingerprint)
+	if rule.Kind != clientcert.KindSHA256 && !info.Verified {
+		return false, desc + " is not issued by a trusted client CA"
+	}
+	if !rule.Matches(info) {
+		return false, fmt.Sprintf("%s does not satisfy %q", desc, rule)
+	}
+	return true, fmt.Sprintf("%s satisfies %q", desc, rule)
+}
+
+// matchReputation checks if the client IP is on one of the reputation lists.
+// Expected format: comma-separated list names, e.g. "tor,vpn".
+func matchReputation(value, clientIP string, lists []string) (bool, string) {
+	if list := reputationListMatch(value, lists); list != "" {
+		return true, fmt.Sprintf("client IP %s is on reputation list %s", clientIP, list)
+	}
+	return false, fmt.Sprintf("client IP %s is on none of the reputation lists %s", clientIP, value)
+}
 
-	if startMinutes <= endMinutes {
-		// Normal range: e.g. 09:00-17:00
-		return currentMinutes >= startMinutes && currentMinutes <= endMinutes
+// reputationListMatch returns the first list of a REPUTATION policy value that
+// is among lists, or an empty string
+func reputationListMatch(value string, lists []string) string {
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); slices.Contains(lists, name) {
+			return name
+		}
+	}
+	return ""
+}
+
+// matchTimeWindow checks if now falls within the time schedule.
+// See timewindow.Schedule for the format, e.g. "Mon-Fri 09:00-17:00 Europe/Sofia".
+func matchTimeWindow(value string, now time.Time) (bool, string) {
+	sched, err := timewindow.Parse(value)
+	if err != nil {
+		return false, fmt.Sprintf("invalid time schedule: %v", err)
+	}
+	at := now.UTC().Format(time.RFC3339)
+	if !sched.Contains(now) {
+		return false, fmt.Sprintf("request time %s is outside %q", at, value)
 	}
-	// Overnight range: e.g. 22:00-06:00
-	return currentMinutes >= startMinutes || currentMinutes <= endMinutes
+	return true, fmt.Sprintf("request time %s is within %q", at, value)
 }
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+	"github.com/go-tangra/go-tangra-sharing/pkg/clientcert"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func regionPolicy(t sharepolicy.Type, value string) *ent.SharePolicy {
+	return &ent.SharePolicy{ID: value, Type: t, Method: sharepolicy.MethodREGION, Value: value}
+}
+
+func TestMatchRegion(t *testing.T) {
+	sanFrancisco := &geoip.Location{Country: "US", Subdivisions: []string{"CA"}, City: "San Francisco"}
+
+	for _, tc := range []struct {
+		region string
+		want   bool
+	}{
+		{"US", true},
+		{"us", true},
+		{"US-CA", true},
+		{"US-ca/san francisco", true},
+		{"US/San Francisco", true},
+		{"US-NY", false},
+		{"US/Los Angeles", false},
+		{"DE", false},
+		{"", false},
+	} {
+		if got, _ := matchRegion(tc.region, sanFrancisco); got != tc.want {
+			t.Errorf("matchRegion(%q) = %v, want %v", tc.region, got, tc.want)
+		}
+	}
+}
+
+func TestEvaluateRegionPolicies(t *testing.T) {
+	berlin := &geoip.Location{Country: "DE", Subdivisions: []string{"BE"}, City: "Berlin"}
+
+	for _, tc := range []struct {
+		name     string
+		policies []*ent.SharePolicy
+		req      *PolicyRequest
+		denied   bool
+	}{
+		{
+			name:     "whitelisted country",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeWHITELIST, "DE")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7", Location: berlin},
+		},
+		{
+			name:     "country outside whitelist",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeWHITELIST, "FR")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7", Location: berlin},
+			denied:   true,
+		},
+		{
+			name:     "blacklisted subdivision",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeBLACKLIST, "DE-BE")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7", Location: berlin},
+			denied:   true,
+		},
+		{
+			name:     "unresolved whitelist fails closed",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeWHITELIST, "DE")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7"},
+			denied:   true,
+		},
+		{
+			name:     "unresolved blacklist fails closed",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeBLACKLIST, "FR")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7"},
+			denied:   true,
+		},
+		{
+			name:     "unresolved whitelist fails open",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeWHITELIST, "DE")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7", GeoFailOpen: true},
+		},
+		{
+			name:     "unresolved blacklist fails open",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeBLACKLIST, "FR")},
+			req:      &PolicyRequest{ClientIP: "198.51.100.7", GeoFailOpen: true},
+		},
+		{
+			name:     "unknown client fails closed even when geo fails open",
+			policies: []*ent.SharePolicy{regionPolicy(sharepolicy.TypeBLACKLIST, "FR")},
+			req:      &PolicyRequest{GeoFailOpen: true},
+			denied:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := EvaluatePolicies(tc.policies, PolicyMode{}, tc.req)
+			if tc.denied && !sharingV1.IsShareAccessDenied(err) {
+				t.Fatalf("expected ShareAccessDenied, got %v", err)
+			}
+			if !tc.denied && err != nil {
+				t.Fatalf("expected access, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEvaluateTimePolicyUsesRequestClock(t *testing.T) {
+	policies := []*ent.SharePolicy{{
+		ID:     "office-hours",
+		Type:   sharepolicy.TypeWHITELIST,
+		Method: sharepolicy.MethodTIME,
+		Value:  "Mon-Fri 09:00-17:00 UTC",
+	}}
+
+	// 2026-03-02 is a Monday, 2026-03-07 a Saturday
+	if _, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Now: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatalf("expected access during office hours, got %v", err)
+	}
+	if _, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Now: time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)}); !sharingV1.IsShareAccessDenied(err) {
+		t.Fatalf("expected ShareAccessDenied on the weekend, got %v", err)
+	}
+}
+
+func TestEvaluateExpressionPolicies(t *testing.T) {
+	policies := []*ent.SharePolicy{
+		{ID: "limit", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodEXPRESSION, Value: `country == "BG" && attempt_count < 3`},
+		{ID: "broken", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodEXPRESSION, Value: `100 / (attempt_count - 1) < 0`},
+	}
+	bg := &geoip.Location{Country: "BG"}
+
+	if _, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Location: bg, AttemptCount: 2}); err != nil {
+		t.Fatalf("expected access, got %v", err)
+	}
+	if _, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Location: bg, AttemptCount: 3}); !sharingV1.IsShareAccessDenied(err) {
+		t.Fatalf("expected ShareAccessDenied after too many attempts, got %v", err)
+	}
+	// Division by zero fails the blacklist closed
+	deniedBy, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Location: bg, AttemptCount: 1})
+	if !sharingV1.IsShareAccessDenied(err) || deniedBy == nil || deniedBy.ID != "broken" {
+		t.Fatalf("expected denial by the failing blacklist, got %v, %v", deniedBy, err)
+	}
+}
+
+func TestPolicyModes(t *testing.T) {
+	policies := []*ent.SharePolicy{
+		{ID: "office", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodNETWORK, Value: "10.0.0.0/8", Priority: 10},
+		{ID: "bg", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodREGION, Value: "BG", Priority: 10},
+		{ID: "printer", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodIP, Value: "10.0.0.66", Priority: 20},
+		{ID: "admin", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodIP, Value: "10.0.0.66", Priority: 5},
+	}
+	office := &PolicyRequest{ClientIP: "10.0.0.1", Location: &geoip.Location{Country: "DE"}}
+	printer := &PolicyRequest{ClientIP: "10.0.0.66", Location: &geoip.Location{Country: "DE"}}
+	remote := &PolicyRequest{ClientIP: "192.0.2.1", Location: &geoip.Location{Country: "FR"}}
+
+	for _, tc := range []struct {
+		name    string
+		mode    sharedlink.PolicyMode
+		req     *PolicyRequest
+		allowed bool
+		by      string
+	}{
+		// Every method must pass: the office is not in BG
+		{"all office", sharedlink.PolicyModeALL_MUST_PASS, office, false, ""},
+		// Any whitelist: the office network is enough, the blacklist still wins
+		{"any office", sharedlink.PolicyModeANY_WHITELIST, office, true, "office"},
+		{"any printer", sharedlink.PolicyModeANY_WHITELIST, printer, false, "printer"},
+		{"any remote", sharedlink.PolicyModeANY_WHITELIST, remote, false, ""},
+		// First match: the admin whitelist has the lowest priority and wins over the blacklist
+		{"first printer", sharedlink.PolicyModeFIRST_MATCH, printer, true, "admin"},
+		{"first office", sharedlink.PolicyModeFIRST_MATCH, office, true, "office"},
+	} {
+		d := ExplainPolicies(policies, PolicyMode{Combine: tc.mode}, tc.req)
+		if (d.Err == nil) != tc.allowed {
+			t.Errorf("%s: allowed = %v, want %v (%v)", tc.name, d.Err == nil, tc.allowed, d.Err)
+		}
+		var decisive []string
+		for _, tr := range d.Trace {
+			if tr.Decisive {
+				decisive = append(decisive, tr.Policy.ID)
+			}
+		}
+		if tc.by != "" && (len(decisive) != 1 || decisive[0] != tc.by) {
+			t.Errorf("%s: decisive = %v, want [%s]", tc.name, decisive, tc.by)
+		}
+	}
+}
+
+func TestPolicyDefault(t *testing.T) {
+	blacklist := []*ent.SharePolicy{
+		{ID: "printer", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodIP, Value: "10.0.0.66"},
+	}
+	req := &PolicyRequest{ClientIP: "10.0.0.1"}
+
+	for _, mode := range []sharedlink.PolicyMode{sharedlink.PolicyModeALL_MUST_PASS, sharedlink.PolicyModeANY_WHITELIST, sharedlink.PolicyModeFIRST_MATCH} {
+		if d := ExplainPolicies(blacklist, PolicyMode{Combine: mode}, req); d.Err != nil || !d.DefaultApplied {
+			t.Errorf("%s: expected default allow, got %v", mode, d.Err)
+		}
+		d := ExplainPolicies(blacklist, PolicyMode{Combine: mode, Default: sharedlink.PolicyDefaultDENY}, req)
+		if !sharingV1.IsShareAccessDenied(d.Err) || !d.DefaultApplied || d.DeniedBy != nil {
+			t.Errorf("%s: expected default deny, got %v", mode, d.Err)
+		}
+	}
+
+	if d := ExplainPolicies(nil, PolicyMode{Default: sharedlink.PolicyDefaultDENY}, req); !sharingV1.IsShareAccessDenied(d.Err) {
+		t.Errorf("expected default deny without policies, got %v", d.Err)
+	}
+}
+
+func TestEvaluateCertificatePolicies(t *testing.T) {
+	const fp = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	policies := []*ent.SharePolicy{
+		{ID: "device", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodCERTIFICATE, Value: "sha256:" + fp},
+		{ID: "ca", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodCERTIFICATE, Value: "issuer:CN=Acme Device CA"},
+	}
+
+	for _, tc := range []struct {
+		name string
+		cert *clientcert.Info
+		want bool
+	}{
+		{"no certificate", nil, false},
+		{"pinned device", &clientcert.Info{Fingerprint: fp}, true},
+		{"unverified issuer", &clientcert.Info{Fingerprint: "00", Issuer: "CN=Acme Device CA"}, false},
+		{"verified issuer", &clientcert.Info{Fingerprint: "00", Issuer: "CN=Acme Device CA", Verified: true}, true},
+	} {
+		_, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{Certificate: tc.cert})
+		if (err == nil) != tc.want {
+			t.Errorf("%s: allowed = %v, want %v (%v)", tc.name, err == nil, tc.want, err)
+		}
+	}
+}
+
+func TestEvaluateReputationPolicies(t *testing.T) {
+	policies := []*ent.SharePolicy{
+		{ID: "anonymizers", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodREPUTATION, Value: "tor,vpn"},
+	}
+
+	for _, tc := range []struct {
+		lists []string
+		want  bool
+	}{
+		{nil, true},
+		{[]string{"hosting"}, true},
+		{[]string{"hosting", "vpn"}, false},
+	} {
+		deniedBy, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "198.51.100.7", ReputationLists: tc.lists})
+		if (err == nil) != tc.want {
+			t.Errorf("lists %v: allowed = %v, want %v (%v)", tc.lists, err == nil, tc.want, err)
+		}
+		if err != nil && deniedBy != policies[0] {
+			t.Errorf("lists %v: denied by %v, want the reputation policy", tc.lists, deniedBy)
+		}
+	}
+
+	if got := reputationListMatch("tor,vpn", []string{"hosting", "vpn"}); got != "vpn" {
+		t.Errorf("reputationListMatch = %q, want vpn", got)
+	}
+}
+
+func TestMatchIPComparesParsedAddresses(t *testing.T) {
+	for _, tc := range []struct {
+		policy, client string
+		want           bool
+	}{
+		{"10.0.0.1", "10.0.0.1", true},
+		{"10.0.0.1", "::ffff:10.0.0.1", true},
+		{"2001:db8::1", "2001:DB8:0:0:0:0:0:1", true},
+		{"10.0.0.1", "10.0.0.2", false},
+		{"10.0.0.1", "", false},
+	} {
+		if got, _ := matchIP(tc.policy, tc.client); got != tc.want {
+			t.Errorf("matchIP(%q, %q) = %v, want %v", tc.policy, tc.client, got, tc.want)
+		}
+	}
+}
+
+func TestExplainPoliciesTracesEveryPolicy(t *testing.T) {
+	policies := []*ent.SharePolicy{
+		{ID: "office", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodNETWORK, Value: "10.0.0.0/8"},
+		{ID: "vpn", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodNETWORK, Value: "192.168.0.0/16"},
+		{ID: "bots", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodDEVICE, Value: "class=bot"},
+	}
+
+	d := ExplainPolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "172.16.0.1"})
+	if !sharingV1.IsShareAccessDenied(d.Err) || d.DeniedMethod != sharepolicy.MethodNETWORK || d.DeniedBy != nil {
+		t.Fatalf("expected NETWORK whitelist denial, got %+v", d)
+	}
+	if len(d.Trace) != len(policies) {
+		t.Fatalf("trace has %d entries, want %d", len(d.Trace), len(policies))
+	}
+	for i, want := range []struct{ matched, decisive bool }{{false, true}, {false, true}, {false, false}} {
+		got := d.Trace[i]
+		if got.Policy != policies[i] || got.Matched != want.matched || got.Decisive != want.decisive || got.Explanation == "" {
+			t.Errorf("trace[%d] = %+v, want matched=%v decisive=%v", i, got, want.matched, want.decisive)
+		}
+	}
+
+	d = ExplainPolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "10.1.2.3"})
+	if d.Err != nil {
+		t.Fatalf("expected access, got %v", d.Err)
+	}
+	if !d.Trace[0].Matched || d.Trace[0].Decisive {
+		t.Errorf("trace[0] = %+v, want matched and not decisive", d.Trace[0])
+	}
+}
+
+func TestPolicySetRulesAreEvaluatedWithSharePolicies(t *testing.T) {
+	set := &ent.PolicySet{ID: "office-set", Rules: []schema.PolicyRule{
+		{Type: "WHITELIST", Method: "NETWORK", Value: "10.0.0.0/8"},
+	}}
+	policies := append([]*ent.SharePolicy{
+		{ID: "own", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodIP, Value: "10.0.0.66"},
+	}, policySetPolicies("share-1", []*ent.PolicySet{set})...)
+
+	if _, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "10.0.0.1"}); err != nil {
+		t.Fatalf("expected access from the office network, got %v", err)
+	}
+	if deniedBy, _, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "10.0.0.66"}); !sharingV1.IsShareAccessDenied(err) || deniedBy == nil || deniedBy.ID != "own" {
+		t.Fatalf("expected denial by the share's own policy, got %v (%v)", err, deniedBy)
+	}
+	if _, method, err := EvaluatePolicies(policies, PolicyMode{}, &PolicyRequest{ClientIP: "192.0.2.1"}); !sharingV1.IsShareAccessDenied(err) || method != sharepolicy.MethodNETWORK {
+		t.Fatalf("expected denial by the set's NETWORK whitelist, got %v", err)
+	}
+}
+
+func TestUnknownClientIPFailsAddressPolicies(t *testing.T) {
+	for _, p := range []*ent.SharePolicy{
+		{ID: "ip", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodIP, Value: "192.0.2.1"},
+		{ID: "net", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodNETWORK, Value: "192.0.2.0/24"},
+		{ID: "rep", Type: sharepolicy.TypeBLACKLIST, Method: sharepolicy.MethodREPUTATION, Value: "tor"},
+		{ID: "office", Type: sharepolicy.TypeWHITELIST, Method: sharepolicy.MethodNETWORK, Value: "10.0.0.0/8"},
+	} {
+		for _, ip := range []string{"", "unknown"} {
+			if _, _, err := EvaluatePolicies([]*ent.SharePolicy{p}, PolicyMode{}, &PolicyRequest{ClientIP: ip}); !sharingV1.IsShareAccessDenied(err) {
+				t.Errorf("%s policy with client IP %q: got %v, want ShareAccessDenied", p.ID, ip, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// PolicySetService implements the SharingPolicySetService gRPC service
+type PolicySetService struct {
+	sharingV1.UnimplementedSharingPolicySetServiceServer
+
+	log           *log.Helper
+	policySetRepo *data.PolicySetRepo
+	linkRepo      *data.SharedLinkRepo
+	dispatcher    *WebhookDispatcher
+
+	reputationFeeds *reputation.Feeds
+}
+
+// NewPolicySetService creates a new PolicySetService
+func NewPolicySetService(
+	ctx *bootstrap.Context,
+	policySetRepo *data.PolicySetRepo,
+	linkRepo *data.SharedLinkRepo,
+	dispatcher *WebhookDispatcher,
+	reputationFeeds *reputation.Feeds,
+) *PolicySetService {
+	return &PolicySetService{
+		log:             ctx.NewLoggerHelper("sharing/service/policy_set"),
+		policySetRepo:   policySetRepo,
+		linkRepo:        linkRepo,
+		dispatcher:      dispatcher,
+		reputationFeeds: reputationFeeds,
+	}
+}
+
+// CreatePolicySet creates a new policy set
+func (s *PolicySetService) CreatePolicySet(ctx context.Context, req *sharingV1.CreatePolicySetRequest) (*sharingV1.CreatePolicySetResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	rules, err := s.policySetRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, policyFieldError("rules", "a policy set needs at least one rule")
+	}
+
+	entity, err := s.policySetRepo.Create(ctx, tenantID, req.Name, req.Description, rules, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishPolicySetChanged(tenantID, entity.ID, "created")
+
+	return &sharingV1.CreatePolicySetResponse{
+		PolicySet: s.policySetRepo.ToProto(entity),
+	}, nil
+}
+
+// GetPolicySet retrieves a policy set by ID
+func (s *PolicySetService) GetPolicySet(ctx context.Context, req *sharingV1.GetPolicySetRequest) (*sharingV1.GetPolicySetResponse, error) {
+	if err := authz.RequireAny(ctx, authz.PermPolicyManage, authz.PermShareCreate); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.policySetRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorPolicySetNotFound("policy set not found")
+	}
+
+	return &sharingV1.GetPolicySetResponse{
+		PolicySet: s.policySetRepo.ToProto(entity),
+	}, nil
+}
+
+// ListPolicySets lists policy sets for the current tenant
+func (s *PolicySetService) ListPolicySets(ctx context.Context, req *sharingV1.ListPolicySetsRequest) (*sharingV1.ListPolicySetsResponse, error) {
+	if err := authz.RequireAny(ctx, authz.PermPolicyManage, authz.PermShareCreate); err != nil {
+		return nil, err
+	}
+
+	entities, total, err := s.policySetRepo.ListByTenant(ctx, getTenantIDFromContext(ctx), req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]*sharingV1.PolicySet, 0, len(entities))
+	for _, e := range entities {
+		sets = append(sets, s.policySetRepo.ToProto(e))
+	}
+
+	return &sharingV1.ListPolicySetsResponse{
+		PolicySets: sets,
+		Total:      uint32(total),
+	}, nil
+}
+
+// UpdatePolicySet updates a policy set. Shares referencing the set use the
+// new rules from their next view on.
+func (s *PolicySetService) UpdatePolicySet(ctx context.Context, req *sharingV1.UpdatePolicySetRequest) (*sharingV1.UpdatePolicySetResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	updatedBy := getUserIDAsUint32(ctx)
+
+	rules, err := s.policySetRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := s.policySetRepo.Update(ctx, req.Id, req.Name, req.Description, rules, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishPolicySetChanged(tenantID, entity.ID, "updated")
+
+	return &sharingV1.UpdatePolicySetResponse{
+		PolicySet: s.policySetRepo.ToProto(entity),
+	}, nil
+}
+
+// DeletePolicySet deletes a policy set that no active share references
+func (s *PolicySetService) DeletePolicySet(ctx context.Context, req *sharingV1.DeletePolicySetRequest) (*emptypb.Empty, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	if err := s.policySetRepo.Delete(ctx, req.Id, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.publishPolicySetChanged(getTenantIDFromContext(ctx), req.Id, "deleted")
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPolicySetShares lists the shares referencing a policy set; callers
+// without the view-all permission only see the shares they created
+func (s *PolicySetService) ListPolicySetShares(ctx context.Context, req *sharingV1.ListPolicySetSharesRequest) (*sharingV1.ListPolicySetSharesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.policySetRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorPolicySetNotFound("policy set not found")
+	}
+
+	shareIDs, err := s.policySetRepo.ListShareLinkIDs(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy *uint32
+	if !authz.HasPermission(ctx, authz.PermShareViewAll) {
+		callerID := authz.CallerID(ctx)
+		createdBy = &callerID
+	}
+
+	links, total, err := s.linkRepo.ListByIDs(ctx, shareIDs, createdBy, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*sharingV1.SharedLink, 0, len(links))
+	for _, link := range links {
+		shares = append(shares, s.linkRepo.ToProto(link))
+	}
+
+	return &sharingV1.ListPolicySetSharesResponse{
+		Shares: shares,
+		Total:  uint32(total),
+	}, nil
+}
+
+// publishPolicySetChanged notifies webhooks that a policy set was created, updated or deleted
+func (s *PolicySetService) publishPolicySetChanged(tenantID uint32, policySetID, action string) {
+	s.dispatcher.Publish(tenantID, EventPolicySetChanged, map[string]any{
+		"policySetId": policySetID,
+		"action":      action,
+	})
+}
+
+// policySetRules validates the rules of a policy set request
+func (s *PolicySetService) policySetRules(inputs []*sharingV1.CreateSharePolicyInput) ([]schema.PolicyRule, error) {
+	validated, err := validatePolicies(s.reputationFeeds, "rules", inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]schema.PolicyRule, 0, len(validated))
+	for _, p := range validated {
+		rules = append(rules, schema.PolicyRule{
+			Type:     p.Type,
+			Method:   p.Method,
+			Value:    p.Value,
+			Reason:   p.Reason,
+			Priority: p.Priority,
+		})
+	}
+	return rules, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/clientcert"
+	"github.com/go-tangra/go-tangra-sharing/pkg/device"
+	"github.com/go-tangra/go-tangra-sharing/pkg/expression"
+	"github.com/go-tangra/go-tangra-sharing/pkg/timewindow"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// validatedPolicy is a policy whose type, method and value were checked and normalized
+type validatedPolicy struct {
+	Type     string
+	Method   string
+	Value    string
+	Reason   string
+	Priority int32
+}
+
+// validatePolicy checks a policy and returns it in its stored form. Fields of
+// the request are named relative to prefix (e.g. "policies[2].") in errors.
+// REPUTATION policies may only name the lists of feeds.
+func validatePolicy(feeds *reputation.Feeds, prefix string, t sharingV1.SharePolicyType, m sharingV1.SharePolicyMethod, value, reason string, priority int32) (*validatedPolicy, error) {
+	pType := policyTypeToString(t)
+	if pType == "" {
+		return nil, policyFieldError(prefix+"type", "policy type must be WHITELIST or BLACKLIST")
+	}
+	pMethod := policyMethodToString(m)
+	if pMethod == "" {
+		return nil, policyFieldError(prefix+"method", "policy method must be IP, REGION, TIME, DEVICE, NETWORK, EXPRESSION, CERTIFICATE or REPUTATION")
+	}
+
+	normalized, err := normalizePolicyValue(feeds, m, value)
+	if err != nil {
+		return nil, policyFieldError(prefix+"value", "invalid %s policy value: %v", pMethod, err)
+	}
+
+	return &validatedPolicy{Type: pType, Method: pMethod, Value: normalized, Reason: reason, Priority: priority}, nil
+}
+
+// validatePolicies checks a list of policies given in the request field
+func validatePolicies(feeds *reputation.Feeds, field string, policies []*sharingV1.CreateSharePolicyInput) ([]*validatedPolicy, error) {
+	out := make([]*validatedPolicy, 0, len(policies))
+	for i, p := range policies {
+		v, err := validatePolicy(feeds, fmt.Sprintf("%s[%d].", field, i), p.Type, p.Method, p.Value, p.Reason, p.Priority)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// policyFieldError returns a BAD_REQUEST error naming the offending request field in its metadata
+func policyFieldError(field, format string, args ...any) error {
+	reason := fmt.Sprintf(format, args...)
+	return sharingV1.ErrorBadRequest("%s: %s", field, reason).WithMetadata(map[string]string{
+		"field":  field,
+		"reason": reason,
+	})
+}
+
+// normalizePolicyValue checks a policy value for its method and returns its canonical form
+func normalizePolicyValue(feeds *reputation.Feeds, method sharingV1.SharePolicyMethod, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("value is required")
+	}
+
+	switch method {
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP:
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", fmt.Errorf("%q is not an IPv4 or IPv6 address", value)
+		}
+		if addr.Zone() != "" {
+			return "", fmt.Errorf("IPv6 zones are not allowed")
+		}
+		return addr.Unmap().String(), nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK:
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a CIDR prefix such as 10.0.0.0/8 or 2001:db8::/32", value)
+		}
+		return prefix.Masked().String(), nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_MAC:
+		// Client MAC addresses are not visible over HTTP, so MAC policies never match
+		return "", fmt.Errorf("MAC policies are deprecated, use CERTIFICATE policies to restrict access to managed devices")
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION:
+		return normalizeRegion(value)
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_TIME:
+		if _, err := timewindow.Parse(value); err != nil {
+			return "", err
+		}
+		return value, nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE:
+		if _, err := device.ParseRule(value); err != nil {
+			return "", err
+		}
+		return value, nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE:
+		rule, err := clientcert.ParseRule(value)
+		if err != nil {
+			return "", err
+		}
+		return rule.String(), nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION:
+		if _, err := expression.CompileCached(value); err != nil {
+			return "", err
+		}
+		return value, nil
+
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION:
+		return normalizeReputationLists(feeds, value)
+
+	default:
+		return "", fmt.Errorf("unsupported policy method %s", method)
+	}
+}
+
+// normalizeRegion checks a region of the form CC[-SUB][/City] (see matchRegion)
+// and returns it with upper-case country and subdivision codes
+func normalizeRegion(value string) (string, error) {
+	region, city, hasCity := strings.Cut(value, "/")
+	country, subdivision, hasSubdivision := strings.Cut(strings.TrimSpace(region), "-")
+
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 || !isAlnum(country, false) {
+		return "", fmt.Errorf("%q is not an ISO 3166-1 alpha-2 country code", country)
+	}
+	out := country
+
+	if hasSubdivision {
+		subdivision = strings.ToUpper(strings.TrimSpace(subdivision))
+		if len(subdivision) == 0 || len(subdivision) > 3 || !isAlnum(subdivision, true) {
+			return "", fmt.Errorf("%q is not an ISO 3166-2 subdivision code", subdivision)
+		}
+		out += "-" + subdivision
+	}
+
+	if hasCity {
+		city = strings.TrimSpace(city)
+		if city == "" {
+			return "", fmt.Errorf("city name is empty")
+		}
+		out += "/" + city
+	}
+
+	return out, nil
+}
+
+// normalizeReputationLists checks a comma-separated list of reputation list
+// names and returns it lower-cased without duplicates. Lists that no feed
+// contributes to are rejected: a policy naming one would never match.
+func normalizeReputationLists(feeds *reputation.Feeds, value string) (string, error) {
+	var lists []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !reputation.ValidListName(name) {
+			return "", fmt.Errorf("%q is not a reputation list name such as tor or vpn", name)
+		}
+		if !feeds.HasList(name) {
+			return "", fmt.Errorf("no reputation feed provides the list %q", name)
+		}
+		if !slices.Contains(lists, name) {
+			lists = append(lists, name)
+		}
+	}
+	return strings.Join(lists, ","), nil
+}
+
+// isAlnum reports whether s consists of upper-case ASCII letters, and digits if allowed
+func isAlnum(s string, digits bool) bool {
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (!digits || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
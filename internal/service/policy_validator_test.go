@@ -0,0 +1,105 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestNormalizePolicyValue(t *testing.T) {
+	feeds := newTestFeeds(t, "tor", "vpn")
+	for _, tc := range []struct {
+		method sharingV1.SharePolicyMethod
+		value  string
+		want   string
+	}{
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, " 10.0.0.1 ", "10.0.0.1"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "::ffff:10.0.0.1", "10.0.0.1"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "2001:0DB8:0000::0001", "2001:db8::1"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK, "192.168.1.17/24", "192.168.1.0/24"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK, "2001:DB8::/32", "2001:db8::/32"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE, "SHA256:9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08", "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE, "issuer: CN=Acme Device CA, O=Acme", "issuer:CN=Acme Device CA,O=Acme"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION, " Tor, vpn ,tor", "tor,vpn"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, "us-ca / San Francisco", "US-CA/San Francisco"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, "de", "DE"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_TIME, "Mon-Fri 09:00-17:00 Europe/Sofia", "Mon-Fri 09:00-17:00 Europe/Sofia"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE, "class=!bot", "class=!bot"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION, " attempt_count < 3 ", "attempt_count < 3"},
+	} {
+		got, err := normalizePolicyValue(feeds, tc.method, tc.value)
+		if err != nil {
+			t.Errorf("normalizePolicyValue(%s, %q): %v", tc.method, tc.value, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("normalizePolicyValue(%s, %q) = %q, want %q", tc.method, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizePolicyValueRejectsInvalidValues(t *testing.T) {
+	feeds := newTestFeeds(t, "tor", "vpn")
+	for _, tc := range []struct {
+		method sharingV1.SharePolicyMethod
+		value  string
+	}{
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, ""},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "10.0.0.256"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "10.0.0.0/8"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "fe80::1%eth0"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK, "10.0.0.0"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK, "10.0.0.0/33"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_MAC, "00:1a:2b:3c:4d:5e"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE, "sha256:abcd"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE, "serial:1234"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION, "tor,,vpn"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION, "tor exits"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION, "tor,vnp"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, "USA"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, "US-"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, "US/"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_TIME, "Mon-Fry 09:00-17:00"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_DEVICE, "model=pixel"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION, "country == 1"},
+		{sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION, "attempt_count"},
+	} {
+		if got, err := normalizePolicyValue(feeds, tc.method, tc.value); err == nil {
+			t.Errorf("normalizePolicyValue(%s, %q) = %q, want error", tc.method, tc.value, got)
+		}
+	}
+}
+
+func TestValidatePoliciesNamesInvalidField(t *testing.T) {
+	_, err := validatePolicies(nil, "policies", []*sharingV1.CreateSharePolicyInput{
+		{
+			Type:   sharingV1.SharePolicyType_SHARE_POLICY_TYPE_WHITELIST,
+			Method: sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP,
+			Value:  "10.0.0.1",
+		},
+		{
+			Type:   sharingV1.SharePolicyType_SHARE_POLICY_TYPE_BLACKLIST,
+			Method: sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK,
+			Value:  "10.0.0/8",
+		},
+	})
+	if !sharingV1.IsBadRequest(err) {
+		t.Fatalf("expected BadRequest, got %v", err)
+	}
+	if field := errors.FromError(err).Metadata["field"]; field != "policies[1].value" {
+		t.Fatalf("field = %q, want policies[1].value", field)
+	}
+
+	_, err = validatePolicy(nil, "", sharingV1.SharePolicyType_SHARE_POLICY_TYPE_UNSPECIFIED, sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, "10.0.0.1", "", 0)
+	if field := errors.FromError(err).Metadata["field"]; field != "type" {
+		t.Fatalf("field = %q, want type", field)
+	}
+
+	// Without feeds no reputation list exists
+	_, err = validatePolicy(nil, "", sharingV1.SharePolicyType_SHARE_POLICY_TYPE_BLACKLIST, sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION, "tor", "", 0)
+	if !sharingV1.IsBadRequest(err) {
+		t.Fatalf("REPUTATION policy without feeds: expected BadRequest, got %v", err)
+	}
+}
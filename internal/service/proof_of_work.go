@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/pow"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	// powChallengeTTL is how long a proof-of-work challenge can be solved and used
+	powChallengeTTL = 2 * time.Minute
+
+	// powFailureWindow is how far back failed attempts from a client IP raise its difficulty
+	powFailureWindow = 15 * time.Minute
+
+	// defaultPowMaxDifficulty caps the difficulty when SHARING_POW_MAX_DIFFICULTY is not set
+	defaultPowMaxDifficulty = 24
+)
+
+// powSettings configure the proof-of-work challenge of the public endpoint
+type powSettings struct {
+	enabled        bool
+	baseDifficulty int
+	maxDifficulty  int
+}
+
+// powSettingsFromEnv reads the proof-of-work settings. SHARING_POW_DIFFICULTY
+// enables the challenge with the difficulty, in leading zero bits, that every
+// client needs; 0 only challenges clients with recent failed attempts.
+// SHARING_POW_MAX_DIFFICULTY caps the difficulty failed attempts scale up to.
+func powSettingsFromEnv(l *log.Helper) powSettings {
+	v := os.Getenv("SHARING_POW_DIFFICULTY")
+	if v == "" {
+		return powSettings{}
+	}
+	base, err := strconv.Atoi(v)
+	if err != nil || base < 0 || base > pow.MaxDifficulty {
+		l.Warnf("Invalid SHARING_POW_DIFFICULTY %q, proof of work disabled", v)
+		return powSettings{}
+	}
+
+	maxDifficulty := defaultPowMaxDifficulty
+	if v := os.Getenv("SHARING_POW_MAX_DIFFICULTY"); v != "" {
+		if maxDifficulty, err = strconv.Atoi(v); err != nil || maxDifficulty <= 0 || maxDifficulty > pow.MaxDifficulty {
+			l.Warnf("Invalid SHARING_POW_MAX_DIFFICULTY %q, using %d", v, defaultPowMaxDifficulty)
+			maxDifficulty = defaultPowMaxDifficulty
+		}
+	}
+
+	return powSettings{
+		enabled:        true,
+		baseDifficulty: min(base, maxDifficulty),
+		maxDifficulty:  maxDifficulty,
+	}
+}
+
+// powDifficulty adds a bit of difficulty, doubling the expected work, each
+// time the number of recent failures of a client doubles
+func (p powSettings) powDifficulty(failures int) int {
+	return min(p.baseDifficulty+bits.Len(uint(failures)), p.maxDifficulty)
+}
+
+// requiredPowDifficulty returns the proof-of-work difficulty required from
+// clientIP, 0 when none is. Clients whose address is unknown cannot be told
+// apart by their failures, so they get the highest difficulty.
+func (s *ShareService) requiredPowDifficulty(ctx context.Context, clientIP string) int {
+	if !s.pow.enabled {
+		return 0
+	}
+	if clientIP == "" {
+		return s.pow.maxDifficulty
+	}
+	failures, err := s.accessEventRepo.CountFailuresByClientIPSince(ctx, clientIP, s.now().Add(-powFailureWindow))
+	if err != nil {
+		s.log.Warnf("Failed to count failed attempts from %s: %v", clientIP, err)
+	}
+	return s.pow.powDifficulty(failures)
+}
+
+// checkProofOfWork verifies the proof of work sent with a request for a
+// share token. The solved challenge must be at least as difficult as the
+// one the client would be issued now, and is spent by the request.
+func (s *ShareService) checkProofOfWork(ctx context.Context, token string, challenge, solution *string) error {
+	required := s.requiredPowDifficulty(ctx, getClientIPFromContext(ctx))
+	if required == 0 {
+		return nil
+	}
+	metadata := map[string]string{"difficulty": strconv.Itoa(required)}
+
+	if challenge == nil || solution == nil {
+		return sharingV1.ErrorShareChallengeRequired("proof of work required").WithMetadata(metadata)
+	}
+	difficulty, err := pow.Verify(s.powKey, token, *challenge, *solution, s.now())
+	switch {
+	case errors.Is(err, pow.ErrExpired):
+		return sharingV1.ErrorShareChallengeRequired("proof of work challenge expired").WithMetadata(metadata)
+	case err != nil:
+		return sharingV1.ErrorShareChallengeRequired("invalid proof of work").WithMetadata(metadata)
+	case difficulty < required:
+		return sharingV1.ErrorShareChallengeRequired("proof of work is not difficult enough").WithMetadata(metadata)
+	case !s.powUsed.Spend(*challenge, s.now()):
+		return sharingV1.ErrorShareChallengeRequired("proof of work challenge already used").WithMetadata(metadata)
+	}
+	return nil
+}
+
+// GetShareChallenge issues a proof-of-work challenge for a share token. The
+// token is not looked up, so challenges reveal nothing about it.
+func (s *ShareService) GetShareChallenge(ctx context.Context, req *sharingV1.GetShareChallengeRequest) (*sharingV1.GetShareChallengeResponse, error) {
+	// Failed attempts are counted across tenants
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	resp := &sharingV1.GetShareChallengeResponse{Algorithm: pow.Algorithm}
+	difficulty := s.requiredPowDifficulty(ctx, getClientIPFromContext(ctx))
+	if difficulty == 0 {
+		return resp, nil
+	}
+
+	expiresAt := s.now().Add(powChallengeTTL)
+	challenge, err := pow.Issue(s.powKey, req.Token, difficulty, expiresAt)
+	if err != nil {
+		s.log.Errorf("Failed to issue proof of work challenge: %v", err)
+		return nil, sharingV1.ErrorEncryptionError("failed to issue challenge")
+	}
+
+	resp.Required = true
+	resp.Challenge = challenge
+	resp.Difficulty = uint32(difficulty)
+	resp.ExpiresAt = timestamppb.New(expiresAt)
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	grpcMD "google.golang.org/grpc/metadata"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/pow"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestPowDifficulty(t *testing.T) {
+	p := powSettings{enabled: true, baseDifficulty: 10, maxDifficulty: 14}
+	for _, tc := range []struct {
+		failures int
+		want     int
+	}{
+		{0, 10},
+		{1, 11},
+		{3, 12},
+		{4, 13},
+		{7, 13},
+		{8, 14},
+		{1000, 14},
+	} {
+		if got := p.powDifficulty(tc.failures); got != tc.want {
+			t.Errorf("powDifficulty(%d) = %d, want %d", tc.failures, got, tc.want)
+		}
+	}
+}
+
+func TestPowSettingsFromEnv(t *testing.T) {
+	l := log.NewHelper(log.DefaultLogger)
+
+	for _, tc := range []struct {
+		difficulty, max string
+		want            powSettings
+	}{
+		{"", "", powSettings{}},
+		{"abc", "", powSettings{}},
+		{"0", "", powSettings{enabled: true, maxDifficulty: defaultPowMaxDifficulty}},
+		{"16", "20", powSettings{enabled: true, baseDifficulty: 16, maxDifficulty: 20}},
+		{"22", "20", powSettings{enabled: true, baseDifficulty: 20, maxDifficulty: 20}},
+		{"8", "99", powSettings{enabled: true, baseDifficulty: 8, maxDifficulty: defaultPowMaxDifficulty}},
+	} {
+		t.Setenv("SHARING_POW_DIFFICULTY", tc.difficulty)
+		t.Setenv("SHARING_POW_MAX_DIFFICULTY", tc.max)
+		if got := powSettingsFromEnv(l); got != tc.want {
+			t.Errorf("powSettingsFromEnv(%q, %q) = %+v, want %+v", tc.difficulty, tc.max, got, tc.want)
+		}
+	}
+}
+
+func TestCheckProofOfWorkRejectsReplays(t *testing.T) {
+	s, _ := newTestShareService(t)
+	s.pow = powSettings{enabled: true, baseDifficulty: 4, maxDifficulty: 8}
+	ctx := grpcMD.NewIncomingContext(tenantContext(tenantA, 0), grpcMD.Pairs("x-client-ip", "198.51.100.7"))
+	token := "tgs_token"
+
+	challenge, err := pow.Issue(s.powKey, token, 4, s.now().Add(powChallengeTTL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution := pow.Solve(challenge, 4)
+
+	if err := s.checkProofOfWork(ctx, token, &challenge, &solution); err != nil {
+		t.Fatalf("first use of a solved challenge: %v", err)
+	}
+	if err := s.checkProofOfWork(ctx, token, &challenge, &solution); !sharingV1.IsShareChallengeRequired(err) {
+		t.Fatalf("replayed challenge: got %v, want ShareChallengeRequired", err)
+	}
+}
+
+func TestUnknownClientsGetTheHighestDifficulty(t *testing.T) {
+	s, _ := newTestShareService(t)
+	s.pow = powSettings{enabled: true, baseDifficulty: 4, maxDifficulty: 20}
+	if got := s.requiredPowDifficulty(tenantContext(tenantA, 0), ""); got != 20 {
+		t.Fatalf("requiredPowDifficulty of an unknown client = %d, want 20", got)
+	}
+}
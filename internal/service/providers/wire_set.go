@@ -15,5 +15,8 @@ import (
 var ProviderSet = wire.NewSet(
 	service.NewShareService,
 	service.NewTemplateService,
+	service.NewPolicySetService,
 	service.NewBackupService,
+	service.NewWebhookDispatcher,
+	service.NewWebhookService,
 )
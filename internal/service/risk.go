@@ -0,0 +1,432 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+	"github.com/go-tangra/go-tangra-sharing/pkg/device"
+	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
+	"github.com/go-tangra/go-tangra-sharing/pkg/stepup"
+	"github.com/go-tangra/go-tangra-sharing/pkg/timewindow"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	// riskCountryWindow is how far back countries count as known for a tenant
+	riskCountryWindow = 90 * 24 * time.Hour
+
+	// riskFailureWindow is how far back failed attempts on a share are counted
+	riskFailureWindow = 24 * time.Hour
+
+	// riskFailedAttemptsThreshold is how many failed attempts make a share look attacked
+	riskFailedAttemptsThreshold = 3
+
+	// riskOffHoursMargin is how far outside business hours an attempt must be to count
+	riskOffHoursMargin = 2 * time.Hour
+
+	// verificationCodeTTL is how long an emailed verification code is valid
+	verificationCodeTTL = 10 * time.Minute
+
+	// verificationCodeResendInterval limits how often a code is emailed for a share
+	verificationCodeResendInterval = time.Minute
+
+	// maxStepUpAttempts is how many code answers are accepted per verification code
+	maxStepUpAttempts = 5
+
+	// maxPassphraseAttempts is how many passphrase answers are accepted until a
+	// step-up challenge is passed; the passphrase is locked after that
+	maxPassphraseAttempts = 5
+
+	// stepUpChallengeTTL is how long after a step-up challenge a verification
+	// code can be requested
+	stepUpChallengeTTL = 15 * time.Minute
+)
+
+// Step-up methods offered to risky access attempts
+const (
+	stepUpMethodPassphrase       = "passphrase"
+	stepUpMethodVerificationCode = "verification_code"
+)
+
+// RiskSignal is an attribute of an access attempt that raises its risk score
+type RiskSignal string
+
+const (
+	// RiskSignalNewCountry is set when no share of the tenant was opened from
+	// the client's country recently
+	RiskSignalNewCountry RiskSignal = "new_country"
+	// RiskSignalAnonymizer is set when the client IP is on a reputation list
+	RiskSignalAnonymizer RiskSignal = "anonymizer"
+	// RiskSignalBot is set when the User-Agent belongs to a bot
+	RiskSignalBot RiskSignal = "bot"
+	// RiskSignalFailedAttempts is set after repeated failed attempts on the share
+	RiskSignalFailedAttempts RiskSignal = "failed_attempts"
+	// RiskSignalOffHours is set when the attempt is far outside business hours
+	RiskSignalOffHours RiskSignal = "off_hours"
+)
+
+// riskWeights is how much each signal adds to the risk score
+var riskWeights = map[RiskSignal]int32{
+	RiskSignalNewCountry:     30,
+	RiskSignalAnonymizer:     40,
+	RiskSignalBot:            40,
+	RiskSignalFailedAttempts: 30,
+	RiskSignalOffHours:       20,
+}
+
+// maxRiskScore caps the risk score
+const maxRiskScore = 100
+
+// ScoreRisk sums the weights of the signals, capped at 100
+func ScoreRisk(signals []RiskSignal) int32 {
+	var score int32
+	for _, signal := range signals {
+		score += riskWeights[signal]
+	}
+	return min(score, maxRiskScore)
+}
+
+// RiskDecision is what happens to an access attempt given its risk score
+type RiskDecision int
+
+const (
+	RiskAllow RiskDecision = iota
+	RiskStepUp
+	RiskDeny
+)
+
+// DecideRisk compares a risk score with the step-up and deny thresholds
+func DecideRisk(score, stepUpThreshold, denyThreshold int32) RiskDecision {
+	switch {
+	case score >= denyThreshold:
+		return RiskDeny
+	case score >= stepUpThreshold:
+		return RiskStepUp
+	default:
+		return RiskAllow
+	}
+}
+
+// riskAssessment is the risk score of an access attempt and how it was reached
+type riskAssessment struct {
+	Score    int32
+	Signals  []RiskSignal
+	Decision RiskDecision
+}
+
+// signalList lists the raised signals for access event reasons
+func (a *riskAssessment) signalList() string {
+	names := make([]string, 0, len(a.Signals))
+	for _, signal := range a.Signals {
+		names = append(names, string(signal))
+	}
+	return strings.Join(names, ", ")
+}
+
+// riskScoreKey carries the risk score of an access attempt to recordAccess
+type riskScoreKey struct{}
+
+// withRiskScore records the risk score of the access attempt in ctx
+func withRiskScore(ctx context.Context, score int32) context.Context {
+	return context.WithValue(ctx, riskScoreKey{}, score)
+}
+
+// riskScoreFromContext returns the risk score of the access attempt, nil when it was not scored
+func riskScoreFromContext(ctx context.Context) *int32 {
+	if score, ok := ctx.Value(riskScoreKey{}).(int32); ok {
+		return &score
+	}
+	return nil
+}
+
+// assessRisk scores an access attempt against the risk settings of the share's
+// tenant. It returns nil when the tenant has not enabled risk-based access.
+// Signals that cannot be evaluated, e.g. without a GeoIP database, are not raised.
+func (s *ShareService) assessRisk(ctx context.Context, entity *ent.SharedLink, clientIP string) (*riskAssessment, error) {
+	tenantID := derefTenantID(entity.TenantID)
+	settings, err := s.riskRepo.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil || !settings.Enabled {
+		return nil, nil
+	}
+
+	now := s.now()
+	var signals []RiskSignal
+
+	if loc, err := s.geoResolver.Lookup(clientIP); err == nil {
+		known, err := s.accessEventRepo.GrantedCountriesSince(ctx, tenantID, now.Add(-riskCountryWindow))
+		if err != nil {
+			s.log.Warnf("Failed to list known countries of tenant %d: %v", tenantID, err)
+		} else if len(known) > 0 && !slices.Contains(known, loc.Country) {
+			signals = append(signals, RiskSignalNewCountry)
+		}
+	}
+
+	if len(s.reputationFeeds.Lists(clientIP)) > 0 {
+		signals = append(signals, RiskSignalAnonymizer)
+	}
+
+	if device.Parse(getUserAgentFromContext(ctx)).Class == device.ClassBot {
+		signals = append(signals, RiskSignalBot)
+	}
+
+	if failures, err := s.accessEventRepo.CountFailuresSince(ctx, entity.ID, now.Add(-riskFailureWindow)); err != nil {
+		s.log.Warnf("Failed to count failed attempts of share %s: %v", entity.ID, err)
+	} else if failures >= riskFailedAttemptsThreshold {
+		signals = append(signals, RiskSignalFailedAttempts)
+	}
+
+	if settings.BusinessHours != "" {
+		if sched, err := timewindow.Parse(settings.BusinessHours); err != nil {
+			s.log.Warnf("Invalid business hours of tenant %d: %v", tenantID, err)
+		} else if farOutside(sched, now, riskOffHoursMargin) {
+			signals = append(signals, RiskSignalOffHours)
+		}
+	}
+
+	score := ScoreRisk(signals)
+	return &riskAssessment{
+		Score:    score,
+		Signals:  signals,
+		Decision: DecideRisk(score, settings.StepUpThreshold, settings.DenyThreshold),
+	}, nil
+}
+
+// farOutside reports whether t is more than margin away from any window of sched
+func farOutside(sched *timewindow.Schedule, t time.Time, margin time.Duration) bool {
+	const step = 15 * time.Minute
+	for d := -margin; d <= margin; d += step {
+		if sched.Contains(t.Add(d)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkStepUp verifies the answer of a risky access attempt to the step-up
+// challenge. Without an answer, or with a wrong one, it returns a
+// ShareStepUpRequired error listing the methods the attempt can answer with,
+// and the outcome to record. The risk score is not disclosed to the client.
+//
+// Passphrase and code answers are limited separately, so requesting new codes
+// does not grant new passphrase guesses.
+func (s *ShareService) checkStepUp(ctx context.Context, entity *ent.SharedLink, req *sharingV1.ViewSharedContentRequest) (shareaccessevent.Outcome, error) {
+	// Verification codes are only sent to shares that were challenged
+	if err := s.linkRepo.MarkStepUpChallenged(ctx, entity.ID, s.now()); err != nil {
+		return shareaccessevent.OutcomeERROR, err
+	}
+
+	methods := []string{stepUpMethodVerificationCode}
+	if entity.PassphraseHash != "" && entity.PassphraseAttempts < maxPassphraseAttempts {
+		methods = append([]string{stepUpMethodPassphrase}, methods...)
+	}
+	challenge := func(format string, args ...any) error {
+		return sharingV1.ErrorShareStepUpRequired(format, args...).WithMetadata(map[string]string{
+			"methods": strings.Join(methods, ","),
+		})
+	}
+
+	if req.Passphrase == nil && req.VerificationCode == nil {
+		return shareaccessevent.OutcomeSTEP_UP_REQUIRED, challenge("additional verification is required to open this share")
+	}
+
+	if req.Passphrase != nil {
+		if entity.PassphraseHash == "" {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("invalid passphrase")
+		}
+		ok, err := s.linkRepo.UsePassphraseAttempt(ctx, entity.ID, maxPassphraseAttempts)
+		if err != nil {
+			return shareaccessevent.OutcomeERROR, err
+		}
+		if !ok {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("too many passphrase attempts, request a verification code instead")
+		}
+		if !stepup.VerifyPassphrase(req.GetPassphrase(), entity.PassphraseHash) {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("invalid passphrase")
+		}
+	} else {
+		ok, err := s.linkRepo.UseStepUpAttempt(ctx, entity.ID, maxStepUpAttempts)
+		if err != nil {
+			return shareaccessevent.OutcomeERROR, err
+		}
+		if !ok {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("too many verification attempts, request a new verification code")
+		}
+		if entity.VerificationCodeHash == nil || *entity.VerificationCodeHash == "" ||
+			entity.VerificationCodeExpiresAt == nil || !s.now().Before(*entity.VerificationCodeExpiresAt) {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("verification code expired, request a new one")
+		}
+		if !stepup.VerifyCode(s.stepUpKey, entity.ID, req.GetVerificationCode(), *entity.VerificationCodeHash) {
+			return shareaccessevent.OutcomeSTEP_UP_FAILED, challenge("invalid verification code")
+		}
+	}
+
+	if err := s.linkRepo.ClearStepUp(ctx, entity.ID); err != nil {
+		s.log.Warnf("Failed to clear step-up state of share %s: %v", entity.ID, err)
+	}
+	return "", nil
+}
+
+// SendShareVerificationCode emails a verification code to the recipient of a
+// share, to answer the step-up challenge of a risky access attempt with. Codes
+// are only sent shortly after an access attempt was challenged.
+func (s *ShareService) SendShareVerificationCode(ctx context.Context, req *sharingV1.SendShareVerificationCodeRequest) (*sharingV1.SendShareVerificationCodeResponse, error) {
+	// Share tokens are global: the link is resolved across tenants
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	if err := s.checkProofOfWork(ctx, req.Token, req.PowChallenge, req.PowSolution); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.linkRepo.GetByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorShareNotFound("share not found or invalid token")
+	}
+	switch {
+	case entity.Revoked:
+		return nil, sharingV1.ErrorShareRevoked("this share has been revoked")
+	case entity.Viewed:
+		return nil, sharingV1.ErrorShareAlreadyViewed("this share has already been viewed")
+	case entity.ExpiresAt != nil && !s.now().Before(*entity.ExpiresAt):
+		return nil, sharingV1.ErrorShareExpired("this share has expired")
+	}
+
+	now := s.now()
+	if entity.StepUpChallengedAt == nil || now.Sub(*entity.StepUpChallengedAt) > stepUpChallengeTTL {
+		return nil, sharingV1.ErrorBadRequest("no verification is pending for this share")
+	}
+
+	// A code sent moment ago is still on its way; do not flood the recipient
+	if entity.VerificationCodeExpiresAt != nil && entity.VerificationCodeExpiresAt.Sub(now) > verificationCodeTTL-verificationCodeResendInterval {
+		return &sharingV1.SendShareVerificationCodeResponse{
+			ExpiresAt: timestamppb.New(*entity.VerificationCodeExpiresAt),
+		}, nil
+	}
+
+	code, err := stepup.NewCode()
+	if err != nil {
+		s.log.Errorf("Failed to generate verification code: %v", err)
+		return nil, sharingV1.ErrorEncryptionError("failed to generate verification code")
+	}
+	expiresAt := now.Add(verificationCodeTTL)
+	if err := s.linkRepo.SetVerificationCode(ctx, entity.ID, stepup.HashCode(s.stepUpKey, entity.ID, code), expiresAt); err != nil {
+		return nil, err
+	}
+
+	queued := s.notifications.Enqueue(senderNotification{
+		entity: entity,
+		send:   func() error { return s.sendVerificationCode(entity, code) },
+		done: func(err error) {
+			if err != nil {
+				s.log.Errorf("Failed to send verification code email for share %s: %v", entity.ID, err)
+			}
+		},
+	})
+	if !queued {
+		s.log.Errorf("Dropped verification code for share %s: notification queue is full or closed", entity.ID)
+		return nil, sharingV1.ErrorServiceUnavailable("verification code could not be sent, try again later")
+	}
+
+	return &sharingV1.SendShareVerificationCodeResponse{
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// sendVerificationCode emails a step-up verification code to the recipient of a share
+func (s *ShareService) sendVerificationCode(entity *ent.SharedLink, code string) error {
+	data := mail.TemplateData{
+		RecipientEmail:   entity.RecipientEmail,
+		ResourceName:     entity.ResourceName,
+		ResourceType:     string(entity.ResourceType),
+		VerificationCode: code,
+		CodeExpiresIn:    fmt.Sprintf("%d minutes", int(verificationCodeTTL.Minutes())),
+	}
+
+	content, err := mail.RenderTemplate(mail.DefaultVerificationCodeSubjectTemplate, mail.DefaultVerificationCodeHTMLBodyTemplate, "", data)
+	if err != nil {
+		return fmt.Errorf("failed to render verification code template: %w", err)
+	}
+
+	return s.sendMail("verification_code", entity.RecipientEmail, "", content)
+}
+
+// GetRiskSettings returns the risk-based access settings of the caller's tenant
+func (s *ShareService) GetRiskSettings(ctx context.Context, _ *sharingV1.GetRiskSettingsRequest) (*sharingV1.GetRiskSettingsResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.riskRepo.GetByTenant(ctx, getTenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.GetRiskSettingsResponse{
+		Settings: s.riskRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateRiskSettings updates the risk-based access settings of the caller's tenant
+func (s *ShareService) UpdateRiskSettings(ctx context.Context, req *sharingV1.UpdateRiskSettingsRequest) (*sharingV1.UpdateRiskSettingsResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+	tenantID := getTenantIDFromContext(ctx)
+
+	existing, err := s.riskRepo.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	current := s.riskRepo.ToProto(existing)
+
+	update := &data.RiskSettingsUpdate{Enabled: req.Enabled}
+	stepUp, deny := current.StepUpThreshold, current.DenyThreshold
+	if req.StepUpThreshold != nil {
+		stepUp = *req.StepUpThreshold
+		v := int32(stepUp)
+		update.StepUpThreshold = &v
+	}
+	if req.DenyThreshold != nil {
+		deny = *req.DenyThreshold
+		v := int32(deny)
+		update.DenyThreshold = &v
+	}
+	if stepUp > deny {
+		return nil, sharingV1.ErrorBadRequest("stepUpThreshold must not be greater than denyThreshold")
+	}
+	if req.BusinessHours != nil {
+		hours := strings.TrimSpace(*req.BusinessHours)
+		if hours != "" {
+			if _, err := timewindow.Parse(hours); err != nil {
+				return nil, sharingV1.ErrorBadRequest("invalid businessHours: %v", err)
+			}
+		}
+		update.BusinessHours = &hours
+	}
+
+	entity, err := s.riskRepo.Upsert(ctx, tenantID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.UpdateRiskSettingsResponse{
+		Settings: s.riskRepo.ToProto(entity),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+	"github.com/go-tangra/go-tangra-sharing/pkg/stepup"
+	"github.com/go-tangra/go-tangra-sharing/pkg/timewindow"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestScoreRisk(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		signals []RiskSignal
+		score   int32
+		want    RiskDecision
+	}{
+		{"no signals", nil, 0, RiskAllow},
+		{"off hours alone", []RiskSignal{RiskSignalOffHours}, 20, RiskAllow},
+		{"new country", []RiskSignal{RiskSignalNewCountry}, 30, RiskStepUp},
+		{"new country after failures", []RiskSignal{RiskSignalNewCountry, RiskSignalFailedAttempts}, 60, RiskStepUp},
+		{"anonymizing bot", []RiskSignal{RiskSignalAnonymizer, RiskSignalBot}, 80, RiskDeny},
+		{"everything", []RiskSignal{RiskSignalNewCountry, RiskSignalAnonymizer, RiskSignalBot, RiskSignalFailedAttempts, RiskSignalOffHours}, 100, RiskDeny},
+	} {
+		score := ScoreRisk(tc.signals)
+		if score != tc.score {
+			t.Errorf("%s: ScoreRisk() = %d, want %d", tc.name, score, tc.score)
+		}
+		if got := DecideRisk(score, 30, 70); got != tc.want {
+			t.Errorf("%s: DecideRisk(%d) = %v, want %v", tc.name, score, got, tc.want)
+		}
+	}
+
+	// A deny threshold above the maximum score never denies
+	if got := DecideRisk(100, 30, 101); got != RiskStepUp {
+		t.Errorf("DecideRisk(100, 30, 101) = %v, want RiskStepUp", got)
+	}
+}
+
+func TestFarOutside(t *testing.T) {
+	sched, err := timewindow.Parse("Mon-Fri 09:00-17:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		at   string
+		want bool
+	}{
+		{"2026-10-14T12:00:00Z", false}, // Wednesday noon
+		{"2026-10-14T18:30:00Z", false}, // just after hours
+		{"2026-10-14T19:30:00Z", true},
+		{"2026-10-14T07:00:00Z", false}, // two hours early
+		{"2026-10-14T03:00:00Z", true},
+		{"2026-10-17T12:00:00Z", true}, // Saturday
+	} {
+		at, _ := time.Parse(time.RFC3339, tc.at)
+		if got := farOutside(sched, at, riskOffHoursMargin); got != tc.want {
+			t.Errorf("farOutside(%s) = %v, want %v", tc.at, got, tc.want)
+		}
+	}
+}
+
+func TestStepUpLimitsPassphraseGuesses(t *testing.T) {
+	s, _ := newTestShareService(t)
+	ctx := tenantContext(tenantA, 0)
+	hash, err := stepup.HashPassphrase("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	share, token := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{PassphraseHash: hash})
+
+	// Codes are only sent to shares whose access was challenged
+	if _, err := s.SendShareVerificationCode(ctx, &sharingV1.SendShareVerificationCodeRequest{Token: token}); !sharingV1.IsBadRequest(err) {
+		t.Fatalf("code without a challenge: got %v, want BadRequest", err)
+	}
+
+	wrong, right := "wrong", "correct horse"
+	for i := 0; i < maxPassphraseAttempts; i++ {
+		entity, err := s.linkRepo.GetByID(ctx, share.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		outcome, err := s.checkStepUp(ctx, entity, &sharingV1.ViewSharedContentRequest{Token: token, Passphrase: &wrong})
+		if outcome != shareaccessevent.OutcomeSTEP_UP_FAILED || !sharingV1.IsShareStepUpRequired(err) {
+			t.Fatalf("wrong passphrase %d: outcome %q, err %v", i+1, outcome, err)
+		}
+	}
+
+	// A new code does not grant new guesses and the passphrase is no longer offered
+	if _, err := s.SendShareVerificationCode(ctx, &sharingV1.SendShareVerificationCodeRequest{Token: token}); err != nil {
+		t.Fatalf("code after a challenge: %v", err)
+	}
+	if n := len(s.notifications.queue); n != 1 {
+		t.Fatalf("%d email(s) queued, want the verification code", n)
+	}
+	entity, err := s.linkRepo.GetByID(ctx, share.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	_, err = s.checkStepUp(ctx, entity, &sharingV1.ViewSharedContentRequest{Token: token, Passphrase: &right})
+	if !sharingV1.IsShareStepUpRequired(err) {
+		t.Fatalf("passphrase after the lockout: got %v, want ShareStepUpRequired", err)
+	}
+	if methods := errors.FromError(err).GetMetadata()["methods"]; methods != stepUpMethodVerificationCode {
+		t.Errorf("methods after the lockout = %q, want %q", methods, stepUpMethodVerificationCode)
+	}
+}
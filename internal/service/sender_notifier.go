@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	// deniedNotificationWindow suppresses repeated denied notifications for the same share
+	deniedNotificationWindow = 15 * time.Minute
+
+	// expirySweepBatchSize limits how many expired shares are processed per sweep
+	expirySweepBatchSize = 100
+
+	// notificationQueueSize bounds the sender notifications waiting to be sent
+	notificationQueueSize = 256
+
+	// notificationWorkers is the number of sender notifications sent concurrently
+	notificationWorkers = 4
+
+	// expiryNoticeMaxAttempts caps the attempts to notify a sender that a share expired
+	expiryNoticeMaxAttempts = 5
+
+	// expiryNoticeRetryDelay is the delay before the first retry of an expiry
+	// notification, doubling with every further attempt
+	expiryNoticeRetryDelay = 5 * time.Minute
+)
+
+// senderNotification is a sender notification waiting to be sent. send, when
+// set, sends another email of the share instead of the notification of kind.
+// done, when set, is called with the outcome of the send.
+type senderNotification struct {
+	entity *ent.SharedLink
+	kind   emailtemplate.Kind
+	data   mail.TemplateData
+	send   func() error
+	done   func(error)
+}
+
+// GetNotificationPreferences returns the caller's sender notification preferences
+func (s *ShareService) GetNotificationPreferences(ctx context.Context, _ *sharingV1.GetNotificationPreferencesRequest) (*sharingV1.GetNotificationPreferencesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDAsUint32(ctx)
+	if userID == nil {
+		return nil, sharingV1.ErrorUnauthorized("user identity is required")
+	}
+
+	entity, err := s.prefRepo.GetByUser(ctx, tenantID, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.GetNotificationPreferencesResponse{
+		Preferences: s.prefRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateNotificationPreferences updates the caller's sender notification preferences
+func (s *ShareService) UpdateNotificationPreferences(ctx context.Context, req *sharingV1.UpdateNotificationPreferencesRequest) (*sharingV1.UpdateNotificationPreferencesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDAsUint32(ctx)
+	if userID == nil {
+		return nil, sharingV1.ErrorUnauthorized("user identity is required")
+	}
+
+	entity, err := s.prefRepo.Upsert(ctx, tenantID, *userID, req.NotifyViewed, req.NotifyDenied, req.NotifyExpired)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.UpdateNotificationPreferencesResponse{
+		Preferences: s.prefRepo.ToProto(entity),
+	}, nil
+}
+
+// ExpireShares clears the content of shares that expired unviewed and queues
+// the notifications of their senders. A share stays in the sweep until its
+// sender was notified or expiryNoticeMaxAttempts were made; attempts are
+// spaced out, so failing notifications do not hold back newer shares.
+// It returns the number of shares processed.
+func (s *ShareService) ExpireShares(ctx context.Context) (int, error) {
+	ctx = viewer.NewSystemViewerContext(ctx)
+	now := time.Now()
+
+	entities, err := s.linkRepo.ListExpiredUnnotified(ctx, now, expiryNoticeMaxAttempts, expirySweepBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entity := range entities {
+		// The content is still set on the first sweep that sees the share
+		if entity.EncryptedContent != nil && len(*entity.EncryptedContent) > 0 {
+			if err := s.linkRepo.MarkExpired(ctx, entity.ID); err != nil {
+				s.log.Warnf("Failed to mark share %s expired: %v", entity.ID, err)
+				continue
+			}
+			s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareExpired, shareEventPayload(entity))
+		}
+		s.notifyExpiry(ctx, entity, now)
+	}
+
+	return len(entities), nil
+}
+
+// notifyExpiry queues the notification that a share expired. The attempt is
+// recorded before the notification is queued and the share is only flagged
+// as notified once it was sent.
+func (s *ShareService) notifyExpiry(ctx context.Context, entity *ent.SharedLink, now time.Time) {
+	if entity.SenderEmail == "" {
+		if err := s.linkRepo.MarkExpiryNotified(ctx, entity.ID); err != nil {
+			s.log.Warnf("Failed to mark share %s expiry notified: %v", entity.ID, err)
+		}
+		return
+	}
+
+	attempt := entity.ExpiryNoticeAttempts + 1
+	if err := s.linkRepo.RecordExpiryNoticeAttempt(ctx, entity.ID, nextExpiryNoticeAttempt(attempt, now)); err != nil {
+		s.log.Warnf("Failed to record expiry notification attempt for share %s: %v", entity.ID, err)
+		return
+	}
+
+	data := mail.TemplateData{}
+	if entity.ExpiresAt != nil {
+		data.EventTime = formatEventTime(*entity.ExpiresAt)
+	}
+	queued := s.notifications.Enqueue(senderNotification{
+		entity: entity,
+		kind:   emailtemplate.KindSHARE_EXPIRED,
+		data:   senderNotificationData(entity, data),
+		done: func(err error) {
+			if err != nil {
+				s.log.Warnf("Failed to send expiry notification for share %s (attempt %d of %d): %v",
+					entity.ID, attempt, expiryNoticeMaxAttempts, err)
+				return
+			}
+			if err := s.linkRepo.MarkExpiryNotified(viewer.NewSystemViewerContext(context.Background()), entity.ID); err != nil {
+				s.log.Warnf("Failed to mark share %s expiry notified: %v", entity.ID, err)
+			}
+		},
+	})
+	if !queued {
+		s.log.Warnf("Notification queue is full or closed, skipped expiry notification attempt %d for share %s", attempt, entity.ID)
+	}
+}
+
+// nextExpiryNoticeAttempt returns when the expiry notification is retried
+// after the given number of attempts, nil when no attempt is left
+func nextExpiryNoticeAttempt(attempts int32, now time.Time) *time.Time {
+	if attempts >= expiryNoticeMaxAttempts {
+		return nil
+	}
+	next := now.Add(expiryNoticeRetryDelay << (attempts - 1))
+	return &next
+}
+
+// notifySenderOfDenial sends a denied notification unless one was already triggered recently
+func (s *ShareService) notifySenderOfDenial(ctx context.Context, entity *ent.SharedLink, clientIP, reason string) {
+	since := time.Now().Add(-deniedNotificationWindow)
+	count, err := s.accessEventRepo.CountSince(ctx, entity.ID, string(shareaccessevent.OutcomePOLICY_DENIED), since)
+	if err != nil {
+		s.log.Warnf("Failed to count recent denied attempts: %v", err)
+		return
+	}
+	// The current attempt has already been recorded
+	if count > 1 {
+		return
+	}
+
+	s.notifySender(entity, emailtemplate.KindSHARE_DENIED, mail.TemplateData{
+		ClientIP:   clientIP,
+		DenyReason: reason,
+		EventTime:  formatEventTime(time.Now()),
+	})
+}
+
+// notifySender queues an email to the creator of a share about an event, honouring their
+// preferences. data is completed with the share details.
+func (s *ShareService) notifySender(entity *ent.SharedLink, kind emailtemplate.Kind, data mail.TemplateData) {
+	if entity.SenderEmail == "" {
+		return
+	}
+
+	if !s.notifications.Enqueue(senderNotification{entity: entity, kind: kind, data: senderNotificationData(entity, data)}) {
+		s.log.Errorf("Dropped %s notification for share %s: notification queue is full or closed", kind, entity.ID)
+	}
+}
+
+// senderNotificationData completes notification data with the share details
+func senderNotificationData(entity *ent.SharedLink, data mail.TemplateData) mail.TemplateData {
+	data.RecipientEmail = entity.RecipientEmail
+	data.ResourceName = entity.ResourceName
+	data.ResourceType = string(entity.ResourceType)
+	return data
+}
+
+// RunNotifications sends queued sender notifications until StopNotifications is
+// called and the queue is drained
+func (s *ShareService) RunNotifications() {
+	s.notifications.Run(func(n senderNotification) {
+		var err error
+		if n.send != nil {
+			err = n.send()
+		} else {
+			err = s.sendSenderNotification(n.entity, n.kind, n.data)
+		}
+		if n.done != nil {
+			n.done(err)
+		} else if err != nil {
+			s.log.Errorf("Failed to send %s notification for share %s: %v", n.kind, n.entity.ID, err)
+		}
+	})
+}
+
+// StopNotifications stops accepting sender notifications and waits until the
+// queued ones were sent or ctx is done
+func (s *ShareService) StopNotifications(ctx context.Context) error {
+	return s.notifications.Close(ctx)
+}
+
+// sendSenderNotification renders and sends a sender notification email
+func (s *ShareService) sendSenderNotification(entity *ent.SharedLink, kind emailtemplate.Kind, data mail.TemplateData) error {
+	if entity.SenderEmail == "" {
+		return nil
+	}
+
+	// Use system viewer context for background workers (bypasses ENT privacy checks)
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	tenantID := derefTenantID(entity.TenantID)
+
+	if entity.CreateBy != nil {
+		pref, err := s.prefRepo.GetByUser(ctx, tenantID, *entity.CreateBy)
+		if err != nil {
+			return err
+		}
+		if pref != nil && !notificationEnabled(pref, kind) {
+			return nil
+		}
+	}
+
+	var subjectTmpl, bodyTmpl, textTmpl string
+
+	tmpl, err := s.templateRepo.GetDefault(ctx, tenantID, string(kind))
+	if err == nil && tmpl != nil {
+		subjectTmpl = tmpl.Subject
+		bodyTmpl = tmpl.HTMLBody
+		textTmpl = tmpl.TextBody
+	}
+
+	// Fall back to built-in defaults
+	if subjectTmpl == "" || bodyTmpl == "" {
+		subjectTmpl, bodyTmpl = defaultNotificationTemplates(kind)
+		textTmpl = ""
+	}
+
+	content, err := mail.RenderTemplate(subjectTmpl, bodyTmpl, textTmpl, data)
+	if err != nil {
+		return fmt.Errorf("failed to render notification template: %w", err)
+	}
+
+	return s.sendMail(strings.ToLower(string(kind)), entity.SenderEmail, "", content)
+}
+
+// notificationEnabled reports whether the preferences allow notifications of the given kind
+func notificationEnabled(pref *ent.NotificationPreference, kind emailtemplate.Kind) bool {
+	switch kind {
+	case emailtemplate.KindSHARE_VIEWED:
+		return pref.NotifyViewed
+	case emailtemplate.KindSHARE_DENIED:
+		return pref.NotifyDenied
+	case emailtemplate.KindSHARE_EXPIRED:
+		return pref.NotifyExpired
+	default:
+		return false
+	}
+}
+
+// defaultNotificationTemplates returns the built-in subject and body for a notification kind
+func defaultNotificationTemplates(kind emailtemplate.Kind) (string, string) {
+	switch kind {
+	case emailtemplate.KindSHARE_VIEWED:
+		return mail.DefaultViewedSubjectTemplate, mail.DefaultViewedHTMLBodyTemplate
+	case emailtemplate.KindSHARE_DENIED:
+		return mail.DefaultDeniedSubjectTemplate, mail.DefaultDeniedHTMLBodyTemplate
+	default:
+		return mail.DefaultExpiredSubjectTemplate, mail.DefaultExpiredHTMLBodyTemplate
+	}
+}
+
+// formatEventTime formats a timestamp for notification emails
+func formatEventTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 MST")
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
+)
+
+func TestExpireSharesSpacesOutFailedNotifications(t *testing.T) {
+	s, client := newTestShareService(t)
+	// Sends fail: the sender address is invalid
+	s.mailSender = mail.NewSender(&mail.SMTPConfig{From: "not an address"})
+	ctx := tenantContext(tenantA, 0)
+
+	expired := time.Now().Add(-time.Hour)
+	failing, _ := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{ExpiresAt: &expired, SenderEmail: "sender@example.com"})
+	silent, _ := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{ExpiresAt: &expired})
+
+	go s.RunNotifications()
+	if n, err := s.ExpireShares(ctx); err != nil || n != 2 {
+		t.Fatalf("ExpireShares = %d, %v; want 2", n, err)
+	}
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.StopNotifications(stopCtx); err != nil {
+		t.Fatalf("StopNotifications: %v", err)
+	}
+
+	// Both shares are expired regardless of the notification
+	got := client.SharedLink.GetX(ctx, failing.ID)
+	if (got.EncryptedContent != nil && len(*got.EncryptedContent) != 0) || got.ExpiryNotified || got.ExpiryNoticeAttempts != 1 ||
+		got.ExpiryNoticeNextAttemptAt == nil || !got.ExpiryNoticeNextAttemptAt.After(time.Now()) {
+		t.Fatalf("share with a failed notification = %+v", got)
+	}
+	if got := client.SharedLink.GetX(ctx, silent.ID); (got.EncryptedContent != nil && len(*got.EncryptedContent) != 0) || !got.ExpiryNotified {
+		t.Fatalf("share without sender = %+v, want expired and notified", got)
+	}
+
+	// The failed notification waits for its retry instead of heading the next sweep
+	if n, err := s.ExpireShares(ctx); err != nil || n != 0 {
+		t.Fatalf("second ExpireShares = %d, %v; want 0", n, err)
+	}
+
+	// The last attempt takes the share out of the sweep
+	client.SharedLink.UpdateOneID(failing.ID).
+		SetExpiryNoticeAttempts(expiryNoticeMaxAttempts - 1).
+		SetExpiryNoticeNextAttemptAt(time.Now().Add(-time.Minute)).
+		ExecX(ctx)
+	if n, err := s.ExpireShares(ctx); err != nil || n != 1 {
+		t.Fatalf("ExpireShares of the last attempt = %d, %v; want 1", n, err)
+	}
+	if n, err := s.ExpireShares(ctx); err != nil || n != 0 {
+		t.Fatalf("ExpireShares after the last attempt = %d, %v; want 0", n, err)
+	}
+}
+
+func TestNextExpiryNoticeAttempt(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for attempts, want := range []time.Duration{0, 5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 40 * time.Minute} {
+		if attempts == 0 {
+			continue
+		}
+		got := nextExpiryNoticeAttempt(int32(attempts), now)
+		if got == nil || got.Sub(now) != want {
+			t.Errorf("nextExpiryNoticeAttempt(%d) = %v, want now+%s", attempts, got, want)
+		}
+	}
+	if got := nextExpiryNoticeAttempt(expiryNoticeMaxAttempts, now); got != nil {
+		t.Errorf("nextExpiryNoticeAttempt(%d) = %v, want nil", expiryNoticeMaxAttempts, got)
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/mattn/go-sqlite3"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
+	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
+)
+
+const (
+	tenantA uint32 = 1
+	tenantB uint32 = 2
+)
+
+// newTestEntClient opens an in-memory sqlite database private to the test
+func newTestEntClient(t *testing.T) *entCrud.EntClient[*ent.Client] {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	return entCrud.NewEntClient(client, drv)
+}
+
+// newTestContext returns the bootstrap context services are created with in tests
+func newTestContext() *bootstrap.Context {
+	return bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+}
+
+// newTestShareService creates a ShareService backed by an in-memory sqlite
+// database private to the test. Upstream clients, mail and webhooks are not set.
+func newTestShareService(t *testing.T) (*ShareService, *ent.Client) {
+	t.Helper()
+
+	entClient := newTestEntClient(t)
+	ctx := newTestContext()
+	s := NewShareService(ctx,
+		data.NewSharedLinkRepo(ctx, entClient),
+		data.NewEmailTemplateRepo(ctx, entClient),
+		data.NewSharePolicyRepo(ctx, entClient),
+		data.NewPolicySetRepo(ctx, entClient),
+		data.NewShareAccessEventRepo(ctx, entClient),
+		data.NewNotificationPreferenceRepo(ctx, entClient),
+		data.NewRiskSettingsRepo(ctx, entClient),
+		nil, nil, nil, nil, nil, nil,
+	)
+	s.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+	return s, entClient.Client()
+}
+
+// tenantContext returns the context of a user of the tenant holding perms
+func tenantContext(tenantID, userID uint32, perms ...string) context.Context {
+	return authz.NewViewerContext(context.Background(), tenantID, userID, nil, perms)
+}
+
+// execTestSQL runs a statement on the test's sqlite database, e.g. to make queries fail
+func execTestSQL(t *testing.T, query string) {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	defer drv.Close()
+	if _, err := drv.DB().ExecContext(context.Background(), query); err != nil {
+		t.Fatalf("exec %q: %v", query, err)
+	}
+}
+
+// createTestShareLink stores a share of the tenant and returns its token
+func createTestShareLink(t *testing.T, s *ShareService, tenantID uint32, in *data.SharedLinkInput) (*ent.SharedLink, string) {
+	t.Helper()
+
+	token, err := crypto.GenerateToken()
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+	ciphertext, nonce, err := crypto.EncryptContent([]byte("s3cret"), s.encryptionKey)
+	if err != nil {
+		t.Fatalf("encrypt content: %v", err)
+	}
+	if in == nil {
+		in = &data.SharedLinkInput{}
+	}
+	in.TenantID, in.Token, in.EncryptedContent, in.Nonce = tenantID, token, ciphertext, nonce
+	in.ResourceType, in.ResourceID, in.ResourceName = "SECRET", "secret-1", "Secret"
+	if in.RecipientEmail == "" {
+		in.RecipientEmail = "r@example.com"
+	}
+
+	entity, err := s.linkRepo.Create(tenantContext(tenantID, 10), in)
+	if err != nil {
+		t.Fatalf("create share: %v", err)
+	}
+	return entity, token
+}
+
+// newTestFeeds creates reputation feeds providing the lists, all of them empty
+func newTestFeeds(t *testing.T, lists ...string) *reputation.Feeds {
+	t.Helper()
+
+	var entries []string
+	for _, list := range lists {
+		path := filepath.Join(t.TempDir(), list+".txt")
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, list+"="+path)
+	}
+	t.Setenv("SHARING_REPUTATION_FEEDS", strings.Join(entries, ","))
+
+	ctx := newTestContext()
+	feeds, cleanup, err := reputation.NewFeeds(ctx)
+	if err != nil {
+		t.Fatalf("create reputation feeds: %v", err)
+	}
+	t.Cleanup(cleanup)
+	return feeds
+}
@@ -5,20 +5,47 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
 	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/emailtemplate"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharedlink"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/sharepolicy"
+	"github.com/go-tangra/go-tangra-sharing/internal/geoip"
+	"github.com/go-tangra/go-tangra-sharing/internal/metrics"
+	"github.com/go-tangra/go-tangra-sharing/internal/reputation"
+	"github.com/go-tangra/go-tangra-sharing/pkg/clientcert"
 	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
+	"github.com/go-tangra/go-tangra-sharing/pkg/device"
+	"github.com/go-tangra/go-tangra-sharing/pkg/devicebinding"
 	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
+	"github.com/go-tangra/go-tangra-sharing/pkg/pow"
+	"github.com/go-tangra/go-tangra-sharing/pkg/stepup"
 
 	"github.com/go-tangra/go-tangra-common/viewer"
 
 	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
 )
 
+const (
+	// accessEventTokenPrefixLen is how much of a presented token is kept in the access log
+	accessEventTokenPrefixLen = 12
+
+	// defaultListSharesPageSize is used for cursor pagination when no page size is given
+	defaultListSharesPageSize = 50
+)
+
 // ShareService implements the SharingShareService gRPC service
 type ShareService struct {
 	sharingV1.UnimplementedSharingShareServiceServer
@@ -27,10 +54,24 @@ type ShareService struct {
 	linkRepo        *data.SharedLinkRepo
 	templateRepo    *data.EmailTemplateRepo
 	policyRepo      *data.SharePolicyRepo
+	policySetRepo   *data.PolicySetRepo
+	accessEventRepo *data.ShareAccessEventRepo
+	prefRepo        *data.NotificationPreferenceRepo
+	riskRepo        *data.RiskSettingsRepo
+	dispatcher      *WebhookDispatcher
 	wardenClient    *data.WardenClient
 	paperlessClient *data.PaperlessClient
 	mailSender      *mail.Sender
+	notifications   *workQueue[senderNotification]
+	geoResolver     *geoip.Resolver
+	reputationFeeds *reputation.Feeds
+	now             func() time.Time
 	encryptionKey   []byte
+	deviceKey       []byte
+	stepUpKey       []byte
+	powKey          []byte
+	pow             powSettings
+	powUsed         *pow.Used
 	appHost         string
 }
 
@@ -40,9 +81,16 @@ func NewShareService(
 	linkRepo *data.SharedLinkRepo,
 	templateRepo *data.EmailTemplateRepo,
 	policyRepo *data.SharePolicyRepo,
+	policySetRepo *data.PolicySetRepo,
+	accessEventRepo *data.ShareAccessEventRepo,
+	prefRepo *data.NotificationPreferenceRepo,
+	riskRepo *data.RiskSettingsRepo,
+	dispatcher *WebhookDispatcher,
 	wardenClient *data.WardenClient,
 	paperlessClient *data.PaperlessClient,
 	mailSender *mail.Sender,
+	geoResolver *geoip.Resolver,
+	reputationFeeds *reputation.Feeds,
 ) *ShareService {
 	l := ctx.NewLoggerHelper("sharing/service/share")
 
@@ -74,10 +122,24 @@ func NewShareService(
 		linkRepo:        linkRepo,
 		templateRepo:    templateRepo,
 		policyRepo:      policyRepo,
+		policySetRepo:   policySetRepo,
+		accessEventRepo: accessEventRepo,
+		prefRepo:        prefRepo,
+		riskRepo:        riskRepo,
+		dispatcher:      dispatcher,
 		wardenClient:    wardenClient,
 		paperlessClient: paperlessClient,
 		mailSender:      mailSender,
+		notifications:   newWorkQueue[senderNotification](notificationQueueSize, notificationWorkers),
+		geoResolver:     geoResolver,
+		reputationFeeds: reputationFeeds,
+		now:             time.Now,
 		encryptionKey:   key,
+		deviceKey:       devicebinding.DeriveKey(key),
+		stepUpKey:       stepup.DeriveKey(key),
+		powKey:          pow.DeriveKey(key),
+		pow:             powSettingsFromEnv(l),
+		powUsed:         pow.NewUsed(),
 		appHost:         appHost,
 	}
 }
@@ -87,6 +149,10 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 	tenantID := getTenantIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 	senderName := getUsernameFromContext(ctx)
+	senderEmail := req.GetNotifyEmail()
+	if senderEmail == "" && strings.Contains(senderName, "@") {
+		senderEmail = senderName
+	}
 	if senderName == "" {
 		senderName = "A user"
 	}
@@ -96,17 +162,67 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 		return nil, sharingV1.ErrorInvalidResourceType("resource type must be SECRET or DOCUMENT")
 	}
 
+	perms := []string{authz.PermShareCreate, resourceTypePermission(req.ResourceType)}
+	if len(req.Policies) > 0 {
+		perms = append(perms, authz.PermPolicyManage)
+	}
+	if err := authz.Require(ctx, perms...); err != nil {
+		return nil, err
+	}
+
+	policies, err := validatePolicies(s.reputationFeeds, "policies", req.Policies)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getPolicySets(ctx, req.PolicySetIds); err != nil {
+		return nil, err
+	}
+	if req.BindDevice && req.GetMaxViews() <= 1 {
+		return nil, sharingV1.ErrorBadRequest("bindDevice requires maxViews greater than 1")
+	}
+
+	// The template is looked up under the caller's viewer, so only templates
+	// of the caller's tenant can be used
+	var tmpl *ent.EmailTemplate
+	if req.GetTemplateId() != "" {
+		if tmpl, err = s.templateRepo.GetByID(ctx, req.GetTemplateId()); err != nil {
+			return nil, err
+		}
+		if tmpl == nil || tmpl.Kind != emailtemplate.KindSHARE {
+			return nil, sharingV1.ErrorTemplateNotFound("template not found")
+		}
+	}
+
+	// Upstream services authorize the read for the calling user
+	if createdBy == nil {
+		return nil, sharingV1.ErrorUnauthorized("user identity is required")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, sharingV1.ErrorBadRequest("expiresAt must be in the future")
+		}
+		expiresAt = &t
+	}
+
 	// Fetch content from upstream service
 	var contentBytes []byte
 	var resourceName string
 	var resourceTypeStr string
+	var upstream string
 
 	switch req.ResourceType {
 	case sharingV1.ResourceType_RESOURCE_TYPE_SECRET:
 		resourceTypeStr = "SECRET"
+		upstream = metrics.UpstreamWarden
 		// Get secret metadata
 		secret, err := s.wardenClient.GetSecret(ctx, tenantID, req.ResourceId)
 		if err != nil {
+			if data.IsUpstreamAccessDenied(err) {
+				return nil, s.upstreamDenied(upstream, req.ResourceId, *createdBy, err)
+			}
 			s.log.Errorf("Failed to get secret from warden: %v", err)
 			return nil, sharingV1.ErrorWardenUnavailable("failed to fetch secret: %v", err)
 		}
@@ -115,6 +231,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 		// Get password
 		password, err := s.wardenClient.GetSecretPassword(ctx, tenantID, req.ResourceId)
 		if err != nil {
+			if data.IsUpstreamAccessDenied(err) {
+				return nil, s.upstreamDenied(upstream, req.ResourceId, *createdBy, err)
+			}
 			s.log.Errorf("Failed to get secret password from warden: %v", err)
 			return nil, sharingV1.ErrorWardenUnavailable("failed to fetch secret password: %v", err)
 		}
@@ -122,9 +241,13 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 
 	case sharingV1.ResourceType_RESOURCE_TYPE_DOCUMENT:
 		resourceTypeStr = "DOCUMENT"
+		upstream = metrics.UpstreamPaperless
 		// Get document metadata
 		doc, err := s.paperlessClient.GetDocument(ctx, tenantID, req.ResourceId)
 		if err != nil {
+			if data.IsUpstreamAccessDenied(err) {
+				return nil, s.upstreamDenied(upstream, req.ResourceId, *createdBy, err)
+			}
 			s.log.Errorf("Failed to get document from paperless: %v", err)
 			return nil, sharingV1.ErrorPaperlessUnavailable("failed to fetch document: %v", err)
 		}
@@ -133,6 +256,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 		// Download document content
 		content, _, _, err := s.paperlessClient.DownloadDocument(ctx, tenantID, req.ResourceId)
 		if err != nil {
+			if data.IsUpstreamAccessDenied(err) {
+				return nil, s.upstreamDenied(upstream, req.ResourceId, *createdBy, err)
+			}
 			s.log.Errorf("Failed to download document from paperless: %v", err)
 			return nil, sharingV1.ErrorPaperlessUnavailable("failed to download document: %v", err)
 		}
@@ -142,9 +268,16 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 		return nil, sharingV1.ErrorInvalidResourceType("unsupported resource type")
 	}
 
+	authorization := &data.UpstreamAuthorization{
+		UserID: *createdBy,
+		Via:    upstream + ":" + data.UpstreamSharePermission,
+		At:     time.Now(),
+	}
+
 	// Generate token
 	token, err := crypto.GenerateToken()
 	if err != nil {
+		metrics.EncryptionErrors.WithLabelValues("generate_token").Inc()
 		s.log.Errorf("Failed to generate token: %v", err)
 		return nil, sharingV1.ErrorEncryptionError("failed to generate share token")
 	}
@@ -152,42 +285,61 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 	// Encrypt content
 	ciphertext, nonce, err := crypto.EncryptContent(contentBytes, s.encryptionKey)
 	if err != nil {
+		metrics.EncryptionErrors.WithLabelValues("encrypt").Inc()
 		s.log.Errorf("Failed to encrypt content: %v", err)
 		return nil, sharingV1.ErrorEncryptionError("failed to encrypt content")
 	}
 
 	// Store in database
-	var templateID string
-	if req.TemplateId != nil {
-		templateID = *req.TemplateId
+	var passphraseHash string
+	if req.Passphrase != nil {
+		if passphraseHash, err = stepup.HashPassphrase(req.GetPassphrase()); err != nil {
+			s.log.Errorf("Failed to hash share passphrase: %v", err)
+			return nil, sharingV1.ErrorEncryptionError("failed to hash passphrase")
+		}
 	}
 
-	entity, err := s.linkRepo.Create(ctx, tenantID, resourceTypeStr, req.ResourceId, resourceName, token, ciphertext, nonce, req.RecipientEmail, req.Message, templateID, createdBy)
+	// The share is only created together with all of its restrictions
+	policyInputs := make([]data.SharePolicyInput, 0, len(policies))
+	for _, p := range policies {
+		policyInputs = append(policyInputs, data.SharePolicyInput{Type: p.Type, Method: p.Method, Value: p.Value, Reason: p.Reason, Priority: p.Priority})
+	}
+	entity, err := s.linkRepo.Create(ctx, &data.SharedLinkInput{
+		TenantID:         tenantID,
+		ResourceType:     resourceTypeStr,
+		ResourceID:       req.ResourceId,
+		ResourceName:     resourceName,
+		Token:            token,
+		EncryptedContent: ciphertext,
+		Nonce:            nonce,
+		RecipientEmail:   req.RecipientEmail,
+		SenderEmail:      senderEmail,
+		Message:          req.Message,
+		TemplateID:       req.GetTemplateId(),
+		ExpiresAt:        expiresAt,
+		CreatedBy:        createdBy,
+		Authorization:    authorization,
+		PolicyMode:       policyModeToString(req.PolicyMode),
+		PolicyDefault:    policyDefaultToString(req.PolicyDefault),
+		MaxViews:         int32(req.GetMaxViews()),
+		BindDevice:       req.BindDevice,
+		PassphraseHash:   passphraseHash,
+		Policies:         policyInputs,
+		PolicySetIDs:     req.PolicySetIds,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Create policies if provided
-	if len(req.Policies) > 0 {
-		for _, p := range req.Policies {
-			pType := policyTypeToString(p.Type)
-			pMethod := policyMethodToString(p.Method)
-			if pType == "" || pMethod == "" {
-				continue
-			}
-			_, err := s.policyRepo.Create(ctx, tenantID, entity.ID, pType, pMethod, p.Value, p.Reason, createdBy)
-			if err != nil {
-				s.log.Warnf("Failed to create share policy: %v", err)
-			}
-		}
-	}
+	metrics.SharesCreated.WithLabelValues(metrics.TenantLabel(tenantID), resourceTypeStr).Inc()
+	s.dispatcher.Publish(tenantID, EventShareCreated, shareEventPayload(entity))
 
 	// Build share link
 	shareLink := fmt.Sprintf("%s/#/shared/%s", s.appHost, token)
 
 	// Send email asynchronously
 	go func() {
-		if sendErr := s.sendShareEmail(tenantID, req.RecipientEmail, senderName, resourceName, resourceTypeStr, req.Message, shareLink, templateID); sendErr != nil {
+		if sendErr := s.sendShareEmail(tenantID, req.RecipientEmail, senderName, senderEmail, resourceName, resourceTypeStr, req.Message, shareLink, tmpl); sendErr != nil {
 			s.log.Errorf("Failed to send share email: %v", sendErr)
 		}
 	}()
@@ -200,12 +352,13 @@ func (s *ShareService) CreateShare(ctx context.Context, req *sharingV1.CreateSha
 
 // GetShare retrieves a share by ID
 func (s *ShareService) GetShare(ctx context.Context, req *sharingV1.GetShareRequest) (*sharingV1.GetShareResponse, error) {
-	entity, err := s.linkRepo.GetByID(ctx, req.Id)
-	if err != nil {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
 		return nil, err
 	}
-	if entity == nil {
-		return nil, sharingV1.ErrorShareNotFound("share not found")
+
+	entity, err := s.getVisibleShare(ctx, req.Id)
+	if err != nil {
+		return nil, err
 	}
 
 	shareProto := s.linkRepo.ToProto(entity)
@@ -217,14 +370,21 @@ func (s *ShareService) GetShare(ctx context.Context, req *sharingV1.GetShareRequ
 			shareProto.Policies = append(shareProto.Policies, s.policyRepo.ToProto(p))
 		}
 	}
+	if setIDs, err := s.policySetRepo.ListSetIDsByShareLinkID(ctx, entity.ID); err == nil {
+		shareProto.PolicySetIds = setIDs
+	}
 
 	return &sharingV1.GetShareResponse{
 		Share: shareProto,
 	}, nil
 }
 
-// ListShares lists shares for the current tenant
+// ListShares lists shares for the current tenant; callers without the
+// view-all permission only see the shares they created
 func (s *ShareService) ListShares(ctx context.Context, req *sharingV1.ListSharesRequest) (*sharingV1.ListSharesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
 	tenantID := getTenantIDFromContext(ctx)
 
 	var page, pageSize uint32
@@ -235,18 +395,63 @@ func (s *ShareService) ListShares(ctx context.Context, req *sharingV1.ListShares
 		pageSize = *req.PageSize
 	}
 
-	var resourceType *string
+	filter := &data.SharedLinkFilter{
+		RecipientEmail: req.RecipientEmail,
+		CreatedBy:      req.CreatedBy,
+		Search:         req.Search,
+	}
+	if !authz.HasPermission(ctx, authz.PermShareViewAll) {
+		callerID := authz.CallerID(ctx)
+		filter.CreatedBy = &callerID
+	}
 	if req.ResourceType != nil && *req.ResourceType != sharingV1.ResourceType_RESOURCE_TYPE_UNSPECIFIED {
-		rt := req.ResourceType.String()
-		resourceType = &rt
+		rt := strings.TrimPrefix(req.ResourceType.String(), "RESOURCE_TYPE_")
+		filter.ResourceType = &rt
+	}
+	if req.Status != nil && *req.Status != sharingV1.ShareStatus_SHARE_STATUS_UNSPECIFIED {
+		status := strings.TrimPrefix(req.Status.String(), "SHARE_STATUS_")
+		filter.Status = &status
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &t
+	}
+	if req.ViewedAfter != nil {
+		t := req.ViewedAfter.AsTime()
+		filter.ViewedAfter = &t
+	}
+	if req.ViewedBefore != nil {
+		t := req.ViewedBefore.AsTime()
+		filter.ViewedBefore = &t
 	}
 
-	var recipientEmail *string
-	if req.RecipientEmail != nil {
-		recipientEmail = req.RecipientEmail
+	sort := data.SharedLinkSort{
+		Field: data.SharedLinkSortCreateTime,
+		Desc:  req.GetSortOrder() != sharingV1.SortOrder_SORT_ORDER_ASC,
+	}
+	switch req.GetSortBy() {
+	case sharingV1.ShareSortField_SHARE_SORT_FIELD_RESOURCE_NAME:
+		sort.Field = data.SharedLinkSortResourceName
+	case sharingV1.ShareSortField_SHARE_SORT_FIELD_RECIPIENT_EMAIL:
+		sort.Field = data.SharedLinkSortRecipientEmail
 	}
 
-	entities, total, err := s.linkRepo.ListByTenant(ctx, tenantID, resourceType, recipientEmail, page, pageSize)
+	var cursor *data.SharedLinkCursor
+	if req.GetPageToken() != "" {
+		var err error
+		if cursor, err = data.DecodeSharedLinkCursor(req.GetPageToken()); err != nil {
+			return nil, err
+		}
+		if pageSize == 0 {
+			pageSize = defaultListSharesPageSize
+		}
+	}
+
+	entities, total, next, err := s.linkRepo.ListByTenant(ctx, tenantID, filter, sort, cursor, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -256,76 +461,185 @@ func (s *ShareService) ListShares(ctx context.Context, req *sharingV1.ListShares
 		shares = append(shares, s.linkRepo.ToProto(e))
 	}
 
-	return &sharingV1.ListSharesResponse{
+	resp := &sharingV1.ListSharesResponse{
 		Shares: shares,
 		Total:  uint32(total),
-	}, nil
+	}
+	if next != nil {
+		resp.NextPageToken = next.Encode()
+	}
+	return resp, nil
 }
 
 // RevokeShare revokes a shared link
 func (s *ShareService) RevokeShare(ctx context.Context, req *sharingV1.RevokeShareRequest) (*emptypb.Empty, error) {
-	entity, err := s.linkRepo.GetByID(ctx, req.Id)
-	if err != nil {
+	if err := authz.Require(ctx, authz.PermShareRevoke); err != nil {
 		return nil, err
 	}
-	if entity == nil {
-		return nil, sharingV1.ErrorShareNotFound("share not found")
+
+	entity, err := s.getVisibleShare(ctx, req.Id)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.linkRepo.Revoke(ctx, req.Id); err != nil {
 		return nil, err
 	}
 
+	s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareRevoked, shareEventPayload(entity))
+
 	return &emptypb.Empty{}, nil
 }
 
-// ViewSharedContent views the content of a shared link (consumes the link)
+// ViewSharedContent views the content of a shared link (the last allowed view consumes the link)
 func (s *ShareService) ViewSharedContent(ctx context.Context, req *sharingV1.ViewSharedContentRequest) (*sharingV1.ViewSharedContentResponse, error) {
+	// Share tokens are global: the link is resolved across tenants
+	ctx = viewer.NewSystemViewerContext(ctx)
+	clientIP := getClientIPFromContext(ctx)
+
+	// Scripted token probing must pay for every token it tries
+	if err := s.checkProofOfWork(ctx, req.Token, req.PowChallenge, req.PowSolution); err != nil {
+		return nil, err
+	}
+
 	entity, err := s.linkRepo.GetByToken(ctx, req.Token)
 	if err != nil {
 		return nil, err
 	}
 	if entity == nil {
+		s.recordAccess(ctx, nil, req.Token, shareaccessevent.OutcomeNOT_FOUND, nil, "share not found or invalid token")
 		return nil, sharingV1.ErrorShareNotFound("share not found or invalid token")
 	}
 
 	if entity.Revoked {
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeREVOKED, nil, "this share has been revoked")
 		return nil, sharingV1.ErrorShareRevoked("this share has been revoked")
 	}
 
 	if entity.Viewed {
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeALREADY_VIEWED, nil, "this share has already been viewed")
 		return nil, sharingV1.ErrorShareAlreadyViewed("this share has already been viewed")
 	}
 
-	// Evaluate access policies before decrypting
-	policies, err := s.policyRepo.ListByShareLinkID(ctx, entity.ID)
+	if entity.ExpiresAt != nil && !s.now().Before(*entity.ExpiresAt) {
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeEXPIRED, nil, "this share has expired")
+		return nil, sharingV1.ErrorShareExpired("this share has expired")
+	}
+
+	// Device-bound shares can only be viewed again from the device of the first view
+	if entity.BindDevice && entity.DeviceBinding != nil && !devicebinding.Verify(s.deviceKey, entity.ID, req.GetDeviceToken(), *entity.DeviceBinding) {
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeDEVICE_MISMATCH, nil, "this share is bound to another device")
+		return nil, sharingV1.ErrorShareDeviceMismatch("this share is bound to another device")
+	}
+
+	// Evaluate access policies before decrypting. Shares that deny by default
+	// are evaluated even without policies.
+	policies, _, err := s.sharePolicies(ctx, entity.ID)
 	if err != nil {
-		s.log.Warnf("Failed to load share policies: %v", err)
+		// Without its policies the share would be less restricted than configured
+		s.log.Errorf("Failed to load share policies: %v", err)
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeERROR, nil, "failed to load share policies")
+		return nil, sharingV1.ErrorInternalServerError("failed to evaluate share policies")
 	}
-	if len(policies) > 0 {
-		clientIP := getClientIPFromContext(ctx)
-		if policyErr := EvaluatePolicies(policies, clientIP); policyErr != nil {
+	if len(policies) > 0 || entity.PolicyDefault == sharedlink.PolicyDefaultDENY {
+		if deniedBy, method, policyErr := EvaluatePolicies(policies, policyModeOf(entity), s.policyRequest(ctx, entity, clientIP, policies)); policyErr != nil {
+			reason := errors.FromError(policyErr).GetMessage()
+			// Denials by default or by no whitelist of any method are not tied to one method
+			methodLabel := string(method)
+			if methodLabel == "" {
+				methodLabel = "NONE"
+			}
+			metrics.PolicyDenials.WithLabelValues(metrics.TenantLabel(derefTenantID(entity.TenantID)), methodLabel).Inc()
+			s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomePOLICY_DENIED, deniedBy, reason)
+			s.notifySenderOfDenial(ctx, entity, clientIP, reason)
+
+			payload := shareEventPayload(entity)
+			payload["clientIp"] = clientIP
+			payload["reason"] = reason
+			if deniedBy != nil {
+				payload["policyId"] = deniedBy.ID
+			}
+			s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareDenied, payload)
 			return nil, policyErr
 		}
 	}
 
+	// Risky attempts must answer a step-up challenge, the riskiest are denied
+	risk, err := s.assessRisk(ctx, entity, clientIP)
+	if err != nil {
+		s.log.Warnf("Failed to assess risk of access to share %s: %v", entity.ID, err)
+	}
+	if risk != nil {
+		ctx = withRiskScore(ctx, risk.Score)
+		switch risk.Decision {
+		case RiskDeny:
+			reason := "access denied: risk score too high"
+			s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeRISK_DENIED, nil, reason+" ("+risk.signalList()+")")
+
+			payload := shareEventPayload(entity)
+			payload["clientIp"] = clientIP
+			payload["reason"] = reason
+			payload["riskScore"] = risk.Score
+			payload["riskSignals"] = risk.Signals
+			s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareDenied, payload)
+			return nil, sharingV1.ErrorShareAccessDenied("%s", reason)
+		case RiskStepUp:
+			if outcome, stepUpErr := s.checkStepUp(ctx, entity, req); stepUpErr != nil {
+				s.recordAccess(ctx, entity, req.Token, outcome, nil, errors.FromError(stepUpErr).GetMessage())
+				return nil, stepUpErr
+			}
+		}
+	}
+
 	// Decrypt content
 	if entity.EncryptedContent == nil || entity.EncryptionNonce == nil {
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeERROR, nil, "share content is no longer available")
 		return nil, sharingV1.ErrorEncryptionError("share content is no longer available")
 	}
 	plaintext, err := crypto.DecryptContent(*entity.EncryptedContent, *entity.EncryptionNonce, s.encryptionKey)
 	if err != nil {
+		metrics.EncryptionErrors.WithLabelValues("decrypt").Inc()
 		s.log.Errorf("Failed to decrypt content: %v", err)
+		s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeERROR, nil, "failed to decrypt content")
 		return nil, sharingV1.ErrorEncryptionError("failed to decrypt content")
 	}
 
-	// Mark as viewed
-	if markErr := s.linkRepo.MarkViewed(ctx, entity.ID, ""); markErr != nil {
-		s.log.Warnf("Failed to mark share as viewed: %v", markErr)
+	// The first view of a device-bound share binds it to the viewing device
+	var deviceToken, deviceBinding string
+	if entity.BindDevice && entity.DeviceBinding == nil {
+		if deviceToken, deviceBinding, err = devicebinding.Issue(s.deviceKey, entity.ID); err != nil {
+			s.log.Errorf("Failed to issue device token: %v", err)
+			s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeERROR, nil, "failed to bind share to device")
+			return nil, sharingV1.ErrorEncryptionError("failed to bind share to device")
+		}
 	}
 
+	// Count the view; of concurrent views only the first one is granted
+	if err := s.linkRepo.RecordView(ctx, entity, clientIP, deviceBinding); err != nil {
+		if sharingV1.IsShareAlreadyViewed(err) {
+			s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeALREADY_VIEWED, nil, "this share has already been viewed")
+		} else {
+			s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeERROR, nil, "failed to record view")
+		}
+		return nil, err
+	}
+	s.recordAccess(ctx, entity, req.Token, shareaccessevent.OutcomeGRANTED, nil, "")
+	metrics.ShareViews.WithLabelValues(metrics.TenantLabel(derefTenantID(entity.TenantID)), string(entity.ResourceType)).Inc()
+	if entity.CreateTime != nil && entity.ViewCount == 0 {
+		metrics.TimeToFirstView.WithLabelValues(string(entity.ResourceType)).Observe(time.Since(*entity.CreateTime).Seconds())
+	}
+	s.notifySender(entity, emailtemplate.KindSHARE_VIEWED, mail.TemplateData{
+		ClientIP:  clientIP,
+		EventTime: formatEventTime(time.Now()),
+	})
+
+	viewedPayload := shareEventPayload(entity)
+	viewedPayload["clientIp"] = clientIP
+	s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareViewed, viewedPayload)
+
 	resp := &sharingV1.ViewSharedContentResponse{
 		ResourceName: entity.ResourceName,
+		DeviceToken:  deviceToken,
 	}
 
 	switch entity.ResourceType {
@@ -342,28 +656,249 @@ func (s *ShareService) ViewSharedContent(ctx context.Context, req *sharingV1.Vie
 	return resp, nil
 }
 
-// sendShareEmail sends the share notification email
-func (s *ShareService) sendShareEmail(tenantID uint32, recipientEmail, senderName, resourceName, resourceType, message, shareLink, templateID string) error {
-	// Use system viewer context for background goroutine (bypasses ENT privacy checks)
-	ctx := viewer.NewSystemViewerContext(context.Background())
+// policyRequest collects the attributes of an access attempt that policies are matched against
+func (s *ShareService) policyRequest(ctx context.Context, entity *ent.SharedLink, clientIP string, policies []*ent.SharePolicy) *PolicyRequest {
+	userAgent := getUserAgentFromContext(ctx)
+	return &PolicyRequest{
+		ClientIP:        clientIP,
+		Now:             s.now(),
+		UserAgent:       userAgent,
+		Device:          device.Parse(userAgent),
+		Location:        s.regionLocation(clientIP, policies),
+		GeoFailOpen:     s.geoResolver.FailOpen(),
+		Certificate:     clientcert.FromContext(ctx),
+		ReputationLists: s.reputationLists(clientIP, policies),
+		AttemptCount:    s.attemptCount(ctx, entity.ID, policies),
+		RecipientEmail:  entity.RecipientEmail,
+	}
+}
 
-	// Try to load template
-	var subjectTmpl, bodyTmpl string
+// hasPolicyMethod reports whether any of the policies uses one of the methods
+func hasPolicyMethod(policies []*ent.SharePolicy, methods ...sharepolicy.Method) bool {
+	return slices.ContainsFunc(policies, func(p *ent.SharePolicy) bool { return slices.Contains(methods, p.Method) })
+}
 
-	if templateID != "" {
-		tmpl, err := s.templateRepo.GetByID(ctx, templateID)
-		if err == nil && tmpl != nil {
-			subjectTmpl = tmpl.Subject
-			bodyTmpl = tmpl.HTMLBody
+// attemptCount counts the earlier attempts to open a share when EXPRESSION policies need it
+func (s *ShareService) attemptCount(ctx context.Context, shareLinkID string, policies []*ent.SharePolicy) int64 {
+	if !hasPolicyMethod(policies, sharepolicy.MethodEXPRESSION) {
+		return 0
+	}
+	count, err := s.accessEventRepo.CountByShareLinkID(ctx, shareLinkID)
+	if err != nil {
+		s.log.Warnf("Failed to count access attempts of share %s: %v", shareLinkID, err)
+		return 0
+	}
+	return int64(count)
+}
+
+// regionLocation resolves the location of clientIP when REGION or EXPRESSION policies need it
+func (s *ShareService) regionLocation(clientIP string, policies []*ent.SharePolicy) *geoip.Location {
+	if !hasPolicyMethod(policies, sharepolicy.MethodREGION, sharepolicy.MethodEXPRESSION) {
+		return nil
+	}
+	loc, err := s.geoResolver.Lookup(clientIP)
+	if err != nil {
+		s.log.Warnf("Failed to resolve location of %s for REGION policies: %v", clientIP, err)
+		return nil
+	}
+	return loc
+}
+
+// reputationLists looks up the reputation lists of clientIP when REPUTATION policies need them
+func (s *ShareService) reputationLists(clientIP string, policies []*ent.SharePolicy) []string {
+	if !hasPolicyMethod(policies, sharepolicy.MethodREPUTATION) {
+		return nil
+	}
+	return s.reputationFeeds.Lists(clientIP)
+}
+
+// recordAccess stores an access attempt in the share access event log.
+// Failures are logged and never block the caller.
+func (s *ShareService) recordAccess(ctx context.Context, entity *ent.SharedLink, token string, outcome shareaccessevent.Outcome, policy *ent.SharePolicy, reason string) {
+	in := &data.ShareAccessEventInput{
+		Outcome:   string(outcome),
+		Reason:    reason,
+		ClientIP:  getClientIPFromContext(ctx),
+		UserAgent: getUserAgentFromContext(ctx),
+		RiskScore: riskScoreFromContext(ctx),
+	}
+	if loc, err := s.geoResolver.Lookup(in.ClientIP); err == nil {
+		in.Country = loc.Country
+	}
+	if entity != nil {
+		in.TenantID = derefTenantID(entity.TenantID)
+		in.ShareLinkID = entity.ID
+	}
+	if policy != nil {
+		in.PolicyID = policy.ID
+		if policy.Method == sharepolicy.MethodREPUTATION {
+			in.ReputationList = reputationListMatch(policy.Value, s.reputationFeeds.Lists(in.ClientIP))
+		}
+	}
+	if len(token) > accessEventTokenPrefixLen {
+		in.TokenPrefix = token[:accessEventTokenPrefixLen]
+	}
+
+	if _, err := s.accessEventRepo.Create(ctx, in); err != nil {
+		s.log.Warnf("Failed to record share access event: %v", err)
+	}
+}
+
+// ListShareAccessEvents lists recorded access attempts for the current tenant.
+// Callers without the view-all permission must filter on one of their own shares.
+func (s *ShareService) ListShareAccessEvents(ctx context.Context, req *sharingV1.ListShareAccessEventsRequest) (*sharingV1.ListShareAccessEventsResponse, error) {
+	if err := authz.Require(ctx, authz.PermAccessView); err != nil {
+		return nil, err
+	}
+	if !authz.HasPermission(ctx, authz.PermShareViewAll) {
+		if req.GetShareLinkId() == "" {
+			return nil, sharingV1.ErrorAccessDenied("missing permission %s", authz.PermShareViewAll)
+		}
+		if _, err := s.getVisibleShare(ctx, req.GetShareLinkId()); err != nil {
+			return nil, err
 		}
 	}
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	filter := &data.ShareAccessEventFilter{
+		ShareLinkID: req.ShareLinkId,
+		ClientIP:    req.ClientIp,
+	}
+	if req.Outcome != nil && *req.Outcome != sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_UNSPECIFIED {
+		outcome := accessOutcomeToString(*req.Outcome)
+		filter.Outcome = &outcome
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		filter.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		filter.EndTime = &t
+	}
+
+	entities, total, err := s.accessEventRepo.ListByTenant(ctx, tenantID, filter, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*sharingV1.ShareAccessEvent, 0, len(entities))
+	for _, e := range entities {
+		events = append(events, s.accessEventRepo.ToProto(e))
+	}
+
+	return &sharingV1.ListShareAccessEventsResponse{
+		Events: events,
+		Total:  uint32(total),
+	}, nil
+}
+
+// ReportLeakedToken handles a leaked token report from a secret scanner and revokes the matching share.
+// Reports come from the signed public endpoint or from callers allowed to revoke shares.
+func (s *ShareService) ReportLeakedToken(ctx context.Context, req *sharingV1.ReportLeakedTokenRequest) (*sharingV1.ReportLeakedTokenResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareRevoke); err != nil {
+		return nil, err
+	}
+	if !crypto.ValidateToken(req.Token) {
+		return nil, sharingV1.ErrorInvalidToken("invalid share token")
+	}
+
+	// Share tokens are global: the link is resolved across tenants
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	entity, err := s.linkRepo.GetByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return &sharingV1.ReportLeakedTokenResponse{}, nil
+	}
+	if entity.Revoked {
+		return &sharingV1.ReportLeakedTokenResponse{Matched: true}, nil
+	}
+
+	if err := s.linkRepo.Revoke(ctx, entity.ID); err != nil {
+		return nil, err
+	}
+	s.log.Warnf("Share %s revoked after leaked token report (source=%q, url=%q)", entity.ID, req.Source, req.Url)
+
+	revokedPayload := shareEventPayload(entity)
+	revokedPayload["reason"] = "leaked token reported"
+	revokedPayload["leakSource"] = req.Source
+	s.dispatcher.Publish(derefTenantID(entity.TenantID), EventShareRevoked, revokedPayload)
+
+	// Notify the sender asynchronously
+	queued := s.notifications.Enqueue(senderNotification{
+		entity: entity,
+		send:   func() error { return s.sendLeakNotification(entity, req.Source, req.Url) },
+		done: func(err error) {
+			if err != nil {
+				s.log.Errorf("Failed to send leak notification email for share %s: %v", entity.ID, err)
+			}
+		},
+	})
+	if !queued {
+		s.log.Errorf("Dropped leak notification for share %s: notification queue is full or closed", entity.ID)
+	}
+
+	return &sharingV1.ReportLeakedTokenResponse{
+		Matched: true,
+		Revoked: true,
+	}, nil
+}
+
+// sendLeakNotification tells the sender that their share link was exposed and has been revoked
+func (s *ShareService) sendLeakNotification(entity *ent.SharedLink, source, url string) error {
+	if entity.SenderEmail == "" {
+		s.log.Infof("Share %s has no sender email, skipping leak notification", entity.ID)
+		return nil
+	}
+
+	data := mail.TemplateData{
+		RecipientEmail: entity.RecipientEmail,
+		ResourceName:   entity.ResourceName,
+		ResourceType:   string(entity.ResourceType),
+		LeakSource:     source,
+		LeakURL:        url,
+	}
+
+	content, err := mail.RenderTemplate(mail.DefaultLeakSubjectTemplate, mail.DefaultLeakHTMLBodyTemplate, "", data)
+	if err != nil {
+		return fmt.Errorf("failed to render leak notification template: %w", err)
+	}
+
+	return s.sendMail("leak", entity.SenderEmail, "", content)
+}
+
+// sendShareEmail sends the share notification email using tmpl, the template
+// chosen by the sender (nil for the tenant default). Replies go to the sender
+// of the share when their email is known.
+func (s *ShareService) sendShareEmail(tenantID uint32, recipientEmail, senderName, senderEmail, resourceName, resourceType, message, shareLink string, tmpl *ent.EmailTemplate) error {
+	// Use system viewer context for background goroutine (bypasses ENT privacy checks)
+	ctx := viewer.NewSystemViewerContext(context.Background())
+
+	var subjectTmpl, bodyTmpl, textTmpl string
+	if tmpl != nil {
+		subjectTmpl = tmpl.Subject
+		bodyTmpl = tmpl.HTMLBody
+		textTmpl = tmpl.TextBody
+	}
 
 	if subjectTmpl == "" || bodyTmpl == "" {
 		// Try default template
-		tmpl, err := s.templateRepo.GetDefault(ctx, tenantID)
+		tmpl, err := s.templateRepo.GetDefault(ctx, tenantID, string(emailtemplate.KindSHARE))
 		if err == nil && tmpl != nil {
 			subjectTmpl = tmpl.Subject
 			bodyTmpl = tmpl.HTMLBody
+			textTmpl = tmpl.TextBody
 		}
 	}
 
@@ -373,6 +908,7 @@ func (s *ShareService) sendShareEmail(tenantID uint32, recipientEmail, senderNam
 	}
 	if bodyTmpl == "" {
 		bodyTmpl = mail.DefaultHTMLBodyTemplate
+		textTmpl = ""
 	}
 
 	data := mail.TemplateData{
@@ -384,32 +920,54 @@ func (s *ShareService) sendShareEmail(tenantID uint32, recipientEmail, senderNam
 		ResourceType:   resourceType,
 	}
 
-	subject, body, err := mail.RenderTemplate(subjectTmpl, bodyTmpl, data)
+	content, err := mail.RenderTemplate(subjectTmpl, bodyTmpl, textTmpl, data)
 	if err != nil {
 		return fmt.Errorf("failed to render email template: %w", err)
 	}
 
-	return s.mailSender.Send(recipientEmail, subject, body)
+	return s.sendMail("share", recipientEmail, senderEmail, content)
+}
+
+// sendMail sends a rendered email and records its latency and outcome under
+// the given kind. An empty replyTo falls back to the configured Reply-To.
+func (s *ShareService) sendMail(kind, to, replyTo string, content *mail.Content) error {
+	start := time.Now()
+	err := s.mailSender.Send(&mail.Message{
+		To:       to,
+		ReplyTo:  replyTo,
+		Subject:  content.Subject,
+		HTMLBody: content.HTMLBody,
+		TextBody: content.TextBody,
+	})
+	metrics.EmailSendDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+	metrics.EmailsSent.WithLabelValues(kind, metrics.ResultLabel(err)).Inc()
+	return err
+}
+
+// CountActiveShares counts active shares per tenant for the metrics endpoint
+func (s *ShareService) CountActiveShares(ctx context.Context) (map[uint32]int, error) {
+	return s.linkRepo.CountActiveByTenant(viewer.NewSystemViewerContext(ctx), time.Now())
 }
 
 // CreateSharePolicy creates a policy restriction for a share link
 func (s *ShareService) CreateSharePolicy(ctx context.Context, req *sharingV1.CreateSharePolicyRequest) (*sharingV1.CreateSharePolicyResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
 	tenantID := getTenantIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 
-	// Verify share link exists
-	entity, err := s.linkRepo.GetByID(ctx, req.ShareLinkId)
-	if err != nil {
+	// Verify share link exists and belongs to the caller
+	if _, err := s.getVisibleShare(ctx, req.ShareLinkId); err != nil {
 		return nil, err
 	}
-	if entity == nil {
-		return nil, sharingV1.ErrorShareNotFound("share not found")
-	}
 
-	pType := policyTypeToString(req.Type)
-	pMethod := policyMethodToString(req.Method)
+	p, err := validatePolicy(s.reputationFeeds, "", req.Type, req.Method, req.Value, req.Reason, req.Priority)
+	if err != nil {
+		return nil, err
+	}
 
-	policy, err := s.policyRepo.Create(ctx, tenantID, req.ShareLinkId, pType, pMethod, req.Value, req.Reason, createdBy)
+	policy, err := s.policyRepo.Create(ctx, tenantID, req.ShareLinkId, p.Type, p.Method, p.Value, p.Reason, p.Priority, createdBy)
 	if err != nil {
 		return nil, err
 	}
@@ -421,6 +979,13 @@ func (s *ShareService) CreateSharePolicy(ctx context.Context, req *sharingV1.Cre
 
 // ListSharePolicies lists policy restrictions for a share link
 func (s *ShareService) ListSharePolicies(ctx context.Context, req *sharingV1.ListSharePoliciesRequest) (*sharingV1.ListSharePoliciesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
+	if _, err := s.getVisibleShare(ctx, req.ShareLinkId); err != nil {
+		return nil, err
+	}
+
 	policies, err := s.policyRepo.ListByShareLinkID(ctx, req.ShareLinkId)
 	if err != nil {
 		return nil, err
@@ -438,17 +1003,368 @@ func (s *ShareService) ListSharePolicies(ctx context.Context, req *sharingV1.Lis
 
 // DeleteSharePolicy deletes a policy restriction
 func (s *ShareService) DeleteSharePolicy(ctx context.Context, req *sharingV1.DeleteSharePolicyRequest) (*emptypb.Empty, error) {
-	if err := s.policyRepo.Delete(ctx, req.Id); err != nil {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+	if _, err := s.getVisibleShare(ctx, req.ShareLinkId); err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Delete(ctx, req.ShareLinkId, req.Id); err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// EvaluateSharePolicies evaluates the policies of a share, or an inline policy
+// list, for a hypothetical client and explains the decision
+func (s *ShareService) EvaluateSharePolicies(ctx context.Context, req *sharingV1.EvaluateSharePoliciesRequest) (*sharingV1.EvaluateSharePoliciesResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareView); err != nil {
+		return nil, err
+	}
+
+	var (
+		share    *ent.SharedLink
+		policies []*ent.SharePolicy
+		sets     []*ent.PolicySet
+		err      error
+	)
+	switch {
+	case req.GetShareLinkId() != "" && (len(req.Policies) > 0 || len(req.PolicySetIds) > 0):
+		return nil, sharingV1.ErrorBadRequest("either share_link_id or policies and policy_set_ids must be set, not both")
+
+	case req.GetShareLinkId() != "":
+		if share, err = s.getVisibleShare(ctx, req.GetShareLinkId()); err != nil {
+			return nil, err
+		}
+		if policies, sets, err = s.sharePolicies(ctx, req.GetShareLinkId()); err != nil {
+			return nil, err
+		}
+
+	default:
+		validated, err := validatePolicies(s.reputationFeeds, "policies", req.Policies)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range validated {
+			policies = append(policies, &ent.SharePolicy{
+				Type:     sharepolicy.Type(p.Type),
+				Method:   sharepolicy.Method(p.Method),
+				Value:    p.Value,
+				Reason:   p.Reason,
+				Priority: p.Priority,
+			})
+		}
+		if sets, err = s.getPolicySets(ctx, req.PolicySetIds); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policySetPolicies("", sets)...)
+	}
+
+	client := req.GetClient()
+	policyReq := &PolicyRequest{
+		ClientIP:       client.GetIp(),
+		Now:            s.now(),
+		UserAgent:      client.GetUserAgent(),
+		Device:         device.Parse(client.GetUserAgent()),
+		GeoFailOpen:    s.geoResolver.FailOpen(),
+		AttemptCount:   client.GetAttemptCount(),
+		RecipientEmail: client.GetRecipientEmail(),
+	}
+	if client.GetTime() != nil {
+		policyReq.Now = client.GetTime().AsTime()
+	}
+	if c := client.GetCertificate(); c != nil {
+		policyReq.Certificate = &clientcert.Info{
+			Fingerprint: strings.ToLower(strings.ReplaceAll(c.GetSha256(), ":", "")),
+			Subject:     c.GetSubject(),
+			Issuer:      c.GetIssuer(),
+			Verified:    c.GetVerified(),
+		}
+		for _, fp := range c.GetCaSha256() {
+			policyReq.Certificate.CAFingerprints = append(policyReq.Certificate.CAFingerprints, strings.ToLower(strings.ReplaceAll(fp, ":", "")))
+		}
+	}
+	mode := PolicyMode{
+		Combine: sharedlink.PolicyMode(policyModeToString(req.PolicyMode)),
+		Default: sharedlink.PolicyDefault(policyDefaultToString(req.PolicyDefault)),
+	}
+	if share != nil {
+		if req.PolicyMode == sharingV1.SharePolicyMode_SHARE_POLICY_MODE_UNSPECIFIED {
+			mode.Combine = share.PolicyMode
+		}
+		if req.PolicyDefault == sharingV1.SharePolicyDefault_SHARE_POLICY_DEFAULT_UNSPECIFIED {
+			mode.Default = share.PolicyDefault
+		}
+		if client.AttemptCount == nil {
+			policyReq.AttemptCount = s.attemptCount(ctx, share.ID, policies)
+		}
+		if policyReq.RecipientEmail == "" {
+			policyReq.RecipientEmail = share.RecipientEmail
+		}
+	}
+	if client.GetCountry() != "" {
+		policyReq.Location = &geoip.Location{
+			Country:      strings.ToUpper(client.GetCountry()),
+			Subdivisions: client.GetSubdivisions(),
+			City:         client.GetCity(),
+		}
+	} else if client.GetIp() != "" {
+		policyReq.Location = s.regionLocation(client.GetIp(), policies)
+	}
+	if len(client.GetReputationLists()) > 0 {
+		policyReq.ReputationLists = client.GetReputationLists()
+	} else {
+		policyReq.ReputationLists = s.reputationLists(client.GetIp(), policies)
+	}
+
+	decision := ExplainPolicies(policies, mode, policyReq)
+
+	resp := &sharingV1.EvaluateSharePoliciesResponse{
+		Allowed:        decision.Err == nil,
+		EvaluatedAt:    timestamppb.New(policyReq.Now),
+		DeviceOs:       policyReq.Device.OS,
+		DeviceBrowser:  policyReq.Device.Browser,
+		DeviceClass:    string(policyReq.Device.Class),
+		PolicyMode:     sharingV1.SharePolicyMode(sharingV1.SharePolicyMode_value["SHARE_POLICY_MODE_"+string(mode.Combine)]),
+		PolicyDefault:  sharingV1.SharePolicyDefault(sharingV1.SharePolicyDefault_value["SHARE_POLICY_DEFAULT_"+string(mode.Default)]),
+		DefaultApplied: decision.DefaultApplied,
+	}
+	if decision.Err != nil {
+		resp.Reason = errors.FromError(decision.Err).GetMessage()
+		resp.DeniedMethod = sharingV1.SharePolicyMethod(sharingV1.SharePolicyMethod_value["SHARE_POLICY_METHOD_"+string(decision.DeniedMethod)])
+	}
+	if loc := policyReq.Location; loc != nil {
+		resp.Country, resp.Subdivisions, resp.City = loc.Country, loc.Subdivisions, loc.City
+	}
+	setIDs := make(map[string]bool, len(sets))
+	for _, set := range sets {
+		setIDs[set.ID] = true
+	}
+	for i, t := range decision.Trace {
+		p := s.policyRepo.ToProto(t.Policy)
+		trace := &sharingV1.SharePolicyTrace{
+			PolicyId:    p.Id,
+			Index:       uint32(i),
+			Type:        p.Type,
+			Method:      p.Method,
+			Value:       p.Value,
+			Matched:     t.Matched,
+			Decisive:    t.Decisive,
+			Explanation: t.Explanation,
+			Priority:    p.Priority,
+		}
+		// Rules of policy sets carry the ID of their set
+		if setIDs[p.Id] {
+			trace.PolicyId, trace.PolicySetId = "", p.Id
+		}
+		resp.Trace = append(resp.Trace, trace)
+	}
+
+	return resp, nil
+}
+
+// SetSharePolicySets replaces the policy sets referenced by a share
+func (s *ShareService) SetSharePolicySets(ctx context.Context, req *sharingV1.SetSharePolicySetsRequest) (*sharingV1.SetSharePolicySetsResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if _, err := s.getVisibleShare(ctx, req.ShareLinkId); err != nil {
+		return nil, err
+	}
+	if _, err := s.getPolicySets(ctx, req.PolicySetIds); err != nil {
+		return nil, err
+	}
+
+	if err := s.policySetRepo.SetShareLinkSets(ctx, tenantID, req.ShareLinkId, req.PolicySetIds, createdBy); err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.SetSharePolicySetsResponse{
+		PolicySetIds: req.PolicySetIds,
+	}, nil
+}
+
+// SetSharePolicyMode changes how the policies of a share are combined
+func (s *ShareService) SetSharePolicyMode(ctx context.Context, req *sharingV1.SetSharePolicyModeRequest) (*sharingV1.SetSharePolicyModeResponse, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getVisibleShare(ctx, req.ShareLinkId); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.linkRepo.SetPolicyMode(ctx, req.ShareLinkId, policyModeToString(req.PolicyMode), policyDefaultToString(req.PolicyDefault))
+	if err != nil {
+		return nil, err
+	}
+
+	proto := s.linkRepo.ToProto(entity)
+	return &sharingV1.SetSharePolicyModeResponse{
+		PolicyMode:    proto.PolicyMode,
+		PolicyDefault: proto.PolicyDefault,
+	}, nil
+}
+
+// ResetShareDeviceBinding lets the sender forget the device a share is bound
+// to, e.g. when the recipient switched browsers. The next view binds it again.
+func (s *ShareService) ResetShareDeviceBinding(ctx context.Context, req *sharingV1.ResetShareDeviceBindingRequest) (*emptypb.Empty, error) {
+	if err := authz.Require(ctx, authz.PermPolicyManage); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.getVisibleShare(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !entity.BindDevice {
+		return nil, sharingV1.ErrorBadRequest("share is not bound to a device")
+	}
+
+	if err := s.linkRepo.ResetDeviceBinding(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getPolicySets loads policy sets of the caller's tenant, or returns PolicySetNotFound
+// if any of them does not exist
+func (s *ShareService) getPolicySets(ctx context.Context, ids []string) ([]*ent.PolicySet, error) {
+	sets, err := s.policySetRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(sets) != len(ids) {
+		found := make(map[string]bool, len(sets))
+		for _, set := range sets {
+			found[set.ID] = true
+		}
+		for _, id := range ids {
+			if !found[id] {
+				return nil, sharingV1.ErrorPolicySetNotFound("policy set %s not found", id)
+			}
+		}
+	}
+	return sets, nil
+}
+
+// sharePolicies loads the policies of a share followed by the rules of the
+// policy sets it references, so that changes to a set apply on the next view
+func (s *ShareService) sharePolicies(ctx context.Context, shareLinkID string) ([]*ent.SharePolicy, []*ent.PolicySet, error) {
+	policies, err := s.policyRepo.ListByShareLinkID(ctx, shareLinkID)
+	if err != nil {
+		return nil, nil, err
+	}
+	setIDs, err := s.policySetRepo.ListSetIDsByShareLinkID(ctx, shareLinkID)
+	if err != nil {
+		return nil, nil, err
+	}
+	sets, err := s.policySetRepo.GetByIDs(ctx, setIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(policies, policySetPolicies(shareLinkID, sets)...), sets, nil
+}
+
+// policySetPolicies converts the rules of policy sets into policies of a share.
+// The policies carry the ID of their set, which is recorded when one denies access.
+func policySetPolicies(shareLinkID string, sets []*ent.PolicySet) []*ent.SharePolicy {
+	var policies []*ent.SharePolicy
+	for _, set := range sets {
+		for _, rule := range set.Rules {
+			policies = append(policies, &ent.SharePolicy{
+				ID:          set.ID,
+				TenantID:    set.TenantID,
+				ShareLinkID: shareLinkID,
+				Type:        sharepolicy.Type(rule.Type),
+				Method:      sharepolicy.Method(rule.Method),
+				Value:       rule.Value,
+				Reason:      rule.Reason,
+				Priority:    rule.Priority,
+			})
+		}
+	}
+	return policies
+}
+
+// getVisibleShare returns a share of the caller's tenant, or ShareNotFound unless
+// the caller created it or may view all shares of the tenant
+func (s *ShareService) getVisibleShare(ctx context.Context, id string) (*ent.SharedLink, error) {
+	entity, err := s.linkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorShareNotFound("share not found")
+	}
+	if !authz.HasPermission(ctx, authz.PermShareViewAll) &&
+		(entity.CreateBy == nil || *entity.CreateBy != authz.CallerID(ctx)) {
+		return nil, sharingV1.ErrorShareNotFound("share not found")
+	}
+	return entity, nil
+}
+
+// upstreamDenied logs an upstream refusal to authorize the caller and returns ACCESS_DENIED
+func (s *ShareService) upstreamDenied(upstream, resourceID string, userID uint32, err error) error {
+	s.log.Warnf("%s denied user %d sharing resource %s: %v", upstream, userID, resourceID, err)
+	return sharingV1.ErrorAccessDenied("%s did not authorize sharing this resource", upstream)
+}
+
+// resourceTypePermission returns the permission required to share a resource type
+func resourceTypePermission(rt sharingV1.ResourceType) string {
+	if rt == sharingV1.ResourceType_RESOURCE_TYPE_DOCUMENT {
+		return authz.PermShareDocument
+	}
+	return authz.PermShareSecret
+}
+
 // GetEncryptionKeyHex returns the hex-encoded encryption key (for debugging only)
 func (s *ShareService) GetEncryptionKeyHex() string {
 	return hex.EncodeToString(s.encryptionKey)
 }
 
+// accessOutcomeToString converts proto enum to ent enum string
+func accessOutcomeToString(o sharingV1.ShareAccessOutcome) string {
+	switch o {
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_GRANTED:
+		return "GRANTED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_POLICY_DENIED:
+		return "POLICY_DENIED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ALREADY_VIEWED:
+		return "ALREADY_VIEWED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_REVOKED:
+		return "REVOKED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_NOT_FOUND:
+		return "NOT_FOUND"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_ERROR:
+		return "ERROR"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_EXPIRED:
+		return "EXPIRED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_DEVICE_MISMATCH:
+		return "DEVICE_MISMATCH"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_REQUIRED:
+		return "STEP_UP_REQUIRED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_STEP_UP_FAILED:
+		return "STEP_UP_FAILED"
+	case sharingV1.ShareAccessOutcome_SHARE_ACCESS_OUTCOME_RISK_DENIED:
+		return "RISK_DENIED"
+	default:
+		return ""
+	}
+}
+
+// derefTenantID safely dereferences an ent tenant ID
+func derefTenantID(v *uint32) uint32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
 // policyTypeToString converts proto enum to ent enum string
 func policyTypeToString(t sharingV1.SharePolicyType) string {
 	switch t {
@@ -461,6 +1377,26 @@ func policyTypeToString(t sharingV1.SharePolicyType) string {
 	}
 }
 
+// policyModeToString converts proto enum to ent enum string, UNSPECIFIED being ALL_MUST_PASS
+func policyModeToString(m sharingV1.SharePolicyMode) string {
+	switch m {
+	case sharingV1.SharePolicyMode_SHARE_POLICY_MODE_ANY_WHITELIST:
+		return "ANY_WHITELIST"
+	case sharingV1.SharePolicyMode_SHARE_POLICY_MODE_FIRST_MATCH:
+		return "FIRST_MATCH"
+	default:
+		return "ALL_MUST_PASS"
+	}
+}
+
+// policyDefaultToString converts proto enum to ent enum string, UNSPECIFIED being ALLOW
+func policyDefaultToString(d sharingV1.SharePolicyDefault) string {
+	if d == sharingV1.SharePolicyDefault_SHARE_POLICY_DEFAULT_DENY {
+		return "DENY"
+	}
+	return "ALLOW"
+}
+
 // policyMethodToString converts proto enum to ent enum string
 func policyMethodToString(m sharingV1.SharePolicyMethod) string {
 	switch m {
@@ -476,6 +1412,12 @@ func policyMethodToString(m sharingV1.SharePolicyMethod) string {
 		return "DEVICE"
 	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK:
 		return "NETWORK"
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_EXPRESSION:
+		return "EXPRESSION"
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_CERTIFICATE:
+		return "CERTIFICATE"
+	case sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REPUTATION:
+		return "REPUTATION"
 	default:
 		return ""
 	}
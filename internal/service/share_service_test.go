@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+func TestEvaluateSharePoliciesExplainsDecision(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := &ShareService{now: func() time.Time { return now }}
+	ctx := authz.NewViewerContext(context.Background(), 1, 10, nil, []string{authz.PermShareView})
+
+	policies := []*sharingV1.CreateSharePolicyInput{
+		{Type: sharingV1.SharePolicyType_SHARE_POLICY_TYPE_WHITELIST, Method: sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_NETWORK, Value: "10.0.0.0/8", Priority: 10},
+		{Type: sharingV1.SharePolicyType_SHARE_POLICY_TYPE_BLACKLIST, Method: sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP, Value: "10.0.0.66", Reason: "shared printer", Priority: 5},
+		{Type: sharingV1.SharePolicyType_SHARE_POLICY_TYPE_WHITELIST, Method: sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_REGION, Value: "DE"},
+	}
+
+	resp, err := s.EvaluateSharePolicies(ctx, &sharingV1.EvaluateSharePoliciesRequest{
+		Policies: policies,
+		Client:   &sharingV1.PolicyEvaluationClient{Ip: "10.0.0.66", Country: "de", UserAgent: "curl/8.0"},
+	})
+	if err != nil {
+		t.Fatalf("EvaluateSharePolicies: %v", err)
+	}
+	if resp.Allowed || resp.Reason != "shared printer" || resp.DeniedMethod != sharingV1.SharePolicyMethod_SHARE_POLICY_METHOD_IP {
+		t.Errorf("decision = allowed %v, reason %q, method %v; want denial by the IP blacklist", resp.Allowed, resp.Reason, resp.DeniedMethod)
+	}
+	if resp.PolicyMode != sharingV1.SharePolicyMode_SHARE_POLICY_MODE_ALL_MUST_PASS || resp.PolicyDefault != sharingV1.SharePolicyDefault_SHARE_POLICY_DEFAULT_ALLOW || resp.DefaultApplied {
+		t.Errorf("mode = %v, default = %v, default applied = %v", resp.PolicyMode, resp.PolicyDefault, resp.DefaultApplied)
+	}
+	if resp.Country != "DE" || !resp.EvaluatedAt.AsTime().Equal(now) {
+		t.Errorf("country = %q, evaluated at = %v", resp.Country, resp.EvaluatedAt.AsTime())
+	}
+
+	// The trace keeps the order of the request and explains every policy
+	if len(resp.Trace) != len(policies) {
+		t.Fatalf("trace has %d entries, want %d", len(resp.Trace), len(policies))
+	}
+	for i, want := range []struct{ matched, decisive bool }{{true, false}, {true, true}, {true, false}} {
+		tr := resp.Trace[i]
+		if tr.Index != uint32(i) || tr.Type != policies[i].Type || tr.Method != policies[i].Method || tr.Value != policies[i].Value || tr.Priority != policies[i].Priority {
+			t.Errorf("trace[%d] = %+v, does not describe policy %d", i, tr, i)
+		}
+		if tr.Matched != want.matched || tr.Decisive != want.decisive || tr.Explanation == "" || tr.PolicySetId != "" {
+			t.Errorf("trace[%d] = %+v, want matched=%v decisive=%v", i, tr, want.matched, want.decisive)
+		}
+	}
+
+	// A client outside every whitelist under FIRST_MATCH falls through to the requested default
+	resp, err = s.EvaluateSharePolicies(ctx, &sharingV1.EvaluateSharePoliciesRequest{
+		Policies:      policies,
+		PolicyMode:    sharingV1.SharePolicyMode_SHARE_POLICY_MODE_FIRST_MATCH,
+		PolicyDefault: sharingV1.SharePolicyDefault_SHARE_POLICY_DEFAULT_DENY,
+		Client:        &sharingV1.PolicyEvaluationClient{Ip: "192.0.2.1", Country: "FR", Time: timestamppb.New(now.Add(time.Hour))},
+	})
+	if err != nil {
+		t.Fatalf("EvaluateSharePolicies: %v", err)
+	}
+	if resp.Allowed || !resp.DefaultApplied || resp.PolicyMode != sharingV1.SharePolicyMode_SHARE_POLICY_MODE_FIRST_MATCH || resp.PolicyDefault != sharingV1.SharePolicyDefault_SHARE_POLICY_DEFAULT_DENY {
+		t.Errorf("decision = %+v, want default deny under FIRST_MATCH", resp)
+	}
+	if !resp.EvaluatedAt.AsTime().Equal(now.Add(time.Hour)) {
+		t.Errorf("evaluated at %v, want the client time", resp.EvaluatedAt.AsTime())
+	}
+	for i, tr := range resp.Trace {
+		if tr.Matched || tr.Decisive {
+			t.Errorf("trace[%d] = %+v, want neither matched nor decisive", i, tr)
+		}
+	}
+
+	// Share and inline policies cannot be explained together
+	shareID := "share-1"
+	_, err = s.EvaluateSharePolicies(ctx, &sharingV1.EvaluateSharePoliciesRequest{ShareLinkId: &shareID, Policies: policies})
+	if !sharingV1.IsBadRequest(err) {
+		t.Errorf("share and policies: err = %v, want BadRequest", err)
+	}
+
+	// Explaining policies requires the view permission
+	_, err = s.EvaluateSharePolicies(authz.NewViewerContext(context.Background(), 1, 10, nil, nil), &sharingV1.EvaluateSharePoliciesRequest{Policies: policies})
+	if err == nil {
+		t.Error("expected an error without the view permission")
+	}
+}
+
+func TestCreateShareRejectsForeignTemplates(t *testing.T) {
+	s, _ := newTestShareService(t)
+	ctxA := tenantContext(tenantA, 10, authz.PermShareCreate, authz.PermShareSecret, authz.PermTemplateManage)
+	ctxB := tenantContext(tenantB, 20, authz.PermShareCreate, authz.PermShareSecret, authz.PermTemplateManage)
+
+	foreign, err := s.templateRepo.Create(ctxB, tenantB, "Tenant B", "SHARE", "Subject", "<p>Body</p>", "", false, nil)
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+	notification, err := s.templateRepo.Create(ctxA, tenantA, "Viewed", "SHARE_VIEWED", "Subject", "<p>Body</p>", "", false, nil)
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+
+	for name, id := range map[string]string{
+		"other tenant":      foreign.ID,
+		"notification kind": notification.ID,
+		"unknown":           "no-such-template",
+	} {
+		_, err := s.CreateShare(ctxA, &sharingV1.CreateShareRequest{
+			ResourceType:   sharingV1.ResourceType_RESOURCE_TYPE_SECRET,
+			ResourceId:     "secret-1",
+			RecipientEmail: "r@example.com",
+			TemplateId:     &id,
+		})
+		if !sharingV1.IsTemplateNotFound(err) {
+			t.Errorf("%s: CreateShare error = %v, want TemplateNotFound", name, err)
+		}
+	}
+
+	// The caller's own share template passes the check
+	own, err := s.templateRepo.Create(ctxA, tenantA, "Tenant A", "SHARE", "Subject", "<p>Body</p>", "", false, nil)
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+	_, err = s.CreateShare(ctxA, &sharingV1.CreateShareRequest{
+		ResourceType:   sharingV1.ResourceType_RESOURCE_TYPE_SECRET,
+		ResourceId:     "secret-1",
+		RecipientEmail: "r@example.com",
+		TemplateId:     &own.ID,
+	})
+	if sharingV1.IsTemplateNotFound(err) {
+		t.Errorf("own template rejected: %v", err)
+	}
+}
+
+func TestViewSharedContentDeniesWhenPoliciesCannotBeLoaded(t *testing.T) {
+	s, client := newTestShareService(t)
+	ctx := tenantContext(tenantA, 0)
+	share, token := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{
+		Policies: []data.SharePolicyInput{{Type: "WHITELIST", Method: "IP", Value: "10.0.0.1"}},
+	})
+
+	execTestSQL(t, "DROP TABLE sharing_share_policies")
+
+	if _, err := s.ViewSharedContent(ctx, &sharingV1.ViewSharedContentRequest{Token: token}); !sharingV1.IsInternalServerError(err) {
+		t.Fatalf("ViewSharedContent error = %v, want InternalServerError", err)
+	}
+
+	// The attempt is recorded and the share is neither consumed nor emptied
+	events, err := client.ShareAccessEvent.Query().All(ctx)
+	if err != nil || len(events) != 1 || events[0].Outcome != "ERROR" || events[0].ShareLinkID != share.ID {
+		t.Fatalf("access events = %v, %v; want one ERROR event of the share", events, err)
+	}
+	after, err := s.linkRepo.GetByID(ctx, share.ID)
+	if err != nil || after.Viewed || after.ViewCount != 0 || after.EncryptedContent == nil {
+		t.Fatalf("share after the failed view = %+v, %v", after, err)
+	}
+}
+
+func TestResetShareDeviceBindingRequiresManagingOwnShare(t *testing.T) {
+	s, client := newTestShareService(t)
+	owner := uint32(10)
+	share, _ := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{CreatedBy: &owner, BindDevice: true})
+	if err := client.SharedLink.UpdateOneID(share.ID).SetDeviceBinding("device-hash").Exec(tenantContext(tenantA, owner)); err != nil {
+		t.Fatalf("bind share: %v", err)
+	}
+	req := &sharingV1.ResetShareDeviceBindingRequest{Id: share.ID}
+
+	// Creating shares does not allow changing their restrictions
+	if _, err := s.ResetShareDeviceBinding(tenantContext(tenantA, owner, authz.PermShareCreate), req); !sharingV1.IsAccessDenied(err) {
+		t.Fatalf("reset with share.create = %v, want AccessDenied", err)
+	}
+	// Managing policies does not extend to other users' shares
+	if _, err := s.ResetShareDeviceBinding(tenantContext(tenantA, 11, authz.PermPolicyManage), req); !sharingV1.IsShareNotFound(err) {
+		t.Fatalf("reset of another user's share = %v, want ShareNotFound", err)
+	}
+
+	if _, err := s.ResetShareDeviceBinding(tenantContext(tenantA, owner, authz.PermPolicyManage), req); err != nil {
+		t.Fatalf("reset by the owner: %v", err)
+	}
+	after, err := client.SharedLink.Get(tenantContext(tenantA, owner), share.ID)
+	if err != nil || after.DeviceBinding != nil {
+		t.Fatalf("device binding after reset = %v, %v; want none", after.DeviceBinding, err)
+	}
+}
+
+func TestReportLeakedTokenQueuesTheLeakNotification(t *testing.T) {
+	s, _ := newTestShareService(t)
+	_, token := createTestShareLink(t, s, tenantA, &data.SharedLinkInput{SenderEmail: "sender@example.com"})
+
+	resp, err := s.ReportLeakedToken(tenantContext(tenantA, 10, authz.PermShareRevoke), &sharingV1.ReportLeakedTokenRequest{Token: token, Source: "scanner"})
+	if err != nil || !resp.Revoked {
+		t.Fatalf("ReportLeakedToken = %+v, %v; want a revoked share", resp, err)
+	}
+
+	// The email is left to the notification workers
+	if n := len(s.notifications.queue); n != 1 {
+		t.Fatalf("%d notification(s) queued, want the leak notification", n)
+	}
+	if n := <-s.notifications.queue; n.send == nil || n.entity.SenderEmail != "sender@example.com" {
+		t.Errorf("queued notification = %+v, want the leak notification of the share", n)
+	}
+}
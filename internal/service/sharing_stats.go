@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent/shareaccessevent"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+const (
+	// defaultStatsRange is the time range covered by GetSharingStats when no start time is given
+	defaultStatsRange = 30 * 24 * time.Hour
+
+	// defaultStatsTopLimit is the default length of the top recipient, domain and sharer lists
+	defaultStatsTopLimit = 10
+)
+
+// GetSharingStats returns aggregated statistics over the shares of the current tenant
+func (s *ShareService) GetSharingStats(ctx context.Context, req *sharingV1.GetSharingStatsRequest) (*sharingV1.GetSharingStatsResponse, error) {
+	if err := authz.Require(ctx, authz.PermShareViewAll); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	now := time.Now()
+
+	end := now
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	start := end.Add(-defaultStatsRange)
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if !start.Before(end) {
+		return nil, sharingV1.ErrorBadRequest("startTime must be before endTime")
+	}
+
+	topLimit := defaultStatsTopLimit
+	if req.TopLimit != nil && *req.TopLimit > 0 {
+		topLimit = int(*req.TopLimit)
+	}
+
+	stats, err := s.linkRepo.Stats(ctx, tenantID, start, end, now, topLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	denials, err := s.accessEventRepo.CountByOutcome(ctx, tenantID, string(shareaccessevent.OutcomePOLICY_DENIED), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &sharingV1.GetSharingStatsResponse{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Total:     uint64(stats.Total()),
+		ByStatus: &sharingV1.ShareStatusCounts{
+			Active:  uint64(stats.Active),
+			Viewed:  uint64(stats.Viewed),
+			Revoked: uint64(stats.Revoked),
+			Expired: uint64(stats.Expired),
+		},
+		MedianSecondsToView: stats.MedianSecondsToView,
+		PolicyDenials:       uint64(denials),
+	}
+
+	for _, c := range stats.ByResourceType {
+		resp.ByResourceType = append(resp.ByResourceType, &sharingV1.ResourceTypeCount{
+			ResourceType: resourceTypeFromString(c.Key),
+			Count:        uint64(c.Count),
+		})
+	}
+	for _, c := range stats.PerDay {
+		resp.PerDay = append(resp.PerDay, &sharingV1.DailyShareCount{Date: c.Key, Count: uint64(c.Count)})
+	}
+	for _, c := range stats.TopRecipients {
+		resp.TopRecipients = append(resp.TopRecipients, &sharingV1.RecipientCount{Key: c.Key, Count: uint64(c.Count)})
+	}
+	for _, c := range stats.TopDomains {
+		resp.TopDomains = append(resp.TopDomains, &sharingV1.RecipientCount{Key: c.Key, Count: uint64(c.Count)})
+	}
+	for _, c := range stats.TopSharers {
+		resp.TopSharers = append(resp.TopSharers, &sharingV1.SharerCount{
+			UserId:      c.UserID,
+			SenderEmail: c.SenderEmail,
+			Count:       uint64(c.Count),
+		})
+	}
+
+	return resp, nil
+}
+
+// resourceTypeFromString converts a stored resource type to its proto enum
+func resourceTypeFromString(t string) sharingV1.ResourceType {
+	switch t {
+	case "SECRET":
+		return sharingV1.ResourceType_RESOURCE_TYPE_SECRET
+	case "DOCUMENT":
+		return sharingV1.ResourceType_RESOURCE_TYPE_DOCUMENT
+	default:
+		return sharingV1.ResourceType_RESOURCE_TYPE_UNSPECIFIED
+	}
+}
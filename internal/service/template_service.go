@@ -7,6 +7,7 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
 	"github.com/go-tangra/go-tangra-sharing/internal/data"
 	"github.com/go-tangra/go-tangra-sharing/pkg/mail"
 
@@ -19,40 +20,54 @@ type TemplateService struct {
 
 	log          *log.Helper
 	templateRepo *data.EmailTemplateRepo
+	dispatcher   *WebhookDispatcher
 }
 
 // NewTemplateService creates a new TemplateService
 func NewTemplateService(
 	ctx *bootstrap.Context,
 	templateRepo *data.EmailTemplateRepo,
+	dispatcher *WebhookDispatcher,
 ) *TemplateService {
 	return &TemplateService{
 		log:          ctx.NewLoggerHelper("sharing/service/template"),
 		templateRepo: templateRepo,
+		dispatcher:   dispatcher,
 	}
 }
 
 // CreateTemplate creates a new email template
 func (s *TemplateService) CreateTemplate(ctx context.Context, req *sharingV1.CreateTemplateRequest) (*sharingV1.CreateTemplateResponse, error) {
+	if err := authz.Require(ctx, authz.PermTemplateManage); err != nil {
+		return nil, err
+	}
+
 	tenantID := getTenantIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 
 	// Validate template by trying to render it
-	_, _, err := mail.RenderTemplate(req.Subject, req.HtmlBody, mail.TemplateData{
+	_, err := mail.RenderTemplate(req.Subject, req.HtmlBody, req.GetTextBody(), mail.TemplateData{
 		SenderName:   "Test User",
 		ResourceName: "Test Resource",
 		ResourceType: "SECRET",
 		ShareLink:    "https://example.com/shared/test",
+		ClientIP:     "203.0.113.10",
+		DenyReason:   "blocked by IP blacklist policy",
+		EventTime:    "2024-01-01 12:00 UTC",
 	})
 	if err != nil {
 		return nil, sharingV1.ErrorInvalidTemplate("invalid template: %v", err)
 	}
 
-	entity, err := s.templateRepo.Create(ctx, tenantID, req.Name, req.Subject, req.HtmlBody, req.IsDefault, createdBy)
+	kind := templateKindToString(req.GetKind())
+
+	entity, err := s.templateRepo.Create(ctx, tenantID, req.Name, kind, req.Subject, req.HtmlBody, req.GetTextBody(), req.IsDefault, createdBy)
 	if err != nil {
 		return nil, err
 	}
 
+	s.publishTemplateChanged(tenantID, entity.ID, "created")
+
 	return &sharingV1.CreateTemplateResponse{
 		Template: s.templateRepo.ToProto(entity),
 	}, nil
@@ -60,6 +75,10 @@ func (s *TemplateService) CreateTemplate(ctx context.Context, req *sharingV1.Cre
 
 // GetTemplate retrieves a template by ID
 func (s *TemplateService) GetTemplate(ctx context.Context, req *sharingV1.GetTemplateRequest) (*sharingV1.GetTemplateResponse, error) {
+	if err := authz.RequireAny(ctx, authz.PermTemplateManage, authz.PermShareCreate); err != nil {
+		return nil, err
+	}
+
 	entity, err := s.templateRepo.GetByID(ctx, req.Id)
 	if err != nil {
 		return nil, err
@@ -75,6 +94,10 @@ func (s *TemplateService) GetTemplate(ctx context.Context, req *sharingV1.GetTem
 
 // ListTemplates lists templates for the current tenant
 func (s *TemplateService) ListTemplates(ctx context.Context, req *sharingV1.ListTemplatesRequest) (*sharingV1.ListTemplatesResponse, error) {
+	if err := authz.RequireAny(ctx, authz.PermTemplateManage, authz.PermShareCreate); err != nil {
+		return nil, err
+	}
+
 	tenantID := getTenantIDFromContext(ctx)
 
 	var page, pageSize uint32
@@ -85,7 +108,13 @@ func (s *TemplateService) ListTemplates(ctx context.Context, req *sharingV1.List
 		pageSize = *req.PageSize
 	}
 
-	entities, total, err := s.templateRepo.ListByTenant(ctx, tenantID, page, pageSize)
+	var kind *string
+	if req.Kind != nil && *req.Kind != sharingV1.TemplateKind_TEMPLATE_KIND_UNSPECIFIED {
+		k := templateKindToString(*req.Kind)
+		kind = &k
+	}
+
+	entities, total, err := s.templateRepo.ListByTenant(ctx, tenantID, kind, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -103,19 +132,27 @@ func (s *TemplateService) ListTemplates(ctx context.Context, req *sharingV1.List
 
 // UpdateTemplate updates an email template
 func (s *TemplateService) UpdateTemplate(ctx context.Context, req *sharingV1.UpdateTemplateRequest) (*sharingV1.UpdateTemplateResponse, error) {
+	if err := authz.Require(ctx, authz.PermTemplateManage); err != nil {
+		return nil, err
+	}
+
 	tenantID := getTenantIDFromContext(ctx)
 	updatedBy := getUserIDAsUint32(ctx)
 
 	// Validate if template content is being updated
 	subjectTmpl := ""
 	bodyTmpl := ""
+	textTmpl := ""
 	if req.Subject != nil {
 		subjectTmpl = *req.Subject
 	}
 	if req.HtmlBody != nil {
 		bodyTmpl = *req.HtmlBody
 	}
-	if subjectTmpl != "" || bodyTmpl != "" {
+	if req.TextBody != nil {
+		textTmpl = *req.TextBody
+	}
+	if subjectTmpl != "" || bodyTmpl != "" || textTmpl != "" {
 		// Load existing template for validation
 		existing, err := s.templateRepo.GetByID(ctx, req.Id)
 		if err != nil {
@@ -130,23 +167,31 @@ func (s *TemplateService) UpdateTemplate(ctx context.Context, req *sharingV1.Upd
 		if bodyTmpl == "" {
 			bodyTmpl = existing.HTMLBody
 		}
+		if req.TextBody == nil {
+			textTmpl = existing.TextBody
+		}
 
-		_, _, err = mail.RenderTemplate(subjectTmpl, bodyTmpl, mail.TemplateData{
+		_, err = mail.RenderTemplate(subjectTmpl, bodyTmpl, textTmpl, mail.TemplateData{
 			SenderName:   "Test User",
 			ResourceName: "Test Resource",
 			ResourceType: "SECRET",
 			ShareLink:    "https://example.com/shared/test",
+			ClientIP:     "203.0.113.10",
+			DenyReason:   "blocked by IP blacklist policy",
+			EventTime:    "2024-01-01 12:00 UTC",
 		})
 		if err != nil {
 			return nil, sharingV1.ErrorInvalidTemplate("invalid template: %v", err)
 		}
 	}
 
-	entity, err := s.templateRepo.Update(ctx, req.Id, tenantID, req.Name, req.Subject, req.HtmlBody, req.IsDefault, updatedBy)
+	entity, err := s.templateRepo.Update(ctx, req.Id, tenantID, req.Name, req.Subject, req.HtmlBody, req.TextBody, req.IsDefault, updatedBy)
 	if err != nil {
 		return nil, err
 	}
 
+	s.publishTemplateChanged(tenantID, entity.ID, "updated")
+
 	return &sharingV1.UpdateTemplateResponse{
 		Template: s.templateRepo.ToProto(entity),
 	}, nil
@@ -154,14 +199,25 @@ func (s *TemplateService) UpdateTemplate(ctx context.Context, req *sharingV1.Upd
 
 // DeleteTemplate deletes an email template
 func (s *TemplateService) DeleteTemplate(ctx context.Context, req *sharingV1.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	if err := authz.Require(ctx, authz.PermTemplateManage); err != nil {
+		return nil, err
+	}
+
 	if err := s.templateRepo.Delete(ctx, req.Id); err != nil {
 		return nil, err
 	}
+
+	s.publishTemplateChanged(getTenantIDFromContext(ctx), req.Id, "deleted")
+
 	return &emptypb.Empty{}, nil
 }
 
 // PreviewTemplate renders a template with sample data
 func (s *TemplateService) PreviewTemplate(ctx context.Context, req *sharingV1.PreviewTemplateRequest) (*sharingV1.PreviewTemplateResponse, error) {
+	if err := authz.Require(ctx, authz.PermTemplateManage); err != nil {
+		return nil, err
+	}
+
 	sampleData := mail.TemplateData{
 		SenderName:     "John Doe",
 		RecipientEmail: "recipient@example.com",
@@ -169,15 +225,43 @@ func (s *TemplateService) PreviewTemplate(ctx context.Context, req *sharingV1.Pr
 		Message:        "Here is the resource you requested.",
 		ResourceName:   "My Secret Credential",
 		ResourceType:   "Secret",
+		ClientIP:       "203.0.113.10",
+		DenyReason:     "blocked by IP blacklist policy",
+		EventTime:      "2024-01-01 12:00 UTC",
 	}
 
-	subject, body, err := mail.RenderTemplate(req.Subject, req.HtmlBody, sampleData)
+	content, err := mail.RenderTemplate(req.Subject, req.HtmlBody, req.GetTextBody(), sampleData)
 	if err != nil {
 		return nil, sharingV1.ErrorInvalidTemplate("invalid template: %v", err)
 	}
 
 	return &sharingV1.PreviewTemplateResponse{
-		RenderedSubject: subject,
-		RenderedBody:    body,
+		RenderedSubject:  content.Subject,
+		RenderedBody:     content.HTMLBody,
+		RenderedTextBody: content.TextBody,
 	}, nil
 }
+
+// publishTemplateChanged notifies webhooks that an email template was created, updated or deleted
+func (s *TemplateService) publishTemplateChanged(tenantID uint32, templateID, action string) {
+	s.dispatcher.Publish(tenantID, EventTemplateChanged, map[string]any{
+		"templateId": templateID,
+		"action":     action,
+	})
+}
+
+// templateKindToString converts proto enum to ent enum string
+func templateKindToString(k sharingV1.TemplateKind) string {
+	switch k {
+	case sharingV1.TemplateKind_TEMPLATE_KIND_SHARE:
+		return "SHARE"
+	case sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_VIEWED:
+		return "SHARE_VIEWED"
+	case sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_DENIED:
+		return "SHARE_DENIED"
+	case sharingV1.TemplateKind_TEMPLATE_KIND_SHARE_EXPIRED:
+		return "SHARE_EXPIRED"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/internal/data/ent"
+	"github.com/go-tangra/go-tangra-sharing/pkg/webhook"
+)
+
+// Webhook event types
+const (
+	EventShareCreated     = "share.created"
+	EventShareViewed      = "share.viewed"
+	EventShareDenied      = "share.denied"
+	EventShareRevoked     = "share.revoked"
+	EventShareExpired     = "share.expired"
+	EventTemplateChanged  = "template.changed"
+	EventPolicySetChanged = "policy_set.changed"
+	EventWebhookTest      = "webhook.test"
+)
+
+// webhookEventTypes lists the event types a webhook can subscribe to
+var webhookEventTypes = []string{
+	EventShareCreated,
+	EventShareViewed,
+	EventShareDenied,
+	EventShareRevoked,
+	EventShareExpired,
+	EventTemplateChanged,
+	EventPolicySetChanged,
+}
+
+const (
+	// webhookMaxAttempts is the number of attempts before a delivery is marked failed
+	webhookMaxAttempts = 6
+
+	// webhookRetryBatchSize limits how many due deliveries are retried per sweep
+	webhookRetryBatchSize = 50
+
+	// webhookQueueSize bounds the events waiting to be delivered
+	webhookQueueSize = 256
+
+	// webhookWorkers is the number of events delivered concurrently
+	webhookWorkers = 4
+)
+
+// WebhookDispatcher fans share lifecycle events out to tenant webhooks
+type WebhookDispatcher struct {
+	log          *log.Helper
+	webhookRepo  *data.WebhookRepo
+	deliveryRepo *data.WebhookDeliveryRepo
+	client       *webhook.Client
+	events       *workQueue[*webhook.Event]
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher
+func NewWebhookDispatcher(
+	ctx *bootstrap.Context,
+	webhookRepo *data.WebhookRepo,
+	deliveryRepo *data.WebhookDeliveryRepo,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		log:          ctx.NewLoggerHelper("sharing/webhook/dispatcher"),
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		client:       webhook.NewClient(),
+		events:       newWorkQueue[*webhook.Event](webhookQueueSize, webhookWorkers),
+	}
+}
+
+// Publish asynchronously delivers an event to every enabled webhook of the
+// tenant subscribed to it. When the queue is full or closed, the deliveries
+// are recorded for the retry worker instead of being attempted right away.
+func (d *WebhookDispatcher) Publish(tenantID uint32, eventType string, payload map[string]any) {
+	if d == nil {
+		return
+	}
+
+	event := &webhook.Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		TenantID:  tenantID,
+		CreatedAt: time.Now().UTC(),
+		Data:      payload,
+	}
+
+	if d.events.Enqueue(event) {
+		return
+	}
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	if err := d.publish(ctx, event, false); err != nil {
+		d.log.Errorf("Failed to publish %s event: %v", eventType, err)
+	}
+}
+
+// Run delivers queued events until Stop is called and the queue is drained
+func (d *WebhookDispatcher) Run() {
+	d.events.Run(func(event *webhook.Event) {
+		// Use system viewer context for background delivery (bypasses ENT privacy checks)
+		ctx := viewer.NewSystemViewerContext(context.Background())
+		if err := d.publish(ctx, event, true); err != nil {
+			d.log.Errorf("Failed to publish %s event: %v", event.Type, err)
+		}
+	})
+}
+
+// Stop stops queueing events and waits until the queued ones were delivered or ctx is done
+func (d *WebhookDispatcher) Stop(ctx context.Context) error {
+	return d.events.Close(ctx)
+}
+
+// publish records a delivery of the event for every subscribed webhook. With
+// attempt set, each delivery is attempted at once, otherwise it is left to
+// the retry worker.
+func (d *WebhookDispatcher) publish(ctx context.Context, event *webhook.Event, attempt bool) error {
+	hooks, err := d.webhookRepo.ListEnabledByTenant(ctx, event.TenantID)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if !subscribesTo(hook, event.Type) {
+			continue
+		}
+
+		nextAttemptAt := time.Now()
+		if attempt {
+			// Give the immediate attempt a head start before the retry worker may pick it up
+			nextAttemptAt = nextAttemptAt.Add(webhook.Backoff(1))
+		}
+		delivery, err := d.deliveryRepo.Create(ctx, event.TenantID, hook.ID, event.ID, event.Type, body, nextAttemptAt)
+		if err != nil {
+			continue
+		}
+		if attempt {
+			d.attempt(ctx, hook, delivery)
+		}
+	}
+
+	return nil
+}
+
+// RetryDue retries pending deliveries whose backoff has elapsed. It returns the number of deliveries attempted.
+func (d *WebhookDispatcher) RetryDue(ctx context.Context) (int, error) {
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	deliveries, err := d.deliveryRepo.ListDue(ctx, time.Now(), webhookRetryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		hook, err := d.webhookRepo.GetByID(ctx, delivery.WebhookID)
+		if err != nil {
+			continue
+		}
+		if hook == nil || !hook.Enabled {
+			_ = d.deliveryRepo.MarkAttemptFailed(ctx, delivery.ID, delivery.Attempts, 0, "webhook deleted or disabled", nil)
+			continue
+		}
+		d.attempt(ctx, hook, delivery)
+	}
+
+	return len(deliveries), nil
+}
+
+// Test sends a signed test event to a webhook synchronously without recording a delivery
+func (d *WebhookDispatcher) Test(ctx context.Context, hook *ent.Webhook) (*webhook.Result, error) {
+	event := &webhook.Event{
+		ID:        uuid.New().String(),
+		Type:      EventWebhookTest,
+		TenantID:  derefTenantID(hook.TenantID),
+		CreatedAt: time.Now().UTC(),
+		Data: map[string]any{
+			"webhookId": hook.ID,
+			"message":   "This is a test event from Go Tangra Sharing",
+		},
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.client.Deliver(ctx, hook.URL, hook.Secret, event.ID, event.Type, body), nil
+}
+
+// attempt makes one delivery attempt and records its outcome
+func (d *WebhookDispatcher) attempt(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) {
+	attempts := delivery.Attempts + 1
+	result := d.client.Deliver(ctx, hook.URL, hook.Secret, delivery.ID, delivery.EventType, []byte(delivery.Payload))
+
+	if result.Success() {
+		_ = d.deliveryRepo.MarkSucceeded(ctx, delivery.ID, attempts, int32(result.StatusCode))
+		return
+	}
+
+	nextAttemptAt := nextDeliveryAttempt(attempts, time.Now())
+	if nextAttemptAt == nil {
+		d.log.Warnf("Webhook delivery %s to %s failed after %d attempts: %v", delivery.ID, hook.URL, attempts, result.Err)
+	}
+
+	// Deliveries are listed to tenants, so only the class of the failure is recorded
+	_ = d.deliveryRepo.MarkAttemptFailed(ctx, delivery.ID, attempts, int32(result.StatusCode), result.ErrorClass(), nextAttemptAt)
+}
+
+// nextDeliveryAttempt returns when a delivery that failed its attempts-th attempt is
+// retried, or nil when it has used up webhookMaxAttempts and is marked failed
+func nextDeliveryAttempt(attempts int32, now time.Time) *time.Time {
+	if attempts >= webhookMaxAttempts {
+		return nil
+	}
+	t := now.Add(webhook.Backoff(int(attempts)))
+	return &t
+}
+
+// subscribesTo reports whether a webhook wants events of the given type
+func subscribesTo(hook *ent.Webhook, eventType string) bool {
+	return len(hook.Events) == 0 || slices.Contains(hook.Events, eventType)
+}
+
+// shareEventPayload builds the webhook payload describing a share
+func shareEventPayload(entity *ent.SharedLink) map[string]any {
+	payload := map[string]any{
+		"shareId":        entity.ID,
+		"resourceType":   string(entity.ResourceType),
+		"resourceId":     entity.ResourceID,
+		"resourceName":   entity.ResourceName,
+		"recipientEmail": entity.RecipientEmail,
+	}
+	if entity.CreateBy != nil {
+		payload["createdBy"] = *entity.CreateBy
+	}
+	if entity.ExpiresAt != nil {
+		payload["expiresAt"] = entity.ExpiresAt.UTC()
+	}
+	return payload
+}
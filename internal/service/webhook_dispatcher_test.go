@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/pkg/webhook"
+)
+
+func TestNextDeliveryAttemptSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// Five retries after the first attempt, then the delivery is failed
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute}
+	for i, delay := range want {
+		attempts := int32(i + 1)
+		next := nextDeliveryAttempt(attempts, now)
+		if next == nil || !next.Equal(now.Add(delay)) {
+			t.Errorf("after attempt %d: next = %v, want %v", attempts, next, now.Add(delay))
+		}
+	}
+	for _, attempts := range []int32{webhookMaxAttempts, webhookMaxAttempts + 1} {
+		if next := nextDeliveryAttempt(attempts, now); next != nil {
+			t.Errorf("after attempt %d: next = %v, want no retry", attempts, next)
+		}
+	}
+	if webhookMaxAttempts != 6 {
+		t.Errorf("webhookMaxAttempts = %d, want 6", webhookMaxAttempts)
+	}
+}
+
+func TestPublishWithoutAttemptLeavesDeliveriesToTheRetryWorker(t *testing.T) {
+	entClient := newTestEntClient(t)
+	bctx := newTestContext()
+	d := NewWebhookDispatcher(bctx, data.NewWebhookRepo(bctx, entClient), data.NewWebhookDeliveryRepo(bctx, entClient))
+	ctx := tenantContext(tenantA, 0)
+
+	hook, err := d.webhookRepo.Create(ctx, tenantA, "Hook", "https://hooks.example.com/sharing", "whsec_test", []string{EventShareCreated}, true, nil)
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+	for _, eventType := range []string{EventShareCreated, EventShareViewed} {
+		event := &webhook.Event{ID: eventType, Type: eventType, TenantID: tenantA, CreatedAt: time.Now().UTC()}
+		if err := d.publish(ctx, event, false); err != nil {
+			t.Fatalf("publish %s: %v", eventType, err)
+		}
+	}
+
+	// Only the subscribed event is recorded, unattempted and due at once
+	due, err := d.deliveryRepo.ListDue(ctx, time.Now(), webhookRetryBatchSize)
+	if err != nil {
+		t.Fatalf("ListDue: %v", err)
+	}
+	if len(due) != 1 || due[0].WebhookID != hook.ID || due[0].EventType != EventShareCreated || due[0].Attempts != 0 {
+		t.Fatalf("due deliveries = %+v, want one unattempted %s delivery", due, EventShareCreated)
+	}
+}
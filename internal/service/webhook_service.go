@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-sharing/internal/authz"
+	"github.com/go-tangra/go-tangra-sharing/internal/data"
+	"github.com/go-tangra/go-tangra-sharing/pkg/webhook"
+
+	sharingV1 "github.com/go-tangra/go-tangra-sharing/gen/go/sharing/service/v1"
+)
+
+// WebhookService implements the SharingWebhookService gRPC service
+type WebhookService struct {
+	sharingV1.UnimplementedSharingWebhookServiceServer
+
+	log          *log.Helper
+	webhookRepo  *data.WebhookRepo
+	deliveryRepo *data.WebhookDeliveryRepo
+	dispatcher   *WebhookDispatcher
+}
+
+// NewWebhookService creates a new WebhookService
+func NewWebhookService(
+	ctx *bootstrap.Context,
+	webhookRepo *data.WebhookRepo,
+	deliveryRepo *data.WebhookDeliveryRepo,
+	dispatcher *WebhookDispatcher,
+) *WebhookService {
+	return &WebhookService{
+		log:          ctx.NewLoggerHelper("sharing/service/webhook"),
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		dispatcher:   dispatcher,
+	}
+}
+
+// CreateWebhook creates a new webhook
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *sharingV1.CreateWebhookRequest) (*sharingV1.CreateWebhookResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if err := validateWebhookURL(req.Url); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEvents(req.Events); err != nil {
+		return nil, err
+	}
+
+	secret := req.GetSecret()
+	if secret == "" {
+		var err error
+		secret, err = webhook.GenerateSecret()
+		if err != nil {
+			s.log.Errorf("Failed to generate webhook secret: %v", err)
+			return nil, sharingV1.ErrorInternalServerError("failed to generate webhook secret")
+		}
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	entity, err := s.webhookRepo.Create(ctx, tenantID, req.Name, req.Url, secret, req.Events, enabled, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.CreateWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(entity),
+		Secret:  secret,
+	}, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *WebhookService) GetWebhook(ctx context.Context, req *sharingV1.GetWebhookRequest) (*sharingV1.GetWebhookResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.webhookRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorNotFound("webhook not found")
+	}
+
+	return &sharingV1.GetWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(entity),
+	}, nil
+}
+
+// ListWebhooks lists webhooks for the current tenant
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *sharingV1.ListWebhooksRequest) (*sharingV1.ListWebhooksResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.webhookRepo.ListByTenant(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*sharingV1.Webhook, 0, len(entities))
+	for _, e := range entities {
+		webhooks = append(webhooks, s.webhookRepo.ToProto(e))
+	}
+
+	return &sharingV1.ListWebhooksResponse{
+		Webhooks: webhooks,
+		Total:    uint32(total),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *sharingV1.UpdateWebhookRequest) (*sharingV1.UpdateWebhookResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	updatedBy := getUserIDAsUint32(ctx)
+
+	if req.Url != nil {
+		if err := validateWebhookURL(*req.Url); err != nil {
+			return nil, err
+		}
+	}
+	if req.UpdateEvents {
+		if err := validateWebhookEvents(req.Events); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.webhookRepo.Update(ctx, req.Id, req.Name, req.Url, req.Secret, req.Events, req.UpdateEvents, req.Enabled, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharingV1.UpdateWebhookResponse{
+		Webhook: s.webhookRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *sharingV1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// TestWebhook sends a signed test event to a webhook and reports the endpoint's response
+func (s *WebhookService) TestWebhook(ctx context.Context, req *sharingV1.TestWebhookRequest) (*sharingV1.TestWebhookResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.webhookRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, sharingV1.ErrorNotFound("webhook not found")
+	}
+
+	result, err := s.dispatcher.Test(ctx, entity)
+	if err != nil {
+		s.log.Errorf("Failed to send test webhook: %v", err)
+		return nil, sharingV1.ErrorInternalServerError("failed to send test webhook")
+	}
+
+	resp := &sharingV1.TestWebhookResponse{
+		Success:        result.Success(),
+		ResponseStatus: int32(result.StatusCode),
+		DurationMs:     result.Duration.Milliseconds(),
+	}
+	if !result.Success() {
+		// Only the class of the failure: transport errors would tell the caller
+		// about hosts and ports on the network the request was made from
+		s.log.Infof("Test delivery to webhook %s failed: %v", entity.ID, result.Err)
+		resp.Error = result.ErrorClass()
+	}
+	return resp, nil
+}
+
+// ListWebhookDeliveries lists delivery attempts for the current tenant's webhooks
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *sharingV1.ListWebhookDeliveriesRequest) (*sharingV1.ListWebhookDeliveriesResponse, error) {
+	if err := authz.Require(ctx, authz.PermWebhookManage); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	var status *string
+	if req.Status != nil && *req.Status != sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		st := deliveryStatusToString(*req.Status)
+		status = &st
+	}
+
+	entities, total, err := s.deliveryRepo.ListByTenant(ctx, tenantID, req.WebhookId, status, req.EventType, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*sharingV1.WebhookDelivery, 0, len(entities))
+	for _, e := range entities {
+		deliveries = append(deliveries, s.deliveryRepo.ToProto(e))
+	}
+
+	return &sharingV1.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      uint32(total),
+	}, nil
+}
+
+// validateWebhookURL ensures the endpoint is an absolute http(s) URL that does not
+// name an internal host. Host names resolving to internal addresses are rejected
+// when a delivery is made.
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return sharingV1.ErrorBadRequest("webhook url must be an absolute http or https URL")
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if addr, err := netip.ParseAddr(host); (err == nil && webhook.ForbiddenAddr(addr)) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return sharingV1.ErrorBadRequest("webhook url must not point to an internal address")
+	}
+	return nil
+}
+
+// validateWebhookEvents ensures every subscribed event type is known
+func validateWebhookEvents(events []string) error {
+	for _, e := range events {
+		if !slices.Contains(webhookEventTypes, e) {
+			return sharingV1.ErrorBadRequest("unknown webhook event type: %s", e)
+		}
+	}
+	return nil
+}
+
+// deliveryStatusToString converts proto enum to ent enum string
+func deliveryStatusToString(st sharingV1.WebhookDeliveryStatus) string {
+	switch st {
+	case sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return "PENDING"
+	case sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return "SUCCEEDED"
+	case sharingV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return "FAILED"
+	default:
+		return ""
+	}
+}
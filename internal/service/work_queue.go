@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"sync"
+)
+
+// workQueue is a bounded queue of items handled by a fixed number of workers.
+// Closing it stops new items and lets the workers drain the queued ones.
+type workQueue[T any] struct {
+	mu      sync.RWMutex
+	closed  bool
+	workers int
+	queue   chan T
+	done    chan struct{}
+}
+
+func newWorkQueue[T any](size, workers int) *workQueue[T] {
+	return &workQueue[T]{
+		workers: workers,
+		queue:   make(chan T, size),
+		done:    make(chan struct{}),
+	}
+}
+
+// Enqueue queues an item without blocking. It reports false when the queue
+// is full or closed.
+func (q *workQueue[T]) Enqueue(item T) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return false
+	}
+	select {
+	case q.queue <- item:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run handles queued items on the queue's workers until the queue is closed
+// and drained
+func (q *workQueue[T]) Run(handle func(T)) {
+	defer close(q.done)
+
+	var wg sync.WaitGroup
+	for range q.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range q.queue {
+				handle(item)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Close stops accepting items and waits until Run drained the queue or ctx is done
+func (q *workQueue[T]) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.queue)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
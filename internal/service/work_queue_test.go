@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWorkQueueDrainsOnClose(t *testing.T) {
+	q := newWorkQueue[int](3, 2)
+	for i := 0; i < 3; i++ {
+		if !q.Enqueue(0) {
+			t.Fatalf("enqueue %d rejected", i)
+		}
+	}
+	// The queue is bounded and rejects items instead of blocking
+	if q.Enqueue(0) {
+		t.Fatal("enqueue on a full queue accepted")
+	}
+
+	var sent atomic.Int32
+	go q.Run(func(int) { sent.Add(1) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := q.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if n := sent.Load(); n != 3 {
+		t.Errorf("sent %d items before close returned, want 3", n)
+	}
+	if q.Enqueue(0) {
+		t.Error("enqueue on a closed queue accepted")
+	}
+	// Closing twice is safe
+	if err := q.Close(ctx); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+func TestWorkQueueCloseHonoursDeadline(t *testing.T) {
+	q := newWorkQueue[int](1, 1)
+	q.Enqueue(0)
+
+	release := make(chan struct{})
+	defer close(release)
+	go q.Run(func(int) { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := q.Close(ctx); err == nil {
+		t.Error("Close returned before the stuck item was handled")
+	}
+}
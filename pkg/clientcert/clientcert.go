@@ -0,0 +1,163 @@
+package clientcert
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Info describes the client certificate presented on a TLS connection
+type Info struct {
+	// Fingerprint is the lower-case hex SHA-256 digest of the DER certificate
+	Fingerprint string
+	// Subject and Issuer are the distinguished names in RFC 2253 form, e.g. "CN=alice,O=Acme"
+	Subject string
+	Issuer  string
+	// Verified is set when the certificate chains to a trusted client CA.
+	// Subject, issuer and CA rules only match verified certificates.
+	Verified bool
+	// CAFingerprints are the fingerprints of the CA certificates of the verified chains
+	CAFingerprints []string
+}
+
+// FromConnectionState returns the client certificate of a TLS connection,
+// or nil when the client did not present one
+func FromConnectionState(cs *tls.ConnectionState) *Info {
+	if cs == nil || len(cs.PeerCertificates) == 0 {
+		return nil
+	}
+
+	leaf := cs.PeerCertificates[0]
+	info := &Info{
+		Fingerprint: Fingerprint(leaf.Raw),
+		Subject:     leaf.Subject.String(),
+		Issuer:      leaf.Issuer.String(),
+		Verified:    len(cs.VerifiedChains) > 0,
+	}
+	for _, chain := range cs.VerifiedChains {
+		for _, ca := range chain[1:] {
+			if fp := Fingerprint(ca.Raw); !slices.Contains(info.CAFingerprints, fp) {
+				info.CAFingerprints = append(info.CAFingerprints, fp)
+			}
+		}
+	}
+	return info
+}
+
+// Fingerprint returns the lower-case hex SHA-256 digest of a DER certificate
+func Fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the client certificate of a request
+func NewContext(ctx context.Context, info *Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the client certificate of a request, nil when there is none
+func FromContext(ctx context.Context) *Info {
+	info, _ := ctx.Value(contextKey{}).(*Info)
+	return info
+}
+
+// Kind is what a certificate rule matches
+type Kind string
+
+const (
+	// KindSHA256 matches the fingerprint of the client certificate
+	KindSHA256 Kind = "sha256"
+	// KindSubject matches the subject distinguished name
+	KindSubject Kind = "subject"
+	// KindIssuer matches the distinguished name of the issuing CA
+	KindIssuer Kind = "issuer"
+	// KindCASHA256 matches the fingerprint of any CA of the verified chain
+	KindCASHA256 Kind = "ca-sha256"
+)
+
+// Rule is a parsed CERTIFICATE policy value of the form "kind:value", e.g.
+//
+//	sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+//	subject:CN=alice,O=Acme
+//	issuer:CN=Acme Device CA,O=Acme
+//	ca-sha256:3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b
+//
+// Fingerprints may contain colons and are case-insensitive; distinguished
+// names are compared case-insensitively, ignoring spaces around separators.
+type Rule struct {
+	Kind  Kind
+	Value string
+}
+
+// ParseRule parses a certificate rule
+func ParseRule(value string) (Rule, error) {
+	kind, v, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok {
+		return Rule{}, fmt.Errorf("%q is not of the form kind:value", value)
+	}
+
+	r := Rule{Kind: Kind(strings.ToLower(strings.TrimSpace(kind)))}
+	switch r.Kind {
+	case KindSHA256, KindCASHA256:
+		fp := strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(v))
+		if len(fp) != sha256.Size*2 {
+			return Rule{}, fmt.Errorf("%s fingerprint must be %d hex digits", r.Kind, sha256.Size*2)
+		}
+		if _, err := hex.DecodeString(fp); err != nil {
+			return Rule{}, fmt.Errorf("%s fingerprint is not hexadecimal", r.Kind)
+		}
+		r.Value = fp
+	case KindSubject, KindIssuer:
+		dn := normalizeDN(v)
+		if dn == "" {
+			return Rule{}, fmt.Errorf("%s distinguished name is empty", r.Kind)
+		}
+		r.Value = dn
+	default:
+		return Rule{}, fmt.Errorf("unknown certificate rule %q, use sha256, subject, issuer or ca-sha256", kind)
+	}
+	return r, nil
+}
+
+// String returns the rule in its canonical form
+func (r Rule) String() string {
+	return string(r.Kind) + ":" + r.Value
+}
+
+// Matches reports whether the client certificate satisfies the rule
+func (r Rule) Matches(info *Info) bool {
+	if info == nil {
+		return false
+	}
+	switch r.Kind {
+	case KindSHA256:
+		return info.Fingerprint == r.Value
+	case KindSubject:
+		return info.Verified && strings.EqualFold(normalizeDN(info.Subject), r.Value)
+	case KindIssuer:
+		return info.Verified && strings.EqualFold(normalizeDN(info.Issuer), r.Value)
+	case KindCASHA256:
+		return info.Verified && slices.Contains(info.CAFingerprints, r.Value)
+	default:
+		return false
+	}
+}
+
+// normalizeDN removes the spaces around the attributes of a distinguished name
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, part := range parts {
+		attr, value, ok := strings.Cut(part, "=")
+		if ok {
+			part = strings.TrimSpace(attr) + "=" + strings.TrimSpace(value)
+		}
+		parts[i] = strings.TrimSpace(part)
+	}
+	return strings.Trim(strings.Join(parts, ","), ",")
+}
@@ -0,0 +1,97 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func newCert(t *testing.T, subject, issuer pkix.Name, isCA bool) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               subject,
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	parent := *tmpl
+	parent.Subject = issuer
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, &parent, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestRuleMatches(t *testing.T) {
+	caName := pkix.Name{CommonName: "Acme Device CA", Organization: []string{"Acme"}}
+	ca := newCert(t, caName, caName, true)
+	leaf := newCert(t, pkix.Name{CommonName: "alice", Organization: []string{"Acme"}}, caName, false)
+
+	verified := FromConnectionState(&tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{leaf},
+		VerifiedChains:   [][]*x509.Certificate{{leaf, ca}},
+	})
+	unverified := FromConnectionState(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}})
+
+	for _, tc := range []struct {
+		rule       string
+		verified   bool
+		unverified bool
+	}{
+		{"sha256:" + Fingerprint(leaf.Raw), true, true},
+		{"sha256:" + Fingerprint(ca.Raw), false, false},
+		{"subject: cn=ALICE, o=Acme", true, false},
+		{"subject:CN=bob,O=Acme", false, false},
+		{"issuer:CN=Acme Device CA,O=Acme", true, false},
+		{"ca-sha256:" + Fingerprint(ca.Raw), true, false},
+	} {
+		rule, err := ParseRule(tc.rule)
+		if err != nil {
+			t.Fatalf("ParseRule(%q): %v", tc.rule, err)
+		}
+		if got := rule.Matches(verified); got != tc.verified {
+			t.Errorf("%q on verified certificate = %v, want %v", tc.rule, got, tc.verified)
+		}
+		if got := rule.Matches(unverified); got != tc.unverified {
+			t.Errorf("%q on unverified certificate = %v, want %v", tc.rule, got, tc.unverified)
+		}
+		if rule.Matches(nil) {
+			t.Errorf("%q matched a request without certificate", tc.rule)
+		}
+	}
+
+	if FromConnectionState(&tls.ConnectionState{}) != nil {
+		t.Error("expected no certificate info without peer certificates")
+	}
+}
+
+func TestParseRuleRejectsInvalidRules(t *testing.T) {
+	for _, value := range []string{
+		"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		"sha256:9f86",
+		"sha256:zz86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		"subject:",
+		"serial:01",
+	} {
+		if _, err := ParseRule(value); err == nil {
+			t.Errorf("ParseRule(%q) succeeded, want an error", value)
+		}
+	}
+}
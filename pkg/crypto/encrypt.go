@@ -3,21 +3,14 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io"
 )
 
-// GenerateToken generates a cryptographically secure random token (32 bytes = 64 hex chars).
-func GenerateToken() (string, error) {
-	b := make([]byte, 32)
-	if _, err := io.ReadFull(rand.Reader, b); err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
-	}
-	return hex.EncodeToString(b), nil
-}
-
 // EncryptContent encrypts data using AES-256-GCM.
 // key must be 32 bytes for AES-256.
 func EncryptContent(data []byte, key []byte) (ciphertext, nonce []byte, err error) {
@@ -79,3 +72,12 @@ func ParseEncryptionKey(hexKey string) ([]byte, error) {
 	}
 	return key, nil
 }
+
+// DeriveSubkey derives a key for one use of the encryption key, such as
+// signing tokens, so that use never handles the encryption key itself. Each
+// use passes its own label.
+func DeriveSubkey(key []byte, label string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(label))
+	return mac.Sum(nil)
+}
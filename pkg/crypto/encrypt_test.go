@@ -0,0 +1,24 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeriveSubkey(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	signing := DeriveSubkey(key, "signing")
+	if len(signing) != 32 || bytes.Equal(signing, key) {
+		t.Fatalf("DeriveSubkey() = %x, want a 32 byte key other than the encryption key", signing)
+	}
+	if !bytes.Equal(DeriveSubkey(key, "signing"), signing) {
+		t.Error("DeriveSubkey is not deterministic")
+	}
+	if bytes.Equal(DeriveSubkey(key, "codes"), signing) {
+		t.Error("different labels derived the same key")
+	}
+	if bytes.Equal(DeriveSubkey([]byte("another key"), "signing"), signing) {
+		t.Error("different keys derived the same key")
+	}
+}
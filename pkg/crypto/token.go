@@ -0,0 +1,103 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// Share tokens have the form "tgs_" + 43 base62 chars of entropy + 6 base62
+// chars of CRC32 checksum (53 chars total). The fixed prefix and checksum let
+// secret scanners (GitHub, GitLab, DLP tools) detect leaked share links with
+// a near-zero false positive rate, e.g. with the regex:
+//
+//	\btgs_[0-9A-Za-z]{49}\b
+const (
+	// TokenPrefix is the fixed, recognizable prefix of every share token.
+	TokenPrefix = "tgs_"
+
+	tokenEntropyBytes  = 32
+	tokenEntropyLength = 43 // ceil(256 / log2(62))
+	tokenChecksumLen   = 6  // ceil(32 / log2(62))
+
+	// TokenLength is the total length of a share token.
+	TokenLength = len(TokenPrefix) + tokenEntropyLength + tokenChecksumLen
+
+	legacyTokenLength = 64
+)
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// GenerateToken generates a cryptographically secure share token with a
+// recognizable prefix and a CRC32 checksum.
+func GenerateToken() (string, error) {
+	b := make([]byte, tokenEntropyBytes)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	body := encodeBase62(new(big.Int).SetBytes(b), tokenEntropyLength)
+	return TokenPrefix + body + tokenChecksum(body), nil
+}
+
+// ValidateToken performs a cheap offline check of the token format and
+// checksum, so malformed or mistyped tokens can be rejected before any
+// database lookup. Legacy 64-char hex tokens are accepted as well.
+func ValidateToken(token string) bool {
+	if IsLegacyToken(token) {
+		return true
+	}
+	if len(token) != TokenLength || !strings.HasPrefix(token, TokenPrefix) {
+		return false
+	}
+
+	rest := token[len(TokenPrefix):]
+	for i := 0; i < len(rest); i++ {
+		if strings.IndexByte(base62Alphabet, rest[i]) < 0 {
+			return false
+		}
+	}
+
+	body := rest[:tokenEntropyLength]
+	return rest[tokenEntropyLength:] == tokenChecksum(body)
+}
+
+// IsLegacyToken reports whether the token uses the old 64-char hex format,
+// which carries no prefix or checksum.
+func IsLegacyToken(token string) bool {
+	if len(token) != legacyTokenLength {
+		return false
+	}
+	for i := 0; i < len(token); i++ {
+		c := token[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenChecksum returns the base62-encoded CRC32 (IEEE) of the token body.
+func tokenChecksum(body string) string {
+	sum := crc32.ChecksumIEEE([]byte(body))
+	return encodeBase62(new(big.Int).SetUint64(uint64(sum)), tokenChecksumLen)
+}
+
+// encodeBase62 encodes n in base62, left-padded with zeros to width chars.
+func encodeBase62(n *big.Int, width int) string {
+	out := make([]byte, width)
+	for i := range out {
+		out[i] = base62Alphabet[0]
+	}
+
+	base := big.NewInt(int64(len(base62Alphabet)))
+	mod := new(big.Int)
+	for i := width - 1; i >= 0 && n.Sign() > 0; i-- {
+		n.DivMod(n, base, mod)
+		out[i] = base62Alphabet[mod.Int64()]
+	}
+	return string(out)
+}
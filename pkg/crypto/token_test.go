@@ -0,0 +1,78 @@
+package crypto
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerateToken(t *testing.T) {
+	pattern := regexp.MustCompile(`^tgs_[0-9A-Za-z]{49}$`)
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		token, err := GenerateToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(token) != TokenLength || !pattern.MatchString(token) {
+			t.Fatalf("GenerateToken() = %q, does not match the scanner pattern", token)
+		}
+		if !ValidateToken(token) {
+			t.Fatalf("ValidateToken(%q) = false for a generated token", token)
+		}
+		if seen[token] {
+			t.Fatalf("GenerateToken() returned %q twice", token)
+		}
+		seen[token] = true
+	}
+}
+
+func TestValidateToken(t *testing.T) {
+	body := strings.Repeat("a", tokenEntropyLength)
+	valid := TokenPrefix + body + tokenChecksum(body)
+
+	// Change one checksum character to another base62 character
+	last := valid[len(valid)-1]
+	other := byte('0')
+	if last == other {
+		other = '1'
+	}
+	badChecksum := valid[:len(valid)-1] + string(other)
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{"valid", valid, true},
+		{"bad checksum", badChecksum, false},
+		{"mistyped body", TokenPrefix + "b" + body[1:] + tokenChecksum(body), false},
+		{"wrong prefix", "tgx_" + body + tokenChecksum(body), false},
+		{"too short", valid[:len(valid)-1], false},
+		{"too long", valid + "0", false},
+		{"non base62 character", TokenPrefix + "-" + body[1:] + tokenChecksum("-"+body[1:]), false},
+		{"empty", "", false},
+		{"legacy hex", strings.Repeat("0123456789abcdef", 4), true},
+		{"legacy with non hex character", strings.Repeat("0123456789abcdeg", 4), false},
+	} {
+		if got := ValidateToken(tc.token); got != tc.want {
+			t.Errorf("%s: ValidateToken(%q) = %v, want %v", tc.name, tc.token, got, tc.want)
+		}
+	}
+}
+
+func TestTokenChecksum(t *testing.T) {
+	for _, tc := range []struct {
+		body string
+		want string
+	}{
+		// CRC32 of "" is 0
+		{"", "000000"},
+		// CRC32 (IEEE) of "123456789" is 0xCBF43926 = 3421780262
+		{"123456789", "3jZRME"},
+	} {
+		if got := tokenChecksum(tc.body); got != tc.want {
+			t.Errorf("tokenChecksum(%q) = %q, want %q", tc.body, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package device
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mileusna/useragent"
+)
+
+// Class is the kind of device a request was made from
+type Class string
+
+const (
+	ClassDesktop Class = "desktop"
+	ClassMobile  Class = "mobile"
+	ClassTablet  Class = "tablet"
+	ClassBot     Class = "bot"
+	ClassUnknown Class = "unknown"
+)
+
+// Rule keys accepted in DEVICE policy values
+const (
+	KeyOS      = "os"
+	KeyBrowser = "browser"
+	KeyClass   = "class"
+)
+
+// Info is the device description parsed from a User-Agent header.
+// Families are lower case with spaces replaced by dashes (e.g. "windows", "macos", "mobile-safari").
+type Info struct {
+	OS      string
+	Browser string
+	Class   Class
+}
+
+// Parse parses a User-Agent header into OS family, browser family and device class
+func Parse(userAgent string) Info {
+	if strings.TrimSpace(userAgent) == "" {
+		return Info{Class: ClassUnknown}
+	}
+
+	ua := useragent.Parse(userAgent)
+	info := Info{
+		OS:      family(ua.OS),
+		Browser: family(ua.Name),
+	}
+
+	switch {
+	case ua.Bot:
+		info.Class = ClassBot
+	case ua.Tablet:
+		info.Class = ClassTablet
+	case ua.Mobile:
+		info.Class = ClassMobile
+	case ua.Desktop:
+		info.Class = ClassDesktop
+	default:
+		info.Class = ClassUnknown
+	}
+
+	return info
+}
+
+// family normalizes an OS or browser name for comparison with rule values
+func family(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// condition restricts one attribute of a device to a set of values
+type condition struct {
+	key    string
+	values []string
+	negate bool
+}
+
+// Rule is a parsed DEVICE policy value. A rule is a semicolon-separated list
+// of conditions of the form key=value[,value...]; a leading "!" negates the
+// value list. Every condition must hold for the rule to match, e.g.
+// "os=windows,macos;class=!bot".
+type Rule struct {
+	conditions []condition
+}
+
+// ParseRule parses a DEVICE policy value
+func ParseRule(s string) (*Rule, error) {
+	rule := &Rule{}
+
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, list, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("condition %q must have the form key=value", part)
+		}
+
+		c := condition{key: strings.ToLower(strings.TrimSpace(key))}
+		switch c.key {
+		case KeyOS, KeyBrowser, KeyClass:
+		default:
+			return nil, fmt.Errorf("unknown device attribute %q (expected os, browser or class)", c.key)
+		}
+
+		list = strings.TrimSpace(list)
+		if strings.HasPrefix(list, "!") {
+			c.negate = true
+			list = list[1:]
+		}
+		for _, v := range strings.Split(list, ",") {
+			if v = family(v); v != "" {
+				c.values = append(c.values, v)
+			}
+		}
+		if len(c.values) == 0 {
+			return nil, fmt.Errorf("condition %q has no values", part)
+		}
+		if c.key == KeyClass {
+			for _, v := range c.values {
+				if !validClass(Class(v)) {
+					return nil, fmt.Errorf("unknown device class %q (expected desktop, mobile, tablet, bot or unknown)", v)
+				}
+			}
+		}
+
+		rule.conditions = append(rule.conditions, c)
+	}
+
+	if len(rule.conditions) == 0 {
+		return nil, fmt.Errorf("device rule is empty")
+	}
+	return rule, nil
+}
+
+// Matches reports whether the device satisfies every condition of the rule
+func (r *Rule) Matches(info Info) bool {
+	for _, c := range r.conditions {
+		var actual string
+		switch c.key {
+		case KeyOS:
+			actual = info.OS
+		case KeyBrowser:
+			actual = info.Browser
+		case KeyClass:
+			actual = string(info.Class)
+		}
+		if slices.Contains(c.values, actual) == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// validClass reports whether c is a known device class
+func validClass(c Class) bool {
+	switch c {
+	case ClassDesktop, ClassMobile, ClassTablet, ClassBot, ClassUnknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,67 @@
+package device
+
+import "testing"
+
+const (
+	uaWindowsChrome = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+	uaIPhoneSafari  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1"
+	uaIPad          = "Mozilla/5.0 (iPad; CPU OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1"
+	uaGooglebot     = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		ua   string
+		want Info
+	}{
+		{uaWindowsChrome, Info{OS: "windows", Browser: "chrome", Class: ClassDesktop}},
+		{uaIPhoneSafari, Info{OS: "ios", Browser: "safari", Class: ClassMobile}},
+		{uaIPad, Info{OS: "ios", Browser: "safari", Class: ClassTablet}},
+		{"", Info{Class: ClassUnknown}},
+	} {
+		if got := Parse(tc.ua); got != tc.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tc.ua, got, tc.want)
+		}
+	}
+
+	if got := Parse(uaGooglebot).Class; got != ClassBot {
+		t.Errorf("Parse(googlebot).Class = %s, want bot", got)
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	windows := Parse(uaWindowsChrome)
+	iphone := Parse(uaIPhoneSafari)
+	bot := Parse(uaGooglebot)
+
+	for _, tc := range []struct {
+		rule string
+		info Info
+		want bool
+	}{
+		{"os=windows,macos", windows, true},
+		{"os=windows,macos", iphone, false},
+		{"class=!bot", windows, true},
+		{"class=!bot", bot, false},
+		{"OS=Windows; class=desktop", windows, true},
+		{"os=windows;class=mobile", windows, false},
+		{"browser=!chrome,firefox", iphone, true},
+		{"browser=!chrome,firefox", windows, false},
+	} {
+		rule, err := ParseRule(tc.rule)
+		if err != nil {
+			t.Fatalf("ParseRule(%q): %v", tc.rule, err)
+		}
+		if got := rule.Matches(tc.info); got != tc.want {
+			t.Errorf("%q.Matches(%+v) = %v, want %v", tc.rule, tc.info, got, tc.want)
+		}
+	}
+}
+
+func TestParseRuleRejectsInvalidValues(t *testing.T) {
+	for _, s := range []string{"", "windows", "model=pixel", "os=", "class=phone"} {
+		if _, err := ParseRule(s); err == nil {
+			t.Errorf("ParseRule(%q) succeeded, want error", s)
+		}
+	}
+}
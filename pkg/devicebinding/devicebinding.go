@@ -0,0 +1,70 @@
+package devicebinding
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
+)
+
+// deviceIDLen is the number of random bytes of a device ID
+const deviceIDLen = 16
+
+// keyLabel separates the binding key from other uses of the encryption key
+const keyLabel = "tangra-share-device-binding"
+
+// DeriveKey derives the key signing device tokens from the share encryption key
+func DeriveKey(encryptionKey []byte) []byte {
+	return crypto.DeriveSubkey(encryptionKey, keyLabel)
+}
+
+// Issue creates the device token binding a share to a new device. The token
+// is handed to the device, e.g. in a cookie; the binding is stored on the
+// share and only reveals a digest of the device ID.
+func Issue(key []byte, shareID string) (token, binding string, err error) {
+	id := make([]byte, deviceIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", fmt.Errorf("generate device id: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(id) + "." + base64.RawURLEncoding.EncodeToString(sign(key, shareID, id))
+	return token, digest(id), nil
+}
+
+// Verify reports whether token was issued for shareID and the device of binding
+func Verify(key []byte, shareID, token, binding string) bool {
+	encodedID, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	id, err := base64.RawURLEncoding.DecodeString(encodedID)
+	if err != nil || len(id) != deviceIDLen {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, sign(key, shareID, id)) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(digest(id)), []byte(binding)) == 1
+}
+
+// sign authenticates a device ID for a share, so tokens cannot be forged or
+// replayed on other shares
+func sign(key []byte, shareID string, id []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(shareID))
+	mac.Write([]byte{0})
+	mac.Write(id)
+	return mac.Sum(nil)
+}
+
+// digest returns the hex SHA-256 digest of a device ID
+func digest(id []byte) string {
+	sum := sha256.Sum256(id)
+	return hex.EncodeToString(sum[:])
+}
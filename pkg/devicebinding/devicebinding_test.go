@@ -0,0 +1,43 @@
+package devicebinding
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIssueVerify(t *testing.T) {
+	key := DeriveKey([]byte("0123456789abcdef0123456789abcdef"))
+
+	token, binding, err := Issue(key, "share-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(key, "share-1", token, binding) {
+		t.Fatal("token does not verify for the device it was issued to")
+	}
+
+	other, otherBinding, err := Issue(key, "share-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		key     []byte
+		shareID string
+		token   string
+		binding string
+	}{
+		{"other device", key, "share-1", other, binding},
+		{"other binding", key, "share-1", token, otherBinding},
+		{"other share", key, "share-2", token, binding},
+		{"other key", DeriveKey([]byte("another key")), "share-1", token, binding},
+		{"swapped device id", key, "share-1", other[:strings.Index(other, ".")] + token[strings.Index(token, "."):], otherBinding},
+		{"missing token", key, "share-1", "", binding},
+		{"malformed token", key, "share-1", "not-a-token", binding},
+	} {
+		if Verify(tc.key, tc.shareID, tc.token, tc.binding) {
+			t.Errorf("%s: token verified, want rejected", tc.name)
+		}
+	}
+}
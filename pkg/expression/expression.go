@@ -0,0 +1,205 @@
+package expression
+
+import (
+	"container/list"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// Variables available to expressions
+const (
+	VarIP             = "ip"
+	VarCountry        = "country"
+	VarTime           = "time"
+	VarUserAgent      = "user_agent"
+	VarAttemptCount   = "attempt_count"
+	VarRecipientEmail = "recipient_email"
+)
+
+const (
+	// CostLimit caps the runtime cost of a single evaluation
+	CostLimit = 10000
+
+	// cacheSize is the number of compiled programs kept in the cache
+	cacheSize = 1024
+)
+
+// Context is the typed request context an EXPRESSION policy is evaluated against
+type Context struct {
+	// IP is the client IP address
+	IP string
+	// Country is the ISO 3166-1 alpha-2 country code of the client, empty when unknown
+	Country string
+	// Time is the time of the access attempt
+	Time time.Time
+	// UserAgent is the raw client User-Agent header
+	UserAgent string
+	// AttemptCount is the number of earlier attempts to open the share
+	AttemptCount int64
+	// RecipientEmail is the address the share was sent to
+	RecipientEmail string
+}
+
+// Program is a compiled and type-checked EXPRESSION policy value.
+// Expressions are CEL (https://cel.dev) and must evaluate to a bool, e.g.
+//
+//	in_cidr(ip, "10.0.0.0/8") ||
+//	  (country == "BG" && time.getDayOfWeek("Europe/Sofia") in [1, 2, 3, 4, 5])
+//
+// Besides the standard library, in_cidr(ip, cidr) reports whether an address
+// lies within a CIDR prefix.
+type Program struct {
+	prg cel.Program
+}
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+)
+
+// environment returns the shared CEL environment declaring the request context
+func environment() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(VarIP, cel.StringType),
+			cel.Variable(VarCountry, cel.StringType),
+			cel.Variable(VarTime, cel.TimestampType),
+			cel.Variable(VarUserAgent, cel.StringType),
+			cel.Variable(VarAttemptCount, cel.IntType),
+			cel.Variable(VarRecipientEmail, cel.StringType),
+			cel.Function("in_cidr",
+				cel.Overload("in_cidr_string_string",
+					[]*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+					cel.BinaryBinding(inCIDR),
+				),
+			),
+		)
+	})
+	return env, envErr
+}
+
+// inCIDR implements in_cidr(ip, cidr); unparseable arguments do not match
+func inCIDR(ipVal, cidrVal ref.Val) ref.Val {
+	ip, err := netip.ParseAddr(strings.TrimSpace(fmt.Sprint(ipVal.Value())))
+	if err != nil {
+		return types.False
+	}
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(fmt.Sprint(cidrVal.Value())))
+	if err != nil {
+		return types.False
+	}
+	return types.Bool(prefix.Masked().Contains(ip.WithZone("").Unmap()))
+}
+
+// Compile parses and type-checks an expression. The result must be a bool.
+func Compile(expr string) (*Program, error) {
+	e, err := environment()
+	if err != nil {
+		return nil, fmt.Errorf("expression environment: %w", err)
+	}
+
+	ast, iss := e.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to bool, not %s", ast.OutputType())
+	}
+
+	prg, err := e.Program(ast,
+		cel.CostLimit(CostLimit),
+		cel.InterruptCheckFrequency(100),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{prg: prg}, nil
+}
+
+// Eval evaluates the program against the request context
+func (p *Program) Eval(c Context) (bool, error) {
+	out, _, err := p.prg.Eval(map[string]any{
+		VarIP:             c.IP,
+		VarCountry:        c.Country,
+		VarTime:           c.Time,
+		VarUserAgent:      c.UserAgent,
+		VarAttemptCount:   c.AttemptCount,
+		VarRecipientEmail: c.RecipientEmail,
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %s, not bool", out.Type())
+	}
+	return result, nil
+}
+
+// cacheEntry is a compiled expression in the cache
+type cacheEntry struct {
+	expr string
+	prg  *Program
+}
+
+// Cache keeps the most recently used compiled programs
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewCache creates a cache holding up to size programs
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Compile returns the cached program of an expression, compiling it on a miss.
+// Compilation errors are not cached.
+func (c *Cache) Compile(expr string) (*Program, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[expr]; ok {
+		c.order.MoveToFront(el)
+		c.mu.Unlock()
+		return el.Value.(*cacheEntry).prg, nil
+	}
+	c.mu.Unlock()
+
+	prg, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[expr]; ok {
+		return el.Value.(*cacheEntry).prg, nil
+	}
+	c.entries[expr] = c.order.PushFront(&cacheEntry{expr: expr, prg: prg})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).expr)
+	}
+	return prg, nil
+}
+
+var defaultCache = NewCache(cacheSize)
+
+// CompileCached compiles an expression using the process-wide program cache
+func CompileCached(expr string) (*Program, error) {
+	return defaultCache.Compile(expr)
+}
@@ -0,0 +1,89 @@
+package expression
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompileRejectsInvalidExpressions(t *testing.T) {
+	for _, expr := range []string{
+		`country ==`,         // syntax error
+		`country == 1`,       // type error
+		`attempt_count + 1`,  // not a bool
+		`unknown_var == "x"`, // undeclared variable
+	} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("Compile(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestProgramEval(t *testing.T) {
+	// 2026-03-02 10:00 UTC is a Monday
+	c := Context{
+		IP:             "10.1.2.3",
+		Country:        "BG",
+		Time:           time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+		UserAgent:      "Mozilla/5.0",
+		AttemptCount:   2,
+		RecipientEmail: "alice@example.com",
+	}
+
+	for _, tc := range []struct {
+		expr string
+		want bool
+	}{
+		{`country == "BG" && attempt_count < 3`, true},
+		{`attempt_count >= 3`, false},
+		{`in_cidr(ip, "10.0.0.0/8")`, true},
+		{`in_cidr(ip, "192.168.0.0/16")`, false},
+		{`in_cidr(ip, "not a prefix")`, false},
+		{`recipient_email.endsWith("@example.com")`, true},
+		{`user_agent.contains("curl")`, false},
+		{`time.getDayOfWeek() == 1 && time.getHours("Europe/Sofia") == 12`, true},
+	} {
+		prg, err := Compile(tc.expr)
+		if err != nil {
+			t.Fatalf("Compile(%q): %v", tc.expr, err)
+		}
+		got, err := prg.Eval(c)
+		if err != nil {
+			t.Fatalf("Eval(%q): %v", tc.expr, err)
+		}
+		if got != tc.want {
+			t.Errorf("Eval(%q) = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestEvalIsCostLimited(t *testing.T) {
+	prg, err := Compile(`[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(a, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(b,
+		[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(c, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(d, a + b + c + d > 0))))`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if _, err := prg.Eval(Context{}); err == nil || !strings.Contains(err.Error(), "cost") {
+		t.Fatalf("Eval error = %v, want a cost limit error", err)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCache(2)
+	a, _ := c.Compile(`attempt_count == 1`)
+	if _, err := c.Compile(`attempt_count == 2`); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := c.Compile(`attempt_count == 1`); again != a {
+		t.Fatal("cached program was not reused")
+	}
+	if _, err := c.Compile(`attempt_count == 3`); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.entries[`attempt_count == 2`]; ok {
+		t.Error("least recently used program was not evicted")
+	}
+	if _, ok := c.entries[`attempt_count == 1`]; !ok {
+		t.Error("recently used program was evicted")
+	}
+}
@@ -0,0 +1,90 @@
+package iptrie
+
+import "net/netip"
+
+// Trie is a binary prefix trie mapping IP prefixes to values. Lookups walk
+// one bit of the address per level, so they cost at most 32 steps for IPv4
+// and 128 for IPv6 regardless of how many prefixes are stored.
+// IPv4-mapped IPv6 addresses and prefixes are treated as IPv4.
+//
+// A Trie is not safe for concurrent modification; build it once and share it
+// read-only, or guard it.
+type Trie[V any] struct {
+	v4, v6 *node[V]
+	size   int
+}
+
+type node[V any] struct {
+	child  [2]*node[V]
+	values []V
+}
+
+// Insert adds a value for a prefix. A prefix can hold several values.
+func (t *Trie[V]) Insert(prefix netip.Prefix, value V) {
+	prefix = unmapPrefix(prefix).Masked()
+	if !prefix.IsValid() {
+		return
+	}
+	root := &t.v4
+	if prefix.Addr().Is6() {
+		root = &t.v6
+	}
+	if *root == nil {
+		*root = &node[V]{}
+	}
+
+	n := *root
+	bytes := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits(); i++ {
+		b := bit(bytes, i)
+		if n.child[b] == nil {
+			n.child[b] = &node[V]{}
+		}
+		n = n.child[b]
+	}
+	n.values = append(n.values, value)
+	t.size++
+}
+
+// Lookup returns the values of every prefix containing addr, least specific first
+func (t *Trie[V]) Lookup(addr netip.Addr) []V {
+	addr = addr.WithZone("").Unmap()
+	n := t.v4
+	if addr.Is6() {
+		n = t.v6
+	}
+
+	var out []V
+	bytes := addr.AsSlice()
+	for i := 0; n != nil; i++ {
+		out = append(out, n.values...)
+		if i == addr.BitLen() {
+			break
+		}
+		n = n.child[bit(bytes, i)]
+	}
+	return out
+}
+
+// Len returns the number of values inserted
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// bit returns bit i of an address, counting from the most significant bit
+func bit(bytes []byte, i int) int {
+	return int(bytes[i/8]>>(7-i%8)) & 1
+}
+
+// unmapPrefix turns an IPv4-mapped IPv6 prefix into the IPv4 prefix it covers
+func unmapPrefix(prefix netip.Prefix) netip.Prefix {
+	addr := prefix.Addr()
+	if !addr.Is4In6() {
+		return prefix
+	}
+	bits := prefix.Bits() - 96
+	if bits < 0 {
+		bits = 0
+	}
+	return netip.PrefixFrom(addr.Unmap(), bits)
+}
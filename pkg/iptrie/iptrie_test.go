@@ -0,0 +1,48 @@
+package iptrie
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	var trie Trie[string]
+	for _, entry := range []struct{ prefix, value string }{
+		{"10.0.0.0/8", "private"},
+		{"10.1.2.0/24", "office"},
+		{"10.1.2.3/32", "host"},
+		{"10.1.2.3/32", "tor"},
+		{"0.0.0.0/0", "any-v4"},
+		{"2001:db8::/32", "doc"},
+		{"::ffff:198.51.100.0/120", "mapped"},
+	} {
+		trie.Insert(netip.MustParsePrefix(entry.prefix), entry.value)
+	}
+
+	for _, tc := range []struct {
+		addr string
+		want []string
+	}{
+		{"10.1.2.3", []string{"any-v4", "private", "office", "host", "tor"}},
+		{"10.1.2.4", []string{"any-v4", "private", "office"}},
+		{"192.0.2.1", []string{"any-v4"}},
+		{"::ffff:10.9.9.9", []string{"any-v4", "private"}},
+		{"198.51.100.7", []string{"any-v4", "mapped"}},
+		{"2001:db8:1::1", []string{"doc"}},
+		{"2001:db9::1", nil},
+	} {
+		if got := trie.Lookup(netip.MustParseAddr(tc.addr)); !slices.Equal(got, tc.want) {
+			t.Errorf("Lookup(%s) = %v, want %v", tc.addr, got, tc.want)
+		}
+	}
+
+	if trie.Len() != 7 {
+		t.Errorf("Len() = %d, want 7", trie.Len())
+	}
+
+	var empty Trie[int]
+	if got := empty.Lookup(netip.MustParseAddr("10.0.0.1")); got != nil {
+		t.Errorf("empty trie Lookup = %v, want nil", got)
+	}
+}
@@ -0,0 +1,159 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// maxHeaderLineLen is the line length headers are folded at (RFC 5322 section 2.1.1)
+const maxHeaderLineLen = 78
+
+// Message is an email with a plain text and an HTML alternative.
+type Message struct {
+	From     string // Address, optionally with a display name ("Name <addr>")
+	To       string
+	ReplyTo  string // Optional
+	Subject  string
+	TextBody string // Generated from HTMLBody when empty
+	HTMLBody string
+
+	// Date and MessageID are set when the message is built if left empty
+	Date      time.Time
+	MessageID string
+}
+
+// Bytes builds the RFC 5322 message: headers in a fixed order, non-ASCII
+// header text encoded per RFC 2047, and a multipart/alternative body with
+// quoted-printable text/plain and text/html parts.
+func (m *Message) Bytes() ([]byte, error) {
+	from, err := mail.ParseAddress(sanitizeHeader(m.From))
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := mail.ParseAddress(sanitizeHeader(m.To))
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+	var replyTo *mail.Address
+	if m.ReplyTo != "" {
+		if replyTo, err = mail.ParseAddress(sanitizeHeader(m.ReplyTo)); err != nil {
+			return nil, fmt.Errorf("invalid reply-to address: %w", err)
+		}
+	}
+
+	date := m.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	messageID := m.MessageID
+	if messageID == "" {
+		if messageID, err = NewMessageID(from.Address); err != nil {
+			return nil, err
+		}
+	}
+	textBody := m.TextBody
+	if textBody == "" {
+		textBody = HTMLToText(m.HTMLBody)
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "Date", date.Format(time.RFC1123Z))
+	writeHeader(&buf, "From", from.String())
+	writeHeader(&buf, "To", to.String())
+	if replyTo != nil {
+		writeHeader(&buf, "Reply-To", replyTo.String())
+	}
+	writeHeader(&buf, "Message-ID", messageID)
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("UTF-8", sanitizeHeader(m.Subject)))
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	writeHeader(&buf, "Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()}))
+	buf.WriteString("\r\n")
+
+	// Clients show the last alternative they support, so the HTML part goes last
+	if err := writePart(mw, "text/plain; charset=UTF-8", textBody); err != nil {
+		return nil, err
+	}
+	if err := writePart(mw, "text/html; charset=UTF-8", m.HTMLBody); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close multipart body: %w", err)
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// NewMessageID generates a unique Message-ID in the domain of the sender address.
+func NewMessageID(fromAddress string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate message id: %w", err)
+	}
+	domain := "localhost"
+	if i := strings.LastIndex(fromAddress, "@"); i >= 0 && i < len(fromAddress)-1 {
+		domain = fromAddress[i+1:]
+	}
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">", nil
+}
+
+// writePart writes a quoted-printable part to a multipart body
+func writePart(mw *multipart.Writer, contentType, content string) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+	pw, err := mw.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to create %s part: %w", contentType, err)
+	}
+	qw := quotedprintable.NewWriter(pw)
+	if _, err := qw.Write([]byte(normalizeNewlines(content))); err != nil {
+		return fmt.Errorf("failed to write %s part: %w", contentType, err)
+	}
+	if err := qw.Close(); err != nil {
+		return fmt.Errorf("failed to write %s part: %w", contentType, err)
+	}
+	return nil
+}
+
+// writeHeader writes a header field, folding it at spaces so lines stay
+// within maxHeaderLineLen where possible. Folding only inserts a line break
+// before a space, so the unfolded value is unchanged.
+func writeHeader(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	buf.WriteString(":")
+	lineLen := len(name) + 1
+	for _, word := range strings.Split(value, " ") {
+		if word != "" && lineLen+1+len(word) > maxHeaderLineLen {
+			buf.WriteString("\r\n")
+			lineLen = 0
+		}
+		buf.WriteString(" ")
+		buf.WriteString(word)
+		lineLen += 1 + len(word)
+	}
+	buf.WriteString("\r\n")
+}
+
+// sanitizeHeader drops line breaks so values cannot inject header fields
+func sanitizeHeader(v string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(v)
+}
+
+// normalizeNewlines converts line endings to CRLF as required on the wire
+func normalizeNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
@@ -0,0 +1,137 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessageBytes(t *testing.T) {
+	msg := &Message{
+		From:     "Tangra Sharing <noreply@example.com>",
+		To:       "Zoë <zoe@example.org>",
+		ReplyTo:  "alice@example.com",
+		Subject:  "Zoë shared a secret with you\r\nBcc: eve@example.net",
+		HTMLBody: "<p>Hello <b>Zoë</b></p>",
+		Date:     time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC),
+	}
+	raw, err := msg.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, line := range strings.Split(string(raw[:bytes.Index(raw, []byte("\r\n\r\n"))]), "\r\n") {
+		if name, _, ok := strings.Cut(line, ":"); ok && !strings.HasPrefix(line, " ") {
+			names = append(names, name)
+		}
+	}
+	want := "Date,From,To,Reply-To,Message-ID,Subject,MIME-Version,Content-Type"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("headers = %s, want %s", got, want)
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := parsed.Header
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != "Zoë shared a secret with you  Bcc: eve@example.net" {
+		t.Errorf("Subject = %q", subject)
+	}
+	if header.Get("Bcc") != "" {
+		t.Error("line breaks in the subject injected a header")
+	}
+	if to, err := header.AddressList("To"); err != nil || to[0].Name != "Zoë" || to[0].Address != "zoe@example.org" {
+		t.Errorf("To = %v, %v", to, err)
+	}
+	if header.Get("Reply-To") != "<alice@example.com>" {
+		t.Errorf("Reply-To = %q", header.Get("Reply-To"))
+	}
+	if date, err := header.Date(); err != nil || !date.Equal(msg.Date) {
+		t.Errorf("Date = %v, %v", date, err)
+	}
+	if id := header.Get("Message-ID"); !strings.HasPrefix(id, "<") || !strings.HasSuffix(id, "@example.com>") {
+		t.Errorf("Message-ID = %q", id)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, %v", header.Get("Content-Type"), err)
+	}
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	for _, want := range []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", "Hello Zoë\n"},
+		{"text/html; charset=UTF-8", "<p>Hello <b>Zoë</b></p>"},
+	} {
+		part, err := mr.NextRawPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := part.Header.Get("Content-Type"); got != want.contentType {
+			t.Errorf("part Content-Type = %q, want %q", got, want.contentType)
+		}
+		body, err := io.ReadAll(quotedprintable.NewReader(part))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.ReplaceAll(string(body), "\r\n", "\n"); got != want.body {
+			t.Errorf("%s part = %q, want %q", want.contentType, got, want.body)
+		}
+	}
+	if _, err := mr.NextPart(); err != io.EOF {
+		t.Errorf("unexpected extra part: %v", err)
+	}
+}
+
+func TestMessageBytesFoldsLongHeaders(t *testing.T) {
+	msg := &Message{
+		From:     "noreply@example.com",
+		To:       "bob@example.org",
+		Subject:  strings.Repeat("Größenänderung der geteilten Datei ", 10),
+		HTMLBody: "<p>Hi</p>",
+	}
+	raw, err := msg.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > maxHeaderLineLen {
+			t.Errorf("line longer than %d characters: %q", maxHeaderLineLen, line)
+		}
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != msg.Subject {
+		t.Errorf("Subject = %q, want %q", subject, msg.Subject)
+	}
+}
+
+func TestMessageBytesRejectsInvalidAddresses(t *testing.T) {
+	for _, msg := range []*Message{
+		{From: "not an address", To: "bob@example.org"},
+		{From: "noreply@example.com", To: ""},
+		{From: "noreply@example.com", To: "bob@example.org", ReplyTo: "@"},
+	} {
+		if _, err := msg.Bytes(); err == nil {
+			t.Errorf("Bytes() accepted From %q, To %q, Reply-To %q", msg.From, msg.To, msg.ReplyTo)
+		}
+	}
+}
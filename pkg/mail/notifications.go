@@ -0,0 +1,140 @@
+package mail
+
+// notificationStyle is shared by the built-in sender notification templates.
+const notificationStyle = `
+    body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; background: #f5f5f5; margin: 0; padding: 20px; }
+    .container { max-width: 600px; margin: 0 auto; background: #fff; border-radius: 8px; padding: 40px; box-shadow: 0 2px 4px rgba(0,0,0,0.1); }
+    .header { text-align: center; margin-bottom: 30px; }
+    .header h1 { color: #1a1a1a; font-size: 24px; margin: 0; }
+    .content { color: #333; line-height: 1.6; }
+    .details { background: #f8f9fa; border-left: 4px solid #4f46e5; padding: 15px; margin: 20px 0; border-radius: 0 4px 4px 0; }
+    .footer { text-align: center; color: #999; font-size: 12px; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee; }`
+
+// DefaultViewedSubjectTemplate is the subject of the notification sent to the
+// sender when the recipient viewed the share.
+const DefaultViewedSubjectTemplate = `{{.RecipientEmail}} viewed your shared {{.ResourceType}}`
+
+// DefaultViewedHTMLBodyTemplate is the body of the notification sent to the
+// sender when the recipient viewed the share.
+const DefaultViewedHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <style>` + notificationStyle + `
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Share viewed</h1>
+    </div>
+    <div class="content">
+      <p>The {{.ResourceType}} <strong>{{.ResourceName}}</strong> you shared with <strong>{{.RecipientEmail}}</strong> has been viewed. The link can no longer be used.</p>
+      <div class="details">
+        {{if .EventTime}}<p>Viewed at: {{.EventTime}}</p>{{end}}
+        {{if .ClientIP}}<p>From IP: {{.ClientIP}}</p>{{end}}
+      </div>
+    </div>
+    <div class="footer">
+      <p>This email was sent via Go Tangra Sharing</p>
+    </div>
+  </div>
+</body>
+</html>`
+
+// DefaultDeniedSubjectTemplate is the subject of the notification sent to the
+// sender when an access attempt was denied by policy.
+const DefaultDeniedSubjectTemplate = `Access to your shared {{.ResourceType}} was denied`
+
+// DefaultDeniedHTMLBodyTemplate is the body of the notification sent to the
+// sender when an access attempt was denied by policy.
+const DefaultDeniedHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <style>` + notificationStyle + `
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Access denied</h1>
+    </div>
+    <div class="content">
+      <p>Someone tried to open the {{.ResourceType}} <strong>{{.ResourceName}}</strong> you shared with <strong>{{.RecipientEmail}}</strong>, but the request was blocked by an access policy.</p>
+      <div class="details">
+        {{if .EventTime}}<p>Attempted at: {{.EventTime}}</p>{{end}}
+        {{if .ClientIP}}<p>From IP: {{.ClientIP}}</p>{{end}}
+        {{if .DenyReason}}<p>Reason: {{.DenyReason}}</p>{{end}}
+      </div>
+      <p>The link is still valid. Revoke it if you do not recognise this attempt.</p>
+    </div>
+    <div class="footer">
+      <p>This email was sent via Go Tangra Sharing</p>
+    </div>
+  </div>
+</body>
+</html>`
+
+// DefaultExpiredSubjectTemplate is the subject of the notification sent to the
+// sender when the share expired without being viewed.
+const DefaultExpiredSubjectTemplate = `Your shared {{.ResourceType}} expired unviewed`
+
+// DefaultExpiredHTMLBodyTemplate is the body of the notification sent to the
+// sender when the share expired without being viewed.
+const DefaultExpiredHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <style>` + notificationStyle + `
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Share expired</h1>
+    </div>
+    <div class="content">
+      <p>The {{.ResourceType}} <strong>{{.ResourceName}}</strong> you shared with <strong>{{.RecipientEmail}}</strong> expired before it was viewed. The link no longer works.</p>
+      {{if .EventTime}}<div class="details"><p>Expired at: {{.EventTime}}</p></div>{{end}}
+      <p>If the recipient still needs access, create a new share.</p>
+    </div>
+    <div class="footer">
+      <p>This email was sent via Go Tangra Sharing</p>
+    </div>
+  </div>
+</body>
+</html>`
+
+// DefaultVerificationCodeSubjectTemplate is the subject of the email sent to
+// the recipient when opening a share requires an email verification code.
+const DefaultVerificationCodeSubjectTemplate = `Your verification code for the shared {{.ResourceType}}`
+
+// DefaultVerificationCodeHTMLBodyTemplate is the body of the email sent to
+// the recipient when opening a share requires an email verification code.
+const DefaultVerificationCodeHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <style>` + notificationStyle + `
+    .code { font-size: 32px; font-weight: 600; letter-spacing: 8px; text-align: center; }
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Verification code</h1>
+    </div>
+    <div class="content">
+      <p>Enter this code to open the {{.ResourceType}} <strong>{{.ResourceName}}</strong> shared with you:</p>
+      <div class="details">
+        <p class="code">{{.VerificationCode}}</p>
+      </div>
+      <p>The code expires in {{.CodeExpiresIn}}. If you did not try to open this share, ignore this email and do not give the code to anyone.</p>
+    </div>
+    <div class="footer">
+      <p>This email was sent via Go Tangra Sharing</p>
+    </div>
+  </div>
+</body>
+</html>`
@@ -3,7 +3,9 @@ package mail
 import (
 	"bytes"
 	"fmt"
-	"html/template"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
 )
 
 // TemplateData holds the variables available in email templates.
@@ -14,32 +16,68 @@ type TemplateData struct {
 	Message        string
 	ResourceName   string
 	ResourceType   string
+
+	// Sender notification fields
+	LeakSource string
+	LeakURL    string
+	ClientIP   string
+	DenyReason string
+	EventTime  string
+
+	// Step-up challenge fields
+	VerificationCode string
+	CodeExpiresIn    string
 }
 
-// RenderTemplate renders a Go html/template with the given data.
-// Returns the rendered subject and body.
-func RenderTemplate(subjectTemplate, htmlBodyTemplate string, data TemplateData) (subject, body string, err error) {
-	// Render subject
-	subjectTmpl, err := template.New("subject").Parse(subjectTemplate)
+// Content is a rendered email.
+type Content struct {
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// RenderTemplate renders an email with the given data. The subject and the
+// plain text body are rendered with text/template, so they are not
+// HTML-escaped, and the HTML body with html/template. When textBodyTemplate
+// is empty the text body is generated from the rendered HTML body.
+func RenderTemplate(subjectTemplate, htmlBodyTemplate, textBodyTemplate string, data TemplateData) (*Content, error) {
+	subject, err := renderText("subject", subjectTemplate, data)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to parse subject template: %w", err)
-	}
-	var subjectBuf bytes.Buffer
-	if err := subjectTmpl.Execute(&subjectBuf, data); err != nil {
-		return "", "", fmt.Errorf("failed to render subject template: %w", err)
+		return nil, err
 	}
 
-	// Render body
-	bodyTmpl, err := template.New("body").Parse(htmlBodyTemplate)
+	bodyTmpl, err := htmltemplate.New("body").Parse(htmlBodyTemplate)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to parse body template: %w", err)
+		return nil, fmt.Errorf("failed to parse body template: %w", err)
 	}
 	var bodyBuf bytes.Buffer
 	if err := bodyTmpl.Execute(&bodyBuf, data); err != nil {
-		return "", "", fmt.Errorf("failed to render body template: %w", err)
+		return nil, fmt.Errorf("failed to render body template: %w", err)
 	}
 
-	return subjectBuf.String(), bodyBuf.String(), nil
+	content := &Content{
+		Subject:  strings.Join(strings.Fields(subject), " "),
+		HTMLBody: bodyBuf.String(),
+	}
+	if textBodyTemplate == "" {
+		content.TextBody = HTMLToText(content.HTMLBody)
+	} else if content.TextBody, err = renderText("text body", textBodyTemplate, data); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// renderText renders a text/template
+func renderText(name, tmpl string, data TemplateData) (string, error) {
+	t, err := texttemplate.New(name).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+	return buf.String(), nil
 }
 
 // DefaultSubjectTemplate is the default email subject template.
@@ -86,3 +124,43 @@ const DefaultHTMLBodyTemplate = `<!DOCTYPE html>
   </div>
 </body>
 </html>`
+
+// DefaultLeakSubjectTemplate is the subject of the notification sent to the
+// sender when their share token was reported as leaked.
+const DefaultLeakSubjectTemplate = `Your shared {{.ResourceType}} link was exposed and has been revoked`
+
+// DefaultLeakHTMLBodyTemplate is the body of the notification sent to the
+// sender when their share token was reported as leaked.
+const DefaultLeakHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <style>
+    body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; background: #f5f5f5; margin: 0; padding: 20px; }
+    .container { max-width: 600px; margin: 0 auto; background: #fff; border-radius: 8px; padding: 40px; box-shadow: 0 2px 4px rgba(0,0,0,0.1); }
+    .header { text-align: center; margin-bottom: 30px; }
+    .header h1 { color: #dc2626; font-size: 24px; margin: 0; }
+    .content { color: #333; line-height: 1.6; }
+    .details { background: #f8f9fa; border-left: 4px solid #dc2626; padding: 15px; margin: 20px 0; border-radius: 0 4px 4px 0; }
+    .footer { text-align: center; color: #999; font-size: 12px; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee; }
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Share link revoked</h1>
+    </div>
+    <div class="content">
+      <p>The link you used to share the {{.ResourceType}} <strong>{{.ResourceName}}</strong> with <strong>{{.RecipientEmail}}</strong> was found in a public location and has been revoked automatically.</p>
+      <div class="details">
+        {{if .LeakSource}}<p>Reported by: {{.LeakSource}}</p>{{end}}
+        {{if .LeakURL}}<p>Found at: {{.LeakURL}}</p>{{end}}
+      </div>
+      <p>If the recipient still needs access, create a new share. Consider rotating the shared {{.ResourceType}} if the link may have been opened by someone else.</p>
+    </div>
+    <div class="footer">
+      <p>This email was sent via Go Tangra Sharing</p>
+    </div>
+  </div>
+</body>
+</html>`
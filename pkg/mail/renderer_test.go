@@ -0,0 +1,77 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	data := TemplateData{
+		SenderName:   "O'Brien & Co",
+		ResourceName: "<db password>",
+		ResourceType: "SECRET",
+		ShareLink:    "https://example.com/#/shared/abc",
+	}
+
+	content, err := RenderTemplate(DefaultSubjectTemplate, DefaultHTMLBodyTemplate, "", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Subject != "O'Brien & Co shared a SECRET with you" {
+		t.Errorf("Subject = %q", content.Subject)
+	}
+	if !strings.Contains(content.HTMLBody, "O&#39;Brien &amp; Co") {
+		t.Error("HTML body does not escape template data")
+	}
+	for _, want := range []string{
+		"O'Brien & Co has shared a SECRET with you: <db password>",
+		"View Shared SECRET (https://example.com/#/shared/abc)",
+	} {
+		if !strings.Contains(content.TextBody, want) {
+			t.Errorf("generated text body lacks %q:\n%s", want, content.TextBody)
+		}
+	}
+	if strings.Contains(content.TextBody, "font-family") {
+		t.Errorf("generated text body contains styles:\n%s", content.TextBody)
+	}
+
+	content, err = RenderTemplate("{{.SenderName}}\n shared", "<p>{{.SenderName}}</p>", "Open {{.ShareLink}}, {{.SenderName}}", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Subject != "O'Brien & Co shared" {
+		t.Errorf("Subject = %q", content.Subject)
+	}
+	if content.TextBody != "Open https://example.com/#/shared/abc, O'Brien & Co" {
+		t.Errorf("TextBody = %q", content.TextBody)
+	}
+
+	if _, err := RenderTemplate("ok", "<p>ok</p>", "{{.Missing", data); err == nil {
+		t.Error("RenderTemplate() accepted an invalid text body template")
+	}
+}
+
+func TestHTMLToText(t *testing.T) {
+	got := HTMLToText(`<html><head><title>Ignored</title><style>p { color: red; }</style></head>
+<body>
+  <h1>Share   revoked</h1>
+  <p>The link to <strong>report.pdf</strong> was
+     revoked.<br>Create a new share.</p>
+  <ul><li>First</li><li>Second <a href="https://example.com/docs">docs</a></li></ul>
+  <p><a href="https://example.com/x">https://example.com/x</a> <a href="#top">top</a></p>
+</body></html>`)
+
+	want := `Share revoked
+
+The link to report.pdf was revoked.
+Create a new share.
+
+- First
+- Second docs (https://example.com/docs)
+
+https://example.com/x top
+`
+	if got != want {
+		t.Errorf("HTMLToText() =\n%s\nwant\n%s", got, want)
+	}
+}
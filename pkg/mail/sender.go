@@ -3,6 +3,7 @@ package mail
 import (
 	"crypto/tls"
 	"fmt"
+	"net/mail"
 	"net/smtp"
 	"os"
 	"strconv"
@@ -14,7 +15,8 @@ type SMTPConfig struct {
 	Port     int
 	Username string
 	Password string
-	From     string
+	From     string // Sender address, optionally with a display name ("Name <addr>")
+	ReplyTo  string // Default Reply-To address, used when a message sets none
 	TLSMode  string // "none", "starttls", "tls" (implicit TLS)
 }
 
@@ -41,6 +43,7 @@ func NewSMTPConfigFromEnv() *SMTPConfig {
 		Username: getEnv("SMTP_USERNAME", ""),
 		Password: getEnv("SMTP_PASSWORD", ""),
 		From:     getEnv("SMTP_FROM", "noreply@example.com"),
+		ReplyTo:  getEnv("SMTP_REPLY_TO", ""),
 		TLSMode:  tlsMode,
 	}
 }
@@ -55,23 +58,29 @@ func NewSender(config *SMTPConfig) *Sender {
 	return &Sender{config: config}
 }
 
-// Send sends an email with HTML body.
-func (s *Sender) Send(to, subject, htmlBody string) error {
-	addr := fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
-
-	headers := map[string]string{
-		"From":         s.config.From,
-		"To":           to,
-		"Subject":      subject,
-		"MIME-Version": "1.0",
-		"Content-Type": "text/html; charset=UTF-8",
+// Send sends a message from the configured sender address. The configured
+// Reply-To is used when the message sets none.
+func (s *Sender) Send(msg *Message) error {
+	from, err := mail.ParseAddress(s.config.From)
+	if err != nil {
+		return fmt.Errorf("invalid SMTP_FROM address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
 	}
 
-	var message string
-	for k, v := range headers {
-		message += fmt.Sprintf("%s: %s\r\n", k, v)
+	m := *msg
+	m.From = s.config.From
+	if m.ReplyTo == "" {
+		m.ReplyTo = s.config.ReplyTo
 	}
-	message += "\r\n" + htmlBody
+	message, err := m.Bytes()
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+
+	addr := fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
 
 	var auth smtp.Auth
 	if s.config.Username != "" {
@@ -80,15 +89,15 @@ func (s *Sender) Send(to, subject, htmlBody string) error {
 
 	switch s.config.TLSMode {
 	case "tls":
-		return s.sendWithImplicitTLS(addr, auth, to, []byte(message))
+		return s.sendWithImplicitTLS(addr, auth, from.Address, to.Address, message)
 	case "starttls":
-		return s.sendWithSTARTTLS(addr, auth, to, []byte(message))
+		return s.sendWithSTARTTLS(addr, auth, from.Address, to.Address, message)
 	default:
-		return smtp.SendMail(addr, auth, s.config.From, []string{to}, []byte(message))
+		return smtp.SendMail(addr, auth, from.Address, []string{to.Address}, message)
 	}
 }
 
-func (s *Sender) sendWithImplicitTLS(addr string, auth smtp.Auth, to string, msg []byte) error {
+func (s *Sender) sendWithImplicitTLS(addr string, auth smtp.Auth, from, to string, msg []byte) error {
 	tlsConfig := &tls.Config{
 		ServerName: s.config.Host,
 		MinVersion: tls.VersionTLS12,
@@ -105,10 +114,10 @@ func (s *Sender) sendWithImplicitTLS(addr string, auth smtp.Auth, to string, msg
 	}
 	defer client.Close()
 
-	return s.sendViaSMTPClient(client, auth, to, msg)
+	return s.sendViaSMTPClient(client, auth, from, to, msg)
 }
 
-func (s *Sender) sendWithSTARTTLS(addr string, auth smtp.Auth, to string, msg []byte) error {
+func (s *Sender) sendWithSTARTTLS(addr string, auth smtp.Auth, from, to string, msg []byte) error {
 	client, err := smtp.Dial(addr)
 	if err != nil {
 		return fmt.Errorf("failed to connect to SMTP server: %w", err)
@@ -123,17 +132,17 @@ func (s *Sender) sendWithSTARTTLS(addr string, auth smtp.Auth, to string, msg []
 		return fmt.Errorf("STARTTLS failed: %w", err)
 	}
 
-	return s.sendViaSMTPClient(client, auth, to, msg)
+	return s.sendViaSMTPClient(client, auth, from, to, msg)
 }
 
-func (s *Sender) sendViaSMTPClient(client *smtp.Client, auth smtp.Auth, to string, msg []byte) error {
+func (s *Sender) sendViaSMTPClient(client *smtp.Client, auth smtp.Auth, from, to string, msg []byte) error {
 	if auth != nil {
 		if err := client.Auth(auth); err != nil {
 			return fmt.Errorf("SMTP auth failed: %w", err)
 		}
 	}
 
-	if err := client.Mail(s.config.From); err != nil {
+	if err := client.Mail(from); err != nil {
 		return fmt.Errorf("SMTP MAIL FROM failed: %w", err)
 	}
 	if err := client.Rcpt(to); err != nil {
@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLToText converts an HTML email body to a plain text alternative. Block
+// elements become line breaks, list items are prefixed with "- " and links
+// keep their target after the link text; markup in head, style and script
+// elements is dropped.
+func HTMLToText(body string) string {
+	var (
+		b       strings.Builder
+		skip    int      // depth inside elements whose text is not shown
+		hrefs   []string // targets of the open links
+		pending bool     // a space is owed before the next word
+	)
+
+	newline := func(n int) {
+		pending = false
+		text := b.String()
+		if text == "" {
+			return
+		}
+		for have := len(text) - len(strings.TrimRight(text, "\n")); have < n; have++ {
+			b.WriteByte('\n')
+		}
+	}
+	write := func(s string) {
+		if text := b.String(); pending && text != "" && !strings.HasSuffix(text, "\n") && !strings.HasSuffix(text, " ") {
+			b.WriteByte(' ')
+		}
+		pending = false
+		b.WriteString(s)
+	}
+
+	z := html.NewTokenizer(strings.NewReader(body))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		tok := z.Token()
+
+		switch tt {
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			words := strings.Fields(tok.Data)
+			if len(words) == 0 {
+				pending = pending || tok.Data != ""
+				continue
+			}
+			if startsWithSpace(tok.Data) {
+				pending = true
+			}
+			write(strings.Join(words, " "))
+			pending = endsWithSpace(tok.Data)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch tok.DataAtom {
+			case atom.Head, atom.Style, atom.Script, atom.Title:
+				if tt == html.StartTagToken {
+					skip++
+				}
+			case atom.Br:
+				b.WriteByte('\n')
+				pending = false
+			case atom.P, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+				atom.Table, atom.Ul, atom.Ol, atom.Blockquote, atom.Hr:
+				newline(2)
+			case atom.Tr:
+				newline(1)
+			case atom.Td, atom.Th:
+				pending = true
+			case atom.Li:
+				newline(1)
+				b.WriteString("- ")
+			case atom.A:
+				if tt == html.StartTagToken {
+					hrefs = append(hrefs, attr(tok, "href"))
+				}
+			}
+
+		case html.EndTagToken:
+			switch tok.DataAtom {
+			case atom.Head, atom.Style, atom.Script, atom.Title:
+				if skip > 0 {
+					skip--
+				}
+			case atom.P, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+				atom.Table, atom.Ul, atom.Ol, atom.Blockquote:
+				newline(2)
+			case atom.Tr, atom.Li:
+				newline(1)
+			case atom.A:
+				if len(hrefs) == 0 {
+					continue
+				}
+				href := hrefs[len(hrefs)-1]
+				hrefs = hrefs[:len(hrefs)-1]
+				if linkTarget(href) && !strings.HasSuffix(b.String(), href) {
+					pending = true
+					write("(" + href + ")")
+				}
+			}
+		}
+	}
+
+	return strings.TrimSpace(b.String()) + "\n"
+}
+
+// linkTarget reports whether href is worth showing in the text part
+func linkTarget(href string) bool {
+	return href != "" && !strings.HasPrefix(href, "#") && !strings.HasPrefix(strings.ToLower(href), "javascript:")
+}
+
+// attr returns the value of an attribute of a tag, "" when it is not set
+func attr(tok html.Token, name string) string {
+	for _, a := range tok.Attr {
+		if a.Key == name {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+func startsWithSpace(s string) bool {
+	return s != "" && strings.TrimLeft(s, " \t\r\n\f") != s
+}
+
+func endsWithSpace(s string) bool {
+	return s != "" && strings.TrimRight(s, " \t\r\n\f") != s
+}
@@ -0,0 +1,153 @@
+package pow
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
+)
+
+// Algorithm names the proof of work clients must compute: a solution for a
+// challenge is a string such that SHA-256(challenge + ":" + solution) starts
+// with at least difficulty zero bits.
+const Algorithm = "sha256-leading-zero-bits"
+
+const (
+	// version prefixes challenges so their format can change
+	version = "v1"
+
+	// nonceLen is the number of random bytes of a challenge
+	nonceLen = 16
+
+	// MaxDifficulty bounds the difficulty of a challenge
+	MaxDifficulty = 32
+
+	// MaxSolutionLen bounds the length of a solution
+	MaxSolutionLen = 32
+
+	// keyLabel separates the challenge key from other uses of the encryption key
+	keyLabel = "tangra-share-proof-of-work"
+)
+
+var (
+	// ErrInvalid is returned for challenges that were not issued for the scope
+	ErrInvalid = errors.New("invalid proof of work challenge")
+	// ErrExpired is returned for challenges past their expiry
+	ErrExpired = errors.New("proof of work challenge expired")
+	// ErrUnsolved is returned when the solution does not solve the challenge
+	ErrUnsolved = errors.New("proof of work solution does not solve the challenge")
+)
+
+// DeriveKey derives the key signing challenges from the share encryption key
+func DeriveKey(encryptionKey []byte) []byte {
+	return crypto.DeriveSubkey(encryptionKey, keyLabel)
+}
+
+// Issue creates a signed challenge of the given difficulty for a scope, such
+// as the share token the client wants to open. Challenges carry their
+// difficulty and expiry, so issuing them keeps no state; Used tells apart the
+// challenges that were already spent.
+func Issue(key []byte, scope string, difficulty int, expiresAt time.Time) (string, error) {
+	if difficulty < 0 || difficulty > MaxDifficulty {
+		return "", fmt.Errorf("difficulty must be between 0 and %d", MaxDifficulty)
+	}
+	nonce := make([]byte, nonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	body := strings.Join([]string{
+		version,
+		base64.RawURLEncoding.EncodeToString(nonce),
+		strconv.Itoa(difficulty),
+		strconv.FormatInt(expiresAt.Unix(), 10),
+	}, ".")
+	return body + "." + base64.RawURLEncoding.EncodeToString(sign(key, scope, body)), nil
+}
+
+// Verify checks that challenge was issued for scope, has not expired and is
+// solved by solution. It returns the difficulty of the challenge.
+func Verify(key []byte, scope, challenge, solution string, now time.Time) (int, error) {
+	body, encodedSig, ok := cutLast(challenge, ".")
+	if !ok {
+		return 0, ErrInvalid
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, sign(key, scope, body)) {
+		return 0, ErrInvalid
+	}
+
+	parts := strings.Split(body, ".")
+	if len(parts) != 4 || parts[0] != version {
+		return 0, ErrInvalid
+	}
+	difficulty, err := strconv.Atoi(parts[2])
+	if err != nil || difficulty < 0 || difficulty > MaxDifficulty {
+		return 0, ErrInvalid
+	}
+	expires, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return difficulty, ErrExpired
+	}
+
+	if len(solution) > MaxSolutionLen || !Solves(challenge, solution, difficulty) {
+		return difficulty, ErrUnsolved
+	}
+	return difficulty, nil
+}
+
+// Solves reports whether solution gives the hash of challenge at least difficulty leading zero bits
+func Solves(challenge, solution string, difficulty int) bool {
+	sum := sha256.Sum256([]byte(challenge + ":" + solution))
+	return leadingZeroBits(sum[:]) >= difficulty
+}
+
+// Solve finds a solution for a challenge by counting up from zero. It takes
+// about 2^difficulty hashes; clients may use any search strategy.
+func Solve(challenge string, difficulty int) string {
+	for i := uint64(0); ; i++ {
+		solution := strconv.FormatUint(i, 10)
+		if Solves(challenge, solution, difficulty) {
+			return solution
+		}
+	}
+}
+
+// leadingZeroBits counts the zero bits at the start of b
+func leadingZeroBits(b []byte) int {
+	n := 0
+	for _, c := range b {
+		if c != 0 {
+			return n + bits.LeadingZeros8(c)
+		}
+		n += 8
+	}
+	return n
+}
+
+// sign authenticates a challenge body for a scope
+func sign(key []byte, scope, body string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(scope))
+	mac.Write([]byte{0})
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
+
+// cutLast slices s around the last instance of sep
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,82 @@
+package pow
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIssueVerify(t *testing.T) {
+	key := DeriveKey([]byte("0123456789abcdef0123456789abcdef"))
+	now := time.Unix(1_800_000_000, 0)
+
+	challenge, err := Issue(key, "tgs_token", 12, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution := Solve(challenge, 12)
+
+	difficulty, err := Verify(key, "tgs_token", challenge, solution, now)
+	if err != nil || difficulty != 12 {
+		t.Fatalf("Verify() = %d, %v; want 12, nil", difficulty, err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		key       []byte
+		scope     string
+		challenge string
+		solution  string
+		now       time.Time
+		want      error
+	}{
+		{"other scope", key, "tgs_other", challenge, solution, now, ErrInvalid},
+		{"other key", DeriveKey([]byte("another key")), "tgs_token", challenge, solution, now, ErrInvalid},
+		{"tampered difficulty", key, "tgs_token", replaceDifficulty(challenge, "0"), solution, now, ErrInvalid},
+		{"garbage", key, "tgs_token", "not-a-challenge", solution, now, ErrInvalid},
+		{"expired", key, "tgs_token", challenge, solution, now.Add(time.Minute), ErrExpired},
+		{"too long solution", key, "tgs_token", challenge, "000000000000000000000000000000000", now, ErrUnsolved},
+	} {
+		if _, err := Verify(tc.key, tc.scope, tc.challenge, tc.solution, tc.now); !errors.Is(err, tc.want) {
+			t.Errorf("%s: Verify() error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	// Counters that do not reach the difficulty are rejected
+	wrong := 0
+	for Solves(challenge, strconv.Itoa(wrong), 12) {
+		wrong++
+	}
+	if _, err := Verify(key, "tgs_token", challenge, strconv.Itoa(wrong), now); !errors.Is(err, ErrUnsolved) {
+		t.Errorf("wrong solution: Verify() error = %v, want ErrUnsolved", err)
+	}
+
+	if _, err := Issue(key, "tgs_token", MaxDifficulty+1, now); err == nil {
+		t.Error("Issue() accepted a difficulty above MaxDifficulty")
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	for _, tc := range []struct {
+		b    []byte
+		want int
+	}{
+		{[]byte{0x80}, 0},
+		{[]byte{0x01}, 7},
+		{[]byte{0x00, 0x10}, 11},
+		{[]byte{0x00, 0x00}, 16},
+	} {
+		if got := leadingZeroBits(tc.b); got != tc.want {
+			t.Errorf("leadingZeroBits(%x) = %d, want %d", tc.b, got, tc.want)
+		}
+	}
+}
+
+// replaceDifficulty swaps the difficulty of a challenge, keeping its signature
+func replaceDifficulty(challenge, difficulty string) string {
+	parts := strings.Split(challenge, ".")
+	parts[2] = difficulty
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,65 @@
+package pow
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pruneInterval is how often Used drops the challenges that expired
+const pruneInterval = time.Minute
+
+// Used remembers the nonces of the challenges that were spent until they
+// expire, so each solved challenge is accepted once. It is kept in memory:
+// instances behind a load balancer each accept a challenge once.
+type Used struct {
+	mu        sync.Mutex
+	nonces    map[string]time.Time
+	nextPrune time.Time
+}
+
+// NewUsed creates an empty set of used challenges
+func NewUsed() *Used {
+	return &Used{nonces: make(map[string]time.Time)}
+}
+
+// Spend marks a challenge that Verify accepted as used. It reports false when
+// the challenge was already used, has expired or is not a challenge.
+func (u *Used) Spend(challenge string, now time.Time) bool {
+	nonce, expiresAt, ok := parseNonce(challenge)
+	if !ok {
+		return false
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if !now.Before(u.nextPrune) {
+		for n, exp := range u.nonces {
+			if !now.Before(exp) {
+				delete(u.nonces, n)
+			}
+		}
+		u.nextPrune = now.Add(pruneInterval)
+	}
+
+	if _, used := u.nonces[nonce]; used || !now.Before(expiresAt) {
+		return false
+	}
+	u.nonces[nonce] = expiresAt
+	return true
+}
+
+// parseNonce returns the nonce and expiry of a challenge
+func parseNonce(challenge string) (string, time.Time, bool) {
+	parts := strings.Split(challenge, ".")
+	if len(parts) != 5 || parts[0] != version || parts[1] == "" {
+		return "", time.Time{}, false
+	}
+	expires, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return parts[1], time.Unix(expires, 0), true
+}
@@ -0,0 +1,41 @@
+package pow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUsedSpendsChallengesOnce(t *testing.T) {
+	key := DeriveKey([]byte("0123456789abcdef0123456789abcdef"))
+	now := time.Unix(1_800_000_000, 0)
+	first, err := Issue(key, "tgs_token", 4, now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Issue(key, "tgs_token", 4, now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := NewUsed()
+	if !u.Spend(first, now) {
+		t.Fatal("first use of a challenge rejected")
+	}
+	if u.Spend(first, now.Add(time.Minute)) {
+		t.Fatal("replayed challenge accepted")
+	}
+	if !u.Spend(second, now.Add(time.Minute)) {
+		t.Fatal("other challenge rejected")
+	}
+	if u.Spend("not-a-challenge", now) {
+		t.Fatal("malformed challenge accepted")
+	}
+
+	// Expired challenges are refused and forgotten
+	if u.Spend(first, now.Add(3*time.Minute)) {
+		t.Fatal("expired challenge accepted")
+	}
+	if len(u.nonces) != 0 {
+		t.Fatalf("%d expired challenge(s) kept", len(u.nonces))
+	}
+}
@@ -0,0 +1,107 @@
+package stepup
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-sharing/pkg/crypto"
+)
+
+const (
+	// passphraseScheme identifies the passphrase hash format
+	passphraseScheme = "pbkdf2-sha256"
+
+	// passphraseIterations follows the OWASP recommendation for PBKDF2-HMAC-SHA256
+	passphraseIterations = 600_000
+
+	passphraseSaltLen = 16
+	passphraseKeyLen  = 32
+
+	// CodeDigits is the length of an email verification code
+	CodeDigits = 6
+
+	// keyLabel separates the code key from other uses of the encryption key
+	keyLabel = "tangra-share-verification-code"
+)
+
+// HashPassphrase hashes a step-up passphrase for storage as
+// "pbkdf2-sha256$<iterations>$<salt>$<key>"
+func HashPassphrase(passphrase string) (string, error) {
+	salt := make([]byte, passphraseSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, passphraseIterations, passphraseKeyLen)
+	if err != nil {
+		return "", fmt.Errorf("derive key: %w", err)
+	}
+	return strings.Join([]string{
+		passphraseScheme,
+		strconv.Itoa(passphraseIterations),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// VerifyPassphrase reports whether passphrase matches a hash created by HashPassphrase
+func VerifyPassphrase(passphrase, encoded string) bool {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || parts[0] != passphraseScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	want, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil || len(want) == 0 {
+		return false
+	}
+	got, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, len(want))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
+
+// DeriveKey derives the key authenticating verification codes from the share encryption key
+func DeriveKey(encryptionKey []byte) []byte {
+	return crypto.DeriveSubkey(encryptionKey, keyLabel)
+}
+
+// NewCode generates a random numeric email verification code
+func NewCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("generate code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", CodeDigits, n.Int64()), nil
+}
+
+// HashCode keys a verification code to a share for storage. The key keeps the
+// small code space from being brute-forced from a leaked hash.
+func HashCode(key []byte, shareID, code string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(shareID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCode reports whether code matches a hash created by HashCode for shareID
+func VerifyCode(key []byte, shareID, code, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashCode(key, shareID, code)), []byte(hash)) == 1
+}
@@ -0,0 +1,61 @@
+package stepup
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPassphrase(t *testing.T) {
+	hash, err := HashPassphrase("correct horse battery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, passphraseScheme+"$") {
+		t.Fatalf("unexpected hash format %q", hash)
+	}
+	if !VerifyPassphrase("correct horse battery", hash) {
+		t.Fatal("passphrase does not verify against its hash")
+	}
+
+	for _, tc := range []struct {
+		name       string
+		passphrase string
+		hash       string
+	}{
+		{"wrong passphrase", "correct horse staple", hash},
+		{"empty hash", "correct horse battery", ""},
+		{"unknown scheme", "correct horse battery", "bcrypt" + strings.TrimPrefix(hash, passphraseScheme)},
+		{"truncated hash", "correct horse battery", hash[:strings.LastIndex(hash, "$")]},
+	} {
+		if VerifyPassphrase(tc.passphrase, tc.hash) {
+			t.Errorf("%s: VerifyPassphrase() = true, want false", tc.name)
+		}
+	}
+
+	if other, _ := HashPassphrase("correct horse battery"); other == hash {
+		t.Error("hashes of the same passphrase share a salt")
+	}
+}
+
+func TestCode(t *testing.T) {
+	key := DeriveKey([]byte("0123456789abcdef0123456789abcdef"))
+
+	code, err := NewCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != CodeDigits || strings.Trim(code, "0123456789") != "" {
+		t.Fatalf("NewCode() = %q, want %d digits", code, CodeDigits)
+	}
+
+	hash := HashCode(key, "share-1", code)
+	if !VerifyCode(key, "share-1", code, hash) {
+		t.Fatal("code does not verify against its hash")
+	}
+	if VerifyCode(key, "share-2", code, hash) {
+		t.Error("code verifies for another share")
+	}
+	if VerifyCode(DeriveKey([]byte("another key")), "share-1", code, hash) {
+		t.Error("code verifies under another key")
+	}
+}
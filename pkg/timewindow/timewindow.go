@@ -0,0 +1,254 @@
+package timewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed TIME policy value: a list of windows separated by ";".
+// A schedule contains an instant when any of its windows does.
+//
+// Each window is a whitespace-separated list of constraints, all of which must hold:
+//
+//	Mon-Fri,Sun          days of the week (ranges and lists, Mon..Sun)
+//	09:00-17:00          time of day, 24-hour; an end before the start spans midnight
+//	from=2026-01-01      not before this date or date-time (YYYY-MM-DD[THH:MM])
+//	until=2026-03-31     not after this date (inclusive) or date-time
+//	Europe/Sofia         IANA time zone the other constraints are expressed in (default UTC)
+//
+// Example: "Mon-Fri 09:00-17:00 Europe/Sofia; Sat 10:00-12:00 Europe/Sofia".
+type Schedule struct {
+	windows []window
+}
+
+// window is a single set of constraints of a schedule
+type window struct {
+	loc *time.Location
+
+	days    [7]bool // indexed by time.Weekday
+	hasDays bool
+
+	start, end int // minutes since midnight
+	hasTime    bool
+
+	from, until time.Time
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Parse parses a TIME policy value
+func Parse(s string) (*Schedule, error) {
+	sched := &Schedule{}
+	for _, part := range strings.Split(s, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		w, err := parseWindow(part)
+		if err != nil {
+			return nil, fmt.Errorf("window %q: %w", strings.TrimSpace(part), err)
+		}
+		sched.windows = append(sched.windows, w)
+	}
+	if len(sched.windows) == 0 {
+		return nil, fmt.Errorf("time schedule is empty")
+	}
+	return sched, nil
+}
+
+// Contains reports whether t falls within any window of the schedule
+func (s *Schedule) Contains(t time.Time) bool {
+	for _, w := range s.windows {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseWindow(s string) (window, error) {
+	w := window{loc: time.UTC}
+	tokens := strings.Fields(s)
+
+	// The time zone applies to dates, so resolve it before the other tokens
+	var rest []string
+	hasZone := false
+	for _, tok := range tokens {
+		if !isZone(tok) {
+			rest = append(rest, tok)
+			continue
+		}
+		if hasZone {
+			return w, fmt.Errorf("more than one time zone")
+		}
+		loc, err := time.LoadLocation(tok)
+		if err != nil {
+			return w, fmt.Errorf("unknown time zone %q", tok)
+		}
+		w.loc = loc
+		hasZone = true
+	}
+
+	hasFrom, hasUntil := false, false
+	for _, tok := range rest {
+		lower := strings.ToLower(tok)
+		switch {
+		case strings.HasPrefix(lower, "from="):
+			if hasFrom {
+				return w, fmt.Errorf("more than one from= bound")
+			}
+			t, _, err := parseDate(tok[len("from="):], w.loc)
+			if err != nil {
+				return w, fmt.Errorf("from: %w", err)
+			}
+			w.from, hasFrom = t, true
+
+		case strings.HasPrefix(lower, "until="):
+			if hasUntil {
+				return w, fmt.Errorf("more than one until= bound")
+			}
+			t, dateOnly, err := parseDate(tok[len("until="):], w.loc)
+			if err != nil {
+				return w, fmt.Errorf("until: %w", err)
+			}
+			if dateOnly {
+				// A bare date includes the whole day
+				t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+			w.until, hasUntil = t, true
+
+		case strings.Contains(tok, ":"):
+			if w.hasTime {
+				return w, fmt.Errorf("more than one time range")
+			}
+			start, end, err := parseTimeRange(tok)
+			if err != nil {
+				return w, err
+			}
+			w.start, w.end, w.hasTime = start, end, true
+
+		default:
+			if w.hasDays {
+				return w, fmt.Errorf("more than one day set")
+			}
+			days, err := parseDays(tok)
+			if err != nil {
+				return w, err
+			}
+			w.days, w.hasDays = days, true
+		}
+	}
+
+	if !w.hasDays && !w.hasTime && !hasFrom && !hasUntil {
+		return w, fmt.Errorf("no day, time or date constraint")
+	}
+	if hasFrom && hasUntil && w.until.Before(w.from) {
+		return w, fmt.Errorf("until is before from")
+	}
+	return w, nil
+}
+
+// isZone reports whether a token names a time zone
+func isZone(tok string) bool {
+	return tok == "UTC" || (strings.Contains(tok, "/") && !strings.Contains(tok, ":") && !strings.Contains(tok, "="))
+}
+
+// parseDate parses YYYY-MM-DD or YYYY-MM-DDTHH:MM in loc and reports whether only a date was given
+func parseDate(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, loc); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04", s, loc); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or YYYY-MM-DDTHH:MM", s)
+}
+
+// parseTimeRange parses HH:MM-HH:MM into minutes since midnight
+func parseTimeRange(s string) (int, int, error) {
+	startStr, endStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", s)
+	}
+	start, err := parseClock(startStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseClock(endStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseClock parses HH:MM into minutes since midnight
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// parseDays parses a comma-separated list of days and day ranges such as "Mon-Fri,Sun"
+func parseDays(s string) ([7]bool, error) {
+	var days [7]bool
+	for _, item := range strings.Split(s, ",") {
+		firstStr, lastStr, isRange := strings.Cut(item, "-")
+		first, ok := weekdays[strings.ToLower(strings.TrimSpace(firstStr))]
+		if !ok {
+			return days, fmt.Errorf("invalid day %q, expected Mon, Tue, Wed, Thu, Fri, Sat or Sun", firstStr)
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdays[strings.ToLower(strings.TrimSpace(lastStr))]; !ok {
+				return days, fmt.Errorf("invalid day %q, expected Mon, Tue, Wed, Thu, Fri, Sat or Sun", lastStr)
+			}
+		}
+		// Ranges may wrap around the week, e.g. Fri-Mon
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func (w window) contains(t time.Time) bool {
+	t = t.In(w.loc)
+
+	if !w.from.IsZero() && t.Before(w.from) {
+		return false
+	}
+	if !w.until.IsZero() && t.After(w.until) {
+		return false
+	}
+
+	if !w.hasTime {
+		return !w.hasDays || w.days[t.Weekday()]
+	}
+
+	minutes := t.Hour()*60 + t.Minute()
+	if w.start <= w.end {
+		// Normal range: e.g. 09:00-17:00
+		return minutes >= w.start && minutes <= w.end && (!w.hasDays || w.days[t.Weekday()])
+	}
+	// Overnight range: e.g. 22:00-06:00; the early hours belong to the previous day's window
+	if minutes >= w.start {
+		return !w.hasDays || w.days[t.Weekday()]
+	}
+	if minutes <= w.end {
+		return !w.hasDays || w.days[(t.Weekday()+6)%7]
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package timewindow
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestScheduleContains(t *testing.T) {
+	sofia := mustLoad(t, "Europe/Sofia")
+
+	// 2026-03-02 is a Monday
+	for _, tc := range []struct {
+		schedule string
+		at       time.Time
+		want     bool
+	}{
+		// Legacy UTC window
+		{"09:00-17:00", time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), true},
+		{"09:00-17:00", time.Date(2026, 3, 2, 17, 1, 0, 0, time.UTC), false},
+		{"22:00-06:00", time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC), true},
+		{"22:00-06:00", time.Date(2026, 3, 2, 5, 0, 0, 0, time.UTC), true},
+		{"22:00-06:00", time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), false},
+
+		// Time zones: 08:30 UTC is 10:30 in Sofia
+		{"Mon-Fri 09:00-17:00 Europe/Sofia", time.Date(2026, 3, 2, 8, 30, 0, 0, time.UTC), true},
+		{"Mon-Fri 09:00-17:00 Europe/Sofia", time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC), false},
+		{"Mon-Fri 09:00-17:00 Europe/Sofia", time.Date(2026, 3, 7, 10, 0, 0, 0, sofia), false},
+
+		// Day sets
+		{"Sat,Sun", time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC), true},
+		{"Fri-Mon", time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), false},
+		{"Fri-Mon", time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), true},
+
+		// Overnight windows belong to the day they start on
+		{"Fri 22:00-02:00", time.Date(2026, 3, 7, 1, 0, 0, 0, time.UTC), true},
+		{"Fri 22:00-02:00", time.Date(2026, 3, 6, 1, 0, 0, 0, time.UTC), false},
+
+		// Absolute date ranges
+		{"from=2026-03-01 until=2026-03-31", time.Date(2026, 3, 31, 23, 59, 0, 0, time.UTC), true},
+		{"from=2026-03-01 until=2026-03-31", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), false},
+		{"from=2026-03-01T12:00 Europe/Sofia", time.Date(2026, 3, 1, 9, 59, 0, 0, time.UTC), false},
+		{"from=2026-03-01T12:00 Europe/Sofia", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), true},
+
+		// Multiple windows
+		{"Mon-Fri 09:00-17:00; Sat 10:00-12:00", time.Date(2026, 3, 7, 11, 0, 0, 0, time.UTC), true},
+		{"Mon-Fri 09:00-17:00; Sat 10:00-12:00", time.Date(2026, 3, 8, 11, 0, 0, 0, time.UTC), false},
+	} {
+		sched, err := Parse(tc.schedule)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.schedule, err)
+		}
+		if got := sched.Contains(tc.at); got != tc.want {
+			t.Errorf("%q.Contains(%s) = %v, want %v", tc.schedule, tc.at.Format(time.RFC3339), got, tc.want)
+		}
+	}
+}
+
+func TestParseRejectsInvalidSchedules(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"9-17",
+		"25:00-26:00",
+		"Mon-Fry 09:00-17:00",
+		"09:00-17:00 Mars/Olympus",
+		"09:00-17:00 10:00-11:00",
+		"from=2026-13-01",
+		"from=2026-03-31 until=2026-03-01",
+		"UTC",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", s)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxResponseBodySize caps how much of an endpoint response is read
+const maxResponseBodySize = 64 << 10
+
+// errBuildRequest is returned when the delivery request cannot be built
+var errBuildRequest = errors.New("failed to build request")
+
+// Event is the JSON envelope sent to webhook endpoints.
+type Event struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	TenantID  uint32         `json:"tenantId"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Data      map[string]any `json:"data"`
+}
+
+// Result describes the outcome of a single delivery attempt.
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// Success reports whether the endpoint accepted the delivery (2xx).
+func (r *Result) Success() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Client delivers signed payloads to webhook endpoints.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+	now        func() time.Time
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for deliveries.
+func WithHTTPClient(c *http.Client) Option {
+	return func(cl *Client) { cl.httpClient = c }
+}
+
+// WithClock sets the clock used for signature timestamps.
+func WithClock(now func() time.Time) Option {
+	return func(cl *Client) { cl.now = now }
+}
+
+// NewClient creates a new webhook Client. The default HTTP client refuses to
+// connect to internal addresses and does not follow redirects, so endpoints
+// cannot be used to reach services on the internal network.
+func NewClient(opts ...Option) *Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: denyInternalAddresses,
+	}
+	c := &Client{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+			// No proxy: the dial check must see the endpoint's own address
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConns:        10,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// A redirect is reported as a non-2xx response instead of being followed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		userAgent: "Tangra-Sharing-Webhook/1.0",
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Deliver POSTs a signed payload to url and reports the result.
+func (c *Client) Deliver(ctx context.Context, url, secret, deliveryID, eventType string, payload []byte) *Result {
+	start := c.now()
+	timestamp := start.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return &Result{Err: fmt.Errorf("%w: %w", errBuildRequest, err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, payload))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &Result{Duration: c.now().Sub(start), Err: err}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	result := &Result{
+		StatusCode: resp.StatusCode,
+		Duration:   c.now().Sub(start),
+	}
+	if !result.Success() {
+		result.Err = fmt.Errorf("endpoint returned HTTP %d", resp.StatusCode)
+	}
+	return result
+}
+
+// ErrorClass describes why a delivery failed without the details of the
+// underlying error, e.g. "HTTP 5xx" or "timeout", so that it can be shown to
+// tenants without revealing anything about the network the request was made
+// from. It returns "" for successful deliveries.
+func (r *Result) ErrorClass() string {
+	var netErr net.Error
+	switch {
+	case r.Success():
+		return ""
+	case r.StatusCode != 0:
+		return fmt.Sprintf("HTTP %dxx", r.StatusCode/100)
+	case errors.Is(r.Err, ErrForbiddenAddress):
+		return "address not allowed"
+	case errors.Is(r.Err, context.DeadlineExceeded), errors.As(r.Err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.Is(r.Err, errBuildRequest):
+		return "invalid request"
+	default:
+		return "connection failed"
+	}
+}
+
+// Backoff returns the delay before retry number attempt (1-based): 30s, 1m, 2m, ... capped at 1h.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := 30 * time.Second
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= time.Hour {
+			return time.Hour
+		}
+	}
+	return d
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDeliverSignsRequest(t *testing.T) {
+	payload := []byte(`{"id":"evt-1","type":"share.created"}`)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	var got *http.Request
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(WithHTTPClient(srv.Client()), WithClock(func() time.Time { return now }))
+	result := c.Deliver(context.Background(), srv.URL, "whsec_test", "d-1", "share.created", payload)
+	if !result.Success() || result.StatusCode != http.StatusNoContent || result.ErrorClass() != "" {
+		t.Fatalf("delivery failed: %+v", result)
+	}
+
+	if got.Method != http.MethodPost || got.Header.Get("Content-Type") != "application/json" || string(gotBody) != string(payload) {
+		t.Errorf("request = %s %s, body %s", got.Method, got.Header.Get("Content-Type"), gotBody)
+	}
+	if got.Header.Get(HeaderEvent) != "share.created" || got.Header.Get(HeaderDelivery) != "d-1" {
+		t.Errorf("event headers = %q, %q", got.Header.Get(HeaderEvent), got.Header.Get(HeaderDelivery))
+	}
+	ts, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil || ts != now.Unix() {
+		t.Fatalf("%s = %q, want %d", HeaderTimestamp, got.Header.Get(HeaderTimestamp), now.Unix())
+	}
+	if sig := got.Header.Get(HeaderSignature); sig != Sign("whsec_test", ts, payload) || !Verify("whsec_test", sig, ts, gotBody, 0) {
+		t.Errorf("%s = %q does not sign the body", HeaderSignature, sig)
+	}
+}
+
+func TestDeliverNon2xxFails(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusInternalServerError} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		result := NewClient(WithHTTPClient(srv.Client())).Deliver(context.Background(), srv.URL, "s", "d-1", "share.created", []byte(`{}`))
+		srv.Close()
+
+		if result.Success() || result.StatusCode != status || result.Err == nil {
+			t.Errorf("HTTP %d: %+v, want a failed delivery", status, result)
+		}
+		if want := strconv.Itoa(status/100) + "xx"; result.ErrorClass() != "HTTP "+want {
+			t.Errorf("HTTP %d: ErrorClass() = %q", status, result.ErrorClass())
+		}
+	}
+}
+
+func TestDeliverTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	httpClient := srv.Client()
+	httpClient.Timeout = 50 * time.Millisecond
+	result := NewClient(WithHTTPClient(httpClient)).Deliver(context.Background(), srv.URL, "s", "d-1", "share.created", []byte(`{}`))
+	if result.Success() || result.Err == nil || result.StatusCode != 0 {
+		t.Fatalf("slow endpoint: %+v, want a failed delivery", result)
+	}
+	if result.ErrorClass() != "timeout" {
+		t.Errorf("ErrorClass() = %q, want timeout", result.ErrorClass())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, want := range map[int]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		5:  8 * time.Minute,
+		7:  32 * time.Minute,
+		8:  time.Hour,
+		20: time.Hour,
+	} {
+		if got := Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
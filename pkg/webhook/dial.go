@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+// ErrForbiddenAddress is returned when an endpoint resolves to an address
+// webhooks may not be delivered to.
+var ErrForbiddenAddress = errors.New("webhook endpoint address is not allowed")
+
+// forbiddenPrefixes lists the ranges not covered by the netip predicates
+// checked in ForbiddenAddr: shared address space (which holds the Alibaba
+// Cloud metadata service), "this network", benchmarking, reserved (including
+// broadcast), the AWS IPv6 metadata service and local-use NAT64, whose
+// translation is specific to the network.
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("fd00:ec2::254/128"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+var (
+	// nat64Prefix is the well-known NAT64 prefix, embedding an IPv4 address in its last 32 bits
+	nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+	// sixToFourPrefix is the 6to4 prefix, embedding an IPv4 address in bits 16 to 47
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")
+)
+
+// ForbiddenAddr reports whether addr is a loopback, private (RFC 1918 and
+// IPv6 ULA), link-local (including the 169.254.169.254 metadata service),
+// unspecified, multicast or otherwise internal address. NAT64 and 6to4
+// addresses are judged by the IPv4 address they reach.
+func ForbiddenAddr(addr netip.Addr) bool {
+	addr = addr.WithZone("").Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, p := range forbiddenPrefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	if v4, ok := embeddedIPv4(addr); ok {
+		return ForbiddenAddr(v4)
+	}
+	return false
+}
+
+// embeddedIPv4 returns the IPv4 address a NAT64 or 6to4 address translates to
+func embeddedIPv4(addr netip.Addr) (netip.Addr, bool) {
+	b := addr.As16()
+	switch {
+	case nat64Prefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(b[12:16])), true
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(b[2:6])), true
+	}
+	return netip.Addr{}, false
+}
+
+// denyInternalAddresses is a net.Dialer Control function rejecting connections
+// to internal addresses. It runs after name resolution, on the address that is
+// actually dialed, so DNS names pointing at internal hosts are rejected too.
+func denyInternalAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if ForbiddenAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
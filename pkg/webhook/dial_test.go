@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestForbiddenAddr(t *testing.T) {
+	for _, tc := range []struct {
+		addr      string
+		forbidden bool
+	}{
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"fd00:ec2::254", true},
+		{"100.100.100.200", true},
+		{"0.0.0.0", true},
+		{"::ffff:127.0.0.1", true},
+		{"224.0.0.1", true},
+		{"198.18.0.1", true},
+		{"240.0.0.1", true},
+		{"255.255.255.255", true},
+		{"64:ff9b::7f00:1", true},
+		{"64:ff9b::a9fe:a9fe", true},
+		{"64:ff9b:1::5db8:d822", true},
+		{"2002:c0a8:101::1", true},
+		{"2002:a00:1::", true},
+		{"64:ff9b::5db8:d822", false},
+		{"2002:5db8:d822::1", false},
+		{"93.184.216.34", false},
+		{"2606:4700::1111", false},
+		{"172.32.0.1", false},
+	} {
+		if got := ForbiddenAddr(netip.MustParseAddr(tc.addr)); got != tc.forbidden {
+			t.Errorf("ForbiddenAddr(%s) = %v, want %v", tc.addr, got, tc.forbidden)
+		}
+	}
+}
+
+func TestClientRefusesInternalAddresses(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { hits++ }))
+	defer srv.Close()
+
+	result := NewClient().Deliver(context.Background(), srv.URL, "secret", "d-1", "share.created", []byte(`{}`))
+	if result.Success() || !errors.Is(result.Err, ErrForbiddenAddress) {
+		t.Fatalf("delivery to %s: %+v, want ErrForbiddenAddress", srv.URL, result)
+	}
+	if hits != 0 {
+		t.Errorf("endpoint received %d requests", hits)
+	}
+	if class := result.ErrorClass(); class != "address not allowed" {
+		t.Errorf("ErrorClass() = %q", class)
+	}
+}
+
+func TestClientDoesNotFollowRedirects(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("redirect was followed")
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	defer srv.Close()
+
+	// The default client's redirect policy on a transport that may reach the test servers
+	httpClient := NewClient().httpClient
+	httpClient.Transport = http.DefaultTransport
+	result := NewClient(WithHTTPClient(httpClient)).Deliver(context.Background(), srv.URL, "secret", "d-1", "share.created", []byte(`{}`))
+	if result.Success() || result.StatusCode != http.StatusFound {
+		t.Fatalf("delivery: %+v, want an unfollowed 302", result)
+	}
+	if class := result.ErrorClass(); class != "HTTP 3xx" {
+		t.Errorf("ErrorClass() = %q, want HTTP 3xx", class)
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderSignature carries the HMAC-SHA256 signature of the request ("sha256=<hex>").
+	HeaderSignature = "X-Tangra-Signature"
+	// HeaderTimestamp carries the Unix timestamp included in the signature.
+	HeaderTimestamp = "X-Tangra-Timestamp"
+	// HeaderEvent carries the event type.
+	HeaderEvent = "X-Tangra-Event"
+	// HeaderDelivery carries the delivery ID.
+	HeaderDelivery = "X-Tangra-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign computes the signature of a payload sent at the given Unix timestamp.
+// The signed message is "<timestamp>.<body>" so receivers can reject replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature produced by Sign and rejects timestamps older than tolerance.
+// A zero tolerance disables the timestamp check.
+func Verify(secret, signature string, timestamp int64, body []byte, tolerance time.Duration) bool {
+	if tolerance > 0 {
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return false
+		}
+	}
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// GenerateSecret generates a random 32-byte signing secret, hex-encoded.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	body := []byte(`{"id":"evt-1","type":"share.created"}`)
+	now := time.Now().Unix()
+
+	sig := Sign("whsec_test", now, body)
+	if !strings.HasPrefix(sig, "sha256=") || len(sig) != len("sha256=")+64 {
+		t.Fatalf("Sign() = %q, want sha256=<64 hex digits>", sig)
+	}
+	if Sign("whsec_test", now, body) != sig {
+		t.Error("Sign is not deterministic")
+	}
+	if !Verify("whsec_test", sig, now, body, 5*time.Minute) {
+		t.Error("Verify rejected a valid signature")
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	body := []byte(`{"id":"evt-1","type":"share.created"}`)
+	now := time.Now().Unix()
+	sig := Sign("whsec_test", now, body)
+	flipped := "0"
+	if strings.HasSuffix(sig, "0") {
+		flipped = "1"
+	}
+
+	for name, ok := range map[string]bool{
+		"body":            Verify("whsec_test", sig, now, []byte(`{"id":"evt-2","type":"share.created"}`), 5*time.Minute),
+		"timestamp":       Verify("whsec_test", sig, now+1, body, 5*time.Minute),
+		"secret":          Verify("whsec_other", sig, now, body, 5*time.Minute),
+		"signature":       Verify("whsec_test", sig[:len(sig)-1]+flipped, now, body, 5*time.Minute),
+		"missing prefix":  Verify("whsec_test", strings.TrimPrefix(sig, "sha256="), now, body, 5*time.Minute),
+		"empty signature": Verify("whsec_test", "", now, body, 5*time.Minute),
+	} {
+		if ok {
+			t.Errorf("tampered %s accepted", name)
+		}
+	}
+}
+
+func TestVerifyTimestampTolerance(t *testing.T) {
+	body := []byte(`{}`)
+	old := time.Now().Add(-10 * time.Minute).Unix()
+	future := time.Now().Add(10 * time.Minute).Unix()
+
+	if Verify("s", Sign("s", old, body), old, body, 5*time.Minute) {
+		t.Error("stale signature accepted")
+	}
+	if Verify("s", Sign("s", future, body), future, body, 5*time.Minute) {
+		t.Error("signature from the future accepted")
+	}
+	// A zero tolerance disables the timestamp check
+	if !Verify("s", Sign("s", old, body), old, body, 0) {
+		t.Error("stale signature rejected without a tolerance")
+	}
+}